@@ -178,6 +178,155 @@ var _ interface {
 	ErrorName() string
 } = TimeRangeValidationError{}
 
+// Validate checks the field values on EventFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *EventFilter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EventFilter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EventFilterMultiError, or
+// nil if none found.
+func (m *EventFilter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EventFilter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Username
+
+	// no validation rules for ServiceName
+
+	// no validation rules for MethodName
+
+	if _, ok := apiv1.ActionType_name[int32(m.GetActionType())]; !ok {
+		err := EventFilterValidationError{
+			field:  "ActionType",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ResourceTypeUrl
+
+	// no validation rules for ResourceIdPrefix
+
+	if all {
+		switch v := interface{}(m.GetStatusCode()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventFilterValidationError{
+					field:  "StatusCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventFilterValidationError{
+					field:  "StatusCode",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatusCode()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventFilterValidationError{
+				field:  "StatusCode",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EventFilterMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventFilterMultiError is an error wrapping multiple validation errors
+// returned by EventFilter.ValidateAll() if the designated constraints aren't met.
+type EventFilterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventFilterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventFilterMultiError) AllErrors() []error { return m }
+
+// EventFilterValidationError is the validation error returned by
+// EventFilter.Validate if the designated constraints aren't met.
+type EventFilterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventFilterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventFilterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventFilterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventFilterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventFilterValidationError) ErrorName() string { return "EventFilterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventFilterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEventFilter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventFilterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventFilterValidationError{}
+
 // Validate checks the field values on GetEventsRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -204,6 +353,35 @@ func (m *GetEventsRequest) validate(all bool) error {
 
 	// no validation rules for Limit
 
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetEventsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetEventsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetEventsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	switch v := m.Window.(type) {
 	case *GetEventsRequest_Range:
 		if v == nil {
@@ -1307,6 +1485,675 @@ var _ interface {
 	ErrorName() string
 } = GetEventsResponseValidationError{}
 
+// Validate checks the field values on ExportEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportEventsRequestMultiError, or nil if none found.
+func (m *ExportEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportEventsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportEventsRequestValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportEventsRequestValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if _, ok := _ExportEventsRequest_Format_NotInLookup[m.GetFormat()]; ok {
+		err := ExportEventsRequestValidationError{
+			field:  "Format",
+			reason: "value must not be in list [FORMAT_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ExportEventsRequest_Format_name[int32(m.GetFormat())]; !ok {
+		err := ExportEventsRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofWindowPresent := false
+	switch v := m.Window.(type) {
+	case *ExportEventsRequest_Range:
+		if v == nil {
+			err := ExportEventsRequestValidationError{
+				field:  "Window",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofWindowPresent = true
+
+		if all {
+			switch v := interface{}(m.GetRange()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportEventsRequestValidationError{
+						field:  "Range",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportEventsRequestValidationError{
+						field:  "Range",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRange()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportEventsRequestValidationError{
+					field:  "Range",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ExportEventsRequest_Since:
+		if v == nil {
+			err := ExportEventsRequestValidationError{
+				field:  "Window",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofWindowPresent = true
+
+		if all {
+			switch v := interface{}(m.GetSince()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportEventsRequestValidationError{
+						field:  "Since",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportEventsRequestValidationError{
+						field:  "Since",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportEventsRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofWindowPresent {
+		err := ExportEventsRequestValidationError{
+			field:  "Window",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportEventsRequestMultiError) AllErrors() []error { return m }
+
+// ExportEventsRequestValidationError is the validation error returned by
+// ExportEventsRequest.Validate if the designated constraints aren't met.
+type ExportEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportEventsRequestValidationError) ErrorName() string {
+	return "ExportEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportEventsRequestValidationError{}
+
+var _ExportEventsRequest_Format_NotInLookup = map[ExportEventsRequest_Format]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on VerifyAuditChainRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAuditChainRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAuditChainRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyAuditChainRequestMultiError, or nil if none found.
+func (m *VerifyAuditChainRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAuditChainRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetRange() == nil {
+		err := VerifyAuditChainRequestValidationError{
+			field:  "Range",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetRange()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VerifyAuditChainRequestValidationError{
+					field:  "Range",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VerifyAuditChainRequestValidationError{
+					field:  "Range",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRange()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VerifyAuditChainRequestValidationError{
+				field:  "Range",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return VerifyAuditChainRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyAuditChainRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyAuditChainRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyAuditChainRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAuditChainRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAuditChainRequestMultiError) AllErrors() []error { return m }
+
+// VerifyAuditChainRequestValidationError is the validation error returned by
+// VerifyAuditChainRequest.Validate if the designated constraints aren't met.
+type VerifyAuditChainRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAuditChainRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAuditChainRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAuditChainRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAuditChainRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAuditChainRequestValidationError) ErrorName() string {
+	return "VerifyAuditChainRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAuditChainRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAuditChainRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAuditChainRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAuditChainRequestValidationError{}
+
+// Validate checks the field values on BrokenChainLink with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BrokenChainLink) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BrokenChainLink with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BrokenChainLinkMultiError, or nil if none found.
+func (m *BrokenChainLink) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BrokenChainLink) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for EventId
+
+	// no validation rules for ChainIndex
+
+	if all {
+		switch v := interface{}(m.GetOccurredAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BrokenChainLinkValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BrokenChainLinkValidationError{
+					field:  "OccurredAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOccurredAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BrokenChainLinkValidationError{
+				field:  "OccurredAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return BrokenChainLinkMultiError(errors)
+	}
+
+	return nil
+}
+
+// BrokenChainLinkMultiError is an error wrapping multiple validation errors
+// returned by BrokenChainLink.ValidateAll() if the designated constraints
+// aren't met.
+type BrokenChainLinkMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BrokenChainLinkMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BrokenChainLinkMultiError) AllErrors() []error { return m }
+
+// BrokenChainLinkValidationError is the validation error returned by
+// BrokenChainLink.Validate if the designated constraints aren't met.
+type BrokenChainLinkValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrokenChainLinkValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrokenChainLinkValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrokenChainLinkValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrokenChainLinkValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrokenChainLinkValidationError) ErrorName() string { return "BrokenChainLinkValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BrokenChainLinkValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBrokenChainLink.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrokenChainLinkValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrokenChainLinkValidationError{}
+
+// Validate checks the field values on VerifyAuditChainResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyAuditChainResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyAuditChainResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyAuditChainResponseMultiError, or nil if none found.
+func (m *VerifyAuditChainResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyAuditChainResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for VerifiedEvents
+
+	if all {
+		switch v := interface{}(m.GetFirstBrokenLink()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, VerifyAuditChainResponseValidationError{
+					field:  "FirstBrokenLink",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, VerifyAuditChainResponseValidationError{
+					field:  "FirstBrokenLink",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstBrokenLink()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return VerifyAuditChainResponseValidationError{
+				field:  "FirstBrokenLink",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for UnchainedEvents
+
+	if len(errors) > 0 {
+		return VerifyAuditChainResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyAuditChainResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyAuditChainResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyAuditChainResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyAuditChainResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyAuditChainResponseMultiError) AllErrors() []error { return m }
+
+// VerifyAuditChainResponseValidationError is the validation error returned by
+// VerifyAuditChainResponse.Validate if the designated constraints aren't met.
+type VerifyAuditChainResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyAuditChainResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyAuditChainResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyAuditChainResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyAuditChainResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyAuditChainResponseValidationError) ErrorName() string {
+	return "VerifyAuditChainResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyAuditChainResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyAuditChainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyAuditChainResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyAuditChainResponseValidationError{}
+
 // Validate checks the field values on GetEventRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -8,6 +8,7 @@ package auditv1
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -19,8 +20,10 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	AuditAPI_GetEvents_FullMethodName = "/clutch.audit.v1.AuditAPI/GetEvents"
-	AuditAPI_GetEvent_FullMethodName  = "/clutch.audit.v1.AuditAPI/GetEvent"
+	AuditAPI_GetEvents_FullMethodName        = "/clutch.audit.v1.AuditAPI/GetEvents"
+	AuditAPI_GetEvent_FullMethodName         = "/clutch.audit.v1.AuditAPI/GetEvent"
+	AuditAPI_VerifyAuditChain_FullMethodName = "/clutch.audit.v1.AuditAPI/VerifyAuditChain"
+	AuditAPI_ExportEvents_FullMethodName     = "/clutch.audit.v1.AuditAPI/ExportEvents"
 )
 
 // AuditAPIClient is the client API for AuditAPI service.
@@ -29,6 +32,10 @@ const (
 type AuditAPIClient interface {
 	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
 	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
+	// Verifies the hash chain of the events of a time range, if the audit service is configured to chain them.
+	VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest, opts ...grpc.CallOption) (*VerifyAuditChainResponse, error)
+	// Streams the events of a time range in the requested format, in chunks of the exported file.
+	ExportEvents(ctx context.Context, in *ExportEventsRequest, opts ...grpc.CallOption) (AuditAPI_ExportEventsClient, error)
 }
 
 type auditAPIClient struct {
@@ -57,12 +64,57 @@ func (c *auditAPIClient) GetEvent(ctx context.Context, in *GetEventRequest, opts
 	return out, nil
 }
 
+func (c *auditAPIClient) VerifyAuditChain(ctx context.Context, in *VerifyAuditChainRequest, opts ...grpc.CallOption) (*VerifyAuditChainResponse, error) {
+	out := new(VerifyAuditChainResponse)
+	err := c.cc.Invoke(ctx, AuditAPI_VerifyAuditChain_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditAPIClient) ExportEvents(ctx context.Context, in *ExportEventsRequest, opts ...grpc.CallOption) (AuditAPI_ExportEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AuditAPI_ServiceDesc.Streams[0], AuditAPI_ExportEvents_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &auditAPIExportEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AuditAPI_ExportEventsClient interface {
+	Recv() (*httpbody.HttpBody, error)
+	grpc.ClientStream
+}
+
+type auditAPIExportEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *auditAPIExportEventsClient) Recv() (*httpbody.HttpBody, error) {
+	m := new(httpbody.HttpBody)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AuditAPIServer is the server API for AuditAPI service.
 // All implementations should embed UnimplementedAuditAPIServer
 // for forward compatibility
 type AuditAPIServer interface {
 	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
 	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
+	// Verifies the hash chain of the events of a time range, if the audit service is configured to chain them.
+	VerifyAuditChain(context.Context, *VerifyAuditChainRequest) (*VerifyAuditChainResponse, error)
+	// Streams the events of a time range in the requested format, in chunks of the exported file.
+	ExportEvents(*ExportEventsRequest, AuditAPI_ExportEventsServer) error
 }
 
 // UnimplementedAuditAPIServer should be embedded to have forward compatible implementations.
@@ -75,6 +127,12 @@ func (UnimplementedAuditAPIServer) GetEvents(context.Context, *GetEventsRequest)
 func (UnimplementedAuditAPIServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
 }
+func (UnimplementedAuditAPIServer) VerifyAuditChain(context.Context, *VerifyAuditChainRequest) (*VerifyAuditChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyAuditChain not implemented")
+}
+func (UnimplementedAuditAPIServer) ExportEvents(*ExportEventsRequest, AuditAPI_ExportEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportEvents not implemented")
+}
 
 // UnsafeAuditAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AuditAPIServer will
@@ -123,6 +181,45 @@ func _AuditAPI_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuditAPI_VerifyAuditChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyAuditChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditAPIServer).VerifyAuditChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditAPI_VerifyAuditChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditAPIServer).VerifyAuditChain(ctx, req.(*VerifyAuditChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditAPI_ExportEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuditAPIServer).ExportEvents(m, &auditAPIExportEventsServer{stream})
+}
+
+type AuditAPI_ExportEventsServer interface {
+	Send(*httpbody.HttpBody) error
+	grpc.ServerStream
+}
+
+type auditAPIExportEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *auditAPIExportEventsServer) Send(m *httpbody.HttpBody) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // AuditAPI_ServiceDesc is the grpc.ServiceDesc for AuditAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -138,7 +235,17 @@ var AuditAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetEvent",
 			Handler:    _AuditAPI_GetEvent_Handler,
 		},
+		{
+			MethodName: "VerifyAuditChain",
+			Handler:    _AuditAPI_VerifyAuditChain_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportEvents",
+			Handler:       _AuditAPI_ExportEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "audit/v1/audit.proto",
 }
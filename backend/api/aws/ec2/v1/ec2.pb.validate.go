@@ -591,6 +591,1809 @@ var _ interface {
 	ErrorName() string
 } = AutoscalingGroupValidationError{}
 
+// Validate checks the field values on ScheduledAction with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ScheduledAction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduledAction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduledActionMultiError, or nil if none found.
+func (m *ScheduledAction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduledAction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for AutoscalingGroupName
+
+	// no validation rules for Region
+
+	// no validation rules for Account
+
+	// no validation rules for Arn
+
+	// no validation rules for Recurrence
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledActionValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledActionValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TimeZone
+
+	if all {
+		switch v := interface{}(m.GetMinSize()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "MinSize",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "MinSize",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinSize()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledActionValidationError{
+				field:  "MinSize",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMaxSize()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "MaxSize",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "MaxSize",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxSize()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledActionValidationError{
+				field:  "MaxSize",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDesiredCapacity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "DesiredCapacity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledActionValidationError{
+					field:  "DesiredCapacity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDesiredCapacity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledActionValidationError{
+				field:  "DesiredCapacity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduledActionMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduledActionMultiError is an error wrapping multiple validation errors
+// returned by ScheduledAction.ValidateAll() if the designated constraints
+// aren't met.
+type ScheduledActionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduledActionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduledActionMultiError) AllErrors() []error { return m }
+
+// ScheduledActionValidationError is the validation error returned by
+// ScheduledAction.Validate if the designated constraints aren't met.
+type ScheduledActionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduledActionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduledActionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduledActionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduledActionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduledActionValidationError) ErrorName() string { return "ScheduledActionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ScheduledActionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduledAction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduledActionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduledActionValidationError{}
+
+// Validate checks the field values on WarmPool with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WarmPool) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarmPool with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WarmPoolMultiError, or nil
+// if none found.
+func (m *WarmPool) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarmPool) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MinSize
+
+	if all {
+		switch v := interface{}(m.GetMaxGroupPreparedCapacity()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WarmPoolValidationError{
+					field:  "MaxGroupPreparedCapacity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WarmPoolValidationError{
+					field:  "MaxGroupPreparedCapacity",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMaxGroupPreparedCapacity()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WarmPoolValidationError{
+				field:  "MaxGroupPreparedCapacity",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PoolState
+
+	// no validation rules for ReuseOnScaleIn
+
+	// no validation rules for PendingDelete
+
+	for idx, item := range m.GetInstances() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WarmPoolValidationError{
+						field:  fmt.Sprintf("Instances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WarmPoolValidationError{
+						field:  fmt.Sprintf("Instances[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WarmPoolValidationError{
+					field:  fmt.Sprintf("Instances[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WarmPoolMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarmPoolMultiError is an error wrapping multiple validation errors returned
+// by WarmPool.ValidateAll() if the designated constraints aren't met.
+type WarmPoolMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarmPoolMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarmPoolMultiError) AllErrors() []error { return m }
+
+// WarmPoolValidationError is the validation error returned by
+// WarmPool.Validate if the designated constraints aren't met.
+type WarmPoolValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarmPoolValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarmPoolValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarmPoolValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarmPoolValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarmPoolValidationError) ErrorName() string { return "WarmPoolValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WarmPoolValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarmPool.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarmPoolValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarmPoolValidationError{}
+
+// Validate checks the field values on ListScheduledActionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScheduledActionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledActionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScheduledActionsRequestMultiError, or nil if none found.
+func (m *ListScheduledActionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledActionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := ListScheduledActionsRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := ListScheduledActionsRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := ListScheduledActionsRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListScheduledActionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScheduledActionsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListScheduledActionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListScheduledActionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledActionsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledActionsRequestMultiError) AllErrors() []error { return m }
+
+// ListScheduledActionsRequestValidationError is the validation error returned
+// by ListScheduledActionsRequest.Validate if the designated constraints
+// aren't met.
+type ListScheduledActionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledActionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledActionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledActionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledActionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledActionsRequestValidationError) ErrorName() string {
+	return "ListScheduledActionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledActionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledActionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScheduledActionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledActionsRequestValidationError{}
+
+// Validate checks the field values on ListScheduledActionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListScheduledActionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledActionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListScheduledActionsResponseMultiError, or nil if none found.
+func (m *ListScheduledActionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledActionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetScheduledActions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListScheduledActionsResponseValidationError{
+						field:  fmt.Sprintf("ScheduledActions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListScheduledActionsResponseValidationError{
+						field:  fmt.Sprintf("ScheduledActions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListScheduledActionsResponseValidationError{
+					field:  fmt.Sprintf("ScheduledActions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListScheduledActionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScheduledActionsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListScheduledActionsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListScheduledActionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledActionsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledActionsResponseMultiError) AllErrors() []error { return m }
+
+// ListScheduledActionsResponseValidationError is the validation error returned
+// by ListScheduledActionsResponse.Validate if the designated constraints
+// aren't met.
+type ListScheduledActionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledActionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledActionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledActionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledActionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledActionsResponseValidationError) ErrorName() string {
+	return "ListScheduledActionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledActionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledActionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListScheduledActionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledActionsResponseValidationError{}
+
+// Validate checks the field values on CreateScheduledActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateScheduledActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateScheduledActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateScheduledActionRequestMultiError, or nil if none found.
+func (m *CreateScheduledActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateScheduledActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := CreateScheduledActionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := CreateScheduledActionRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := CreateScheduledActionRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetScheduledAction() == nil {
+		err := CreateScheduledActionRequestValidationError{
+			field:  "ScheduledAction",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetScheduledAction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateScheduledActionRequestValidationError{
+					field:  "ScheduledAction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateScheduledActionRequestValidationError{
+					field:  "ScheduledAction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledAction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateScheduledActionRequestValidationError{
+				field:  "ScheduledAction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateScheduledActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateScheduledActionRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateScheduledActionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateScheduledActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateScheduledActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateScheduledActionRequestMultiError) AllErrors() []error { return m }
+
+// CreateScheduledActionRequestValidationError is the validation error returned
+// by CreateScheduledActionRequest.Validate if the designated constraints
+// aren't met.
+type CreateScheduledActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScheduledActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScheduledActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScheduledActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScheduledActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScheduledActionRequestValidationError) ErrorName() string {
+	return "CreateScheduledActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScheduledActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScheduledActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScheduledActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScheduledActionRequestValidationError{}
+
+// Validate checks the field values on CreateScheduledActionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateScheduledActionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateScheduledActionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateScheduledActionResponseMultiError, or nil if none found.
+func (m *CreateScheduledActionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateScheduledActionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetScheduledAction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateScheduledActionResponseValidationError{
+					field:  "ScheduledAction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateScheduledActionResponseValidationError{
+					field:  "ScheduledAction",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetScheduledAction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateScheduledActionResponseValidationError{
+				field:  "ScheduledAction",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateScheduledActionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateScheduledActionResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateScheduledActionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type CreateScheduledActionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateScheduledActionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateScheduledActionResponseMultiError) AllErrors() []error { return m }
+
+// CreateScheduledActionResponseValidationError is the validation error
+// returned by CreateScheduledActionResponse.Validate if the designated
+// constraints aren't met.
+type CreateScheduledActionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateScheduledActionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateScheduledActionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateScheduledActionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateScheduledActionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateScheduledActionResponseValidationError) ErrorName() string {
+	return "CreateScheduledActionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateScheduledActionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateScheduledActionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateScheduledActionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateScheduledActionResponseValidationError{}
+
+// Validate checks the field values on DeleteScheduledActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteScheduledActionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteScheduledActionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteScheduledActionRequestMultiError, or nil if none found.
+func (m *DeleteScheduledActionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteScheduledActionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := DeleteScheduledActionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAutoscalingGroupName()) < 1 {
+		err := DeleteScheduledActionRequestValidationError{
+			field:  "AutoscalingGroupName",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := DeleteScheduledActionRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DeleteScheduledActionRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteScheduledActionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteScheduledActionRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteScheduledActionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DeleteScheduledActionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteScheduledActionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteScheduledActionRequestMultiError) AllErrors() []error { return m }
+
+// DeleteScheduledActionRequestValidationError is the validation error returned
+// by DeleteScheduledActionRequest.Validate if the designated constraints
+// aren't met.
+type DeleteScheduledActionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteScheduledActionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteScheduledActionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteScheduledActionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteScheduledActionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteScheduledActionRequestValidationError) ErrorName() string {
+	return "DeleteScheduledActionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteScheduledActionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteScheduledActionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteScheduledActionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteScheduledActionRequestValidationError{}
+
+// Validate checks the field values on DeleteScheduledActionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteScheduledActionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteScheduledActionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteScheduledActionResponseMultiError, or nil if none found.
+func (m *DeleteScheduledActionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteScheduledActionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteScheduledActionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteScheduledActionResponseMultiError is an error wrapping multiple
+// validation errors returned by DeleteScheduledActionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteScheduledActionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteScheduledActionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteScheduledActionResponseMultiError) AllErrors() []error { return m }
+
+// DeleteScheduledActionResponseValidationError is the validation error
+// returned by DeleteScheduledActionResponse.Validate if the designated
+// constraints aren't met.
+type DeleteScheduledActionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteScheduledActionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteScheduledActionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteScheduledActionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteScheduledActionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteScheduledActionResponseValidationError) ErrorName() string {
+	return "DeleteScheduledActionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteScheduledActionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteScheduledActionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteScheduledActionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteScheduledActionResponseValidationError{}
+
+// Validate checks the field values on DescribeWarmPoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeWarmPoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeWarmPoolRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeWarmPoolRequestMultiError, or nil if none found.
+func (m *DescribeWarmPoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeWarmPoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := DescribeWarmPoolRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := DescribeWarmPoolRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DescribeWarmPoolRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeWarmPoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeWarmPoolRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeWarmPoolRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeWarmPoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeWarmPoolRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeWarmPoolRequestMultiError) AllErrors() []error { return m }
+
+// DescribeWarmPoolRequestValidationError is the validation error returned by
+// DescribeWarmPoolRequest.Validate if the designated constraints aren't met.
+type DescribeWarmPoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeWarmPoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeWarmPoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeWarmPoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeWarmPoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeWarmPoolRequestValidationError) ErrorName() string {
+	return "DescribeWarmPoolRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeWarmPoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeWarmPoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeWarmPoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeWarmPoolRequestValidationError{}
+
+// Validate checks the field values on DescribeWarmPoolResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeWarmPoolResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeWarmPoolResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeWarmPoolResponseMultiError, or nil if none found.
+func (m *DescribeWarmPoolResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeWarmPoolResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetWarmPool()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeWarmPoolResponseValidationError{
+					field:  "WarmPool",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeWarmPoolResponseValidationError{
+					field:  "WarmPool",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWarmPool()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeWarmPoolResponseValidationError{
+				field:  "WarmPool",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeWarmPoolResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeWarmPoolResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeWarmPoolResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeWarmPoolResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeWarmPoolResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeWarmPoolResponseMultiError) AllErrors() []error { return m }
+
+// DescribeWarmPoolResponseValidationError is the validation error returned by
+// DescribeWarmPoolResponse.Validate if the designated constraints aren't met.
+type DescribeWarmPoolResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeWarmPoolResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeWarmPoolResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeWarmPoolResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeWarmPoolResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeWarmPoolResponseValidationError) ErrorName() string {
+	return "DescribeWarmPoolResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeWarmPoolResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeWarmPoolResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeWarmPoolResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeWarmPoolResponseValidationError{}
+
+// Validate checks the field values on UpdateWarmPoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateWarmPoolRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateWarmPoolRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateWarmPoolRequestMultiError, or nil if none found.
+func (m *UpdateWarmPoolRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateWarmPoolRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := UpdateWarmPoolRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := UpdateWarmPoolRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := UpdateWarmPoolRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetWarmPool() == nil {
+		err := UpdateWarmPoolRequestValidationError{
+			field:  "WarmPool",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetWarmPool()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateWarmPoolRequestValidationError{
+					field:  "WarmPool",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateWarmPoolRequestValidationError{
+					field:  "WarmPool",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWarmPool()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateWarmPoolRequestValidationError{
+				field:  "WarmPool",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateWarmPoolRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateWarmPoolRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateWarmPoolRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateWarmPoolRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateWarmPoolRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateWarmPoolRequestMultiError) AllErrors() []error { return m }
+
+// UpdateWarmPoolRequestValidationError is the validation error returned by
+// UpdateWarmPoolRequest.Validate if the designated constraints aren't met.
+type UpdateWarmPoolRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateWarmPoolRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateWarmPoolRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateWarmPoolRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateWarmPoolRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateWarmPoolRequestValidationError) ErrorName() string {
+	return "UpdateWarmPoolRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateWarmPoolRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateWarmPoolRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateWarmPoolRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateWarmPoolRequestValidationError{}
+
+// Validate checks the field values on UpdateWarmPoolResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateWarmPoolResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateWarmPoolResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateWarmPoolResponseMultiError, or nil if none found.
+func (m *UpdateWarmPoolResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateWarmPoolResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return UpdateWarmPoolResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateWarmPoolResponseMultiError is an error wrapping multiple validation
+// errors returned by UpdateWarmPoolResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateWarmPoolResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateWarmPoolResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateWarmPoolResponseMultiError) AllErrors() []error { return m }
+
+// UpdateWarmPoolResponseValidationError is the validation error returned by
+// UpdateWarmPoolResponse.Validate if the designated constraints aren't met.
+type UpdateWarmPoolResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateWarmPoolResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateWarmPoolResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateWarmPoolResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateWarmPoolResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateWarmPoolResponseValidationError) ErrorName() string {
+	return "UpdateWarmPoolResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateWarmPoolResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateWarmPoolResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateWarmPoolResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateWarmPoolResponseValidationError{}
+
 // Validate checks the field values on GetInstanceRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -646,6 +2449,8 @@ func (m *GetInstanceRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for IncludeTargetHealth
+
 	if len(errors) > 0 {
 		return GetInstanceRequestMultiError(errors)
 	}
@@ -1371,6 +3176,40 @@ func (m *Instance) validate(all bool) error {
 
 	// no validation rules for Account
 
+	for idx, item := range m.GetTargetHealth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InstanceValidationError{
+						field:  fmt.Sprintf("TargetHealth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InstanceValidationError{
+						field:  fmt.Sprintf("TargetHealth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InstanceValidationError{
+					field:  fmt.Sprintf("TargetHealth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return InstanceMultiError(errors)
 	}
@@ -1559,3 +3398,113 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AutoscalingGroup_InstanceValidationError{}
+
+// Validate checks the field values on WarmPool_Instance with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *WarmPool_Instance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WarmPool_Instance with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WarmPool_InstanceMultiError, or nil if none found.
+func (m *WarmPool_Instance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WarmPool_Instance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Zone
+
+	// no validation rules for LifecycleState
+
+	// no validation rules for Healthy
+
+	if len(errors) > 0 {
+		return WarmPool_InstanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// WarmPool_InstanceMultiError is an error wrapping multiple validation errors
+// returned by WarmPool_Instance.ValidateAll() if the designated constraints
+// aren't met.
+type WarmPool_InstanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WarmPool_InstanceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WarmPool_InstanceMultiError) AllErrors() []error { return m }
+
+// WarmPool_InstanceValidationError is the validation error returned by
+// WarmPool_Instance.Validate if the designated constraints aren't met.
+type WarmPool_InstanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WarmPool_InstanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WarmPool_InstanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WarmPool_InstanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WarmPool_InstanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WarmPool_InstanceValidationError) ErrorName() string {
+	return "WarmPool_InstanceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WarmPool_InstanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWarmPool_Instance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WarmPool_InstanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WarmPool_InstanceValidationError{}
@@ -23,6 +23,11 @@ const (
 	EC2API_TerminateInstance_FullMethodName      = "/clutch.aws.ec2.v1.EC2API/TerminateInstance"
 	EC2API_ResizeAutoscalingGroup_FullMethodName = "/clutch.aws.ec2.v1.EC2API/ResizeAutoscalingGroup"
 	EC2API_RebootInstance_FullMethodName         = "/clutch.aws.ec2.v1.EC2API/RebootInstance"
+	EC2API_ListScheduledActions_FullMethodName   = "/clutch.aws.ec2.v1.EC2API/ListScheduledActions"
+	EC2API_CreateScheduledAction_FullMethodName  = "/clutch.aws.ec2.v1.EC2API/CreateScheduledAction"
+	EC2API_DeleteScheduledAction_FullMethodName  = "/clutch.aws.ec2.v1.EC2API/DeleteScheduledAction"
+	EC2API_DescribeWarmPool_FullMethodName       = "/clutch.aws.ec2.v1.EC2API/DescribeWarmPool"
+	EC2API_UpdateWarmPool_FullMethodName         = "/clutch.aws.ec2.v1.EC2API/UpdateWarmPool"
 )
 
 // EC2APIClient is the client API for EC2API service.
@@ -33,6 +38,11 @@ type EC2APIClient interface {
 	TerminateInstance(ctx context.Context, in *TerminateInstanceRequest, opts ...grpc.CallOption) (*TerminateInstanceResponse, error)
 	ResizeAutoscalingGroup(ctx context.Context, in *ResizeAutoscalingGroupRequest, opts ...grpc.CallOption) (*ResizeAutoscalingGroupResponse, error)
 	RebootInstance(ctx context.Context, in *RebootInstanceRequest, opts ...grpc.CallOption) (*RebootInstanceResponse, error)
+	ListScheduledActions(ctx context.Context, in *ListScheduledActionsRequest, opts ...grpc.CallOption) (*ListScheduledActionsResponse, error)
+	CreateScheduledAction(ctx context.Context, in *CreateScheduledActionRequest, opts ...grpc.CallOption) (*CreateScheduledActionResponse, error)
+	DeleteScheduledAction(ctx context.Context, in *DeleteScheduledActionRequest, opts ...grpc.CallOption) (*DeleteScheduledActionResponse, error)
+	DescribeWarmPool(ctx context.Context, in *DescribeWarmPoolRequest, opts ...grpc.CallOption) (*DescribeWarmPoolResponse, error)
+	UpdateWarmPool(ctx context.Context, in *UpdateWarmPoolRequest, opts ...grpc.CallOption) (*UpdateWarmPoolResponse, error)
 }
 
 type eC2APIClient struct {
@@ -79,6 +89,51 @@ func (c *eC2APIClient) RebootInstance(ctx context.Context, in *RebootInstanceReq
 	return out, nil
 }
 
+func (c *eC2APIClient) ListScheduledActions(ctx context.Context, in *ListScheduledActionsRequest, opts ...grpc.CallOption) (*ListScheduledActionsResponse, error) {
+	out := new(ListScheduledActionsResponse)
+	err := c.cc.Invoke(ctx, EC2API_ListScheduledActions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eC2APIClient) CreateScheduledAction(ctx context.Context, in *CreateScheduledActionRequest, opts ...grpc.CallOption) (*CreateScheduledActionResponse, error) {
+	out := new(CreateScheduledActionResponse)
+	err := c.cc.Invoke(ctx, EC2API_CreateScheduledAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eC2APIClient) DeleteScheduledAction(ctx context.Context, in *DeleteScheduledActionRequest, opts ...grpc.CallOption) (*DeleteScheduledActionResponse, error) {
+	out := new(DeleteScheduledActionResponse)
+	err := c.cc.Invoke(ctx, EC2API_DeleteScheduledAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eC2APIClient) DescribeWarmPool(ctx context.Context, in *DescribeWarmPoolRequest, opts ...grpc.CallOption) (*DescribeWarmPoolResponse, error) {
+	out := new(DescribeWarmPoolResponse)
+	err := c.cc.Invoke(ctx, EC2API_DescribeWarmPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eC2APIClient) UpdateWarmPool(ctx context.Context, in *UpdateWarmPoolRequest, opts ...grpc.CallOption) (*UpdateWarmPoolResponse, error) {
+	out := new(UpdateWarmPoolResponse)
+	err := c.cc.Invoke(ctx, EC2API_UpdateWarmPool_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EC2APIServer is the server API for EC2API service.
 // All implementations should embed UnimplementedEC2APIServer
 // for forward compatibility
@@ -87,6 +142,11 @@ type EC2APIServer interface {
 	TerminateInstance(context.Context, *TerminateInstanceRequest) (*TerminateInstanceResponse, error)
 	ResizeAutoscalingGroup(context.Context, *ResizeAutoscalingGroupRequest) (*ResizeAutoscalingGroupResponse, error)
 	RebootInstance(context.Context, *RebootInstanceRequest) (*RebootInstanceResponse, error)
+	ListScheduledActions(context.Context, *ListScheduledActionsRequest) (*ListScheduledActionsResponse, error)
+	CreateScheduledAction(context.Context, *CreateScheduledActionRequest) (*CreateScheduledActionResponse, error)
+	DeleteScheduledAction(context.Context, *DeleteScheduledActionRequest) (*DeleteScheduledActionResponse, error)
+	DescribeWarmPool(context.Context, *DescribeWarmPoolRequest) (*DescribeWarmPoolResponse, error)
+	UpdateWarmPool(context.Context, *UpdateWarmPoolRequest) (*UpdateWarmPoolResponse, error)
 }
 
 // UnimplementedEC2APIServer should be embedded to have forward compatible implementations.
@@ -105,6 +165,21 @@ func (UnimplementedEC2APIServer) ResizeAutoscalingGroup(context.Context, *Resize
 func (UnimplementedEC2APIServer) RebootInstance(context.Context, *RebootInstanceRequest) (*RebootInstanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RebootInstance not implemented")
 }
+func (UnimplementedEC2APIServer) ListScheduledActions(context.Context, *ListScheduledActionsRequest) (*ListScheduledActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledActions not implemented")
+}
+func (UnimplementedEC2APIServer) CreateScheduledAction(context.Context, *CreateScheduledActionRequest) (*CreateScheduledActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduledAction not implemented")
+}
+func (UnimplementedEC2APIServer) DeleteScheduledAction(context.Context, *DeleteScheduledActionRequest) (*DeleteScheduledActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduledAction not implemented")
+}
+func (UnimplementedEC2APIServer) DescribeWarmPool(context.Context, *DescribeWarmPoolRequest) (*DescribeWarmPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeWarmPool not implemented")
+}
+func (UnimplementedEC2APIServer) UpdateWarmPool(context.Context, *UpdateWarmPoolRequest) (*UpdateWarmPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWarmPool not implemented")
+}
 
 // UnsafeEC2APIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EC2APIServer will
@@ -189,6 +264,96 @@ func _EC2API_RebootInstance_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EC2API_ListScheduledActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EC2APIServer).ListScheduledActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EC2API_ListScheduledActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EC2APIServer).ListScheduledActions(ctx, req.(*ListScheduledActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EC2API_CreateScheduledAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduledActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EC2APIServer).CreateScheduledAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EC2API_CreateScheduledAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EC2APIServer).CreateScheduledAction(ctx, req.(*CreateScheduledActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EC2API_DeleteScheduledAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduledActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EC2APIServer).DeleteScheduledAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EC2API_DeleteScheduledAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EC2APIServer).DeleteScheduledAction(ctx, req.(*DeleteScheduledActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EC2API_DescribeWarmPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeWarmPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EC2APIServer).DescribeWarmPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EC2API_DescribeWarmPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EC2APIServer).DescribeWarmPool(ctx, req.(*DescribeWarmPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EC2API_UpdateWarmPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWarmPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EC2APIServer).UpdateWarmPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EC2API_UpdateWarmPool_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EC2APIServer).UpdateWarmPool(ctx, req.(*UpdateWarmPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EC2API_ServiceDesc is the grpc.ServiceDesc for EC2API service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -212,6 +377,26 @@ var EC2API_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RebootInstance",
 			Handler:    _EC2API_RebootInstance_Handler,
 		},
+		{
+			MethodName: "ListScheduledActions",
+			Handler:    _EC2API_ListScheduledActions_Handler,
+		},
+		{
+			MethodName: "CreateScheduledAction",
+			Handler:    _EC2API_CreateScheduledAction_Handler,
+		},
+		{
+			MethodName: "DeleteScheduledAction",
+			Handler:    _EC2API_DeleteScheduledAction_Handler,
+		},
+		{
+			MethodName: "DescribeWarmPool",
+			Handler:    _EC2API_DescribeWarmPool_Handler,
+		},
+		{
+			MethodName: "UpdateWarmPool",
+			Handler:    _EC2API_UpdateWarmPool_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "aws/ec2/v1/ec2.proto",
@@ -0,0 +1,2361 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.17.3
+// source: aws/elbv2/v1/elbv2.proto
+
+package elbv2v1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "github.com/lyft/clutch/backend/api/api/v1"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_LoadBalancer.html
+type LoadBalancer_Type int32
+
+const (
+	LoadBalancer_UNSPECIFIED LoadBalancer_Type = 0
+	LoadBalancer_UNKNOWN     LoadBalancer_Type = 1
+	LoadBalancer_APPLICATION LoadBalancer_Type = 2
+	LoadBalancer_NETWORK     LoadBalancer_Type = 3
+	LoadBalancer_GATEWAY     LoadBalancer_Type = 4
+)
+
+// Enum value maps for LoadBalancer_Type.
+var (
+	LoadBalancer_Type_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "UNKNOWN",
+		2: "APPLICATION",
+		3: "NETWORK",
+		4: "GATEWAY",
+	}
+	LoadBalancer_Type_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"UNKNOWN":     1,
+		"APPLICATION": 2,
+		"NETWORK":     3,
+		"GATEWAY":     4,
+	}
+)
+
+func (x LoadBalancer_Type) Enum() *LoadBalancer_Type {
+	p := new(LoadBalancer_Type)
+	*p = x
+	return p
+}
+
+func (x LoadBalancer_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoadBalancer_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_aws_elbv2_v1_elbv2_proto_enumTypes[0].Descriptor()
+}
+
+func (LoadBalancer_Type) Type() protoreflect.EnumType {
+	return &file_aws_elbv2_v1_elbv2_proto_enumTypes[0]
+}
+
+func (x LoadBalancer_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoadBalancer_Type.Descriptor instead.
+func (LoadBalancer_Type) EnumDescriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type LoadBalancer_Scheme int32
+
+const (
+	LoadBalancer_SCHEME_UNSPECIFIED LoadBalancer_Scheme = 0
+	LoadBalancer_SCHEME_UNKNOWN     LoadBalancer_Scheme = 1
+	LoadBalancer_INTERNET_FACING    LoadBalancer_Scheme = 2
+	LoadBalancer_INTERNAL           LoadBalancer_Scheme = 3
+)
+
+// Enum value maps for LoadBalancer_Scheme.
+var (
+	LoadBalancer_Scheme_name = map[int32]string{
+		0: "SCHEME_UNSPECIFIED",
+		1: "SCHEME_UNKNOWN",
+		2: "INTERNET_FACING",
+		3: "INTERNAL",
+	}
+	LoadBalancer_Scheme_value = map[string]int32{
+		"SCHEME_UNSPECIFIED": 0,
+		"SCHEME_UNKNOWN":     1,
+		"INTERNET_FACING":    2,
+		"INTERNAL":           3,
+	}
+)
+
+func (x LoadBalancer_Scheme) Enum() *LoadBalancer_Scheme {
+	p := new(LoadBalancer_Scheme)
+	*p = x
+	return p
+}
+
+func (x LoadBalancer_Scheme) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoadBalancer_Scheme) Descriptor() protoreflect.EnumDescriptor {
+	return file_aws_elbv2_v1_elbv2_proto_enumTypes[1].Descriptor()
+}
+
+func (LoadBalancer_Scheme) Type() protoreflect.EnumType {
+	return &file_aws_elbv2_v1_elbv2_proto_enumTypes[1]
+}
+
+func (x LoadBalancer_Scheme) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoadBalancer_Scheme.Descriptor instead.
+func (LoadBalancer_Scheme) EnumDescriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{0, 1}
+}
+
+// https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_LoadBalancerState.html
+type LoadBalancer_State int32
+
+const (
+	LoadBalancer_STATE_UNSPECIFIED LoadBalancer_State = 0
+	LoadBalancer_STATE_UNKNOWN     LoadBalancer_State = 1
+	LoadBalancer_ACTIVE            LoadBalancer_State = 2
+	LoadBalancer_PROVISIONING      LoadBalancer_State = 3
+	LoadBalancer_ACTIVE_IMPAIRED   LoadBalancer_State = 4
+	LoadBalancer_FAILED            LoadBalancer_State = 5
+)
+
+// Enum value maps for LoadBalancer_State.
+var (
+	LoadBalancer_State_name = map[int32]string{
+		0: "STATE_UNSPECIFIED",
+		1: "STATE_UNKNOWN",
+		2: "ACTIVE",
+		3: "PROVISIONING",
+		4: "ACTIVE_IMPAIRED",
+		5: "FAILED",
+	}
+	LoadBalancer_State_value = map[string]int32{
+		"STATE_UNSPECIFIED": 0,
+		"STATE_UNKNOWN":     1,
+		"ACTIVE":            2,
+		"PROVISIONING":      3,
+		"ACTIVE_IMPAIRED":   4,
+		"FAILED":            5,
+	}
+)
+
+func (x LoadBalancer_State) Enum() *LoadBalancer_State {
+	p := new(LoadBalancer_State)
+	*p = x
+	return p
+}
+
+func (x LoadBalancer_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoadBalancer_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_aws_elbv2_v1_elbv2_proto_enumTypes[2].Descriptor()
+}
+
+func (LoadBalancer_State) Type() protoreflect.EnumType {
+	return &file_aws_elbv2_v1_elbv2_proto_enumTypes[2]
+}
+
+func (x LoadBalancer_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoadBalancer_State.Descriptor instead.
+func (LoadBalancer_State) EnumDescriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{0, 2}
+}
+
+type TargetGroup_TargetType int32
+
+const (
+	TargetGroup_UNSPECIFIED TargetGroup_TargetType = 0
+	TargetGroup_UNKNOWN     TargetGroup_TargetType = 1
+	TargetGroup_INSTANCE    TargetGroup_TargetType = 2
+	TargetGroup_IP          TargetGroup_TargetType = 3
+	TargetGroup_LAMBDA      TargetGroup_TargetType = 4
+	TargetGroup_ALB         TargetGroup_TargetType = 5
+)
+
+// Enum value maps for TargetGroup_TargetType.
+var (
+	TargetGroup_TargetType_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "UNKNOWN",
+		2: "INSTANCE",
+		3: "IP",
+		4: "LAMBDA",
+		5: "ALB",
+	}
+	TargetGroup_TargetType_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"UNKNOWN":     1,
+		"INSTANCE":    2,
+		"IP":          3,
+		"LAMBDA":      4,
+		"ALB":         5,
+	}
+)
+
+func (x TargetGroup_TargetType) Enum() *TargetGroup_TargetType {
+	p := new(TargetGroup_TargetType)
+	*p = x
+	return p
+}
+
+func (x TargetGroup_TargetType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetGroup_TargetType) Descriptor() protoreflect.EnumDescriptor {
+	return file_aws_elbv2_v1_elbv2_proto_enumTypes[3].Descriptor()
+}
+
+func (TargetGroup_TargetType) Type() protoreflect.EnumType {
+	return &file_aws_elbv2_v1_elbv2_proto_enumTypes[3]
+}
+
+func (x TargetGroup_TargetType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TargetGroup_TargetType.Descriptor instead.
+func (TargetGroup_TargetType) EnumDescriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{3, 0}
+}
+
+// https://docs.aws.amazon.com/elasticloadbalancing/latest/APIReference/API_TargetHealth.html
+type TargetHealth_State int32
+
+const (
+	TargetHealth_UNSPECIFIED        TargetHealth_State = 0
+	TargetHealth_UNKNOWN            TargetHealth_State = 1
+	TargetHealth_INITIAL            TargetHealth_State = 2
+	TargetHealth_HEALTHY            TargetHealth_State = 3
+	TargetHealth_UNHEALTHY          TargetHealth_State = 4
+	TargetHealth_UNHEALTHY_DRAINING TargetHealth_State = 5
+	TargetHealth_UNUSED             TargetHealth_State = 6
+	TargetHealth_DRAINING           TargetHealth_State = 7
+	TargetHealth_UNAVAILABLE        TargetHealth_State = 8
+)
+
+// Enum value maps for TargetHealth_State.
+var (
+	TargetHealth_State_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "UNKNOWN",
+		2: "INITIAL",
+		3: "HEALTHY",
+		4: "UNHEALTHY",
+		5: "UNHEALTHY_DRAINING",
+		6: "UNUSED",
+		7: "DRAINING",
+		8: "UNAVAILABLE",
+	}
+	TargetHealth_State_value = map[string]int32{
+		"UNSPECIFIED":        0,
+		"UNKNOWN":            1,
+		"INITIAL":            2,
+		"HEALTHY":            3,
+		"UNHEALTHY":          4,
+		"UNHEALTHY_DRAINING": 5,
+		"UNUSED":             6,
+		"DRAINING":           7,
+		"UNAVAILABLE":        8,
+	}
+)
+
+func (x TargetHealth_State) Enum() *TargetHealth_State {
+	p := new(TargetHealth_State)
+	*p = x
+	return p
+}
+
+func (x TargetHealth_State) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetHealth_State) Descriptor() protoreflect.EnumDescriptor {
+	return file_aws_elbv2_v1_elbv2_proto_enumTypes[4].Descriptor()
+}
+
+func (TargetHealth_State) Type() protoreflect.EnumType {
+	return &file_aws_elbv2_v1_elbv2_proto_enumTypes[4]
+}
+
+func (x TargetHealth_State) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TargetHealth_State.Descriptor instead.
+func (TargetHealth_State) EnumDescriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{5, 0}
+}
+
+// The reason code is only set when the target is not in the HEALTHY state.
+type TargetHealth_Reason int32
+
+const (
+	TargetHealth_REASON_UNSPECIFIED                TargetHealth_Reason = 0
+	TargetHealth_REASON_UNKNOWN                    TargetHealth_Reason = 1
+	TargetHealth_ELB_REGISTRATION_IN_PROGRESS      TargetHealth_Reason = 2
+	TargetHealth_ELB_INITIAL_HEALTH_CHECKING       TargetHealth_Reason = 3
+	TargetHealth_ELB_INTERNAL_ERROR                TargetHealth_Reason = 4
+	TargetHealth_TARGET_RESPONSE_CODE_MISMATCH     TargetHealth_Reason = 5
+	TargetHealth_TARGET_TIMEOUT                    TargetHealth_Reason = 6
+	TargetHealth_TARGET_FAILED_HEALTH_CHECKS       TargetHealth_Reason = 7
+	TargetHealth_TARGET_NOT_REGISTERED             TargetHealth_Reason = 8
+	TargetHealth_TARGET_NOT_IN_USE                 TargetHealth_Reason = 9
+	TargetHealth_TARGET_DEREGISTRATION_IN_PROGRESS TargetHealth_Reason = 10
+	TargetHealth_TARGET_INVALID_STATE              TargetHealth_Reason = 11
+	TargetHealth_TARGET_IP_UNUSABLE                TargetHealth_Reason = 12
+	TargetHealth_TARGET_HEALTH_CHECK_DISABLED      TargetHealth_Reason = 13
+)
+
+// Enum value maps for TargetHealth_Reason.
+var (
+	TargetHealth_Reason_name = map[int32]string{
+		0:  "REASON_UNSPECIFIED",
+		1:  "REASON_UNKNOWN",
+		2:  "ELB_REGISTRATION_IN_PROGRESS",
+		3:  "ELB_INITIAL_HEALTH_CHECKING",
+		4:  "ELB_INTERNAL_ERROR",
+		5:  "TARGET_RESPONSE_CODE_MISMATCH",
+		6:  "TARGET_TIMEOUT",
+		7:  "TARGET_FAILED_HEALTH_CHECKS",
+		8:  "TARGET_NOT_REGISTERED",
+		9:  "TARGET_NOT_IN_USE",
+		10: "TARGET_DEREGISTRATION_IN_PROGRESS",
+		11: "TARGET_INVALID_STATE",
+		12: "TARGET_IP_UNUSABLE",
+		13: "TARGET_HEALTH_CHECK_DISABLED",
+	}
+	TargetHealth_Reason_value = map[string]int32{
+		"REASON_UNSPECIFIED":                0,
+		"REASON_UNKNOWN":                    1,
+		"ELB_REGISTRATION_IN_PROGRESS":      2,
+		"ELB_INITIAL_HEALTH_CHECKING":       3,
+		"ELB_INTERNAL_ERROR":                4,
+		"TARGET_RESPONSE_CODE_MISMATCH":     5,
+		"TARGET_TIMEOUT":                    6,
+		"TARGET_FAILED_HEALTH_CHECKS":       7,
+		"TARGET_NOT_REGISTERED":             8,
+		"TARGET_NOT_IN_USE":                 9,
+		"TARGET_DEREGISTRATION_IN_PROGRESS": 10,
+		"TARGET_INVALID_STATE":              11,
+		"TARGET_IP_UNUSABLE":                12,
+		"TARGET_HEALTH_CHECK_DISABLED":      13,
+	}
+)
+
+func (x TargetHealth_Reason) Enum() *TargetHealth_Reason {
+	p := new(TargetHealth_Reason)
+	*p = x
+	return p
+}
+
+func (x TargetHealth_Reason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetHealth_Reason) Descriptor() protoreflect.EnumDescriptor {
+	return file_aws_elbv2_v1_elbv2_proto_enumTypes[5].Descriptor()
+}
+
+func (TargetHealth_Reason) Type() protoreflect.EnumType {
+	return &file_aws_elbv2_v1_elbv2_proto_enumTypes[5]
+}
+
+func (x TargetHealth_Reason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TargetHealth_Reason.Descriptor instead.
+func (TargetHealth_Reason) EnumDescriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{5, 1}
+}
+
+// An application, network or gateway load balancer.
+type LoadBalancer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name    string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Region  string              `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account string              `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Arn     string              `protobuf:"bytes,4,opt,name=arn,proto3" json:"arn,omitempty"`
+	DnsName string              `protobuf:"bytes,5,opt,name=dns_name,json=dnsName,proto3" json:"dns_name,omitempty"`
+	Type    LoadBalancer_Type   `protobuf:"varint,6,opt,name=type,proto3,enum=clutch.aws.elbv2.v1.LoadBalancer_Type" json:"type,omitempty"`
+	Scheme  LoadBalancer_Scheme `protobuf:"varint,7,opt,name=scheme,proto3,enum=clutch.aws.elbv2.v1.LoadBalancer_Scheme" json:"scheme,omitempty"`
+	State   LoadBalancer_State  `protobuf:"varint,8,opt,name=state,proto3,enum=clutch.aws.elbv2.v1.LoadBalancer_State" json:"state,omitempty"`
+	// A description of the state, if provided by AWS.
+	StateReason string `protobuf:"bytes,9,opt,name=state_reason,json=stateReason,proto3" json:"state_reason,omitempty"`
+	VpcId       string `protobuf:"bytes,10,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	// The AZs the load balancer is enabled in.
+	Zones []string `protobuf:"bytes,11,rep,name=zones,proto3" json:"zones,omitempty"`
+}
+
+func (x *LoadBalancer) Reset() {
+	*x = LoadBalancer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadBalancer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadBalancer) ProtoMessage() {}
+
+func (x *LoadBalancer) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadBalancer.ProtoReflect.Descriptor instead.
+func (*LoadBalancer) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LoadBalancer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetArn() string {
+	if x != nil {
+		return x.Arn
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetDnsName() string {
+	if x != nil {
+		return x.DnsName
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetType() LoadBalancer_Type {
+	if x != nil {
+		return x.Type
+	}
+	return LoadBalancer_UNSPECIFIED
+}
+
+func (x *LoadBalancer) GetScheme() LoadBalancer_Scheme {
+	if x != nil {
+		return x.Scheme
+	}
+	return LoadBalancer_SCHEME_UNSPECIFIED
+}
+
+func (x *LoadBalancer) GetState() LoadBalancer_State {
+	if x != nil {
+		return x.State
+	}
+	return LoadBalancer_STATE_UNSPECIFIED
+}
+
+func (x *LoadBalancer) GetStateReason() string {
+	if x != nil {
+		return x.StateReason
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetVpcId() string {
+	if x != nil {
+		return x.VpcId
+	}
+	return ""
+}
+
+func (x *LoadBalancer) GetZones() []string {
+	if x != nil {
+		return x.Zones
+	}
+	return nil
+}
+
+// A listener on a load balancer and the target groups it forwards to by default.
+type Listener struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Arn             string `protobuf:"bytes,1,opt,name=arn,proto3" json:"arn,omitempty"`
+	LoadBalancerArn string `protobuf:"bytes,2,opt,name=load_balancer_arn,json=loadBalancerArn,proto3" json:"load_balancer_arn,omitempty"`
+	Port            int32  `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// e.g. HTTP, HTTPS, TCP, TLS, UDP, TCP_UDP, GENEVE
+	Protocol string `protobuf:"bytes,4,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	// The target groups referenced by the listener's default forward actions.
+	DefaultTargetGroupArns []string `protobuf:"bytes,5,rep,name=default_target_group_arns,json=defaultTargetGroupArns,proto3" json:"default_target_group_arns,omitempty"`
+}
+
+func (x *Listener) Reset() {
+	*x = Listener{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Listener) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Listener) ProtoMessage() {}
+
+func (x *Listener) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Listener.ProtoReflect.Descriptor instead.
+func (*Listener) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Listener) GetArn() string {
+	if x != nil {
+		return x.Arn
+	}
+	return ""
+}
+
+func (x *Listener) GetLoadBalancerArn() string {
+	if x != nil {
+		return x.LoadBalancerArn
+	}
+	return ""
+}
+
+func (x *Listener) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Listener) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *Listener) GetDefaultTargetGroupArns() []string {
+	if x != nil {
+		return x.DefaultTargetGroupArns
+	}
+	return nil
+}
+
+// Target group health check settings.
+type HealthCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled                 bool   `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Protocol                string `protobuf:"bytes,2,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Port                    string `protobuf:"bytes,3,opt,name=port,proto3" json:"port,omitempty"`
+	Path                    string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	IntervalSeconds         int32  `protobuf:"varint,5,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"`
+	TimeoutSeconds          int32  `protobuf:"varint,6,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	HealthyThresholdCount   int32  `protobuf:"varint,7,opt,name=healthy_threshold_count,json=healthyThresholdCount,proto3" json:"healthy_threshold_count,omitempty"`
+	UnhealthyThresholdCount int32  `protobuf:"varint,8,opt,name=unhealthy_threshold_count,json=unhealthyThresholdCount,proto3" json:"unhealthy_threshold_count,omitempty"`
+	// The HTTP or gRPC codes to use when checking for a successful response.
+	Matcher string `protobuf:"bytes,9,opt,name=matcher,proto3" json:"matcher,omitempty"`
+}
+
+func (x *HealthCheck) Reset() {
+	*x = HealthCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HealthCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HealthCheck) ProtoMessage() {}
+
+func (x *HealthCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HealthCheck.ProtoReflect.Descriptor instead.
+func (*HealthCheck) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HealthCheck) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *HealthCheck) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *HealthCheck) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetTimeoutSeconds() int32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetHealthyThresholdCount() int32 {
+	if x != nil {
+		return x.HealthyThresholdCount
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetUnhealthyThresholdCount() int32 {
+	if x != nil {
+		return x.UnhealthyThresholdCount
+	}
+	return 0
+}
+
+func (x *HealthCheck) GetMatcher() string {
+	if x != nil {
+		return x.Matcher
+	}
+	return ""
+}
+
+type TargetGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Region     string                 `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account    string                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Arn        string                 `protobuf:"bytes,4,opt,name=arn,proto3" json:"arn,omitempty"`
+	Protocol   string                 `protobuf:"bytes,5,opt,name=protocol,proto3" json:"protocol,omitempty"`
+	Port       int32                  `protobuf:"varint,6,opt,name=port,proto3" json:"port,omitempty"`
+	VpcId      string                 `protobuf:"bytes,7,opt,name=vpc_id,json=vpcId,proto3" json:"vpc_id,omitempty"`
+	TargetType TargetGroup_TargetType `protobuf:"varint,8,opt,name=target_type,json=targetType,proto3,enum=clutch.aws.elbv2.v1.TargetGroup_TargetType" json:"target_type,omitempty"`
+	// The load balancers that route traffic to this target group.
+	LoadBalancerArns []string     `protobuf:"bytes,9,rep,name=load_balancer_arns,json=loadBalancerArns,proto3" json:"load_balancer_arns,omitempty"`
+	HealthCheck      *HealthCheck `protobuf:"bytes,10,opt,name=health_check,json=healthCheck,proto3" json:"health_check,omitempty"`
+}
+
+func (x *TargetGroup) Reset() {
+	*x = TargetGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetGroup) ProtoMessage() {}
+
+func (x *TargetGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetGroup.ProtoReflect.Descriptor instead.
+func (*TargetGroup) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TargetGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *TargetGroup) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *TargetGroup) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *TargetGroup) GetArn() string {
+	if x != nil {
+		return x.Arn
+	}
+	return ""
+}
+
+func (x *TargetGroup) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *TargetGroup) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *TargetGroup) GetVpcId() string {
+	if x != nil {
+		return x.VpcId
+	}
+	return ""
+}
+
+func (x *TargetGroup) GetTargetType() TargetGroup_TargetType {
+	if x != nil {
+		return x.TargetType
+	}
+	return TargetGroup_UNSPECIFIED
+}
+
+func (x *TargetGroup) GetLoadBalancerArns() []string {
+	if x != nil {
+		return x.LoadBalancerArns
+	}
+	return nil
+}
+
+func (x *TargetGroup) GetHealthCheck() *HealthCheck {
+	if x != nil {
+		return x.HealthCheck
+	}
+	return nil
+}
+
+// A target registered with a target group.
+type Target struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The instance ID, IP address, Lambda function ARN or ALB ARN depending on the target type of the group.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The port the target receives traffic on. If unset the port of the target group is used.
+	Port int32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Only used for IP targets outside of the VPC of the target group, otherwise determined by AWS.
+	AvailabilityZone string `protobuf:"bytes,3,opt,name=availability_zone,json=availabilityZone,proto3" json:"availability_zone,omitempty"`
+}
+
+func (x *Target) Reset() {
+	*x = Target{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Target) ProtoMessage() {}
+
+func (x *Target) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Target.ProtoReflect.Descriptor instead.
+func (*Target) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Target) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Target) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Target) GetAvailabilityZone() string {
+	if x != nil {
+		return x.AvailabilityZone
+	}
+	return ""
+}
+
+// The health of a single target in a target group.
+type TargetHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetGroupName string  `protobuf:"bytes,1,opt,name=target_group_name,json=targetGroupName,proto3" json:"target_group_name,omitempty"`
+	TargetGroupArn  string  `protobuf:"bytes,2,opt,name=target_group_arn,json=targetGroupArn,proto3" json:"target_group_arn,omitempty"`
+	Target          *Target `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	// The port used for health checks of the target.
+	HealthCheckPort string              `protobuf:"bytes,4,opt,name=health_check_port,json=healthCheckPort,proto3" json:"health_check_port,omitempty"`
+	State           TargetHealth_State  `protobuf:"varint,5,opt,name=state,proto3,enum=clutch.aws.elbv2.v1.TargetHealth_State" json:"state,omitempty"`
+	Reason          TargetHealth_Reason `protobuf:"varint,6,opt,name=reason,proto3,enum=clutch.aws.elbv2.v1.TargetHealth_Reason" json:"reason,omitempty"`
+	// A human readable description of the reason.
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *TargetHealth) Reset() {
+	*x = TargetHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetHealth) ProtoMessage() {}
+
+func (x *TargetHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetHealth.ProtoReflect.Descriptor instead.
+func (*TargetHealth) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TargetHealth) GetTargetGroupName() string {
+	if x != nil {
+		return x.TargetGroupName
+	}
+	return ""
+}
+
+func (x *TargetHealth) GetTargetGroupArn() string {
+	if x != nil {
+		return x.TargetGroupArn
+	}
+	return ""
+}
+
+func (x *TargetHealth) GetTarget() *Target {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+func (x *TargetHealth) GetHealthCheckPort() string {
+	if x != nil {
+		return x.HealthCheckPort
+	}
+	return ""
+}
+
+func (x *TargetHealth) GetState() TargetHealth_State {
+	if x != nil {
+		return x.State
+	}
+	return TargetHealth_UNSPECIFIED
+}
+
+func (x *TargetHealth) GetReason() TargetHealth_Reason {
+	if x != nil {
+		return x.Reason
+	}
+	return TargetHealth_REASON_UNSPECIFIED
+}
+
+func (x *TargetHealth) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type DescribeLoadBalancersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The names of the load balancers to describe, if empty all load balancers in the region are returned.
+	Names   []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Region  string   `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *DescribeLoadBalancersRequest) Reset() {
+	*x = DescribeLoadBalancersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeLoadBalancersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeLoadBalancersRequest) ProtoMessage() {}
+
+func (x *DescribeLoadBalancersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeLoadBalancersRequest.ProtoReflect.Descriptor instead.
+func (*DescribeLoadBalancersRequest) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DescribeLoadBalancersRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *DescribeLoadBalancersRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *DescribeLoadBalancersRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type DescribeLoadBalancersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoadBalancers []*LoadBalancer `protobuf:"bytes,1,rep,name=load_balancers,json=loadBalancers,proto3" json:"load_balancers,omitempty"`
+}
+
+func (x *DescribeLoadBalancersResponse) Reset() {
+	*x = DescribeLoadBalancersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeLoadBalancersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeLoadBalancersResponse) ProtoMessage() {}
+
+func (x *DescribeLoadBalancersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeLoadBalancersResponse.ProtoReflect.Descriptor instead.
+func (*DescribeLoadBalancersResponse) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DescribeLoadBalancersResponse) GetLoadBalancers() []*LoadBalancer {
+	if x != nil {
+		return x.LoadBalancers
+	}
+	return nil
+}
+
+type DescribeListenersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoadBalancerName string `protobuf:"bytes,1,opt,name=load_balancer_name,json=loadBalancerName,proto3" json:"load_balancer_name,omitempty"`
+	Region           string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account          string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *DescribeListenersRequest) Reset() {
+	*x = DescribeListenersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeListenersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeListenersRequest) ProtoMessage() {}
+
+func (x *DescribeListenersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeListenersRequest.ProtoReflect.Descriptor instead.
+func (*DescribeListenersRequest) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DescribeListenersRequest) GetLoadBalancerName() string {
+	if x != nil {
+		return x.LoadBalancerName
+	}
+	return ""
+}
+
+func (x *DescribeListenersRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *DescribeListenersRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type DescribeListenersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Listeners []*Listener `protobuf:"bytes,1,rep,name=listeners,proto3" json:"listeners,omitempty"`
+}
+
+func (x *DescribeListenersResponse) Reset() {
+	*x = DescribeListenersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeListenersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeListenersResponse) ProtoMessage() {}
+
+func (x *DescribeListenersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeListenersResponse.ProtoReflect.Descriptor instead.
+func (*DescribeListenersResponse) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *DescribeListenersResponse) GetListeners() []*Listener {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+type DescribeTargetGroupsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The names of the target groups to describe, if empty all target groups in the region are returned.
+	Names   []string `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	Region  string   `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account string   `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *DescribeTargetGroupsRequest) Reset() {
+	*x = DescribeTargetGroupsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeTargetGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTargetGroupsRequest) ProtoMessage() {}
+
+func (x *DescribeTargetGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTargetGroupsRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTargetGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *DescribeTargetGroupsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *DescribeTargetGroupsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *DescribeTargetGroupsRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type DescribeTargetGroupsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetGroups []*TargetGroup `protobuf:"bytes,1,rep,name=target_groups,json=targetGroups,proto3" json:"target_groups,omitempty"`
+}
+
+func (x *DescribeTargetGroupsResponse) Reset() {
+	*x = DescribeTargetGroupsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeTargetGroupsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTargetGroupsResponse) ProtoMessage() {}
+
+func (x *DescribeTargetGroupsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTargetGroupsResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTargetGroupsResponse) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *DescribeTargetGroupsResponse) GetTargetGroups() []*TargetGroup {
+	if x != nil {
+		return x.TargetGroups
+	}
+	return nil
+}
+
+type DescribeTargetHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetGroupName string `protobuf:"bytes,1,opt,name=target_group_name,json=targetGroupName,proto3" json:"target_group_name,omitempty"`
+	Region          string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account         string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *DescribeTargetHealthRequest) Reset() {
+	*x = DescribeTargetHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeTargetHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTargetHealthRequest) ProtoMessage() {}
+
+func (x *DescribeTargetHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTargetHealthRequest.ProtoReflect.Descriptor instead.
+func (*DescribeTargetHealthRequest) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DescribeTargetHealthRequest) GetTargetGroupName() string {
+	if x != nil {
+		return x.TargetGroupName
+	}
+	return ""
+}
+
+func (x *DescribeTargetHealthRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *DescribeTargetHealthRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type DescribeTargetHealthResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetHealth []*TargetHealth `protobuf:"bytes,1,rep,name=target_health,json=targetHealth,proto3" json:"target_health,omitempty"`
+}
+
+func (x *DescribeTargetHealthResponse) Reset() {
+	*x = DescribeTargetHealthResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DescribeTargetHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DescribeTargetHealthResponse) ProtoMessage() {}
+
+func (x *DescribeTargetHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DescribeTargetHealthResponse.ProtoReflect.Descriptor instead.
+func (*DescribeTargetHealthResponse) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DescribeTargetHealthResponse) GetTargetHealth() []*TargetHealth {
+	if x != nil {
+		return x.TargetHealth
+	}
+	return nil
+}
+
+type RegisterTargetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetGroupName string    `protobuf:"bytes,1,opt,name=target_group_name,json=targetGroupName,proto3" json:"target_group_name,omitempty"`
+	Region          string    `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account         string    `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Targets         []*Target `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets,omitempty"`
+}
+
+func (x *RegisterTargetsRequest) Reset() {
+	*x = RegisterTargetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterTargetsRequest) ProtoMessage() {}
+
+func (x *RegisterTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterTargetsRequest.ProtoReflect.Descriptor instead.
+func (*RegisterTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RegisterTargetsRequest) GetTargetGroupName() string {
+	if x != nil {
+		return x.TargetGroupName
+	}
+	return ""
+}
+
+func (x *RegisterTargetsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RegisterTargetsRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *RegisterTargetsRequest) GetTargets() []*Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type RegisterTargetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RegisterTargetsResponse) Reset() {
+	*x = RegisterTargetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RegisterTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterTargetsResponse) ProtoMessage() {}
+
+func (x *RegisterTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterTargetsResponse.ProtoReflect.Descriptor instead.
+func (*RegisterTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{15}
+}
+
+type DeregisterTargetsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetGroupName string    `protobuf:"bytes,1,opt,name=target_group_name,json=targetGroupName,proto3" json:"target_group_name,omitempty"`
+	Region          string    `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account         string    `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Targets         []*Target `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets,omitempty"`
+}
+
+func (x *DeregisterTargetsRequest) Reset() {
+	*x = DeregisterTargetsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterTargetsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterTargetsRequest) ProtoMessage() {}
+
+func (x *DeregisterTargetsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterTargetsRequest.ProtoReflect.Descriptor instead.
+func (*DeregisterTargetsRequest) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeregisterTargetsRequest) GetTargetGroupName() string {
+	if x != nil {
+		return x.TargetGroupName
+	}
+	return ""
+}
+
+func (x *DeregisterTargetsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *DeregisterTargetsRequest) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+func (x *DeregisterTargetsRequest) GetTargets() []*Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type DeregisterTargetsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeregisterTargetsResponse) Reset() {
+	*x = DeregisterTargetsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeregisterTargetsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeregisterTargetsResponse) ProtoMessage() {}
+
+func (x *DeregisterTargetsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_aws_elbv2_v1_elbv2_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeregisterTargetsResponse.ProtoReflect.Descriptor instead.
+func (*DeregisterTargetsResponse) Descriptor() ([]byte, []int) {
+	return file_aws_elbv2_v1_elbv2_proto_rawDescGZIP(), []int{17}
+}
+
+var File_aws_elbv2_v1_elbv2_proto protoreflect.FileDescriptor
+
+var file_aws_elbv2_v1_elbv2_proto_rawDesc = []byte{
+	0x0a, 0x18, 0x61, 0x77, 0x73, 0x2f, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2f, 0x76, 0x31, 0x2f, 0x65,
+	0x6c, 0x62, 0x76, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x13, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x1a,
+	0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0xef, 0x05, 0x0a, 0x0c, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x72, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x72, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x6e, 0x73,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x6e, 0x73,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x3a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x26, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e,
+	0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x40, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c,
+	0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65,
+	0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x7a,
+	0x6f, 0x6e, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x7a, 0x6f, 0x6e, 0x65,
+	0x73, 0x22, 0x4f, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x50, 0x50, 0x4c, 0x49,
+	0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4e, 0x45, 0x54, 0x57,
+	0x4f, 0x52, 0x4b, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59,
+	0x10, 0x04, 0x22, 0x57, 0x0a, 0x06, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x12,
+	0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x53, 0x43, 0x48, 0x45, 0x4d, 0x45, 0x5f, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x54, 0x45,
+	0x52, 0x4e, 0x45, 0x54, 0x5f, 0x46, 0x41, 0x43, 0x49, 0x4e, 0x47, 0x10, 0x02, 0x12, 0x0c, 0x0a,
+	0x08, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x10, 0x03, 0x22, 0x70, 0x0a, 0x05, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x53,
+	0x54, 0x41, 0x54, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x0a,
+	0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x50, 0x52,
+	0x4f, 0x56, 0x49, 0x53, 0x49, 0x4f, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f,
+	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x49, 0x4d, 0x50, 0x41, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0x04, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x05, 0x3a, 0x43, 0xb2,
+	0xe1, 0x1c, 0x3f, 0x0a, 0x3d, 0x0a, 0x20, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77,
+	0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x19, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x7b, 0x6e, 0x61, 0x6d,
+	0x65, 0x7d, 0x22, 0xb3, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x12,
+	0x10, 0x0a, 0x03, 0x61, 0x72, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x72,
+	0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x5f, 0x61, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6c, 0x6f,
+	0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x41, 0x72, 0x6e, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x39, 0x0a,
+	0x19, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x61, 0x72, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x16, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x41, 0x72, 0x6e, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x0b, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x17, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x19, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x5f,
+	0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x17, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x72, 0x22, 0x88, 0x04, 0x0a, 0x0b, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x61, 0x72, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x72, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x15, 0x0a, 0x06, 0x76, 0x70, 0x63, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x70, 0x63, 0x49, 0x64, 0x12, 0x4c, 0x0a, 0x0b, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x2e, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x5f, 0x61, 0x72, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x10, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x41,
+	0x72, 0x6e, 0x73, 0x12, 0x43, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e,
+	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x0b, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x22, 0x55, 0x0a, 0x0a, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x43, 0x45,
+	0x10, 0x02, 0x12, 0x06, 0x0a, 0x02, 0x49, 0x50, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x41,
+	0x4d, 0x42, 0x44, 0x41, 0x10, 0x04, 0x12, 0x07, 0x0a, 0x03, 0x41, 0x4c, 0x42, 0x10, 0x05, 0x3a,
+	0x42, 0xb2, 0xe1, 0x1c, 0x3e, 0x0a, 0x3c, 0x0a, 0x1f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x19, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x7b, 0x6e, 0x61,
+	0x6d, 0x65, 0x7d, 0x22, 0x6f, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x17, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x1a, 0x06, 0x18, 0xff, 0xff, 0x03, 0x28,
+	0x00, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x10, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79,
+	0x5a, 0x6f, 0x6e, 0x65, 0x22, 0x93, 0x07, 0x0a, 0x0c, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x61, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x41, 0x72, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3d, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x27, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x2e, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22,
+	0x91, 0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53,
+	0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x49, 0x4e, 0x49, 0x54, 0x49,
+	0x41, 0x4c, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10,
+	0x03, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x04,
+	0x12, 0x16, 0x0a, 0x12, 0x55, 0x4e, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x5f, 0x44, 0x52,
+	0x41, 0x49, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x55, 0x4e, 0x55, 0x53,
+	0x45, 0x44, 0x10, 0x06, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x49, 0x4e, 0x47,
+	0x10, 0x07, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c,
+	0x45, 0x10, 0x08, 0x22, 0x94, 0x03, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x12, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x41, 0x53, 0x4f, 0x4e,
+	0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x45, 0x4c,
+	0x42, 0x5f, 0x52, 0x45, 0x47, 0x49, 0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49,
+	0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x02, 0x12, 0x1f, 0x0a, 0x1b,
+	0x45, 0x4c, 0x42, 0x5f, 0x49, 0x4e, 0x49, 0x54, 0x49, 0x41, 0x4c, 0x5f, 0x48, 0x45, 0x41, 0x4c,
+	0x54, 0x48, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0x03, 0x12, 0x16, 0x0a,
+	0x12, 0x45, 0x4c, 0x42, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x4e, 0x41, 0x4c, 0x5f, 0x45, 0x52,
+	0x52, 0x4f, 0x52, 0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f,
+	0x52, 0x45, 0x53, 0x50, 0x4f, 0x4e, 0x53, 0x45, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x5f, 0x4d, 0x49,
+	0x53, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x10, 0x05, 0x12, 0x12, 0x0a, 0x0e, 0x54, 0x41, 0x52, 0x47,
+	0x45, 0x54, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0x06, 0x12, 0x1f, 0x0a, 0x1b,
+	0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x5f, 0x48, 0x45,
+	0x41, 0x4c, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x53, 0x10, 0x07, 0x12, 0x19, 0x0a,
+	0x15, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x47, 0x49,
+	0x53, 0x54, 0x45, 0x52, 0x45, 0x44, 0x10, 0x08, 0x12, 0x15, 0x0a, 0x11, 0x54, 0x41, 0x52, 0x47,
+	0x45, 0x54, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x5f, 0x55, 0x53, 0x45, 0x10, 0x09, 0x12,
+	0x25, 0x0a, 0x21, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x52, 0x45, 0x47, 0x49,
+	0x53, 0x54, 0x52, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47,
+	0x52, 0x45, 0x53, 0x53, 0x10, 0x0a, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54,
+	0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x10, 0x0b,
+	0x12, 0x16, 0x0a, 0x12, 0x54, 0x41, 0x52, 0x47, 0x45, 0x54, 0x5f, 0x49, 0x50, 0x5f, 0x55, 0x4e,
+	0x55, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x0c, 0x12, 0x20, 0x0a, 0x1c, 0x54, 0x41, 0x52, 0x47,
+	0x45, 0x54, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x5f, 0x43, 0x48, 0x45, 0x43, 0x4b, 0x5f,
+	0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x0d, 0x22, 0x78, 0x0a, 0x1c, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0x7f, 0x0a, 0x1d, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61,
+	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32,
+	0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72,
+	0x52, 0x0d, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x3a,
+	0x14, 0xaa, 0xe1, 0x1c, 0x10, 0x0a, 0x0e, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x72, 0x73, 0x22, 0xe8, 0x01, 0x0a, 0x18, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x35, 0x0a, 0x12, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x10, 0x6c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x20, 0x01, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x51, 0xb2,
+	0xe1, 0x1c, 0x4d, 0x0a, 0x4b, 0x0a, 0x20, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77,
+	0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x12, 0x27, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x7b, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x22, 0x58, 0x0a, 0x19, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c,
+	0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x52,
+	0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x77, 0x0a, 0x1b, 0x44, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12,
+	0x1f, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x12, 0x21, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x22, 0x7a, 0x0a, 0x1c, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x45, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31,
+	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x3a, 0x13, 0xaa, 0xe1, 0x1c, 0x0f,
+	0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x22,
+	0xe7, 0x01, 0x0a, 0x1b, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x33, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x20, 0x01, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x4f, 0xb2, 0xe1, 0x1c, 0x4b, 0x0a, 0x49,
+	0x0a, 0x1f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62,
+	0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x12, 0x26, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x7b, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x22, 0x66, 0x0a, 0x1c, 0x44, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c,
+	0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x22, 0xa3, 0x02, 0x0a, 0x16, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x11,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01,
+	0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1f, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x07, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x3a, 0x4f, 0xb2, 0xe1, 0x1c, 0x4b, 0x0a, 0x49, 0x0a, 0x1f,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
+	0x26, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x7b, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x7d, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xa5, 0x02, 0x0a, 0x18, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
+	0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x33, 0x0a, 0x11, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x20, 0x01, 0x52, 0x0f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52,
+	0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01,
+	0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x3a, 0x4f, 0xb2, 0xe1, 0x1c, 0x4b, 0x0a,
+	0x49, 0x0a, 0x1f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c,
+	0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x12, 0x26, 0x7b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x7d, 0x2f, 0x7b, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x7d, 0x2f, 0x7b, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x22, 0x1b, 0x0a, 0x19, 0x44, 0x65,
+	0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x94, 0x08, 0x0a, 0x08, 0x45, 0x4c, 0x42, 0x56,
+	0x32, 0x41, 0x50, 0x49, 0x12, 0xb4, 0x01, 0x0a, 0x15, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x12, 0x31,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76,
+	0x32, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x6f, 0x61,
+	0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65,
+	0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4c, 0x6f, 0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x77, 0x73, 0x2f,
+	0x65, 0x6c, 0x62, 0x76, 0x32, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x6f,
+	0x61, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x72, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x11,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65,
+	0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c,
+	0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x30, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01,
+	0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x65, 0x6c, 0x62, 0x76, 0x32,
+	0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x30, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x33, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x3a, 0x01,
+	0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x65, 0x6c, 0x62, 0x76, 0x32,
+	0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0xb0, 0x01, 0x0a, 0x14, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x30,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76,
+	0x32, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x31, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c,
+	0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x33, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x27, 0x3a, 0x01, 0x2a, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x65, 0x6c,
+	0x62, 0x76, 0x32, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x9c, 0x01, 0x0a, 0x0f, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x2b, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x03, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x77,
+	0x73, 0x2f, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2f, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x72, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x12, 0x2d, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32,
+	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x61, 0x77, 0x73, 0x2e, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x65, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0xaa, 0xe1,
+	0x1c, 0x02, 0x08, 0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2f, 0x64, 0x65, 0x72,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x42, 0x39,
+	0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66,
+	0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x2f, 0x76,
+	0x31, 0x3b, 0x65, 0x6c, 0x62, 0x76, 0x32, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_aws_elbv2_v1_elbv2_proto_rawDescOnce sync.Once
+	file_aws_elbv2_v1_elbv2_proto_rawDescData = file_aws_elbv2_v1_elbv2_proto_rawDesc
+)
+
+func file_aws_elbv2_v1_elbv2_proto_rawDescGZIP() []byte {
+	file_aws_elbv2_v1_elbv2_proto_rawDescOnce.Do(func() {
+		file_aws_elbv2_v1_elbv2_proto_rawDescData = protoimpl.X.CompressGZIP(file_aws_elbv2_v1_elbv2_proto_rawDescData)
+	})
+	return file_aws_elbv2_v1_elbv2_proto_rawDescData
+}
+
+var file_aws_elbv2_v1_elbv2_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_aws_elbv2_v1_elbv2_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_aws_elbv2_v1_elbv2_proto_goTypes = []interface{}{
+	(LoadBalancer_Type)(0),                // 0: clutch.aws.elbv2.v1.LoadBalancer.Type
+	(LoadBalancer_Scheme)(0),              // 1: clutch.aws.elbv2.v1.LoadBalancer.Scheme
+	(LoadBalancer_State)(0),               // 2: clutch.aws.elbv2.v1.LoadBalancer.State
+	(TargetGroup_TargetType)(0),           // 3: clutch.aws.elbv2.v1.TargetGroup.TargetType
+	(TargetHealth_State)(0),               // 4: clutch.aws.elbv2.v1.TargetHealth.State
+	(TargetHealth_Reason)(0),              // 5: clutch.aws.elbv2.v1.TargetHealth.Reason
+	(*LoadBalancer)(nil),                  // 6: clutch.aws.elbv2.v1.LoadBalancer
+	(*Listener)(nil),                      // 7: clutch.aws.elbv2.v1.Listener
+	(*HealthCheck)(nil),                   // 8: clutch.aws.elbv2.v1.HealthCheck
+	(*TargetGroup)(nil),                   // 9: clutch.aws.elbv2.v1.TargetGroup
+	(*Target)(nil),                        // 10: clutch.aws.elbv2.v1.Target
+	(*TargetHealth)(nil),                  // 11: clutch.aws.elbv2.v1.TargetHealth
+	(*DescribeLoadBalancersRequest)(nil),  // 12: clutch.aws.elbv2.v1.DescribeLoadBalancersRequest
+	(*DescribeLoadBalancersResponse)(nil), // 13: clutch.aws.elbv2.v1.DescribeLoadBalancersResponse
+	(*DescribeListenersRequest)(nil),      // 14: clutch.aws.elbv2.v1.DescribeListenersRequest
+	(*DescribeListenersResponse)(nil),     // 15: clutch.aws.elbv2.v1.DescribeListenersResponse
+	(*DescribeTargetGroupsRequest)(nil),   // 16: clutch.aws.elbv2.v1.DescribeTargetGroupsRequest
+	(*DescribeTargetGroupsResponse)(nil),  // 17: clutch.aws.elbv2.v1.DescribeTargetGroupsResponse
+	(*DescribeTargetHealthRequest)(nil),   // 18: clutch.aws.elbv2.v1.DescribeTargetHealthRequest
+	(*DescribeTargetHealthResponse)(nil),  // 19: clutch.aws.elbv2.v1.DescribeTargetHealthResponse
+	(*RegisterTargetsRequest)(nil),        // 20: clutch.aws.elbv2.v1.RegisterTargetsRequest
+	(*RegisterTargetsResponse)(nil),       // 21: clutch.aws.elbv2.v1.RegisterTargetsResponse
+	(*DeregisterTargetsRequest)(nil),      // 22: clutch.aws.elbv2.v1.DeregisterTargetsRequest
+	(*DeregisterTargetsResponse)(nil),     // 23: clutch.aws.elbv2.v1.DeregisterTargetsResponse
+}
+var file_aws_elbv2_v1_elbv2_proto_depIdxs = []int32{
+	0,  // 0: clutch.aws.elbv2.v1.LoadBalancer.type:type_name -> clutch.aws.elbv2.v1.LoadBalancer.Type
+	1,  // 1: clutch.aws.elbv2.v1.LoadBalancer.scheme:type_name -> clutch.aws.elbv2.v1.LoadBalancer.Scheme
+	2,  // 2: clutch.aws.elbv2.v1.LoadBalancer.state:type_name -> clutch.aws.elbv2.v1.LoadBalancer.State
+	3,  // 3: clutch.aws.elbv2.v1.TargetGroup.target_type:type_name -> clutch.aws.elbv2.v1.TargetGroup.TargetType
+	8,  // 4: clutch.aws.elbv2.v1.TargetGroup.health_check:type_name -> clutch.aws.elbv2.v1.HealthCheck
+	10, // 5: clutch.aws.elbv2.v1.TargetHealth.target:type_name -> clutch.aws.elbv2.v1.Target
+	4,  // 6: clutch.aws.elbv2.v1.TargetHealth.state:type_name -> clutch.aws.elbv2.v1.TargetHealth.State
+	5,  // 7: clutch.aws.elbv2.v1.TargetHealth.reason:type_name -> clutch.aws.elbv2.v1.TargetHealth.Reason
+	6,  // 8: clutch.aws.elbv2.v1.DescribeLoadBalancersResponse.load_balancers:type_name -> clutch.aws.elbv2.v1.LoadBalancer
+	7,  // 9: clutch.aws.elbv2.v1.DescribeListenersResponse.listeners:type_name -> clutch.aws.elbv2.v1.Listener
+	9,  // 10: clutch.aws.elbv2.v1.DescribeTargetGroupsResponse.target_groups:type_name -> clutch.aws.elbv2.v1.TargetGroup
+	11, // 11: clutch.aws.elbv2.v1.DescribeTargetHealthResponse.target_health:type_name -> clutch.aws.elbv2.v1.TargetHealth
+	10, // 12: clutch.aws.elbv2.v1.RegisterTargetsRequest.targets:type_name -> clutch.aws.elbv2.v1.Target
+	10, // 13: clutch.aws.elbv2.v1.DeregisterTargetsRequest.targets:type_name -> clutch.aws.elbv2.v1.Target
+	12, // 14: clutch.aws.elbv2.v1.ELBV2API.DescribeLoadBalancers:input_type -> clutch.aws.elbv2.v1.DescribeLoadBalancersRequest
+	14, // 15: clutch.aws.elbv2.v1.ELBV2API.DescribeListeners:input_type -> clutch.aws.elbv2.v1.DescribeListenersRequest
+	16, // 16: clutch.aws.elbv2.v1.ELBV2API.DescribeTargetGroups:input_type -> clutch.aws.elbv2.v1.DescribeTargetGroupsRequest
+	18, // 17: clutch.aws.elbv2.v1.ELBV2API.DescribeTargetHealth:input_type -> clutch.aws.elbv2.v1.DescribeTargetHealthRequest
+	20, // 18: clutch.aws.elbv2.v1.ELBV2API.RegisterTargets:input_type -> clutch.aws.elbv2.v1.RegisterTargetsRequest
+	22, // 19: clutch.aws.elbv2.v1.ELBV2API.DeregisterTargets:input_type -> clutch.aws.elbv2.v1.DeregisterTargetsRequest
+	13, // 20: clutch.aws.elbv2.v1.ELBV2API.DescribeLoadBalancers:output_type -> clutch.aws.elbv2.v1.DescribeLoadBalancersResponse
+	15, // 21: clutch.aws.elbv2.v1.ELBV2API.DescribeListeners:output_type -> clutch.aws.elbv2.v1.DescribeListenersResponse
+	17, // 22: clutch.aws.elbv2.v1.ELBV2API.DescribeTargetGroups:output_type -> clutch.aws.elbv2.v1.DescribeTargetGroupsResponse
+	19, // 23: clutch.aws.elbv2.v1.ELBV2API.DescribeTargetHealth:output_type -> clutch.aws.elbv2.v1.DescribeTargetHealthResponse
+	21, // 24: clutch.aws.elbv2.v1.ELBV2API.RegisterTargets:output_type -> clutch.aws.elbv2.v1.RegisterTargetsResponse
+	23, // 25: clutch.aws.elbv2.v1.ELBV2API.DeregisterTargets:output_type -> clutch.aws.elbv2.v1.DeregisterTargetsResponse
+	20, // [20:26] is the sub-list for method output_type
+	14, // [14:20] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_aws_elbv2_v1_elbv2_proto_init() }
+func file_aws_elbv2_v1_elbv2_proto_init() {
+	if File_aws_elbv2_v1_elbv2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadBalancer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Listener); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HealthCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetGroup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Target); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeLoadBalancersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeLoadBalancersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeListenersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeListenersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeTargetGroupsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeTargetGroupsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeTargetHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DescribeTargetHealthResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterTargetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterTargetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterTargetsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_aws_elbv2_v1_elbv2_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeregisterTargetsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_aws_elbv2_v1_elbv2_proto_rawDesc,
+			NumEnums:      6,
+			NumMessages:   18,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_aws_elbv2_v1_elbv2_proto_goTypes,
+		DependencyIndexes: file_aws_elbv2_v1_elbv2_proto_depIdxs,
+		EnumInfos:         file_aws_elbv2_v1_elbv2_proto_enumTypes,
+		MessageInfos:      file_aws_elbv2_v1_elbv2_proto_msgTypes,
+	}.Build()
+	File_aws_elbv2_v1_elbv2_proto = out.File
+	file_aws_elbv2_v1_elbv2_proto_rawDesc = nil
+	file_aws_elbv2_v1_elbv2_proto_goTypes = nil
+	file_aws_elbv2_v1_elbv2_proto_depIdxs = nil
+}
@@ -0,0 +1,2417 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: aws/elbv2/v1/elbv2.proto
+
+package elbv2v1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on LoadBalancer with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LoadBalancer) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LoadBalancer with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LoadBalancerMultiError, or
+// nil if none found.
+func (m *LoadBalancer) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LoadBalancer) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Region
+
+	// no validation rules for Account
+
+	// no validation rules for Arn
+
+	// no validation rules for DnsName
+
+	// no validation rules for Type
+
+	// no validation rules for Scheme
+
+	// no validation rules for State
+
+	// no validation rules for StateReason
+
+	// no validation rules for VpcId
+
+	if len(errors) > 0 {
+		return LoadBalancerMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoadBalancerMultiError is an error wrapping multiple validation errors
+// returned by LoadBalancer.ValidateAll() if the designated constraints aren't met.
+type LoadBalancerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoadBalancerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoadBalancerMultiError) AllErrors() []error { return m }
+
+// LoadBalancerValidationError is the validation error returned by
+// LoadBalancer.Validate if the designated constraints aren't met.
+type LoadBalancerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoadBalancerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoadBalancerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoadBalancerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoadBalancerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoadBalancerValidationError) ErrorName() string { return "LoadBalancerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoadBalancerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLoadBalancer.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoadBalancerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoadBalancerValidationError{}
+
+// Validate checks the field values on Listener with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Listener) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Listener with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ListenerMultiError, or nil
+// if none found.
+func (m *Listener) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Listener) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Arn
+
+	// no validation rules for LoadBalancerArn
+
+	// no validation rules for Port
+
+	// no validation rules for Protocol
+
+	if len(errors) > 0 {
+		return ListenerMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListenerMultiError is an error wrapping multiple validation errors returned
+// by Listener.ValidateAll() if the designated constraints aren't met.
+type ListenerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListenerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListenerMultiError) AllErrors() []error { return m }
+
+// ListenerValidationError is the validation error returned by
+// Listener.Validate if the designated constraints aren't met.
+type ListenerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListenerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListenerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListenerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListenerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListenerValidationError) ErrorName() string { return "ListenerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListenerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListener.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListenerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListenerValidationError{}
+
+// Validate checks the field values on HealthCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HealthCheck) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HealthCheck with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HealthCheckMultiError, or
+// nil if none found.
+func (m *HealthCheck) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HealthCheck) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Enabled
+
+	// no validation rules for Protocol
+
+	// no validation rules for Port
+
+	// no validation rules for Path
+
+	// no validation rules for IntervalSeconds
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for HealthyThresholdCount
+
+	// no validation rules for UnhealthyThresholdCount
+
+	// no validation rules for Matcher
+
+	if len(errors) > 0 {
+		return HealthCheckMultiError(errors)
+	}
+
+	return nil
+}
+
+// HealthCheckMultiError is an error wrapping multiple validation errors
+// returned by HealthCheck.ValidateAll() if the designated constraints aren't met.
+type HealthCheckMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HealthCheckMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HealthCheckMultiError) AllErrors() []error { return m }
+
+// HealthCheckValidationError is the validation error returned by
+// HealthCheck.Validate if the designated constraints aren't met.
+type HealthCheckValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthCheckValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthCheckValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthCheckValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthCheckValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthCheckValidationError) ErrorName() string { return "HealthCheckValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HealthCheckValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthCheck.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthCheckValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthCheckValidationError{}
+
+// Validate checks the field values on TargetGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TargetGroup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TargetGroup with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TargetGroupMultiError, or
+// nil if none found.
+func (m *TargetGroup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TargetGroup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Region
+
+	// no validation rules for Account
+
+	// no validation rules for Arn
+
+	// no validation rules for Protocol
+
+	// no validation rules for Port
+
+	// no validation rules for VpcId
+
+	// no validation rules for TargetType
+
+	if all {
+		switch v := interface{}(m.GetHealthCheck()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TargetGroupValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TargetGroupValidationError{
+					field:  "HealthCheck",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealthCheck()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TargetGroupValidationError{
+				field:  "HealthCheck",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TargetGroupMultiError(errors)
+	}
+
+	return nil
+}
+
+// TargetGroupMultiError is an error wrapping multiple validation errors
+// returned by TargetGroup.ValidateAll() if the designated constraints aren't met.
+type TargetGroupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TargetGroupMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TargetGroupMultiError) AllErrors() []error { return m }
+
+// TargetGroupValidationError is the validation error returned by
+// TargetGroup.Validate if the designated constraints aren't met.
+type TargetGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TargetGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TargetGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TargetGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TargetGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TargetGroupValidationError) ErrorName() string { return "TargetGroupValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TargetGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTargetGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TargetGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TargetGroupValidationError{}
+
+// Validate checks the field values on Target with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Target) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Target with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in TargetMultiError, or nil if none found.
+func (m *Target) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Target) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) < 1 {
+		err := TargetValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetPort(); val < 0 || val > 65535 {
+		err := TargetValidationError{
+			field:  "Port",
+			reason: "value must be inside range [0, 65535]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for AvailabilityZone
+
+	if len(errors) > 0 {
+		return TargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// TargetMultiError is an error wrapping multiple validation errors returned by
+// Target.ValidateAll() if the designated constraints aren't met.
+type TargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TargetMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TargetMultiError) AllErrors() []error { return m }
+
+// TargetValidationError is the validation error returned by Target.Validate if
+// the designated constraints aren't met.
+type TargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TargetValidationError) ErrorName() string { return "TargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TargetValidationError{}
+
+// Validate checks the field values on TargetHealth with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TargetHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TargetHealth with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TargetHealthMultiError, or
+// nil if none found.
+func (m *TargetHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TargetHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TargetGroupName
+
+	// no validation rules for TargetGroupArn
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TargetHealthValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TargetHealthValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TargetHealthValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for HealthCheckPort
+
+	// no validation rules for State
+
+	// no validation rules for Reason
+
+	// no validation rules for Description
+
+	if len(errors) > 0 {
+		return TargetHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// TargetHealthMultiError is an error wrapping multiple validation errors
+// returned by TargetHealth.ValidateAll() if the designated constraints aren't met.
+type TargetHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TargetHealthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TargetHealthMultiError) AllErrors() []error { return m }
+
+// TargetHealthValidationError is the validation error returned by
+// TargetHealth.Validate if the designated constraints aren't met.
+type TargetHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TargetHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TargetHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TargetHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TargetHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TargetHealthValidationError) ErrorName() string { return "TargetHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TargetHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTargetHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TargetHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TargetHealthValidationError{}
+
+// Validate checks the field values on DescribeLoadBalancersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeLoadBalancersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeLoadBalancersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeLoadBalancersRequestMultiError, or nil if none found.
+func (m *DescribeLoadBalancersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeLoadBalancersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetRegion()) < 1 {
+		err := DescribeLoadBalancersRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DescribeLoadBalancersRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeLoadBalancersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeLoadBalancersRequestMultiError is an error wrapping multiple
+// validation errors returned by DescribeLoadBalancersRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DescribeLoadBalancersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeLoadBalancersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeLoadBalancersRequestMultiError) AllErrors() []error { return m }
+
+// DescribeLoadBalancersRequestValidationError is the validation error returned
+// by DescribeLoadBalancersRequest.Validate if the designated constraints
+// aren't met.
+type DescribeLoadBalancersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeLoadBalancersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeLoadBalancersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeLoadBalancersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeLoadBalancersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeLoadBalancersRequestValidationError) ErrorName() string {
+	return "DescribeLoadBalancersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeLoadBalancersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeLoadBalancersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeLoadBalancersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeLoadBalancersRequestValidationError{}
+
+// Validate checks the field values on DescribeLoadBalancersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeLoadBalancersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeLoadBalancersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DescribeLoadBalancersResponseMultiError, or nil if none found.
+func (m *DescribeLoadBalancersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeLoadBalancersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLoadBalancers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DescribeLoadBalancersResponseValidationError{
+						field:  fmt.Sprintf("LoadBalancers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DescribeLoadBalancersResponseValidationError{
+						field:  fmt.Sprintf("LoadBalancers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DescribeLoadBalancersResponseValidationError{
+					field:  fmt.Sprintf("LoadBalancers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DescribeLoadBalancersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeLoadBalancersResponseMultiError is an error wrapping multiple
+// validation errors returned by DescribeLoadBalancersResponse.ValidateAll()
+// if the designated constraints aren't met.
+type DescribeLoadBalancersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeLoadBalancersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeLoadBalancersResponseMultiError) AllErrors() []error { return m }
+
+// DescribeLoadBalancersResponseValidationError is the validation error
+// returned by DescribeLoadBalancersResponse.Validate if the designated
+// constraints aren't met.
+type DescribeLoadBalancersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeLoadBalancersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeLoadBalancersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeLoadBalancersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeLoadBalancersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeLoadBalancersResponseValidationError) ErrorName() string {
+	return "DescribeLoadBalancersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeLoadBalancersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeLoadBalancersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeLoadBalancersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeLoadBalancersResponseValidationError{}
+
+// Validate checks the field values on DescribeListenersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeListenersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeListenersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeListenersRequestMultiError, or nil if none found.
+func (m *DescribeListenersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeListenersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetLoadBalancerName()) < 1 {
+		err := DescribeListenersRequestValidationError{
+			field:  "LoadBalancerName",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := DescribeListenersRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DescribeListenersRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeListenersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeListenersRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeListenersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeListenersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeListenersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeListenersRequestMultiError) AllErrors() []error { return m }
+
+// DescribeListenersRequestValidationError is the validation error returned by
+// DescribeListenersRequest.Validate if the designated constraints aren't met.
+type DescribeListenersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeListenersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeListenersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeListenersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeListenersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeListenersRequestValidationError) ErrorName() string {
+	return "DescribeListenersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeListenersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeListenersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeListenersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeListenersRequestValidationError{}
+
+// Validate checks the field values on DescribeListenersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeListenersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeListenersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeListenersResponseMultiError, or nil if none found.
+func (m *DescribeListenersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeListenersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetListeners() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DescribeListenersResponseValidationError{
+						field:  fmt.Sprintf("Listeners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DescribeListenersResponseValidationError{
+						field:  fmt.Sprintf("Listeners[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DescribeListenersResponseValidationError{
+					field:  fmt.Sprintf("Listeners[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DescribeListenersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeListenersResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeListenersResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DescribeListenersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeListenersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeListenersResponseMultiError) AllErrors() []error { return m }
+
+// DescribeListenersResponseValidationError is the validation error returned by
+// DescribeListenersResponse.Validate if the designated constraints aren't met.
+type DescribeListenersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeListenersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeListenersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeListenersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeListenersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeListenersResponseValidationError) ErrorName() string {
+	return "DescribeListenersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeListenersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeListenersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeListenersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeListenersResponseValidationError{}
+
+// Validate checks the field values on DescribeTargetGroupsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeTargetGroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeTargetGroupsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeTargetGroupsRequestMultiError, or nil if none found.
+func (m *DescribeTargetGroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeTargetGroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetRegion()) < 1 {
+		err := DescribeTargetGroupsRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DescribeTargetGroupsRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeTargetGroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeTargetGroupsRequestMultiError is an error wrapping multiple
+// validation errors returned by DescribeTargetGroupsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DescribeTargetGroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeTargetGroupsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeTargetGroupsRequestMultiError) AllErrors() []error { return m }
+
+// DescribeTargetGroupsRequestValidationError is the validation error returned
+// by DescribeTargetGroupsRequest.Validate if the designated constraints
+// aren't met.
+type DescribeTargetGroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeTargetGroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeTargetGroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeTargetGroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeTargetGroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeTargetGroupsRequestValidationError) ErrorName() string {
+	return "DescribeTargetGroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeTargetGroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeTargetGroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeTargetGroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeTargetGroupsRequestValidationError{}
+
+// Validate checks the field values on DescribeTargetGroupsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeTargetGroupsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeTargetGroupsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeTargetGroupsResponseMultiError, or nil if none found.
+func (m *DescribeTargetGroupsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeTargetGroupsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTargetGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DescribeTargetGroupsResponseValidationError{
+						field:  fmt.Sprintf("TargetGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DescribeTargetGroupsResponseValidationError{
+						field:  fmt.Sprintf("TargetGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DescribeTargetGroupsResponseValidationError{
+					field:  fmt.Sprintf("TargetGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DescribeTargetGroupsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeTargetGroupsResponseMultiError is an error wrapping multiple
+// validation errors returned by DescribeTargetGroupsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DescribeTargetGroupsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeTargetGroupsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeTargetGroupsResponseMultiError) AllErrors() []error { return m }
+
+// DescribeTargetGroupsResponseValidationError is the validation error returned
+// by DescribeTargetGroupsResponse.Validate if the designated constraints
+// aren't met.
+type DescribeTargetGroupsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeTargetGroupsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeTargetGroupsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeTargetGroupsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeTargetGroupsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeTargetGroupsResponseValidationError) ErrorName() string {
+	return "DescribeTargetGroupsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeTargetGroupsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeTargetGroupsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeTargetGroupsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeTargetGroupsResponseValidationError{}
+
+// Validate checks the field values on DescribeTargetHealthRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeTargetHealthRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeTargetHealthRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeTargetHealthRequestMultiError, or nil if none found.
+func (m *DescribeTargetHealthRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeTargetHealthRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTargetGroupName()) < 1 {
+		err := DescribeTargetHealthRequestValidationError{
+			field:  "TargetGroupName",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := DescribeTargetHealthRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DescribeTargetHealthRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeTargetHealthRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeTargetHealthRequestMultiError is an error wrapping multiple
+// validation errors returned by DescribeTargetHealthRequest.ValidateAll() if
+// the designated constraints aren't met.
+type DescribeTargetHealthRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeTargetHealthRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeTargetHealthRequestMultiError) AllErrors() []error { return m }
+
+// DescribeTargetHealthRequestValidationError is the validation error returned
+// by DescribeTargetHealthRequest.Validate if the designated constraints
+// aren't met.
+type DescribeTargetHealthRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeTargetHealthRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeTargetHealthRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeTargetHealthRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeTargetHealthRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeTargetHealthRequestValidationError) ErrorName() string {
+	return "DescribeTargetHealthRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeTargetHealthRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeTargetHealthRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeTargetHealthRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeTargetHealthRequestValidationError{}
+
+// Validate checks the field values on DescribeTargetHealthResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeTargetHealthResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeTargetHealthResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeTargetHealthResponseMultiError, or nil if none found.
+func (m *DescribeTargetHealthResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeTargetHealthResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTargetHealth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DescribeTargetHealthResponseValidationError{
+						field:  fmt.Sprintf("TargetHealth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DescribeTargetHealthResponseValidationError{
+						field:  fmt.Sprintf("TargetHealth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DescribeTargetHealthResponseValidationError{
+					field:  fmt.Sprintf("TargetHealth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DescribeTargetHealthResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeTargetHealthResponseMultiError is an error wrapping multiple
+// validation errors returned by DescribeTargetHealthResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DescribeTargetHealthResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeTargetHealthResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeTargetHealthResponseMultiError) AllErrors() []error { return m }
+
+// DescribeTargetHealthResponseValidationError is the validation error returned
+// by DescribeTargetHealthResponse.Validate if the designated constraints
+// aren't met.
+type DescribeTargetHealthResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeTargetHealthResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeTargetHealthResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeTargetHealthResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeTargetHealthResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeTargetHealthResponseValidationError) ErrorName() string {
+	return "DescribeTargetHealthResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeTargetHealthResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeTargetHealthResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeTargetHealthResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeTargetHealthResponseValidationError{}
+
+// Validate checks the field values on RegisterTargetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterTargetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterTargetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterTargetsRequestMultiError, or nil if none found.
+func (m *RegisterTargetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterTargetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTargetGroupName()) < 1 {
+		err := RegisterTargetsRequestValidationError{
+			field:  "TargetGroupName",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := RegisterTargetsRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := RegisterTargetsRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTargets()) < 1 {
+		err := RegisterTargetsRequestValidationError{
+			field:  "Targets",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RegisterTargetsRequestValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RegisterTargetsRequestValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RegisterTargetsRequestValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RegisterTargetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterTargetsRequestMultiError is an error wrapping multiple validation
+// errors returned by RegisterTargetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterTargetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterTargetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterTargetsRequestMultiError) AllErrors() []error { return m }
+
+// RegisterTargetsRequestValidationError is the validation error returned by
+// RegisterTargetsRequest.Validate if the designated constraints aren't met.
+type RegisterTargetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterTargetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterTargetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterTargetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterTargetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterTargetsRequestValidationError) ErrorName() string {
+	return "RegisterTargetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterTargetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterTargetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterTargetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterTargetsRequestValidationError{}
+
+// Validate checks the field values on RegisterTargetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RegisterTargetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RegisterTargetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RegisterTargetsResponseMultiError, or nil if none found.
+func (m *RegisterTargetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RegisterTargetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RegisterTargetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RegisterTargetsResponseMultiError is an error wrapping multiple validation
+// errors returned by RegisterTargetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RegisterTargetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RegisterTargetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RegisterTargetsResponseMultiError) AllErrors() []error { return m }
+
+// RegisterTargetsResponseValidationError is the validation error returned by
+// RegisterTargetsResponse.Validate if the designated constraints aren't met.
+type RegisterTargetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RegisterTargetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RegisterTargetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RegisterTargetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RegisterTargetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RegisterTargetsResponseValidationError) ErrorName() string {
+	return "RegisterTargetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RegisterTargetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRegisterTargetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RegisterTargetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RegisterTargetsResponseValidationError{}
+
+// Validate checks the field values on DeregisterTargetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeregisterTargetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeregisterTargetsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeregisterTargetsRequestMultiError, or nil if none found.
+func (m *DeregisterTargetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeregisterTargetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTargetGroupName()) < 1 {
+		err := DeregisterTargetsRequestValidationError{
+			field:  "TargetGroupName",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := DeregisterTargetsRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DeregisterTargetsRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetTargets()) < 1 {
+		err := DeregisterTargetsRequestValidationError{
+			field:  "Targets",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetTargets() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeregisterTargetsRequestValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeregisterTargetsRequestValidationError{
+						field:  fmt.Sprintf("Targets[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeregisterTargetsRequestValidationError{
+					field:  fmt.Sprintf("Targets[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeregisterTargetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeregisterTargetsRequestMultiError is an error wrapping multiple validation
+// errors returned by DeregisterTargetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeregisterTargetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeregisterTargetsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeregisterTargetsRequestMultiError) AllErrors() []error { return m }
+
+// DeregisterTargetsRequestValidationError is the validation error returned by
+// DeregisterTargetsRequest.Validate if the designated constraints aren't met.
+type DeregisterTargetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeregisterTargetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeregisterTargetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeregisterTargetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeregisterTargetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeregisterTargetsRequestValidationError) ErrorName() string {
+	return "DeregisterTargetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeregisterTargetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeregisterTargetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeregisterTargetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeregisterTargetsRequestValidationError{}
+
+// Validate checks the field values on DeregisterTargetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeregisterTargetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeregisterTargetsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeregisterTargetsResponseMultiError, or nil if none found.
+func (m *DeregisterTargetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeregisterTargetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeregisterTargetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeregisterTargetsResponseMultiError is an error wrapping multiple validation
+// errors returned by DeregisterTargetsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DeregisterTargetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeregisterTargetsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeregisterTargetsResponseMultiError) AllErrors() []error { return m }
+
+// DeregisterTargetsResponseValidationError is the validation error returned by
+// DeregisterTargetsResponse.Validate if the designated constraints aren't met.
+type DeregisterTargetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeregisterTargetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeregisterTargetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeregisterTargetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeregisterTargetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeregisterTargetsResponseValidationError) ErrorName() string {
+	return "DeregisterTargetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeregisterTargetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeregisterTargetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeregisterTargetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeregisterTargetsResponseValidationError{}
@@ -0,0 +1,292 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.17.3
+// source: aws/elbv2/v1/elbv2.proto
+
+package elbv2v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	ELBV2API_DescribeLoadBalancers_FullMethodName = "/clutch.aws.elbv2.v1.ELBV2API/DescribeLoadBalancers"
+	ELBV2API_DescribeListeners_FullMethodName     = "/clutch.aws.elbv2.v1.ELBV2API/DescribeListeners"
+	ELBV2API_DescribeTargetGroups_FullMethodName  = "/clutch.aws.elbv2.v1.ELBV2API/DescribeTargetGroups"
+	ELBV2API_DescribeTargetHealth_FullMethodName  = "/clutch.aws.elbv2.v1.ELBV2API/DescribeTargetHealth"
+	ELBV2API_RegisterTargets_FullMethodName       = "/clutch.aws.elbv2.v1.ELBV2API/RegisterTargets"
+	ELBV2API_DeregisterTargets_FullMethodName     = "/clutch.aws.elbv2.v1.ELBV2API/DeregisterTargets"
+)
+
+// ELBV2APIClient is the client API for ELBV2API service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ELBV2APIClient interface {
+	DescribeLoadBalancers(ctx context.Context, in *DescribeLoadBalancersRequest, opts ...grpc.CallOption) (*DescribeLoadBalancersResponse, error)
+	DescribeListeners(ctx context.Context, in *DescribeListenersRequest, opts ...grpc.CallOption) (*DescribeListenersResponse, error)
+	DescribeTargetGroups(ctx context.Context, in *DescribeTargetGroupsRequest, opts ...grpc.CallOption) (*DescribeTargetGroupsResponse, error)
+	DescribeTargetHealth(ctx context.Context, in *DescribeTargetHealthRequest, opts ...grpc.CallOption) (*DescribeTargetHealthResponse, error)
+	RegisterTargets(ctx context.Context, in *RegisterTargetsRequest, opts ...grpc.CallOption) (*RegisterTargetsResponse, error)
+	DeregisterTargets(ctx context.Context, in *DeregisterTargetsRequest, opts ...grpc.CallOption) (*DeregisterTargetsResponse, error)
+}
+
+type eLBV2APIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewELBV2APIClient(cc grpc.ClientConnInterface) ELBV2APIClient {
+	return &eLBV2APIClient{cc}
+}
+
+func (c *eLBV2APIClient) DescribeLoadBalancers(ctx context.Context, in *DescribeLoadBalancersRequest, opts ...grpc.CallOption) (*DescribeLoadBalancersResponse, error) {
+	out := new(DescribeLoadBalancersResponse)
+	err := c.cc.Invoke(ctx, ELBV2API_DescribeLoadBalancers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eLBV2APIClient) DescribeListeners(ctx context.Context, in *DescribeListenersRequest, opts ...grpc.CallOption) (*DescribeListenersResponse, error) {
+	out := new(DescribeListenersResponse)
+	err := c.cc.Invoke(ctx, ELBV2API_DescribeListeners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eLBV2APIClient) DescribeTargetGroups(ctx context.Context, in *DescribeTargetGroupsRequest, opts ...grpc.CallOption) (*DescribeTargetGroupsResponse, error) {
+	out := new(DescribeTargetGroupsResponse)
+	err := c.cc.Invoke(ctx, ELBV2API_DescribeTargetGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eLBV2APIClient) DescribeTargetHealth(ctx context.Context, in *DescribeTargetHealthRequest, opts ...grpc.CallOption) (*DescribeTargetHealthResponse, error) {
+	out := new(DescribeTargetHealthResponse)
+	err := c.cc.Invoke(ctx, ELBV2API_DescribeTargetHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eLBV2APIClient) RegisterTargets(ctx context.Context, in *RegisterTargetsRequest, opts ...grpc.CallOption) (*RegisterTargetsResponse, error) {
+	out := new(RegisterTargetsResponse)
+	err := c.cc.Invoke(ctx, ELBV2API_RegisterTargets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eLBV2APIClient) DeregisterTargets(ctx context.Context, in *DeregisterTargetsRequest, opts ...grpc.CallOption) (*DeregisterTargetsResponse, error) {
+	out := new(DeregisterTargetsResponse)
+	err := c.cc.Invoke(ctx, ELBV2API_DeregisterTargets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ELBV2APIServer is the server API for ELBV2API service.
+// All implementations should embed UnimplementedELBV2APIServer
+// for forward compatibility
+type ELBV2APIServer interface {
+	DescribeLoadBalancers(context.Context, *DescribeLoadBalancersRequest) (*DescribeLoadBalancersResponse, error)
+	DescribeListeners(context.Context, *DescribeListenersRequest) (*DescribeListenersResponse, error)
+	DescribeTargetGroups(context.Context, *DescribeTargetGroupsRequest) (*DescribeTargetGroupsResponse, error)
+	DescribeTargetHealth(context.Context, *DescribeTargetHealthRequest) (*DescribeTargetHealthResponse, error)
+	RegisterTargets(context.Context, *RegisterTargetsRequest) (*RegisterTargetsResponse, error)
+	DeregisterTargets(context.Context, *DeregisterTargetsRequest) (*DeregisterTargetsResponse, error)
+}
+
+// UnimplementedELBV2APIServer should be embedded to have forward compatible implementations.
+type UnimplementedELBV2APIServer struct {
+}
+
+func (UnimplementedELBV2APIServer) DescribeLoadBalancers(context.Context, *DescribeLoadBalancersRequest) (*DescribeLoadBalancersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeLoadBalancers not implemented")
+}
+func (UnimplementedELBV2APIServer) DescribeListeners(context.Context, *DescribeListenersRequest) (*DescribeListenersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeListeners not implemented")
+}
+func (UnimplementedELBV2APIServer) DescribeTargetGroups(context.Context, *DescribeTargetGroupsRequest) (*DescribeTargetGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTargetGroups not implemented")
+}
+func (UnimplementedELBV2APIServer) DescribeTargetHealth(context.Context, *DescribeTargetHealthRequest) (*DescribeTargetHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeTargetHealth not implemented")
+}
+func (UnimplementedELBV2APIServer) RegisterTargets(context.Context, *RegisterTargetsRequest) (*RegisterTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTargets not implemented")
+}
+func (UnimplementedELBV2APIServer) DeregisterTargets(context.Context, *DeregisterTargetsRequest) (*DeregisterTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterTargets not implemented")
+}
+
+// UnsafeELBV2APIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ELBV2APIServer will
+// result in compilation errors.
+type UnsafeELBV2APIServer interface {
+	mustEmbedUnimplementedELBV2APIServer()
+}
+
+func RegisterELBV2APIServer(s grpc.ServiceRegistrar, srv ELBV2APIServer) {
+	s.RegisterService(&ELBV2API_ServiceDesc, srv)
+}
+
+func _ELBV2API_DescribeLoadBalancers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeLoadBalancersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ELBV2APIServer).DescribeLoadBalancers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ELBV2API_DescribeLoadBalancers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ELBV2APIServer).DescribeLoadBalancers(ctx, req.(*DescribeLoadBalancersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ELBV2API_DescribeListeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeListenersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ELBV2APIServer).DescribeListeners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ELBV2API_DescribeListeners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ELBV2APIServer).DescribeListeners(ctx, req.(*DescribeListenersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ELBV2API_DescribeTargetGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTargetGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ELBV2APIServer).DescribeTargetGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ELBV2API_DescribeTargetGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ELBV2APIServer).DescribeTargetGroups(ctx, req.(*DescribeTargetGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ELBV2API_DescribeTargetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeTargetHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ELBV2APIServer).DescribeTargetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ELBV2API_DescribeTargetHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ELBV2APIServer).DescribeTargetHealth(ctx, req.(*DescribeTargetHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ELBV2API_RegisterTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ELBV2APIServer).RegisterTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ELBV2API_RegisterTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ELBV2APIServer).RegisterTargets(ctx, req.(*RegisterTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ELBV2API_DeregisterTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeregisterTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ELBV2APIServer).DeregisterTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ELBV2API_DeregisterTargets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ELBV2APIServer).DeregisterTargets(ctx, req.(*DeregisterTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ELBV2API_ServiceDesc is the grpc.ServiceDesc for ELBV2API service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ELBV2API_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "clutch.aws.elbv2.v1.ELBV2API",
+	HandlerType: (*ELBV2APIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DescribeLoadBalancers",
+			Handler:    _ELBV2API_DescribeLoadBalancers_Handler,
+		},
+		{
+			MethodName: "DescribeListeners",
+			Handler:    _ELBV2API_DescribeListeners_Handler,
+		},
+		{
+			MethodName: "DescribeTargetGroups",
+			Handler:    _ELBV2API_DescribeTargetGroups_Handler,
+		},
+		{
+			MethodName: "DescribeTargetHealth",
+			Handler:    _ELBV2API_DescribeTargetHealth_Handler,
+		},
+		{
+			MethodName: "RegisterTargets",
+			Handler:    _ELBV2API_RegisterTargets_Handler,
+		},
+		{
+			MethodName: "DeregisterTargets",
+			Handler:    _ELBV2API_DeregisterTargets_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aws/elbv2/v1/elbv2.proto",
+}
@@ -0,0 +1,1843 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: aws/lambda/v1/lambda.proto
+
+package lambdav1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on Function with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Function) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Function with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FunctionMultiError, or nil
+// if none found.
+func (m *Function) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Function) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Region
+
+	// no validation rules for Account
+
+	// no validation rules for Arn
+
+	// no validation rules for Description
+
+	// no validation rules for Runtime
+
+	// no validation rules for Handler
+
+	// no validation rules for PackageType
+
+	// no validation rules for MemorySizeMb
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for LastModified
+
+	// no validation rules for State
+
+	// no validation rules for StateReason
+
+	if all {
+		switch v := interface{}(m.GetReservedConcurrentExecutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "ReservedConcurrentExecutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FunctionValidationError{
+					field:  "ReservedConcurrentExecutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReservedConcurrentExecutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FunctionValidationError{
+				field:  "ReservedConcurrentExecutions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetAliases() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FunctionValidationError{
+						field:  fmt.Sprintf("Aliases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FunctionValidationError{
+						field:  fmt.Sprintf("Aliases[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FunctionValidationError{
+					field:  fmt.Sprintf("Aliases[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FunctionValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FunctionValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return FunctionValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return FunctionMultiError(errors)
+	}
+
+	return nil
+}
+
+// FunctionMultiError is an error wrapping multiple validation errors returned
+// by Function.ValidateAll() if the designated constraints aren't met.
+type FunctionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FunctionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FunctionMultiError) AllErrors() []error { return m }
+
+// FunctionValidationError is the validation error returned by
+// Function.Validate if the designated constraints aren't met.
+type FunctionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FunctionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FunctionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FunctionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FunctionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FunctionValidationError) ErrorName() string { return "FunctionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FunctionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFunction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FunctionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FunctionValidationError{}
+
+// Validate checks the field values on InvocationSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *InvocationSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvocationSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvocationSummaryMultiError, or nil if none found.
+func (m *InvocationSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvocationSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Invocations
+
+	// no validation rules for Errors
+
+	// no validation rules for Throttles
+
+	// no validation rules for ErrorRate
+
+	if all {
+		switch v := interface{}(m.GetPeriod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvocationSummaryValidationError{
+					field:  "Period",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvocationSummaryValidationError{
+					field:  "Period",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvocationSummaryValidationError{
+				field:  "Period",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetDatapoints() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, InvocationSummaryValidationError{
+						field:  fmt.Sprintf("Datapoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, InvocationSummaryValidationError{
+						field:  fmt.Sprintf("Datapoints[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return InvocationSummaryValidationError{
+					field:  fmt.Sprintf("Datapoints[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return InvocationSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvocationSummaryMultiError is an error wrapping multiple validation errors
+// returned by InvocationSummary.ValidateAll() if the designated constraints
+// aren't met.
+type InvocationSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvocationSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvocationSummaryMultiError) AllErrors() []error { return m }
+
+// InvocationSummaryValidationError is the validation error returned by
+// InvocationSummary.Validate if the designated constraints aren't met.
+type InvocationSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvocationSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvocationSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvocationSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvocationSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvocationSummaryValidationError) ErrorName() string {
+	return "InvocationSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InvocationSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvocationSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvocationSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvocationSummaryValidationError{}
+
+// Validate checks the field values on DescribeFunctionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeFunctionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeFunctionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeFunctionRequestMultiError, or nil if none found.
+func (m *DescribeFunctionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeFunctionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := DescribeFunctionRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := DescribeFunctionRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DescribeFunctionRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeFunctionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeFunctionRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeFunctionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeFunctionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeFunctionRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeFunctionRequestMultiError) AllErrors() []error { return m }
+
+// DescribeFunctionRequestValidationError is the validation error returned by
+// DescribeFunctionRequest.Validate if the designated constraints aren't met.
+type DescribeFunctionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeFunctionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeFunctionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeFunctionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeFunctionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeFunctionRequestValidationError) ErrorName() string {
+	return "DescribeFunctionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeFunctionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeFunctionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeFunctionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeFunctionRequestValidationError{}
+
+// Validate checks the field values on DescribeFunctionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeFunctionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeFunctionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeFunctionResponseMultiError, or nil if none found.
+func (m *DescribeFunctionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeFunctionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetFunction()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeFunctionResponseValidationError{
+					field:  "Function",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeFunctionResponseValidationError{
+					field:  "Function",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFunction()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeFunctionResponseValidationError{
+				field:  "Function",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeFunctionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeFunctionResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeFunctionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeFunctionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeFunctionResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeFunctionResponseMultiError) AllErrors() []error { return m }
+
+// DescribeFunctionResponseValidationError is the validation error returned by
+// DescribeFunctionResponse.Validate if the designated constraints aren't met.
+type DescribeFunctionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeFunctionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeFunctionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeFunctionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeFunctionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeFunctionResponseValidationError) ErrorName() string {
+	return "DescribeFunctionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeFunctionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeFunctionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeFunctionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeFunctionResponseValidationError{}
+
+// Validate checks the field values on GetInvocationSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvocationSummaryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvocationSummaryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvocationSummaryRequestMultiError, or nil if none found.
+func (m *GetInvocationSummaryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvocationSummaryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := GetInvocationSummaryRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := GetInvocationSummaryRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := GetInvocationSummaryRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetWindow(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = GetInvocationSummaryRequestValidationError{
+				field:  "Window",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(1209600*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(60*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := GetInvocationSummaryRequestValidationError{
+					field:  "Window",
+					reason: "value must be inside range [1m0s, 336h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetInvocationSummaryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvocationSummaryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetInvocationSummaryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetInvocationSummaryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvocationSummaryRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvocationSummaryRequestMultiError) AllErrors() []error { return m }
+
+// GetInvocationSummaryRequestValidationError is the validation error returned
+// by GetInvocationSummaryRequest.Validate if the designated constraints
+// aren't met.
+type GetInvocationSummaryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvocationSummaryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvocationSummaryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvocationSummaryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvocationSummaryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvocationSummaryRequestValidationError) ErrorName() string {
+	return "GetInvocationSummaryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvocationSummaryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvocationSummaryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvocationSummaryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvocationSummaryRequestValidationError{}
+
+// Validate checks the field values on GetInvocationSummaryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetInvocationSummaryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetInvocationSummaryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetInvocationSummaryResponseMultiError, or nil if none found.
+func (m *GetInvocationSummaryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetInvocationSummaryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSummary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetInvocationSummaryResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetInvocationSummaryResponseValidationError{
+					field:  "Summary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSummary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetInvocationSummaryResponseValidationError{
+				field:  "Summary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetInvocationSummaryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetInvocationSummaryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetInvocationSummaryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetInvocationSummaryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetInvocationSummaryResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetInvocationSummaryResponseMultiError) AllErrors() []error { return m }
+
+// GetInvocationSummaryResponseValidationError is the validation error returned
+// by GetInvocationSummaryResponse.Validate if the designated constraints
+// aren't met.
+type GetInvocationSummaryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetInvocationSummaryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetInvocationSummaryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetInvocationSummaryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetInvocationSummaryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetInvocationSummaryResponseValidationError) ErrorName() string {
+	return "GetInvocationSummaryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetInvocationSummaryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInvocationSummaryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetInvocationSummaryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetInvocationSummaryResponseValidationError{}
+
+// Validate checks the field values on PutFunctionConcurrencyRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutFunctionConcurrencyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutFunctionConcurrencyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PutFunctionConcurrencyRequestMultiError, or nil if none found.
+func (m *PutFunctionConcurrencyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutFunctionConcurrencyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := PutFunctionConcurrencyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := PutFunctionConcurrencyRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := PutFunctionConcurrencyRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetReservedConcurrentExecutions() < 0 {
+		err := PutFunctionConcurrencyRequestValidationError{
+			field:  "ReservedConcurrentExecutions",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PutFunctionConcurrencyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutFunctionConcurrencyRequestMultiError is an error wrapping multiple
+// validation errors returned by PutFunctionConcurrencyRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PutFunctionConcurrencyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutFunctionConcurrencyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutFunctionConcurrencyRequestMultiError) AllErrors() []error { return m }
+
+// PutFunctionConcurrencyRequestValidationError is the validation error
+// returned by PutFunctionConcurrencyRequest.Validate if the designated
+// constraints aren't met.
+type PutFunctionConcurrencyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutFunctionConcurrencyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutFunctionConcurrencyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutFunctionConcurrencyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutFunctionConcurrencyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutFunctionConcurrencyRequestValidationError) ErrorName() string {
+	return "PutFunctionConcurrencyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutFunctionConcurrencyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutFunctionConcurrencyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutFunctionConcurrencyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutFunctionConcurrencyRequestValidationError{}
+
+// Validate checks the field values on PutFunctionConcurrencyResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PutFunctionConcurrencyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PutFunctionConcurrencyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PutFunctionConcurrencyResponseMultiError, or nil if none found.
+func (m *PutFunctionConcurrencyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PutFunctionConcurrencyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PutFunctionConcurrencyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PutFunctionConcurrencyResponseMultiError is an error wrapping multiple
+// validation errors returned by PutFunctionConcurrencyResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PutFunctionConcurrencyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PutFunctionConcurrencyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PutFunctionConcurrencyResponseMultiError) AllErrors() []error { return m }
+
+// PutFunctionConcurrencyResponseValidationError is the validation error
+// returned by PutFunctionConcurrencyResponse.Validate if the designated
+// constraints aren't met.
+type PutFunctionConcurrencyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PutFunctionConcurrencyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PutFunctionConcurrencyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PutFunctionConcurrencyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PutFunctionConcurrencyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PutFunctionConcurrencyResponseValidationError) ErrorName() string {
+	return "PutFunctionConcurrencyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PutFunctionConcurrencyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPutFunctionConcurrencyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PutFunctionConcurrencyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PutFunctionConcurrencyResponseValidationError{}
+
+// Validate checks the field values on DeleteFunctionConcurrencyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteFunctionConcurrencyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFunctionConcurrencyRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteFunctionConcurrencyRequestMultiError, or nil if none found.
+func (m *DeleteFunctionConcurrencyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFunctionConcurrencyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetName()) < 1 {
+		err := DeleteFunctionConcurrencyRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := DeleteFunctionConcurrencyRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DeleteFunctionConcurrencyRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteFunctionConcurrencyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFunctionConcurrencyRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteFunctionConcurrencyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFunctionConcurrencyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFunctionConcurrencyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFunctionConcurrencyRequestMultiError) AllErrors() []error { return m }
+
+// DeleteFunctionConcurrencyRequestValidationError is the validation error
+// returned by DeleteFunctionConcurrencyRequest.Validate if the designated
+// constraints aren't met.
+type DeleteFunctionConcurrencyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFunctionConcurrencyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFunctionConcurrencyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFunctionConcurrencyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFunctionConcurrencyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFunctionConcurrencyRequestValidationError) ErrorName() string {
+	return "DeleteFunctionConcurrencyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFunctionConcurrencyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFunctionConcurrencyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFunctionConcurrencyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFunctionConcurrencyRequestValidationError{}
+
+// Validate checks the field values on DeleteFunctionConcurrencyResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteFunctionConcurrencyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteFunctionConcurrencyResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// DeleteFunctionConcurrencyResponseMultiError, or nil if none found.
+func (m *DeleteFunctionConcurrencyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteFunctionConcurrencyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteFunctionConcurrencyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteFunctionConcurrencyResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteFunctionConcurrencyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteFunctionConcurrencyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteFunctionConcurrencyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteFunctionConcurrencyResponseMultiError) AllErrors() []error { return m }
+
+// DeleteFunctionConcurrencyResponseValidationError is the validation error
+// returned by DeleteFunctionConcurrencyResponse.Validate if the designated
+// constraints aren't met.
+type DeleteFunctionConcurrencyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteFunctionConcurrencyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteFunctionConcurrencyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteFunctionConcurrencyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteFunctionConcurrencyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteFunctionConcurrencyResponseValidationError) ErrorName() string {
+	return "DeleteFunctionConcurrencyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteFunctionConcurrencyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteFunctionConcurrencyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteFunctionConcurrencyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteFunctionConcurrencyResponseValidationError{}
+
+// Validate checks the field values on Function_Alias with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Function_Alias) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Function_Alias with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Function_AliasMultiError,
+// or nil if none found.
+func (m *Function_Alias) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Function_Alias) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Arn
+
+	// no validation rules for Description
+
+	// no validation rules for FunctionVersion
+
+	// no validation rules for AdditionalVersionWeights
+
+	if len(errors) > 0 {
+		return Function_AliasMultiError(errors)
+	}
+
+	return nil
+}
+
+// Function_AliasMultiError is an error wrapping multiple validation errors
+// returned by Function_Alias.ValidateAll() if the designated constraints
+// aren't met.
+type Function_AliasMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Function_AliasMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Function_AliasMultiError) AllErrors() []error { return m }
+
+// Function_AliasValidationError is the validation error returned by
+// Function_Alias.Validate if the designated constraints aren't met.
+type Function_AliasValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Function_AliasValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Function_AliasValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Function_AliasValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Function_AliasValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Function_AliasValidationError) ErrorName() string { return "Function_AliasValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Function_AliasValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFunction_Alias.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Function_AliasValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Function_AliasValidationError{}
+
+// Validate checks the field values on Function_Version with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *Function_Version) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Function_Version with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Function_VersionMultiError, or nil if none found.
+func (m *Function_Version) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Function_Version) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Version
+
+	// no validation rules for Description
+
+	// no validation rules for LastModified
+
+	if len(errors) > 0 {
+		return Function_VersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// Function_VersionMultiError is an error wrapping multiple validation errors
+// returned by Function_Version.ValidateAll() if the designated constraints
+// aren't met.
+type Function_VersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Function_VersionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Function_VersionMultiError) AllErrors() []error { return m }
+
+// Function_VersionValidationError is the validation error returned by
+// Function_Version.Validate if the designated constraints aren't met.
+type Function_VersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Function_VersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Function_VersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Function_VersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Function_VersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Function_VersionValidationError) ErrorName() string { return "Function_VersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Function_VersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFunction_Version.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Function_VersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Function_VersionValidationError{}
+
+// Validate checks the field values on InvocationSummary_Datapoint with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *InvocationSummary_Datapoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InvocationSummary_Datapoint with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// InvocationSummary_DatapointMultiError, or nil if none found.
+func (m *InvocationSummary_Datapoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InvocationSummary_Datapoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InvocationSummary_DatapointValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InvocationSummary_DatapointValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InvocationSummary_DatapointValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Invocations
+
+	// no validation rules for Errors
+
+	// no validation rules for Throttles
+
+	if len(errors) > 0 {
+		return InvocationSummary_DatapointMultiError(errors)
+	}
+
+	return nil
+}
+
+// InvocationSummary_DatapointMultiError is an error wrapping multiple
+// validation errors returned by InvocationSummary_Datapoint.ValidateAll() if
+// the designated constraints aren't met.
+type InvocationSummary_DatapointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InvocationSummary_DatapointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InvocationSummary_DatapointMultiError) AllErrors() []error { return m }
+
+// InvocationSummary_DatapointValidationError is the validation error returned
+// by InvocationSummary_Datapoint.Validate if the designated constraints
+// aren't met.
+type InvocationSummary_DatapointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InvocationSummary_DatapointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InvocationSummary_DatapointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InvocationSummary_DatapointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InvocationSummary_DatapointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InvocationSummary_DatapointValidationError) ErrorName() string {
+	return "InvocationSummary_DatapointValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e InvocationSummary_DatapointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInvocationSummary_Datapoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InvocationSummary_DatapointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InvocationSummary_DatapointValidationError{}
@@ -0,0 +1,218 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.17.3
+// source: aws/lambda/v1/lambda.proto
+
+package lambdav1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	LambdaAPI_DescribeFunction_FullMethodName          = "/clutch.aws.lambda.v1.LambdaAPI/DescribeFunction"
+	LambdaAPI_GetInvocationSummary_FullMethodName      = "/clutch.aws.lambda.v1.LambdaAPI/GetInvocationSummary"
+	LambdaAPI_PutFunctionConcurrency_FullMethodName    = "/clutch.aws.lambda.v1.LambdaAPI/PutFunctionConcurrency"
+	LambdaAPI_DeleteFunctionConcurrency_FullMethodName = "/clutch.aws.lambda.v1.LambdaAPI/DeleteFunctionConcurrency"
+)
+
+// LambdaAPIClient is the client API for LambdaAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LambdaAPIClient interface {
+	DescribeFunction(ctx context.Context, in *DescribeFunctionRequest, opts ...grpc.CallOption) (*DescribeFunctionResponse, error)
+	GetInvocationSummary(ctx context.Context, in *GetInvocationSummaryRequest, opts ...grpc.CallOption) (*GetInvocationSummaryResponse, error)
+	PutFunctionConcurrency(ctx context.Context, in *PutFunctionConcurrencyRequest, opts ...grpc.CallOption) (*PutFunctionConcurrencyResponse, error)
+	DeleteFunctionConcurrency(ctx context.Context, in *DeleteFunctionConcurrencyRequest, opts ...grpc.CallOption) (*DeleteFunctionConcurrencyResponse, error)
+}
+
+type lambdaAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLambdaAPIClient(cc grpc.ClientConnInterface) LambdaAPIClient {
+	return &lambdaAPIClient{cc}
+}
+
+func (c *lambdaAPIClient) DescribeFunction(ctx context.Context, in *DescribeFunctionRequest, opts ...grpc.CallOption) (*DescribeFunctionResponse, error) {
+	out := new(DescribeFunctionResponse)
+	err := c.cc.Invoke(ctx, LambdaAPI_DescribeFunction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lambdaAPIClient) GetInvocationSummary(ctx context.Context, in *GetInvocationSummaryRequest, opts ...grpc.CallOption) (*GetInvocationSummaryResponse, error) {
+	out := new(GetInvocationSummaryResponse)
+	err := c.cc.Invoke(ctx, LambdaAPI_GetInvocationSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lambdaAPIClient) PutFunctionConcurrency(ctx context.Context, in *PutFunctionConcurrencyRequest, opts ...grpc.CallOption) (*PutFunctionConcurrencyResponse, error) {
+	out := new(PutFunctionConcurrencyResponse)
+	err := c.cc.Invoke(ctx, LambdaAPI_PutFunctionConcurrency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lambdaAPIClient) DeleteFunctionConcurrency(ctx context.Context, in *DeleteFunctionConcurrencyRequest, opts ...grpc.CallOption) (*DeleteFunctionConcurrencyResponse, error) {
+	out := new(DeleteFunctionConcurrencyResponse)
+	err := c.cc.Invoke(ctx, LambdaAPI_DeleteFunctionConcurrency_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LambdaAPIServer is the server API for LambdaAPI service.
+// All implementations should embed UnimplementedLambdaAPIServer
+// for forward compatibility
+type LambdaAPIServer interface {
+	DescribeFunction(context.Context, *DescribeFunctionRequest) (*DescribeFunctionResponse, error)
+	GetInvocationSummary(context.Context, *GetInvocationSummaryRequest) (*GetInvocationSummaryResponse, error)
+	PutFunctionConcurrency(context.Context, *PutFunctionConcurrencyRequest) (*PutFunctionConcurrencyResponse, error)
+	DeleteFunctionConcurrency(context.Context, *DeleteFunctionConcurrencyRequest) (*DeleteFunctionConcurrencyResponse, error)
+}
+
+// UnimplementedLambdaAPIServer should be embedded to have forward compatible implementations.
+type UnimplementedLambdaAPIServer struct {
+}
+
+func (UnimplementedLambdaAPIServer) DescribeFunction(context.Context, *DescribeFunctionRequest) (*DescribeFunctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeFunction not implemented")
+}
+func (UnimplementedLambdaAPIServer) GetInvocationSummary(context.Context, *GetInvocationSummaryRequest) (*GetInvocationSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvocationSummary not implemented")
+}
+func (UnimplementedLambdaAPIServer) PutFunctionConcurrency(context.Context, *PutFunctionConcurrencyRequest) (*PutFunctionConcurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PutFunctionConcurrency not implemented")
+}
+func (UnimplementedLambdaAPIServer) DeleteFunctionConcurrency(context.Context, *DeleteFunctionConcurrencyRequest) (*DeleteFunctionConcurrencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFunctionConcurrency not implemented")
+}
+
+// UnsafeLambdaAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LambdaAPIServer will
+// result in compilation errors.
+type UnsafeLambdaAPIServer interface {
+	mustEmbedUnimplementedLambdaAPIServer()
+}
+
+func RegisterLambdaAPIServer(s grpc.ServiceRegistrar, srv LambdaAPIServer) {
+	s.RegisterService(&LambdaAPI_ServiceDesc, srv)
+}
+
+func _LambdaAPI_DescribeFunction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeFunctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LambdaAPIServer).DescribeFunction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LambdaAPI_DescribeFunction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LambdaAPIServer).DescribeFunction(ctx, req.(*DescribeFunctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LambdaAPI_GetInvocationSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInvocationSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LambdaAPIServer).GetInvocationSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LambdaAPI_GetInvocationSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LambdaAPIServer).GetInvocationSummary(ctx, req.(*GetInvocationSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LambdaAPI_PutFunctionConcurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PutFunctionConcurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LambdaAPIServer).PutFunctionConcurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LambdaAPI_PutFunctionConcurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LambdaAPIServer).PutFunctionConcurrency(ctx, req.(*PutFunctionConcurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LambdaAPI_DeleteFunctionConcurrency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFunctionConcurrencyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LambdaAPIServer).DeleteFunctionConcurrency(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LambdaAPI_DeleteFunctionConcurrency_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LambdaAPIServer).DeleteFunctionConcurrency(ctx, req.(*DeleteFunctionConcurrencyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LambdaAPI_ServiceDesc is the grpc.ServiceDesc for LambdaAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LambdaAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "clutch.aws.lambda.v1.LambdaAPI",
+	HandlerType: (*LambdaAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DescribeFunction",
+			Handler:    _LambdaAPI_DescribeFunction_Handler,
+		},
+		{
+			MethodName: "GetInvocationSummary",
+			Handler:    _LambdaAPI_GetInvocationSummary_Handler,
+		},
+		{
+			MethodName: "PutFunctionConcurrency",
+			Handler:    _LambdaAPI_PutFunctionConcurrency_Handler,
+		},
+		{
+			MethodName: "DeleteFunctionConcurrency",
+			Handler:    _LambdaAPI_DeleteFunctionConcurrency_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aws/lambda/v1/lambda.proto",
+}
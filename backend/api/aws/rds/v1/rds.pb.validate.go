@@ -0,0 +1,2261 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: aws/rds/v1/rds.proto
+
+package rdsv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PendingMaintenanceAction with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PendingMaintenanceAction) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PendingMaintenanceAction with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PendingMaintenanceActionMultiError, or nil if none found.
+func (m *PendingMaintenanceAction) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PendingMaintenanceAction) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Action
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetAutoAppliedAfterDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PendingMaintenanceActionValidationError{
+					field:  "AutoAppliedAfterDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PendingMaintenanceActionValidationError{
+					field:  "AutoAppliedAfterDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAutoAppliedAfterDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PendingMaintenanceActionValidationError{
+				field:  "AutoAppliedAfterDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetForcedApplyDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PendingMaintenanceActionValidationError{
+					field:  "ForcedApplyDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PendingMaintenanceActionValidationError{
+					field:  "ForcedApplyDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetForcedApplyDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PendingMaintenanceActionValidationError{
+				field:  "ForcedApplyDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCurrentApplyDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PendingMaintenanceActionValidationError{
+					field:  "CurrentApplyDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PendingMaintenanceActionValidationError{
+					field:  "CurrentApplyDate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCurrentApplyDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PendingMaintenanceActionValidationError{
+				field:  "CurrentApplyDate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OptInStatus
+
+	if len(errors) > 0 {
+		return PendingMaintenanceActionMultiError(errors)
+	}
+
+	return nil
+}
+
+// PendingMaintenanceActionMultiError is an error wrapping multiple validation
+// errors returned by PendingMaintenanceAction.ValidateAll() if the designated
+// constraints aren't met.
+type PendingMaintenanceActionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PendingMaintenanceActionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PendingMaintenanceActionMultiError) AllErrors() []error { return m }
+
+// PendingMaintenanceActionValidationError is the validation error returned by
+// PendingMaintenanceAction.Validate if the designated constraints aren't met.
+type PendingMaintenanceActionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PendingMaintenanceActionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PendingMaintenanceActionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PendingMaintenanceActionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PendingMaintenanceActionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PendingMaintenanceActionValidationError) ErrorName() string {
+	return "PendingMaintenanceActionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PendingMaintenanceActionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPendingMaintenanceAction.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PendingMaintenanceActionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PendingMaintenanceActionValidationError{}
+
+// Validate checks the field values on Endpoint with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Endpoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Endpoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in EndpointMultiError, or nil
+// if none found.
+func (m *Endpoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Endpoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Address
+
+	// no validation rules for Port
+
+	if len(errors) > 0 {
+		return EndpointMultiError(errors)
+	}
+
+	return nil
+}
+
+// EndpointMultiError is an error wrapping multiple validation errors returned
+// by Endpoint.ValidateAll() if the designated constraints aren't met.
+type EndpointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EndpointMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EndpointMultiError) AllErrors() []error { return m }
+
+// EndpointValidationError is the validation error returned by
+// Endpoint.Validate if the designated constraints aren't met.
+type EndpointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EndpointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EndpointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EndpointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EndpointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EndpointValidationError) ErrorName() string { return "EndpointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EndpointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEndpoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EndpointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EndpointValidationError{}
+
+// Validate checks the field values on DBInstance with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DBInstance) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DBInstance with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DBInstanceMultiError, or
+// nil if none found.
+func (m *DBInstance) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DBInstance) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Identifier
+
+	// no validation rules for Region
+
+	// no validation rules for Account
+
+	// no validation rules for Arn
+
+	// no validation rules for Engine
+
+	// no validation rules for EngineVersion
+
+	// no validation rules for InstanceClass
+
+	// no validation rules for Status
+
+	// no validation rules for AvailabilityZone
+
+	// no validation rules for SecondaryAvailabilityZone
+
+	// no validation rules for MultiAz
+
+	if all {
+		switch v := interface{}(m.GetEndpoint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DBInstanceValidationError{
+					field:  "Endpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DBInstanceValidationError{
+					field:  "Endpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndpoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DBInstanceValidationError{
+				field:  "Endpoint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for DbClusterIdentifier
+
+	// no validation rules for ReadReplicaSourceIdentifier
+
+	// no validation rules for PreferredMaintenanceWindow
+
+	for idx, item := range m.GetPendingMaintenanceActions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DBInstanceValidationError{
+						field:  fmt.Sprintf("PendingMaintenanceActions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DBInstanceValidationError{
+						field:  fmt.Sprintf("PendingMaintenanceActions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DBInstanceValidationError{
+					field:  fmt.Sprintf("PendingMaintenanceActions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DBInstanceMultiError(errors)
+	}
+
+	return nil
+}
+
+// DBInstanceMultiError is an error wrapping multiple validation errors
+// returned by DBInstance.ValidateAll() if the designated constraints aren't met.
+type DBInstanceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DBInstanceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DBInstanceMultiError) AllErrors() []error { return m }
+
+// DBInstanceValidationError is the validation error returned by
+// DBInstance.Validate if the designated constraints aren't met.
+type DBInstanceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DBInstanceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DBInstanceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DBInstanceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DBInstanceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DBInstanceValidationError) ErrorName() string { return "DBInstanceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DBInstanceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDBInstance.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DBInstanceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DBInstanceValidationError{}
+
+// Validate checks the field values on DBClusterMember with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DBClusterMember) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DBClusterMember with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DBClusterMemberMultiError, or nil if none found.
+func (m *DBClusterMember) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DBClusterMember) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DbInstanceIdentifier
+
+	// no validation rules for Writer
+
+	// no validation rules for PromotionTier
+
+	if len(errors) > 0 {
+		return DBClusterMemberMultiError(errors)
+	}
+
+	return nil
+}
+
+// DBClusterMemberMultiError is an error wrapping multiple validation errors
+// returned by DBClusterMember.ValidateAll() if the designated constraints
+// aren't met.
+type DBClusterMemberMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DBClusterMemberMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DBClusterMemberMultiError) AllErrors() []error { return m }
+
+// DBClusterMemberValidationError is the validation error returned by
+// DBClusterMember.Validate if the designated constraints aren't met.
+type DBClusterMemberValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DBClusterMemberValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DBClusterMemberValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DBClusterMemberValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DBClusterMemberValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DBClusterMemberValidationError) ErrorName() string { return "DBClusterMemberValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DBClusterMemberValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDBClusterMember.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DBClusterMemberValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DBClusterMemberValidationError{}
+
+// Validate checks the field values on DBCluster with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DBCluster) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DBCluster with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DBClusterMultiError, or nil
+// if none found.
+func (m *DBCluster) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DBCluster) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Identifier
+
+	// no validation rules for Region
+
+	// no validation rules for Account
+
+	// no validation rules for Arn
+
+	// no validation rules for Engine
+
+	// no validation rules for EngineVersion
+
+	// no validation rules for Status
+
+	// no validation rules for MultiAz
+
+	// no validation rules for Endpoint
+
+	// no validation rules for ReaderEndpoint
+
+	// no validation rules for Port
+
+	for idx, item := range m.GetMembers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DBClusterValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DBClusterValidationError{
+						field:  fmt.Sprintf("Members[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DBClusterValidationError{
+					field:  fmt.Sprintf("Members[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PreferredMaintenanceWindow
+
+	for idx, item := range m.GetPendingMaintenanceActions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DBClusterValidationError{
+						field:  fmt.Sprintf("PendingMaintenanceActions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DBClusterValidationError{
+						field:  fmt.Sprintf("PendingMaintenanceActions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DBClusterValidationError{
+					field:  fmt.Sprintf("PendingMaintenanceActions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DBClusterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DBClusterMultiError is an error wrapping multiple validation errors returned
+// by DBCluster.ValidateAll() if the designated constraints aren't met.
+type DBClusterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DBClusterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DBClusterMultiError) AllErrors() []error { return m }
+
+// DBClusterValidationError is the validation error returned by
+// DBCluster.Validate if the designated constraints aren't met.
+type DBClusterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DBClusterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DBClusterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DBClusterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DBClusterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DBClusterValidationError) ErrorName() string { return "DBClusterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DBClusterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDBCluster.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DBClusterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DBClusterValidationError{}
+
+// Validate checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Event) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Event with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in EventMultiError, or nil if none found.
+func (m *Event) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Event) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SourceIdentifier
+
+	// no validation rules for SourceType
+
+	// no validation rules for SourceArn
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetDate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EventValidationError{
+					field:  "Date",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EventValidationError{
+				field:  "Date",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return EventMultiError(errors)
+	}
+
+	return nil
+}
+
+// EventMultiError is an error wrapping multiple validation errors returned by
+// Event.ValidateAll() if the designated constraints aren't met.
+type EventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EventMultiError) AllErrors() []error { return m }
+
+// EventValidationError is the validation error returned by Event.Validate if
+// the designated constraints aren't met.
+type EventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EventValidationError) ErrorName() string { return "EventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e EventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EventValidationError{}
+
+// Validate checks the field values on DescribeDBInstanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeDBInstanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeDBInstanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeDBInstanceRequestMultiError, or nil if none found.
+func (m *DescribeDBInstanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeDBInstanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIdentifier()) < 1 {
+		err := DescribeDBInstanceRequestValidationError{
+			field:  "Identifier",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := DescribeDBInstanceRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DescribeDBInstanceRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeDBInstanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeDBInstanceRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeDBInstanceRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DescribeDBInstanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeDBInstanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeDBInstanceRequestMultiError) AllErrors() []error { return m }
+
+// DescribeDBInstanceRequestValidationError is the validation error returned by
+// DescribeDBInstanceRequest.Validate if the designated constraints aren't met.
+type DescribeDBInstanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeDBInstanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeDBInstanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeDBInstanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeDBInstanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeDBInstanceRequestValidationError) ErrorName() string {
+	return "DescribeDBInstanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeDBInstanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeDBInstanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeDBInstanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeDBInstanceRequestValidationError{}
+
+// Validate checks the field values on DescribeDBInstanceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeDBInstanceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeDBInstanceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeDBInstanceResponseMultiError, or nil if none found.
+func (m *DescribeDBInstanceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeDBInstanceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetInstance()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeDBInstanceResponseValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeDBInstanceResponseValidationError{
+					field:  "Instance",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInstance()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeDBInstanceResponseValidationError{
+				field:  "Instance",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeDBInstanceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeDBInstanceResponseMultiError is an error wrapping multiple
+// validation errors returned by DescribeDBInstanceResponse.ValidateAll() if
+// the designated constraints aren't met.
+type DescribeDBInstanceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeDBInstanceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeDBInstanceResponseMultiError) AllErrors() []error { return m }
+
+// DescribeDBInstanceResponseValidationError is the validation error returned
+// by DescribeDBInstanceResponse.Validate if the designated constraints aren't met.
+type DescribeDBInstanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeDBInstanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeDBInstanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeDBInstanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeDBInstanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeDBInstanceResponseValidationError) ErrorName() string {
+	return "DescribeDBInstanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeDBInstanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeDBInstanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeDBInstanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeDBInstanceResponseValidationError{}
+
+// Validate checks the field values on DescribeDBClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeDBClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeDBClusterRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeDBClusterRequestMultiError, or nil if none found.
+func (m *DescribeDBClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeDBClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIdentifier()) < 1 {
+		err := DescribeDBClusterRequestValidationError{
+			field:  "Identifier",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := DescribeDBClusterRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DescribeDBClusterRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DescribeDBClusterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeDBClusterRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeDBClusterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeDBClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeDBClusterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeDBClusterRequestMultiError) AllErrors() []error { return m }
+
+// DescribeDBClusterRequestValidationError is the validation error returned by
+// DescribeDBClusterRequest.Validate if the designated constraints aren't met.
+type DescribeDBClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeDBClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeDBClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeDBClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeDBClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeDBClusterRequestValidationError) ErrorName() string {
+	return "DescribeDBClusterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeDBClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeDBClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeDBClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeDBClusterRequestValidationError{}
+
+// Validate checks the field values on DescribeDBClusterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeDBClusterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeDBClusterResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeDBClusterResponseMultiError, or nil if none found.
+func (m *DescribeDBClusterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeDBClusterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCluster()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DescribeDBClusterResponseValidationError{
+					field:  "Cluster",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DescribeDBClusterResponseValidationError{
+					field:  "Cluster",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCluster()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DescribeDBClusterResponseValidationError{
+				field:  "Cluster",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeDBClusterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeDBClusterResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeDBClusterResponse.ValidateAll() if the
+// designated constraints aren't met.
+type DescribeDBClusterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeDBClusterResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeDBClusterResponseMultiError) AllErrors() []error { return m }
+
+// DescribeDBClusterResponseValidationError is the validation error returned by
+// DescribeDBClusterResponse.Validate if the designated constraints aren't met.
+type DescribeDBClusterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeDBClusterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeDBClusterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeDBClusterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeDBClusterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeDBClusterResponseValidationError) ErrorName() string {
+	return "DescribeDBClusterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeDBClusterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeDBClusterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeDBClusterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeDBClusterResponseValidationError{}
+
+// Validate checks the field values on RebootDBInstanceRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RebootDBInstanceRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RebootDBInstanceRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RebootDBInstanceRequestMultiError, or nil if none found.
+func (m *RebootDBInstanceRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RebootDBInstanceRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIdentifier()) < 1 {
+		err := RebootDBInstanceRequestValidationError{
+			field:  "Identifier",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := RebootDBInstanceRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := RebootDBInstanceRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for ForceFailover
+
+	if len(errors) > 0 {
+		return RebootDBInstanceRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RebootDBInstanceRequestMultiError is an error wrapping multiple validation
+// errors returned by RebootDBInstanceRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RebootDBInstanceRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RebootDBInstanceRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RebootDBInstanceRequestMultiError) AllErrors() []error { return m }
+
+// RebootDBInstanceRequestValidationError is the validation error returned by
+// RebootDBInstanceRequest.Validate if the designated constraints aren't met.
+type RebootDBInstanceRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebootDBInstanceRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebootDBInstanceRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebootDBInstanceRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebootDBInstanceRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebootDBInstanceRequestValidationError) ErrorName() string {
+	return "RebootDBInstanceRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebootDBInstanceRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebootDBInstanceRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebootDBInstanceRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebootDBInstanceRequestValidationError{}
+
+// Validate checks the field values on RebootDBInstanceResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RebootDBInstanceResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RebootDBInstanceResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RebootDBInstanceResponseMultiError, or nil if none found.
+func (m *RebootDBInstanceResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RebootDBInstanceResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RebootDBInstanceResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RebootDBInstanceResponseMultiError is an error wrapping multiple validation
+// errors returned by RebootDBInstanceResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RebootDBInstanceResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RebootDBInstanceResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RebootDBInstanceResponseMultiError) AllErrors() []error { return m }
+
+// RebootDBInstanceResponseValidationError is the validation error returned by
+// RebootDBInstanceResponse.Validate if the designated constraints aren't met.
+type RebootDBInstanceResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RebootDBInstanceResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RebootDBInstanceResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RebootDBInstanceResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RebootDBInstanceResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RebootDBInstanceResponseValidationError) ErrorName() string {
+	return "RebootDBInstanceResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RebootDBInstanceResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRebootDBInstanceResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RebootDBInstanceResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RebootDBInstanceResponseValidationError{}
+
+// Validate checks the field values on FailoverDBClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FailoverDBClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FailoverDBClusterRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FailoverDBClusterRequestMultiError, or nil if none found.
+func (m *FailoverDBClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FailoverDBClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetIdentifier()) < 1 {
+		err := FailoverDBClusterRequestValidationError{
+			field:  "Identifier",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetRegion()) < 1 {
+		err := FailoverDBClusterRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := FailoverDBClusterRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for TargetDbInstanceIdentifier
+
+	if len(errors) > 0 {
+		return FailoverDBClusterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FailoverDBClusterRequestMultiError is an error wrapping multiple validation
+// errors returned by FailoverDBClusterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FailoverDBClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FailoverDBClusterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FailoverDBClusterRequestMultiError) AllErrors() []error { return m }
+
+// FailoverDBClusterRequestValidationError is the validation error returned by
+// FailoverDBClusterRequest.Validate if the designated constraints aren't met.
+type FailoverDBClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailoverDBClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailoverDBClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailoverDBClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailoverDBClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailoverDBClusterRequestValidationError) ErrorName() string {
+	return "FailoverDBClusterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FailoverDBClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailoverDBClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailoverDBClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailoverDBClusterRequestValidationError{}
+
+// Validate checks the field values on FailoverDBClusterResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FailoverDBClusterResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FailoverDBClusterResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FailoverDBClusterResponseMultiError, or nil if none found.
+func (m *FailoverDBClusterResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FailoverDBClusterResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FailoverDBClusterResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FailoverDBClusterResponseMultiError is an error wrapping multiple validation
+// errors returned by FailoverDBClusterResponse.ValidateAll() if the
+// designated constraints aren't met.
+type FailoverDBClusterResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FailoverDBClusterResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FailoverDBClusterResponseMultiError) AllErrors() []error { return m }
+
+// FailoverDBClusterResponseValidationError is the validation error returned by
+// FailoverDBClusterResponse.Validate if the designated constraints aren't met.
+type FailoverDBClusterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailoverDBClusterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailoverDBClusterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailoverDBClusterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailoverDBClusterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailoverDBClusterResponseValidationError) ErrorName() string {
+	return "FailoverDBClusterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FailoverDBClusterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailoverDBClusterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailoverDBClusterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailoverDBClusterResponseValidationError{}
+
+// Validate checks the field values on DescribeEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeEventsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeEventsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeEventsRequestMultiError, or nil if none found.
+func (m *DescribeEventsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeEventsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetRegion()) < 1 {
+		err := DescribeEventsRequestValidationError{
+			field:  "Region",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetAccount()) < 1 {
+		err := DescribeEventsRequestValidationError{
+			field:  "Account",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for SourceIdentifier
+
+	// no validation rules for SourceType
+
+	if d := m.GetDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = DescribeEventsRequestValidationError{
+				field:  "Duration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(1209600*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(60*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := DescribeEventsRequestValidationError{
+					field:  "Duration",
+					reason: "value must be inside range [1m0s, 336h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return DescribeEventsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeEventsRequestMultiError is an error wrapping multiple validation
+// errors returned by DescribeEventsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeEventsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeEventsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeEventsRequestMultiError) AllErrors() []error { return m }
+
+// DescribeEventsRequestValidationError is the validation error returned by
+// DescribeEventsRequest.Validate if the designated constraints aren't met.
+type DescribeEventsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeEventsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeEventsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeEventsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeEventsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeEventsRequestValidationError) ErrorName() string {
+	return "DescribeEventsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeEventsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeEventsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeEventsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeEventsRequestValidationError{}
+
+// Validate checks the field values on DescribeEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DescribeEventsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DescribeEventsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DescribeEventsResponseMultiError, or nil if none found.
+func (m *DescribeEventsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DescribeEventsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DescribeEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DescribeEventsResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DescribeEventsResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DescribeEventsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DescribeEventsResponseMultiError is an error wrapping multiple validation
+// errors returned by DescribeEventsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DescribeEventsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DescribeEventsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DescribeEventsResponseMultiError) AllErrors() []error { return m }
+
+// DescribeEventsResponseValidationError is the validation error returned by
+// DescribeEventsResponse.Validate if the designated constraints aren't met.
+type DescribeEventsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DescribeEventsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DescribeEventsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DescribeEventsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DescribeEventsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DescribeEventsResponseValidationError) ErrorName() string {
+	return "DescribeEventsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DescribeEventsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDescribeEventsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DescribeEventsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DescribeEventsResponseValidationError{}
@@ -0,0 +1,255 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.17.3
+// source: aws/rds/v1/rds.proto
+
+package rdsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	RDSAPI_DescribeDBInstance_FullMethodName = "/clutch.aws.rds.v1.RDSAPI/DescribeDBInstance"
+	RDSAPI_DescribeDBCluster_FullMethodName  = "/clutch.aws.rds.v1.RDSAPI/DescribeDBCluster"
+	RDSAPI_RebootDBInstance_FullMethodName   = "/clutch.aws.rds.v1.RDSAPI/RebootDBInstance"
+	RDSAPI_FailoverDBCluster_FullMethodName  = "/clutch.aws.rds.v1.RDSAPI/FailoverDBCluster"
+	RDSAPI_DescribeEvents_FullMethodName     = "/clutch.aws.rds.v1.RDSAPI/DescribeEvents"
+)
+
+// RDSAPIClient is the client API for RDSAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RDSAPIClient interface {
+	DescribeDBInstance(ctx context.Context, in *DescribeDBInstanceRequest, opts ...grpc.CallOption) (*DescribeDBInstanceResponse, error)
+	DescribeDBCluster(ctx context.Context, in *DescribeDBClusterRequest, opts ...grpc.CallOption) (*DescribeDBClusterResponse, error)
+	RebootDBInstance(ctx context.Context, in *RebootDBInstanceRequest, opts ...grpc.CallOption) (*RebootDBInstanceResponse, error)
+	FailoverDBCluster(ctx context.Context, in *FailoverDBClusterRequest, opts ...grpc.CallOption) (*FailoverDBClusterResponse, error)
+	DescribeEvents(ctx context.Context, in *DescribeEventsRequest, opts ...grpc.CallOption) (*DescribeEventsResponse, error)
+}
+
+type rDSAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRDSAPIClient(cc grpc.ClientConnInterface) RDSAPIClient {
+	return &rDSAPIClient{cc}
+}
+
+func (c *rDSAPIClient) DescribeDBInstance(ctx context.Context, in *DescribeDBInstanceRequest, opts ...grpc.CallOption) (*DescribeDBInstanceResponse, error) {
+	out := new(DescribeDBInstanceResponse)
+	err := c.cc.Invoke(ctx, RDSAPI_DescribeDBInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rDSAPIClient) DescribeDBCluster(ctx context.Context, in *DescribeDBClusterRequest, opts ...grpc.CallOption) (*DescribeDBClusterResponse, error) {
+	out := new(DescribeDBClusterResponse)
+	err := c.cc.Invoke(ctx, RDSAPI_DescribeDBCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rDSAPIClient) RebootDBInstance(ctx context.Context, in *RebootDBInstanceRequest, opts ...grpc.CallOption) (*RebootDBInstanceResponse, error) {
+	out := new(RebootDBInstanceResponse)
+	err := c.cc.Invoke(ctx, RDSAPI_RebootDBInstance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rDSAPIClient) FailoverDBCluster(ctx context.Context, in *FailoverDBClusterRequest, opts ...grpc.CallOption) (*FailoverDBClusterResponse, error) {
+	out := new(FailoverDBClusterResponse)
+	err := c.cc.Invoke(ctx, RDSAPI_FailoverDBCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rDSAPIClient) DescribeEvents(ctx context.Context, in *DescribeEventsRequest, opts ...grpc.CallOption) (*DescribeEventsResponse, error) {
+	out := new(DescribeEventsResponse)
+	err := c.cc.Invoke(ctx, RDSAPI_DescribeEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RDSAPIServer is the server API for RDSAPI service.
+// All implementations should embed UnimplementedRDSAPIServer
+// for forward compatibility
+type RDSAPIServer interface {
+	DescribeDBInstance(context.Context, *DescribeDBInstanceRequest) (*DescribeDBInstanceResponse, error)
+	DescribeDBCluster(context.Context, *DescribeDBClusterRequest) (*DescribeDBClusterResponse, error)
+	RebootDBInstance(context.Context, *RebootDBInstanceRequest) (*RebootDBInstanceResponse, error)
+	FailoverDBCluster(context.Context, *FailoverDBClusterRequest) (*FailoverDBClusterResponse, error)
+	DescribeEvents(context.Context, *DescribeEventsRequest) (*DescribeEventsResponse, error)
+}
+
+// UnimplementedRDSAPIServer should be embedded to have forward compatible implementations.
+type UnimplementedRDSAPIServer struct {
+}
+
+func (UnimplementedRDSAPIServer) DescribeDBInstance(context.Context, *DescribeDBInstanceRequest) (*DescribeDBInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeDBInstance not implemented")
+}
+func (UnimplementedRDSAPIServer) DescribeDBCluster(context.Context, *DescribeDBClusterRequest) (*DescribeDBClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeDBCluster not implemented")
+}
+func (UnimplementedRDSAPIServer) RebootDBInstance(context.Context, *RebootDBInstanceRequest) (*RebootDBInstanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebootDBInstance not implemented")
+}
+func (UnimplementedRDSAPIServer) FailoverDBCluster(context.Context, *FailoverDBClusterRequest) (*FailoverDBClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailoverDBCluster not implemented")
+}
+func (UnimplementedRDSAPIServer) DescribeEvents(context.Context, *DescribeEventsRequest) (*DescribeEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeEvents not implemented")
+}
+
+// UnsafeRDSAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RDSAPIServer will
+// result in compilation errors.
+type UnsafeRDSAPIServer interface {
+	mustEmbedUnimplementedRDSAPIServer()
+}
+
+func RegisterRDSAPIServer(s grpc.ServiceRegistrar, srv RDSAPIServer) {
+	s.RegisterService(&RDSAPI_ServiceDesc, srv)
+}
+
+func _RDSAPI_DescribeDBInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeDBInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RDSAPIServer).DescribeDBInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RDSAPI_DescribeDBInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RDSAPIServer).DescribeDBInstance(ctx, req.(*DescribeDBInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RDSAPI_DescribeDBCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeDBClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RDSAPIServer).DescribeDBCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RDSAPI_DescribeDBCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RDSAPIServer).DescribeDBCluster(ctx, req.(*DescribeDBClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RDSAPI_RebootDBInstance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootDBInstanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RDSAPIServer).RebootDBInstance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RDSAPI_RebootDBInstance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RDSAPIServer).RebootDBInstance(ctx, req.(*RebootDBInstanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RDSAPI_FailoverDBCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailoverDBClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RDSAPIServer).FailoverDBCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RDSAPI_FailoverDBCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RDSAPIServer).FailoverDBCluster(ctx, req.(*FailoverDBClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RDSAPI_DescribeEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RDSAPIServer).DescribeEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RDSAPI_DescribeEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RDSAPIServer).DescribeEvents(ctx, req.(*DescribeEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RDSAPI_ServiceDesc is the grpc.ServiceDesc for RDSAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RDSAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "clutch.aws.rds.v1.RDSAPI",
+	HandlerType: (*RDSAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DescribeDBInstance",
+			Handler:    _RDSAPI_DescribeDBInstance_Handler,
+		},
+		{
+			MethodName: "DescribeDBCluster",
+			Handler:    _RDSAPI_DescribeDBCluster_Handler,
+		},
+		{
+			MethodName: "RebootDBInstance",
+			Handler:    _RDSAPI_RebootDBInstance_Handler,
+		},
+		{
+			MethodName: "FailoverDBCluster",
+			Handler:    _RDSAPI_FailoverDBCluster_Handler,
+		},
+		{
+			MethodName: "DescribeEvents",
+			Handler:    _RDSAPI_DescribeEvents_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "aws/rds/v1/rds.proto",
+}
@@ -1557,3 +1557,2766 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetExperimentRunDetailsResponseValidationError{}
+
+// Validate checks the field values on GetExperimentRunReportRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExperimentRunReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExperimentRunReportRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetExperimentRunReportRequestMultiError, or nil if none found.
+func (m *GetExperimentRunReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExperimentRunReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) < 1 {
+		err := GetExperimentRunReportRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := GetExperimentRunReportRequest_Format_name[int32(m.GetFormat())]; !ok {
+		err := GetExperimentRunReportRequestValidationError{
+			field:  "Format",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetExperimentRunReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExperimentRunReportRequestMultiError is an error wrapping multiple
+// validation errors returned by GetExperimentRunReportRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetExperimentRunReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExperimentRunReportRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExperimentRunReportRequestMultiError) AllErrors() []error { return m }
+
+// GetExperimentRunReportRequestValidationError is the validation error
+// returned by GetExperimentRunReportRequest.Validate if the designated
+// constraints aren't met.
+type GetExperimentRunReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExperimentRunReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExperimentRunReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExperimentRunReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExperimentRunReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExperimentRunReportRequestValidationError) ErrorName() string {
+	return "GetExperimentRunReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExperimentRunReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExperimentRunReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExperimentRunReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExperimentRunReportRequestValidationError{}
+
+// Validate checks the field values on GetExperimentRunReportResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExperimentRunReportResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExperimentRunReportResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetExperimentRunReportResponseMultiError, or nil if none found.
+func (m *GetExperimentRunReportResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExperimentRunReportResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetReport()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExperimentRunReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExperimentRunReportResponseValidationError{
+					field:  "Report",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReport()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExperimentRunReportResponseValidationError{
+				field:  "Report",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Export
+
+	if len(errors) > 0 {
+		return GetExperimentRunReportResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExperimentRunReportResponseMultiError is an error wrapping multiple
+// validation errors returned by GetExperimentRunReportResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetExperimentRunReportResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExperimentRunReportResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExperimentRunReportResponseMultiError) AllErrors() []error { return m }
+
+// GetExperimentRunReportResponseValidationError is the validation error
+// returned by GetExperimentRunReportResponse.Validate if the designated
+// constraints aren't met.
+type GetExperimentRunReportResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExperimentRunReportResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExperimentRunReportResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExperimentRunReportResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExperimentRunReportResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExperimentRunReportResponseValidationError) ErrorName() string {
+	return "GetExperimentRunReportResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExperimentRunReportResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExperimentRunReportResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExperimentRunReportResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExperimentRunReportResponseValidationError{}
+
+// Validate checks the field values on CreateExperimentScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateExperimentScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExperimentScheduleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateExperimentScheduleRequestMultiError, or nil if none found.
+func (m *CreateExperimentScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExperimentScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) > 64 {
+		err := CreateExperimentScheduleRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateExperimentScheduleRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := CreateExperimentScheduleRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9-._~]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetConfig() == nil {
+		err := CreateExperimentScheduleRequestValidationError{
+			field:  "Config",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if a := m.GetConfig(); a != nil {
+
+	}
+
+	if utf8.RuneCountInString(m.GetCron()) < 1 {
+		err := CreateExperimentScheduleRequestValidationError{
+			field:  "Cron",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetDuration() == nil {
+		err := CreateExperimentScheduleRequestValidationError{
+			field:  "Duration",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = CreateExperimentScheduleRequestValidationError{
+				field:  "Duration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(60*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := CreateExperimentScheduleRequestValidationError{
+					field:  "Duration",
+					reason: "value must be greater than or equal to 1m0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	for idx, item := range m.GetBlackoutWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateExperimentScheduleRequestValidationError{
+						field:  fmt.Sprintf("BlackoutWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateExperimentScheduleRequestValidationError{
+						field:  fmt.Sprintf("BlackoutWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateExperimentScheduleRequestValidationError{
+					field:  fmt.Sprintf("BlackoutWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Paused
+
+	if len(errors) > 0 {
+		return CreateExperimentScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExperimentScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateExperimentScheduleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateExperimentScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExperimentScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExperimentScheduleRequestMultiError) AllErrors() []error { return m }
+
+// CreateExperimentScheduleRequestValidationError is the validation error
+// returned by CreateExperimentScheduleRequest.Validate if the designated
+// constraints aren't met.
+type CreateExperimentScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperimentScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperimentScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperimentScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperimentScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperimentScheduleRequestValidationError) ErrorName() string {
+	return "CreateExperimentScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperimentScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperimentScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperimentScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperimentScheduleRequestValidationError{}
+
+var _CreateExperimentScheduleRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9-._~]*$")
+
+// Validate checks the field values on CreateExperimentScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateExperimentScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExperimentScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateExperimentScheduleResponseMultiError, or nil if none found.
+func (m *CreateExperimentScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExperimentScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExperimentScheduleResponseValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExperimentScheduleResponseValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExperimentScheduleResponseValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateExperimentScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExperimentScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateExperimentScheduleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateExperimentScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExperimentScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExperimentScheduleResponseMultiError) AllErrors() []error { return m }
+
+// CreateExperimentScheduleResponseValidationError is the validation error
+// returned by CreateExperimentScheduleResponse.Validate if the designated
+// constraints aren't met.
+type CreateExperimentScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperimentScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperimentScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperimentScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperimentScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperimentScheduleResponseValidationError) ErrorName() string {
+	return "CreateExperimentScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperimentScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperimentScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperimentScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperimentScheduleResponseValidationError{}
+
+// Validate checks the field values on GetExperimentSchedulesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExperimentSchedulesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExperimentSchedulesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetExperimentSchedulesRequestMultiError, or nil if none found.
+func (m *GetExperimentSchedulesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExperimentSchedulesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetExperimentSchedulesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExperimentSchedulesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetExperimentSchedulesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetExperimentSchedulesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExperimentSchedulesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExperimentSchedulesRequestMultiError) AllErrors() []error { return m }
+
+// GetExperimentSchedulesRequestValidationError is the validation error
+// returned by GetExperimentSchedulesRequest.Validate if the designated
+// constraints aren't met.
+type GetExperimentSchedulesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExperimentSchedulesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExperimentSchedulesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExperimentSchedulesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExperimentSchedulesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExperimentSchedulesRequestValidationError) ErrorName() string {
+	return "GetExperimentSchedulesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExperimentSchedulesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExperimentSchedulesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExperimentSchedulesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExperimentSchedulesRequestValidationError{}
+
+// Validate checks the field values on GetExperimentSchedulesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExperimentSchedulesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExperimentSchedulesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetExperimentSchedulesResponseMultiError, or nil if none found.
+func (m *GetExperimentSchedulesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExperimentSchedulesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSchedules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetExperimentSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetExperimentSchedulesResponseValidationError{
+						field:  fmt.Sprintf("Schedules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetExperimentSchedulesResponseValidationError{
+					field:  fmt.Sprintf("Schedules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetExperimentSchedulesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExperimentSchedulesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetExperimentSchedulesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetExperimentSchedulesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExperimentSchedulesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExperimentSchedulesResponseMultiError) AllErrors() []error { return m }
+
+// GetExperimentSchedulesResponseValidationError is the validation error
+// returned by GetExperimentSchedulesResponse.Validate if the designated
+// constraints aren't met.
+type GetExperimentSchedulesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExperimentSchedulesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExperimentSchedulesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExperimentSchedulesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExperimentSchedulesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExperimentSchedulesResponseValidationError) ErrorName() string {
+	return "GetExperimentSchedulesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExperimentSchedulesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExperimentSchedulesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExperimentSchedulesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExperimentSchedulesResponseValidationError{}
+
+// Validate checks the field values on SetExperimentSchedulePausedRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetExperimentSchedulePausedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetExperimentSchedulePausedRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetExperimentSchedulePausedRequestMultiError, or nil if none found.
+func (m *SetExperimentSchedulePausedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetExperimentSchedulePausedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) < 1 {
+		err := SetExperimentSchedulePausedRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Paused
+
+	if len(errors) > 0 {
+		return SetExperimentSchedulePausedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetExperimentSchedulePausedRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// SetExperimentSchedulePausedRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetExperimentSchedulePausedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetExperimentSchedulePausedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetExperimentSchedulePausedRequestMultiError) AllErrors() []error { return m }
+
+// SetExperimentSchedulePausedRequestValidationError is the validation error
+// returned by SetExperimentSchedulePausedRequest.Validate if the designated
+// constraints aren't met.
+type SetExperimentSchedulePausedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetExperimentSchedulePausedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetExperimentSchedulePausedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetExperimentSchedulePausedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetExperimentSchedulePausedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetExperimentSchedulePausedRequestValidationError) ErrorName() string {
+	return "SetExperimentSchedulePausedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetExperimentSchedulePausedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetExperimentSchedulePausedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetExperimentSchedulePausedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetExperimentSchedulePausedRequestValidationError{}
+
+// Validate checks the field values on SetExperimentSchedulePausedResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *SetExperimentSchedulePausedResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetExperimentSchedulePausedResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// SetExperimentSchedulePausedResponseMultiError, or nil if none found.
+func (m *SetExperimentSchedulePausedResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetExperimentSchedulePausedResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSchedule()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetExperimentSchedulePausedResponseValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetExperimentSchedulePausedResponseValidationError{
+					field:  "Schedule",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSchedule()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetExperimentSchedulePausedResponseValidationError{
+				field:  "Schedule",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetExperimentSchedulePausedResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetExperimentSchedulePausedResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// SetExperimentSchedulePausedResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetExperimentSchedulePausedResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetExperimentSchedulePausedResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetExperimentSchedulePausedResponseMultiError) AllErrors() []error { return m }
+
+// SetExperimentSchedulePausedResponseValidationError is the validation error
+// returned by SetExperimentSchedulePausedResponse.Validate if the designated
+// constraints aren't met.
+type SetExperimentSchedulePausedResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetExperimentSchedulePausedResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetExperimentSchedulePausedResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetExperimentSchedulePausedResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetExperimentSchedulePausedResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetExperimentSchedulePausedResponseValidationError) ErrorName() string {
+	return "SetExperimentSchedulePausedResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetExperimentSchedulePausedResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetExperimentSchedulePausedResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetExperimentSchedulePausedResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetExperimentSchedulePausedResponseValidationError{}
+
+// Validate checks the field values on DeleteExperimentScheduleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteExperimentScheduleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteExperimentScheduleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteExperimentScheduleRequestMultiError, or nil if none found.
+func (m *DeleteExperimentScheduleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteExperimentScheduleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) < 1 {
+		err := DeleteExperimentScheduleRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteExperimentScheduleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteExperimentScheduleRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteExperimentScheduleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteExperimentScheduleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteExperimentScheduleRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteExperimentScheduleRequestMultiError) AllErrors() []error { return m }
+
+// DeleteExperimentScheduleRequestValidationError is the validation error
+// returned by DeleteExperimentScheduleRequest.Validate if the designated
+// constraints aren't met.
+type DeleteExperimentScheduleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteExperimentScheduleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteExperimentScheduleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteExperimentScheduleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteExperimentScheduleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteExperimentScheduleRequestValidationError) ErrorName() string {
+	return "DeleteExperimentScheduleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteExperimentScheduleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteExperimentScheduleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteExperimentScheduleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteExperimentScheduleRequestValidationError{}
+
+// Validate checks the field values on DeleteExperimentScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteExperimentScheduleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteExperimentScheduleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteExperimentScheduleResponseMultiError, or nil if none found.
+func (m *DeleteExperimentScheduleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteExperimentScheduleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteExperimentScheduleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteExperimentScheduleResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteExperimentScheduleResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteExperimentScheduleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteExperimentScheduleResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteExperimentScheduleResponseMultiError) AllErrors() []error { return m }
+
+// DeleteExperimentScheduleResponseValidationError is the validation error
+// returned by DeleteExperimentScheduleResponse.Validate if the designated
+// constraints aren't met.
+type DeleteExperimentScheduleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteExperimentScheduleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteExperimentScheduleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteExperimentScheduleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteExperimentScheduleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteExperimentScheduleResponseValidationError) ErrorName() string {
+	return "DeleteExperimentScheduleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteExperimentScheduleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteExperimentScheduleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteExperimentScheduleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteExperimentScheduleResponseValidationError{}
+
+// Validate checks the field values on CreateExperimentTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateExperimentTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExperimentTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateExperimentTemplateRequestMultiError, or nil if none found.
+func (m *CreateExperimentTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExperimentTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) > 64 {
+		err := CreateExperimentTemplateRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateExperimentTemplateRequest_Id_Pattern.MatchString(m.GetId()) {
+		err := CreateExperimentTemplateRequestValidationError{
+			field:  "Id",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9-._~]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 300 {
+		err := CreateExperimentTemplateRequestValidationError{
+			field:  "Description",
+			reason: "value length must be at most 300 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetConfig() == nil {
+		err := CreateExperimentTemplateRequestValidationError{
+			field:  "Config",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExperimentTemplateRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExperimentTemplateRequestValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExperimentTemplateRequestValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateExperimentTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateExperimentTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateExperimentTemplateRequestValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateExperimentTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExperimentTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateExperimentTemplateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateExperimentTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExperimentTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExperimentTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateExperimentTemplateRequestValidationError is the validation error
+// returned by CreateExperimentTemplateRequest.Validate if the designated
+// constraints aren't met.
+type CreateExperimentTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperimentTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperimentTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperimentTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperimentTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperimentTemplateRequestValidationError) ErrorName() string {
+	return "CreateExperimentTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperimentTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperimentTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperimentTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperimentTemplateRequestValidationError{}
+
+var _CreateExperimentTemplateRequest_Id_Pattern = regexp.MustCompile("^[A-Za-z0-9-._~]*$")
+
+// Validate checks the field values on CreateExperimentTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateExperimentTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExperimentTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateExperimentTemplateResponseMultiError, or nil if none found.
+func (m *CreateExperimentTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExperimentTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExperimentTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExperimentTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExperimentTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateExperimentTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExperimentTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateExperimentTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateExperimentTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExperimentTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExperimentTemplateResponseMultiError) AllErrors() []error { return m }
+
+// CreateExperimentTemplateResponseValidationError is the validation error
+// returned by CreateExperimentTemplateResponse.Validate if the designated
+// constraints aren't met.
+type CreateExperimentTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperimentTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperimentTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperimentTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperimentTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperimentTemplateResponseValidationError) ErrorName() string {
+	return "CreateExperimentTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperimentTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperimentTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperimentTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperimentTemplateResponseValidationError{}
+
+// Validate checks the field values on GetExperimentTemplatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExperimentTemplatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExperimentTemplatesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetExperimentTemplatesRequestMultiError, or nil if none found.
+func (m *GetExperimentTemplatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExperimentTemplatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetExperimentTemplatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExperimentTemplatesRequestMultiError is an error wrapping multiple
+// validation errors returned by GetExperimentTemplatesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetExperimentTemplatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExperimentTemplatesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExperimentTemplatesRequestMultiError) AllErrors() []error { return m }
+
+// GetExperimentTemplatesRequestValidationError is the validation error
+// returned by GetExperimentTemplatesRequest.Validate if the designated
+// constraints aren't met.
+type GetExperimentTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExperimentTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExperimentTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExperimentTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExperimentTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExperimentTemplatesRequestValidationError) ErrorName() string {
+	return "GetExperimentTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExperimentTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExperimentTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExperimentTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExperimentTemplatesRequestValidationError{}
+
+// Validate checks the field values on GetExperimentTemplatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExperimentTemplatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExperimentTemplatesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetExperimentTemplatesResponseMultiError, or nil if none found.
+func (m *GetExperimentTemplatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExperimentTemplatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetTemplates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetExperimentTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetExperimentTemplatesResponseValidationError{
+						field:  fmt.Sprintf("Templates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetExperimentTemplatesResponseValidationError{
+					field:  fmt.Sprintf("Templates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetExperimentTemplatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExperimentTemplatesResponseMultiError is an error wrapping multiple
+// validation errors returned by GetExperimentTemplatesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetExperimentTemplatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExperimentTemplatesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExperimentTemplatesResponseMultiError) AllErrors() []error { return m }
+
+// GetExperimentTemplatesResponseValidationError is the validation error
+// returned by GetExperimentTemplatesResponse.Validate if the designated
+// constraints aren't met.
+type GetExperimentTemplatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExperimentTemplatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExperimentTemplatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExperimentTemplatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExperimentTemplatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExperimentTemplatesResponseValidationError) ErrorName() string {
+	return "GetExperimentTemplatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExperimentTemplatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExperimentTemplatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExperimentTemplatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExperimentTemplatesResponseValidationError{}
+
+// Validate checks the field values on GetExperimentTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExperimentTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExperimentTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetExperimentTemplateRequestMultiError, or nil if none found.
+func (m *GetExperimentTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExperimentTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) < 1 {
+		err := GetExperimentTemplateRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetExperimentTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExperimentTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by GetExperimentTemplateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetExperimentTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExperimentTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExperimentTemplateRequestMultiError) AllErrors() []error { return m }
+
+// GetExperimentTemplateRequestValidationError is the validation error returned
+// by GetExperimentTemplateRequest.Validate if the designated constraints
+// aren't met.
+type GetExperimentTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExperimentTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExperimentTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExperimentTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExperimentTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExperimentTemplateRequestValidationError) ErrorName() string {
+	return "GetExperimentTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExperimentTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExperimentTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExperimentTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExperimentTemplateRequestValidationError{}
+
+// Validate checks the field values on GetExperimentTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetExperimentTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetExperimentTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetExperimentTemplateResponseMultiError, or nil if none found.
+func (m *GetExperimentTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetExperimentTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTemplate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetExperimentTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetExperimentTemplateResponseValidationError{
+					field:  "Template",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTemplate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetExperimentTemplateResponseValidationError{
+				field:  "Template",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetExperimentTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetExperimentTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by GetExperimentTemplateResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetExperimentTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetExperimentTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetExperimentTemplateResponseMultiError) AllErrors() []error { return m }
+
+// GetExperimentTemplateResponseValidationError is the validation error
+// returned by GetExperimentTemplateResponse.Validate if the designated
+// constraints aren't met.
+type GetExperimentTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetExperimentTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetExperimentTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetExperimentTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetExperimentTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetExperimentTemplateResponseValidationError) ErrorName() string {
+	return "GetExperimentTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetExperimentTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetExperimentTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetExperimentTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetExperimentTemplateResponseValidationError{}
+
+// Validate checks the field values on DeleteExperimentTemplateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteExperimentTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteExperimentTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteExperimentTemplateRequestMultiError, or nil if none found.
+func (m *DeleteExperimentTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteExperimentTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetId()) < 1 {
+		err := DeleteExperimentTemplateRequestValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteExperimentTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteExperimentTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by DeleteExperimentTemplateRequest.ValidateAll()
+// if the designated constraints aren't met.
+type DeleteExperimentTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteExperimentTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteExperimentTemplateRequestMultiError) AllErrors() []error { return m }
+
+// DeleteExperimentTemplateRequestValidationError is the validation error
+// returned by DeleteExperimentTemplateRequest.Validate if the designated
+// constraints aren't met.
+type DeleteExperimentTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteExperimentTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteExperimentTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteExperimentTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteExperimentTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteExperimentTemplateRequestValidationError) ErrorName() string {
+	return "DeleteExperimentTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteExperimentTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteExperimentTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteExperimentTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteExperimentTemplateRequestValidationError{}
+
+// Validate checks the field values on DeleteExperimentTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *DeleteExperimentTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteExperimentTemplateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// DeleteExperimentTemplateResponseMultiError, or nil if none found.
+func (m *DeleteExperimentTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteExperimentTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DeleteExperimentTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteExperimentTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// DeleteExperimentTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteExperimentTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteExperimentTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteExperimentTemplateResponseMultiError) AllErrors() []error { return m }
+
+// DeleteExperimentTemplateResponseValidationError is the validation error
+// returned by DeleteExperimentTemplateResponse.Validate if the designated
+// constraints aren't met.
+type DeleteExperimentTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteExperimentTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteExperimentTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteExperimentTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteExperimentTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteExperimentTemplateResponseValidationError) ErrorName() string {
+	return "DeleteExperimentTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteExperimentTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteExperimentTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteExperimentTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteExperimentTemplateResponseValidationError{}
+
+// Validate checks the field values on CreateExperimentFromTemplateRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *CreateExperimentFromTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExperimentFromTemplateRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateExperimentFromTemplateRequestMultiError, or nil if none found.
+func (m *CreateExperimentFromTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExperimentFromTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetTemplateId()) < 1 {
+		err := CreateExperimentFromTemplateRequestValidationError{
+			field:  "TemplateId",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetParameters()))
+		i := 0
+		for key := range m.GetParameters() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetParameters()[key]
+			_ = val
+
+			// no validation rules for Parameters[key]
+
+			if all {
+				switch v := interface{}(val).(type) {
+				case interface{ ValidateAll() error }:
+					if err := v.ValidateAll(); err != nil {
+						errors = append(errors, CreateExperimentFromTemplateRequestValidationError{
+							field:  fmt.Sprintf("Parameters[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				case interface{ Validate() error }:
+					if err := v.Validate(); err != nil {
+						errors = append(errors, CreateExperimentFromTemplateRequestValidationError{
+							field:  fmt.Sprintf("Parameters[%v]", key),
+							reason: "embedded message failed validation",
+							cause:  err,
+						})
+					}
+				}
+			} else if v, ok := interface{}(val).(interface{ Validate() error }); ok {
+				if err := v.Validate(); err != nil {
+					return CreateExperimentFromTemplateRequestValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", key),
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetRunId()) > 100 {
+		err := CreateExperimentFromTemplateRequestValidationError{
+			field:  "RunId",
+			reason: "value length must be at most 100 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_CreateExperimentFromTemplateRequest_RunId_Pattern.MatchString(m.GetRunId()) {
+		err := CreateExperimentFromTemplateRequestValidationError{
+			field:  "RunId",
+			reason: "value does not match regex pattern \"^[A-Za-z0-9-._~]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExperimentFromTemplateRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExperimentFromTemplateRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExperimentFromTemplateRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExperimentFromTemplateRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExperimentFromTemplateRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExperimentFromTemplateRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateExperimentFromTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExperimentFromTemplateRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateExperimentFromTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateExperimentFromTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExperimentFromTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExperimentFromTemplateRequestMultiError) AllErrors() []error { return m }
+
+// CreateExperimentFromTemplateRequestValidationError is the validation error
+// returned by CreateExperimentFromTemplateRequest.Validate if the designated
+// constraints aren't met.
+type CreateExperimentFromTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperimentFromTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperimentFromTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperimentFromTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperimentFromTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperimentFromTemplateRequestValidationError) ErrorName() string {
+	return "CreateExperimentFromTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperimentFromTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperimentFromTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperimentFromTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperimentFromTemplateRequestValidationError{}
+
+var _CreateExperimentFromTemplateRequest_RunId_Pattern = regexp.MustCompile("^[A-Za-z0-9-._~]*$")
+
+// Validate checks the field values on CreateExperimentFromTemplateResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *CreateExperimentFromTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateExperimentFromTemplateResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// CreateExperimentFromTemplateResponseMultiError, or nil if none found.
+func (m *CreateExperimentFromTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateExperimentFromTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetExperiment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateExperimentFromTemplateResponseValidationError{
+					field:  "Experiment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateExperimentFromTemplateResponseValidationError{
+					field:  "Experiment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExperiment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateExperimentFromTemplateResponseValidationError{
+				field:  "Experiment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateExperimentFromTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateExperimentFromTemplateResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// CreateExperimentFromTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateExperimentFromTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateExperimentFromTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateExperimentFromTemplateResponseMultiError) AllErrors() []error { return m }
+
+// CreateExperimentFromTemplateResponseValidationError is the validation error
+// returned by CreateExperimentFromTemplateResponse.Validate if the designated
+// constraints aren't met.
+type CreateExperimentFromTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateExperimentFromTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateExperimentFromTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateExperimentFromTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateExperimentFromTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateExperimentFromTemplateResponseValidationError) ErrorName() string {
+	return "CreateExperimentFromTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateExperimentFromTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateExperimentFromTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateExperimentFromTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateExperimentFromTemplateResponseValidationError{}
@@ -19,12 +19,22 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ExperimentsAPI_CreateExperiment_FullMethodName        = "/clutch.chaos.experimentation.v1.ExperimentsAPI/CreateExperiment"
-	ExperimentsAPI_CreateOrGetExperiment_FullMethodName   = "/clutch.chaos.experimentation.v1.ExperimentsAPI/CreateOrGetExperiment"
-	ExperimentsAPI_CancelExperimentRun_FullMethodName     = "/clutch.chaos.experimentation.v1.ExperimentsAPI/CancelExperimentRun"
-	ExperimentsAPI_GetExperiments_FullMethodName          = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetExperiments"
-	ExperimentsAPI_GetListView_FullMethodName             = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetListView"
-	ExperimentsAPI_GetExperimentRunDetails_FullMethodName = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetExperimentRunDetails"
+	ExperimentsAPI_CreateExperiment_FullMethodName             = "/clutch.chaos.experimentation.v1.ExperimentsAPI/CreateExperiment"
+	ExperimentsAPI_CreateOrGetExperiment_FullMethodName        = "/clutch.chaos.experimentation.v1.ExperimentsAPI/CreateOrGetExperiment"
+	ExperimentsAPI_CancelExperimentRun_FullMethodName          = "/clutch.chaos.experimentation.v1.ExperimentsAPI/CancelExperimentRun"
+	ExperimentsAPI_GetExperiments_FullMethodName               = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetExperiments"
+	ExperimentsAPI_GetListView_FullMethodName                  = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetListView"
+	ExperimentsAPI_GetExperimentRunDetails_FullMethodName      = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetExperimentRunDetails"
+	ExperimentsAPI_GetExperimentRunReport_FullMethodName       = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetExperimentRunReport"
+	ExperimentsAPI_CreateExperimentSchedule_FullMethodName     = "/clutch.chaos.experimentation.v1.ExperimentsAPI/CreateExperimentSchedule"
+	ExperimentsAPI_GetExperimentSchedules_FullMethodName       = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetExperimentSchedules"
+	ExperimentsAPI_SetExperimentSchedulePaused_FullMethodName  = "/clutch.chaos.experimentation.v1.ExperimentsAPI/SetExperimentSchedulePaused"
+	ExperimentsAPI_DeleteExperimentSchedule_FullMethodName     = "/clutch.chaos.experimentation.v1.ExperimentsAPI/DeleteExperimentSchedule"
+	ExperimentsAPI_CreateExperimentTemplate_FullMethodName     = "/clutch.chaos.experimentation.v1.ExperimentsAPI/CreateExperimentTemplate"
+	ExperimentsAPI_GetExperimentTemplates_FullMethodName       = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetExperimentTemplates"
+	ExperimentsAPI_GetExperimentTemplate_FullMethodName        = "/clutch.chaos.experimentation.v1.ExperimentsAPI/GetExperimentTemplate"
+	ExperimentsAPI_DeleteExperimentTemplate_FullMethodName     = "/clutch.chaos.experimentation.v1.ExperimentsAPI/DeleteExperimentTemplate"
+	ExperimentsAPI_CreateExperimentFromTemplate_FullMethodName = "/clutch.chaos.experimentation.v1.ExperimentsAPI/CreateExperimentFromTemplate"
 )
 
 // ExperimentsAPIClient is the client API for ExperimentsAPI service.
@@ -48,6 +58,25 @@ type ExperimentsAPIClient interface {
 	GetListView(ctx context.Context, in *GetListViewRequest, opts ...grpc.CallOption) (*GetListViewResponse, error)
 	// Fetch the list of properties in the format that's optimized for displaying to the end user.
 	GetExperimentRunDetails(ctx context.Context, in *GetExperimentRunDetailsRequest, opts ...grpc.CallOption) (*GetExperimentRunDetailsResponse, error)
+	// Assemble a report of a run with a timeline of its events and summaries of the metrics of the clusters it targeted,
+	// optionally exported as JSON or Markdown.
+	GetExperimentRunReport(ctx context.Context, in *GetExperimentRunReportRequest, opts ...grpc.CallOption) (*GetExperimentRunReportResponse, error)
+	// Create a schedule that runs an experiment on a recurring basis. Runs are created by the scheduler service
+	// when each occurrence is due.
+	CreateExperimentSchedule(ctx context.Context, in *CreateExperimentScheduleRequest, opts ...grpc.CallOption) (*CreateExperimentScheduleResponse, error)
+	GetExperimentSchedules(ctx context.Context, in *GetExperimentSchedulesRequest, opts ...grpc.CallOption) (*GetExperimentSchedulesResponse, error)
+	// Pause or resume a schedule. Pausing a schedule does not cancel a run that it already created.
+	SetExperimentSchedulePaused(ctx context.Context, in *SetExperimentSchedulePausedRequest, opts ...grpc.CallOption) (*SetExperimentSchedulePausedResponse, error)
+	DeleteExperimentSchedule(ctx context.Context, in *DeleteExperimentScheduleRequest, opts ...grpc.CallOption) (*DeleteExperimentScheduleResponse, error)
+	// Save an experiment configuration with named parameters, so that runs can be created from it by providing their
+	// values.
+	CreateExperimentTemplate(ctx context.Context, in *CreateExperimentTemplateRequest, opts ...grpc.CallOption) (*CreateExperimentTemplateResponse, error)
+	GetExperimentTemplates(ctx context.Context, in *GetExperimentTemplatesRequest, opts ...grpc.CallOption) (*GetExperimentTemplatesResponse, error)
+	GetExperimentTemplate(ctx context.Context, in *GetExperimentTemplateRequest, opts ...grpc.CallOption) (*GetExperimentTemplateResponse, error)
+	DeleteExperimentTemplate(ctx context.Context, in *DeleteExperimentTemplateRequest, opts ...grpc.CallOption) (*DeleteExperimentTemplateResponse, error)
+	// Create an experiment from a template and the values of its parameters. The resulting configuration is validated
+	// before the experiment is created.
+	CreateExperimentFromTemplate(ctx context.Context, in *CreateExperimentFromTemplateRequest, opts ...grpc.CallOption) (*CreateExperimentFromTemplateResponse, error)
 }
 
 type experimentsAPIClient struct {
@@ -112,6 +141,96 @@ func (c *experimentsAPIClient) GetExperimentRunDetails(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *experimentsAPIClient) GetExperimentRunReport(ctx context.Context, in *GetExperimentRunReportRequest, opts ...grpc.CallOption) (*GetExperimentRunReportResponse, error) {
+	out := new(GetExperimentRunReportResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_GetExperimentRunReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentsAPIClient) CreateExperimentSchedule(ctx context.Context, in *CreateExperimentScheduleRequest, opts ...grpc.CallOption) (*CreateExperimentScheduleResponse, error) {
+	out := new(CreateExperimentScheduleResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_CreateExperimentSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentsAPIClient) GetExperimentSchedules(ctx context.Context, in *GetExperimentSchedulesRequest, opts ...grpc.CallOption) (*GetExperimentSchedulesResponse, error) {
+	out := new(GetExperimentSchedulesResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_GetExperimentSchedules_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentsAPIClient) SetExperimentSchedulePaused(ctx context.Context, in *SetExperimentSchedulePausedRequest, opts ...grpc.CallOption) (*SetExperimentSchedulePausedResponse, error) {
+	out := new(SetExperimentSchedulePausedResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_SetExperimentSchedulePaused_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentsAPIClient) DeleteExperimentSchedule(ctx context.Context, in *DeleteExperimentScheduleRequest, opts ...grpc.CallOption) (*DeleteExperimentScheduleResponse, error) {
+	out := new(DeleteExperimentScheduleResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_DeleteExperimentSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentsAPIClient) CreateExperimentTemplate(ctx context.Context, in *CreateExperimentTemplateRequest, opts ...grpc.CallOption) (*CreateExperimentTemplateResponse, error) {
+	out := new(CreateExperimentTemplateResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_CreateExperimentTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentsAPIClient) GetExperimentTemplates(ctx context.Context, in *GetExperimentTemplatesRequest, opts ...grpc.CallOption) (*GetExperimentTemplatesResponse, error) {
+	out := new(GetExperimentTemplatesResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_GetExperimentTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentsAPIClient) GetExperimentTemplate(ctx context.Context, in *GetExperimentTemplateRequest, opts ...grpc.CallOption) (*GetExperimentTemplateResponse, error) {
+	out := new(GetExperimentTemplateResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_GetExperimentTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentsAPIClient) DeleteExperimentTemplate(ctx context.Context, in *DeleteExperimentTemplateRequest, opts ...grpc.CallOption) (*DeleteExperimentTemplateResponse, error) {
+	out := new(DeleteExperimentTemplateResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_DeleteExperimentTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *experimentsAPIClient) CreateExperimentFromTemplate(ctx context.Context, in *CreateExperimentFromTemplateRequest, opts ...grpc.CallOption) (*CreateExperimentFromTemplateResponse, error) {
+	out := new(CreateExperimentFromTemplateResponse)
+	err := c.cc.Invoke(ctx, ExperimentsAPI_CreateExperimentFromTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ExperimentsAPIServer is the server API for ExperimentsAPI service.
 // All implementations should embed UnimplementedExperimentsAPIServer
 // for forward compatibility
@@ -133,6 +252,25 @@ type ExperimentsAPIServer interface {
 	GetListView(context.Context, *GetListViewRequest) (*GetListViewResponse, error)
 	// Fetch the list of properties in the format that's optimized for displaying to the end user.
 	GetExperimentRunDetails(context.Context, *GetExperimentRunDetailsRequest) (*GetExperimentRunDetailsResponse, error)
+	// Assemble a report of a run with a timeline of its events and summaries of the metrics of the clusters it targeted,
+	// optionally exported as JSON or Markdown.
+	GetExperimentRunReport(context.Context, *GetExperimentRunReportRequest) (*GetExperimentRunReportResponse, error)
+	// Create a schedule that runs an experiment on a recurring basis. Runs are created by the scheduler service
+	// when each occurrence is due.
+	CreateExperimentSchedule(context.Context, *CreateExperimentScheduleRequest) (*CreateExperimentScheduleResponse, error)
+	GetExperimentSchedules(context.Context, *GetExperimentSchedulesRequest) (*GetExperimentSchedulesResponse, error)
+	// Pause or resume a schedule. Pausing a schedule does not cancel a run that it already created.
+	SetExperimentSchedulePaused(context.Context, *SetExperimentSchedulePausedRequest) (*SetExperimentSchedulePausedResponse, error)
+	DeleteExperimentSchedule(context.Context, *DeleteExperimentScheduleRequest) (*DeleteExperimentScheduleResponse, error)
+	// Save an experiment configuration with named parameters, so that runs can be created from it by providing their
+	// values.
+	CreateExperimentTemplate(context.Context, *CreateExperimentTemplateRequest) (*CreateExperimentTemplateResponse, error)
+	GetExperimentTemplates(context.Context, *GetExperimentTemplatesRequest) (*GetExperimentTemplatesResponse, error)
+	GetExperimentTemplate(context.Context, *GetExperimentTemplateRequest) (*GetExperimentTemplateResponse, error)
+	DeleteExperimentTemplate(context.Context, *DeleteExperimentTemplateRequest) (*DeleteExperimentTemplateResponse, error)
+	// Create an experiment from a template and the values of its parameters. The resulting configuration is validated
+	// before the experiment is created.
+	CreateExperimentFromTemplate(context.Context, *CreateExperimentFromTemplateRequest) (*CreateExperimentFromTemplateResponse, error)
 }
 
 // UnimplementedExperimentsAPIServer should be embedded to have forward compatible implementations.
@@ -157,6 +295,36 @@ func (UnimplementedExperimentsAPIServer) GetListView(context.Context, *GetListVi
 func (UnimplementedExperimentsAPIServer) GetExperimentRunDetails(context.Context, *GetExperimentRunDetailsRequest) (*GetExperimentRunDetailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetExperimentRunDetails not implemented")
 }
+func (UnimplementedExperimentsAPIServer) GetExperimentRunReport(context.Context, *GetExperimentRunReportRequest) (*GetExperimentRunReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperimentRunReport not implemented")
+}
+func (UnimplementedExperimentsAPIServer) CreateExperimentSchedule(context.Context, *CreateExperimentScheduleRequest) (*CreateExperimentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExperimentSchedule not implemented")
+}
+func (UnimplementedExperimentsAPIServer) GetExperimentSchedules(context.Context, *GetExperimentSchedulesRequest) (*GetExperimentSchedulesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperimentSchedules not implemented")
+}
+func (UnimplementedExperimentsAPIServer) SetExperimentSchedulePaused(context.Context, *SetExperimentSchedulePausedRequest) (*SetExperimentSchedulePausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExperimentSchedulePaused not implemented")
+}
+func (UnimplementedExperimentsAPIServer) DeleteExperimentSchedule(context.Context, *DeleteExperimentScheduleRequest) (*DeleteExperimentScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExperimentSchedule not implemented")
+}
+func (UnimplementedExperimentsAPIServer) CreateExperimentTemplate(context.Context, *CreateExperimentTemplateRequest) (*CreateExperimentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExperimentTemplate not implemented")
+}
+func (UnimplementedExperimentsAPIServer) GetExperimentTemplates(context.Context, *GetExperimentTemplatesRequest) (*GetExperimentTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperimentTemplates not implemented")
+}
+func (UnimplementedExperimentsAPIServer) GetExperimentTemplate(context.Context, *GetExperimentTemplateRequest) (*GetExperimentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExperimentTemplate not implemented")
+}
+func (UnimplementedExperimentsAPIServer) DeleteExperimentTemplate(context.Context, *DeleteExperimentTemplateRequest) (*DeleteExperimentTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteExperimentTemplate not implemented")
+}
+func (UnimplementedExperimentsAPIServer) CreateExperimentFromTemplate(context.Context, *CreateExperimentFromTemplateRequest) (*CreateExperimentFromTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateExperimentFromTemplate not implemented")
+}
 
 // UnsafeExperimentsAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ExperimentsAPIServer will
@@ -277,6 +445,186 @@ func _ExperimentsAPI_GetExperimentRunDetails_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ExperimentsAPI_GetExperimentRunReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentRunReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).GetExperimentRunReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_GetExperimentRunReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).GetExperimentRunReport(ctx, req.(*GetExperimentRunReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentsAPI_CreateExperimentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperimentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).CreateExperimentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_CreateExperimentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).CreateExperimentSchedule(ctx, req.(*CreateExperimentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentsAPI_GetExperimentSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).GetExperimentSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_GetExperimentSchedules_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).GetExperimentSchedules(ctx, req.(*GetExperimentSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentsAPI_SetExperimentSchedulePaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExperimentSchedulePausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).SetExperimentSchedulePaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_SetExperimentSchedulePaused_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).SetExperimentSchedulePaused(ctx, req.(*SetExperimentSchedulePausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentsAPI_DeleteExperimentSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExperimentScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).DeleteExperimentSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_DeleteExperimentSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).DeleteExperimentSchedule(ctx, req.(*DeleteExperimentScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentsAPI_CreateExperimentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperimentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).CreateExperimentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_CreateExperimentTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).CreateExperimentTemplate(ctx, req.(*CreateExperimentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentsAPI_GetExperimentTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).GetExperimentTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_GetExperimentTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).GetExperimentTemplates(ctx, req.(*GetExperimentTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentsAPI_GetExperimentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExperimentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).GetExperimentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_GetExperimentTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).GetExperimentTemplate(ctx, req.(*GetExperimentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentsAPI_DeleteExperimentTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteExperimentTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).DeleteExperimentTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_DeleteExperimentTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).DeleteExperimentTemplate(ctx, req.(*DeleteExperimentTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ExperimentsAPI_CreateExperimentFromTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateExperimentFromTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ExperimentsAPIServer).CreateExperimentFromTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ExperimentsAPI_CreateExperimentFromTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ExperimentsAPIServer).CreateExperimentFromTemplate(ctx, req.(*CreateExperimentFromTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ExperimentsAPI_ServiceDesc is the grpc.ServiceDesc for ExperimentsAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -308,6 +656,46 @@ var ExperimentsAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetExperimentRunDetails",
 			Handler:    _ExperimentsAPI_GetExperimentRunDetails_Handler,
 		},
+		{
+			MethodName: "GetExperimentRunReport",
+			Handler:    _ExperimentsAPI_GetExperimentRunReport_Handler,
+		},
+		{
+			MethodName: "CreateExperimentSchedule",
+			Handler:    _ExperimentsAPI_CreateExperimentSchedule_Handler,
+		},
+		{
+			MethodName: "GetExperimentSchedules",
+			Handler:    _ExperimentsAPI_GetExperimentSchedules_Handler,
+		},
+		{
+			MethodName: "SetExperimentSchedulePaused",
+			Handler:    _ExperimentsAPI_SetExperimentSchedulePaused_Handler,
+		},
+		{
+			MethodName: "DeleteExperimentSchedule",
+			Handler:    _ExperimentsAPI_DeleteExperimentSchedule_Handler,
+		},
+		{
+			MethodName: "CreateExperimentTemplate",
+			Handler:    _ExperimentsAPI_CreateExperimentTemplate_Handler,
+		},
+		{
+			MethodName: "GetExperimentTemplates",
+			Handler:    _ExperimentsAPI_GetExperimentTemplates_Handler,
+		},
+		{
+			MethodName: "GetExperimentTemplate",
+			Handler:    _ExperimentsAPI_GetExperimentTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteExperimentTemplate",
+			Handler:    _ExperimentsAPI_DeleteExperimentTemplate_Handler,
+		},
+		{
+			MethodName: "CreateExperimentFromTemplate",
+			Handler:    _ExperimentsAPI_CreateExperimentFromTemplate_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "chaos/experimentation/v1/experimentation.proto",
@@ -0,0 +1,512 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.17.3
+// source: chaos/experimentation/v1/run_report.proto
+
+package experimentationv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TimelineEvent_Type int32
+
+const (
+	TimelineEvent_TYPE_UNSPECIFIED TimelineEvent_Type = 0
+	TimelineEvent_TYPE_CREATED     TimelineEvent_Type = 1
+	TimelineEvent_TYPE_STARTED     TimelineEvent_Type = 2
+	// The run was cancelled by a user or terminated by a termination criterion before it ended.
+	TimelineEvent_TYPE_CANCELED TimelineEvent_Type = 3
+	TimelineEvent_TYPE_ENDED    TimelineEvent_Type = 4
+	// A request concerning the run, as recorded by the audit service.
+	TimelineEvent_TYPE_AUDIT TimelineEvent_Type = 5
+)
+
+// Enum value maps for TimelineEvent_Type.
+var (
+	TimelineEvent_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_CREATED",
+		2: "TYPE_STARTED",
+		3: "TYPE_CANCELED",
+		4: "TYPE_ENDED",
+		5: "TYPE_AUDIT",
+	}
+	TimelineEvent_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_CREATED":     1,
+		"TYPE_STARTED":     2,
+		"TYPE_CANCELED":    3,
+		"TYPE_ENDED":       4,
+		"TYPE_AUDIT":       5,
+	}
+)
+
+func (x TimelineEvent_Type) Enum() *TimelineEvent_Type {
+	p := new(TimelineEvent_Type)
+	*p = x
+	return p
+}
+
+func (x TimelineEvent_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimelineEvent_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_chaos_experimentation_v1_run_report_proto_enumTypes[0].Descriptor()
+}
+
+func (TimelineEvent_Type) Type() protoreflect.EnumType {
+	return &file_chaos_experimentation_v1_run_report_proto_enumTypes[0]
+}
+
+func (x TimelineEvent_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimelineEvent_Type.Descriptor instead.
+func (TimelineEvent_Type) EnumDescriptor() ([]byte, []int) {
+	return file_chaos_experimentation_v1_run_report_proto_rawDescGZIP(), []int{1, 0}
+}
+
+// A report of what happened during an experiment run, e.g. for postmortems and game day writeups.
+type ExperimentRunReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The details of the run, as returned by `GetExperimentRunDetails`.
+	Details *ExperimentRunDetails `protobuf:"bytes,1,opt,name=details,proto3" json:"details,omitempty"`
+	// The events of the run in chronological order.
+	Timeline []*TimelineEvent `protobuf:"bytes,2,rep,name=timeline,proto3" json:"timeline,omitempty"`
+	// Summaries of the configured metrics of the clusters targeted by the run. Empty if no metrics provider is
+	// configured.
+	MetricSummaries []*MetricSummary       `protobuf:"bytes,3,rep,name=metric_summaries,json=metricSummaries,proto3" json:"metric_summaries,omitempty"`
+	GenerationTime  *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=generation_time,json=generationTime,proto3" json:"generation_time,omitempty"`
+	// Whether audit events of the run were left out of the timeline, because more audit events concern the run than a
+	// report reads.
+	AuditEventsTruncated bool `protobuf:"varint,5,opt,name=audit_events_truncated,json=auditEventsTruncated,proto3" json:"audit_events_truncated,omitempty"`
+}
+
+func (x *ExperimentRunReport) Reset() {
+	*x = ExperimentRunReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_experimentation_v1_run_report_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExperimentRunReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExperimentRunReport) ProtoMessage() {}
+
+func (x *ExperimentRunReport) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_experimentation_v1_run_report_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExperimentRunReport.ProtoReflect.Descriptor instead.
+func (*ExperimentRunReport) Descriptor() ([]byte, []int) {
+	return file_chaos_experimentation_v1_run_report_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ExperimentRunReport) GetDetails() *ExperimentRunDetails {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *ExperimentRunReport) GetTimeline() []*TimelineEvent {
+	if x != nil {
+		return x.Timeline
+	}
+	return nil
+}
+
+func (x *ExperimentRunReport) GetMetricSummaries() []*MetricSummary {
+	if x != nil {
+		return x.MetricSummaries
+	}
+	return nil
+}
+
+func (x *ExperimentRunReport) GetGenerationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.GenerationTime
+	}
+	return nil
+}
+
+func (x *ExperimentRunReport) GetAuditEventsTruncated() bool {
+	if x != nil {
+		return x.AuditEventsTruncated
+	}
+	return false
+}
+
+type TimelineEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Type        TimelineEvent_Type     `protobuf:"varint,2,opt,name=type,proto3,enum=clutch.chaos.experimentation.v1.TimelineEvent_Type" json:"type,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// The user who made the request of an audit event.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *TimelineEvent) Reset() {
+	*x = TimelineEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_experimentation_v1_run_report_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TimelineEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimelineEvent) ProtoMessage() {}
+
+func (x *TimelineEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_experimentation_v1_run_report_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimelineEvent.ProtoReflect.Descriptor instead.
+func (*TimelineEvent) Descriptor() ([]byte, []int) {
+	return file_chaos_experimentation_v1_run_report_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TimelineEvent) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *TimelineEvent) GetType() TimelineEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return TimelineEvent_TYPE_UNSPECIFIED
+}
+
+func (x *TimelineEvent) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *TimelineEvent) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+// The value of a metric of a cluster before, during and after a run. Each value is unset if the period it describes
+// is not over yet or the metric has no value for it.
+type MetricSummary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the metric.
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Cluster string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// Evaluated over the period that ends when the run starts and is as long as the run.
+	Before *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=before,proto3" json:"before,omitempty"`
+	During *wrapperspb.DoubleValue `protobuf:"bytes,4,opt,name=during,proto3" json:"during,omitempty"`
+	// Evaluated over the period that starts when the run ends and is as long as the run.
+	After *wrapperspb.DoubleValue `protobuf:"bytes,5,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *MetricSummary) Reset() {
+	*x = MetricSummary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_experimentation_v1_run_report_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricSummary) ProtoMessage() {}
+
+func (x *MetricSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_experimentation_v1_run_report_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricSummary.ProtoReflect.Descriptor instead.
+func (*MetricSummary) Descriptor() ([]byte, []int) {
+	return file_chaos_experimentation_v1_run_report_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MetricSummary) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MetricSummary) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *MetricSummary) GetBefore() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *MetricSummary) GetDuring() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.During
+	}
+	return nil
+}
+
+func (x *MetricSummary) GetAfter() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+var File_chaos_experimentation_v1_run_report_proto protoreflect.FileDescriptor
+
+var file_chaos_experimentation_v1_run_report_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x72, 0x75, 0x6e, 0x5f, 0x72,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1f, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1f, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x63,
+	0x68, 0x61, 0x6f, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x03, 0x0a, 0x13, 0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x4f, 0x0a, 0x07,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x45, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x75, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x4a, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x59, 0x0a, 0x10, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61,
+	0x6f, 0x73, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x61, 0x75, 0x64, 0x69, 0x74,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0xbb, 0x02, 0x0a, 0x0d, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x47, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x33, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x73, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x14, 0x0a, 0x10, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43,
+	0x52, 0x45, 0x41, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0e, 0x0a,
+	0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x12, 0x0e, 0x0a,
+	0x0a, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x55, 0x44, 0x49, 0x54, 0x10, 0x05, 0x22, 0xdd, 0x01,
+	0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a,
+	0x06, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x62, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x06, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x05, 0x61, 0x66, 0x74,
+	0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x42, 0x4f, 0x5a,
+	0x4d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74,
+	0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chaos_experimentation_v1_run_report_proto_rawDescOnce sync.Once
+	file_chaos_experimentation_v1_run_report_proto_rawDescData = file_chaos_experimentation_v1_run_report_proto_rawDesc
+)
+
+func file_chaos_experimentation_v1_run_report_proto_rawDescGZIP() []byte {
+	file_chaos_experimentation_v1_run_report_proto_rawDescOnce.Do(func() {
+		file_chaos_experimentation_v1_run_report_proto_rawDescData = protoimpl.X.CompressGZIP(file_chaos_experimentation_v1_run_report_proto_rawDescData)
+	})
+	return file_chaos_experimentation_v1_run_report_proto_rawDescData
+}
+
+var file_chaos_experimentation_v1_run_report_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_chaos_experimentation_v1_run_report_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_chaos_experimentation_v1_run_report_proto_goTypes = []interface{}{
+	(TimelineEvent_Type)(0),        // 0: clutch.chaos.experimentation.v1.TimelineEvent.Type
+	(*ExperimentRunReport)(nil),    // 1: clutch.chaos.experimentation.v1.ExperimentRunReport
+	(*TimelineEvent)(nil),          // 2: clutch.chaos.experimentation.v1.TimelineEvent
+	(*MetricSummary)(nil),          // 3: clutch.chaos.experimentation.v1.MetricSummary
+	(*ExperimentRunDetails)(nil),   // 4: clutch.chaos.experimentation.v1.ExperimentRunDetails
+	(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	(*wrapperspb.DoubleValue)(nil), // 6: google.protobuf.DoubleValue
+}
+var file_chaos_experimentation_v1_run_report_proto_depIdxs = []int32{
+	4, // 0: clutch.chaos.experimentation.v1.ExperimentRunReport.details:type_name -> clutch.chaos.experimentation.v1.ExperimentRunDetails
+	2, // 1: clutch.chaos.experimentation.v1.ExperimentRunReport.timeline:type_name -> clutch.chaos.experimentation.v1.TimelineEvent
+	3, // 2: clutch.chaos.experimentation.v1.ExperimentRunReport.metric_summaries:type_name -> clutch.chaos.experimentation.v1.MetricSummary
+	5, // 3: clutch.chaos.experimentation.v1.ExperimentRunReport.generation_time:type_name -> google.protobuf.Timestamp
+	5, // 4: clutch.chaos.experimentation.v1.TimelineEvent.time:type_name -> google.protobuf.Timestamp
+	0, // 5: clutch.chaos.experimentation.v1.TimelineEvent.type:type_name -> clutch.chaos.experimentation.v1.TimelineEvent.Type
+	6, // 6: clutch.chaos.experimentation.v1.MetricSummary.before:type_name -> google.protobuf.DoubleValue
+	6, // 7: clutch.chaos.experimentation.v1.MetricSummary.during:type_name -> google.protobuf.DoubleValue
+	6, // 8: clutch.chaos.experimentation.v1.MetricSummary.after:type_name -> google.protobuf.DoubleValue
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
+}
+
+func init() { file_chaos_experimentation_v1_run_report_proto_init() }
+func file_chaos_experimentation_v1_run_report_proto_init() {
+	if File_chaos_experimentation_v1_run_report_proto != nil {
+		return
+	}
+	file_chaos_experimentation_v1_experiment_run_details_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_chaos_experimentation_v1_run_report_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExperimentRunReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_experimentation_v1_run_report_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TimelineEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_experimentation_v1_run_report_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricSummary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_chaos_experimentation_v1_run_report_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_chaos_experimentation_v1_run_report_proto_goTypes,
+		DependencyIndexes: file_chaos_experimentation_v1_run_report_proto_depIdxs,
+		EnumInfos:         file_chaos_experimentation_v1_run_report_proto_enumTypes,
+		MessageInfos:      file_chaos_experimentation_v1_run_report_proto_msgTypes,
+	}.Build()
+	File_chaos_experimentation_v1_run_report_proto = out.File
+	file_chaos_experimentation_v1_run_report_proto_rawDesc = nil
+	file_chaos_experimentation_v1_run_report_proto_goTypes = nil
+	file_chaos_experimentation_v1_run_report_proto_depIdxs = nil
+}
@@ -0,0 +1,592 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: chaos/experimentation/v1/run_report.proto
+
+package experimentationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ExperimentRunReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExperimentRunReport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExperimentRunReport with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExperimentRunReportMultiError, or nil if none found.
+func (m *ExperimentRunReport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExperimentRunReport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetDetails()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExperimentRunReportValidationError{
+					field:  "Details",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExperimentRunReportValidationError{
+					field:  "Details",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDetails()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperimentRunReportValidationError{
+				field:  "Details",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetTimeline() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExperimentRunReportValidationError{
+						field:  fmt.Sprintf("Timeline[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExperimentRunReportValidationError{
+						field:  fmt.Sprintf("Timeline[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExperimentRunReportValidationError{
+					field:  fmt.Sprintf("Timeline[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetMetricSummaries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExperimentRunReportValidationError{
+						field:  fmt.Sprintf("MetricSummaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExperimentRunReportValidationError{
+						field:  fmt.Sprintf("MetricSummaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExperimentRunReportValidationError{
+					field:  fmt.Sprintf("MetricSummaries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetGenerationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExperimentRunReportValidationError{
+					field:  "GenerationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExperimentRunReportValidationError{
+					field:  "GenerationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGenerationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperimentRunReportValidationError{
+				field:  "GenerationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for AuditEventsTruncated
+
+	if len(errors) > 0 {
+		return ExperimentRunReportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExperimentRunReportMultiError is an error wrapping multiple validation
+// errors returned by ExperimentRunReport.ValidateAll() if the designated
+// constraints aren't met.
+type ExperimentRunReportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExperimentRunReportMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExperimentRunReportMultiError) AllErrors() []error { return m }
+
+// ExperimentRunReportValidationError is the validation error returned by
+// ExperimentRunReport.Validate if the designated constraints aren't met.
+type ExperimentRunReportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExperimentRunReportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExperimentRunReportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExperimentRunReportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExperimentRunReportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExperimentRunReportValidationError) ErrorName() string {
+	return "ExperimentRunReportValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExperimentRunReportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExperimentRunReport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExperimentRunReportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExperimentRunReportValidationError{}
+
+// Validate checks the field values on TimelineEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TimelineEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TimelineEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TimelineEventMultiError, or
+// nil if none found.
+func (m *TimelineEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TimelineEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TimelineEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TimelineEventValidationError{
+					field:  "Time",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TimelineEventValidationError{
+				field:  "Time",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Type
+
+	// no validation rules for Description
+
+	// no validation rules for Username
+
+	if len(errors) > 0 {
+		return TimelineEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// TimelineEventMultiError is an error wrapping multiple validation errors
+// returned by TimelineEvent.ValidateAll() if the designated constraints
+// aren't met.
+type TimelineEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TimelineEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TimelineEventMultiError) AllErrors() []error { return m }
+
+// TimelineEventValidationError is the validation error returned by
+// TimelineEvent.Validate if the designated constraints aren't met.
+type TimelineEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TimelineEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TimelineEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TimelineEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TimelineEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TimelineEventValidationError) ErrorName() string { return "TimelineEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TimelineEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTimelineEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TimelineEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TimelineEventValidationError{}
+
+// Validate checks the field values on MetricSummary with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricSummary with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricSummaryMultiError, or
+// nil if none found.
+func (m *MetricSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Cluster
+
+	if all {
+		switch v := interface{}(m.GetBefore()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricSummaryValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricSummaryValidationError{
+					field:  "Before",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBefore()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricSummaryValidationError{
+				field:  "Before",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDuring()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricSummaryValidationError{
+					field:  "During",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricSummaryValidationError{
+					field:  "During",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuring()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricSummaryValidationError{
+				field:  "During",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricSummaryValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricSummaryValidationError{
+					field:  "After",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricSummaryValidationError{
+				field:  "After",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetricSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricSummaryMultiError is an error wrapping multiple validation errors
+// returned by MetricSummary.ValidateAll() if the designated constraints
+// aren't met.
+type MetricSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricSummaryMultiError) AllErrors() []error { return m }
+
+// MetricSummaryValidationError is the validation error returned by
+// MetricSummary.Validate if the designated constraints aren't met.
+type MetricSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricSummaryValidationError) ErrorName() string { return "MetricSummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricSummaryValidationError{}
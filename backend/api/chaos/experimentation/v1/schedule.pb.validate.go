@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: chaos/experimentation/v1/schedule.proto
+
+package experimentationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on BlackoutWindow with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BlackoutWindow) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlackoutWindow with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BlackoutWindowMultiError,
+// or nil if none found.
+func (m *BlackoutWindow) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlackoutWindow) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetStartTime() == nil {
+		err := BlackoutWindowValidationError{
+			field:  "StartTime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEndTime() == nil {
+		err := BlackoutWindowValidationError{
+			field:  "EndTime",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 150 {
+		err := BlackoutWindowValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 150 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return BlackoutWindowMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlackoutWindowMultiError is an error wrapping multiple validation errors
+// returned by BlackoutWindow.ValidateAll() if the designated constraints
+// aren't met.
+type BlackoutWindowMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlackoutWindowMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlackoutWindowMultiError) AllErrors() []error { return m }
+
+// BlackoutWindowValidationError is the validation error returned by
+// BlackoutWindow.Validate if the designated constraints aren't met.
+type BlackoutWindowValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlackoutWindowValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlackoutWindowValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlackoutWindowValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlackoutWindowValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlackoutWindowValidationError) ErrorName() string { return "BlackoutWindowValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlackoutWindowValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlackoutWindow.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlackoutWindowValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlackoutWindowValidationError{}
+
+// Validate checks the field values on ExperimentSchedule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExperimentSchedule) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExperimentSchedule with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExperimentScheduleMultiError, or nil if none found.
+func (m *ExperimentSchedule) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExperimentSchedule) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperimentScheduleValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Cron
+
+	if all {
+		switch v := interface{}(m.GetDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "Duration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperimentScheduleValidationError{
+				field:  "Duration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetBlackoutWindows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExperimentScheduleValidationError{
+						field:  fmt.Sprintf("BlackoutWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExperimentScheduleValidationError{
+						field:  fmt.Sprintf("BlackoutWindows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExperimentScheduleValidationError{
+					field:  fmt.Sprintf("BlackoutWindows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Paused
+
+	if all {
+		switch v := interface{}(m.GetCreationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "CreationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "CreationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperimentScheduleValidationError{
+				field:  "CreationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastOccurrenceTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "LastOccurrenceTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "LastOccurrenceTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastOccurrenceTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperimentScheduleValidationError{
+				field:  "LastOccurrenceTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for LastRunId
+
+	if all {
+		switch v := interface{}(m.GetNextOccurrenceTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "NextOccurrenceTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExperimentScheduleValidationError{
+					field:  "NextOccurrenceTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextOccurrenceTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperimentScheduleValidationError{
+				field:  "NextOccurrenceTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExperimentScheduleMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExperimentScheduleMultiError is an error wrapping multiple validation errors
+// returned by ExperimentSchedule.ValidateAll() if the designated constraints
+// aren't met.
+type ExperimentScheduleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExperimentScheduleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExperimentScheduleMultiError) AllErrors() []error { return m }
+
+// ExperimentScheduleValidationError is the validation error returned by
+// ExperimentSchedule.Validate if the designated constraints aren't met.
+type ExperimentScheduleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExperimentScheduleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExperimentScheduleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExperimentScheduleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExperimentScheduleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExperimentScheduleValidationError) ErrorName() string {
+	return "ExperimentScheduleValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExperimentScheduleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExperimentSchedule.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExperimentScheduleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExperimentScheduleValidationError{}
@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: chaos/experimentation/v1/template.proto
+
+package experimentationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on TemplateParameter with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TemplateParameter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TemplateParameter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TemplateParameterMultiError, or nil if none found.
+func (m *TemplateParameter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TemplateParameter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetName()) > 64 {
+		err := TemplateParameterValidationError{
+			field:  "Name",
+			reason: "value length must be at most 64 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if !_TemplateParameter_Name_Pattern.MatchString(m.GetName()) {
+		err := TemplateParameterValidationError{
+			field:  "Name",
+			reason: "value does not match regex pattern \"^[A-Za-z_][A-Za-z0-9_]*$\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _TemplateParameter_Type_NotInLookup[m.GetType()]; ok {
+		err := TemplateParameterValidationError{
+			field:  "Type",
+			reason: "value must not be in list [TYPE_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := TemplateParameter_Type_name[int32(m.GetType())]; !ok {
+		err := TemplateParameterValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetDescription()) > 300 {
+		err := TemplateParameterValidationError{
+			field:  "Description",
+			reason: "value length must be at most 300 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetMin()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateParameterValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateParameterValidationError{
+					field:  "Min",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMin()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateParameterValidationError{
+				field:  "Min",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetMax()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TemplateParameterValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TemplateParameterValidationError{
+					field:  "Max",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMax()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TemplateParameterValidationError{
+				field:  "Max",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TemplateParameterMultiError(errors)
+	}
+
+	return nil
+}
+
+// TemplateParameterMultiError is an error wrapping multiple validation errors
+// returned by TemplateParameter.ValidateAll() if the designated constraints
+// aren't met.
+type TemplateParameterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TemplateParameterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TemplateParameterMultiError) AllErrors() []error { return m }
+
+// TemplateParameterValidationError is the validation error returned by
+// TemplateParameter.Validate if the designated constraints aren't met.
+type TemplateParameterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TemplateParameterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TemplateParameterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TemplateParameterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TemplateParameterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TemplateParameterValidationError) ErrorName() string {
+	return "TemplateParameterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TemplateParameterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTemplateParameter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TemplateParameterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TemplateParameterValidationError{}
+
+var _TemplateParameter_Name_Pattern = regexp.MustCompile("^[A-Za-z_][A-Za-z0-9_]*$")
+
+var _TemplateParameter_Type_NotInLookup = map[TemplateParameter_Type]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on ExperimentTemplate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExperimentTemplate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExperimentTemplate with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExperimentTemplateMultiError, or nil if none found.
+func (m *ExperimentTemplate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExperimentTemplate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Description
+
+	if all {
+		switch v := interface{}(m.GetConfig()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExperimentTemplateValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExperimentTemplateValidationError{
+					field:  "Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfig()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperimentTemplateValidationError{
+				field:  "Config",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetParameters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExperimentTemplateValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExperimentTemplateValidationError{
+						field:  fmt.Sprintf("Parameters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExperimentTemplateValidationError{
+					field:  fmt.Sprintf("Parameters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExperimentTemplateValidationError{
+					field:  "CreationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExperimentTemplateValidationError{
+					field:  "CreationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExperimentTemplateValidationError{
+				field:  "CreationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ExperimentTemplateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExperimentTemplateMultiError is an error wrapping multiple validation errors
+// returned by ExperimentTemplate.ValidateAll() if the designated constraints
+// aren't met.
+type ExperimentTemplateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExperimentTemplateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExperimentTemplateMultiError) AllErrors() []error { return m }
+
+// ExperimentTemplateValidationError is the validation error returned by
+// ExperimentTemplate.Validate if the designated constraints aren't met.
+type ExperimentTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExperimentTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExperimentTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExperimentTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExperimentTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExperimentTemplateValidationError) ErrorName() string {
+	return "ExperimentTemplateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExperimentTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExperimentTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExperimentTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExperimentTemplateValidationError{}
@@ -0,0 +1,547 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.17.3
+// source: chaos/k8sexperimentation/v1/k8sexperimentation.proto
+
+package k8sexperimentationv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The configuration of a fault injected into Kubernetes pods.
+type PodFaultConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The targeting of the fault describing what pods are being considered for faults.
+	Targeting *PodTargeting `protobuf:"bytes,1,opt,name=targeting,proto3" json:"targeting,omitempty"`
+	// Types that are assignable to Fault:
+	//
+	//	*PodFaultConfig_PodKillFault
+	//	*PodFaultConfig_StressFault
+	Fault isPodFaultConfig_Fault `protobuf_oneof:"fault"`
+}
+
+func (x *PodFaultConfig) Reset() {
+	*x = PodFaultConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodFaultConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodFaultConfig) ProtoMessage() {}
+
+func (x *PodFaultConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodFaultConfig.ProtoReflect.Descriptor instead.
+func (*PodFaultConfig) Descriptor() ([]byte, []int) {
+	return file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PodFaultConfig) GetTargeting() *PodTargeting {
+	if x != nil {
+		return x.Targeting
+	}
+	return nil
+}
+
+func (m *PodFaultConfig) GetFault() isPodFaultConfig_Fault {
+	if m != nil {
+		return m.Fault
+	}
+	return nil
+}
+
+func (x *PodFaultConfig) GetPodKillFault() *PodKillFault {
+	if x, ok := x.GetFault().(*PodFaultConfig_PodKillFault); ok {
+		return x.PodKillFault
+	}
+	return nil
+}
+
+func (x *PodFaultConfig) GetStressFault() *StressFault {
+	if x, ok := x.GetFault().(*PodFaultConfig_StressFault); ok {
+		return x.StressFault
+	}
+	return nil
+}
+
+type isPodFaultConfig_Fault interface {
+	isPodFaultConfig_Fault()
+}
+
+type PodFaultConfig_PodKillFault struct {
+	// The pod kill fault.
+	PodKillFault *PodKillFault `protobuf:"bytes,2,opt,name=pod_kill_fault,json=podKillFault,proto3,oneof"`
+}
+
+type PodFaultConfig_StressFault struct {
+	// The stress fault.
+	StressFault *StressFault `protobuf:"bytes,3,opt,name=stress_fault,json=stressFault,proto3,oneof"`
+}
+
+func (*PodFaultConfig_PodKillFault) isPodFaultConfig_Fault() {}
+
+func (*PodFaultConfig_StressFault) isPodFaultConfig_Fault() {}
+
+// The pods that are considered for fault injection.
+type PodTargeting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The clientset to use, which may be empty if the cluster identifies the clientset.
+	Clientset string `protobuf:"bytes,1,opt,name=clientset,proto3" json:"clientset,omitempty"`
+	// The cluster of the pods. Guardrails treat it as the upstream cluster of the experiment, so denied clusters, tiers
+	// and the limit of experiments per upstream cluster apply to it.
+	Cluster string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	// The namespace of the pods.
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	// The labels that pods must have to be considered.
+	LabelSelector map[string]string `protobuf:"bytes,4,rep,name=label_selector,json=labelSelector,proto3" json:"label_selector,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PodTargeting) Reset() {
+	*x = PodTargeting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodTargeting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodTargeting) ProtoMessage() {}
+
+func (x *PodTargeting) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodTargeting.ProtoReflect.Descriptor instead.
+func (*PodTargeting) Descriptor() ([]byte, []int) {
+	return file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PodTargeting) GetClientset() string {
+	if x != nil {
+		return x.Clientset
+	}
+	return ""
+}
+
+func (x *PodTargeting) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *PodTargeting) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PodTargeting) GetLabelSelector() map[string]string {
+	if x != nil {
+		return x.LabelSelector
+	}
+	return nil
+}
+
+// The definition of a pod kill fault, which deletes a percentage of the matching pods on an interval for as long as
+// the experiment runs.
+type PodKillFault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The percentage of the matching pods to delete on every interval, rounded up to at least one pod.
+	Percentage uint32 `protobuf:"varint,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// The interval at which pods are deleted.
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+}
+
+func (x *PodKillFault) Reset() {
+	*x = PodKillFault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PodKillFault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PodKillFault) ProtoMessage() {}
+
+func (x *PodKillFault) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PodKillFault.ProtoReflect.Descriptor instead.
+func (*PodKillFault) Descriptor() ([]byte, []int) {
+	return file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PodKillFault) GetPercentage() uint32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *PodKillFault) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+// The definition of a stress fault, which adds an ephemeral container generating CPU and/or memory load to a
+// percentage of the matching pods when the experiment starts.
+type StressFault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The percentage of the matching pods to stress, rounded up to at least one pod.
+	Percentage uint32 `protobuf:"varint,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// The number of workers generating CPU load.
+	CpuWorkers uint32 `protobuf:"varint,2,opt,name=cpu_workers,json=cpuWorkers,proto3" json:"cpu_workers,omitempty"`
+	// The load of every CPU worker in percent, defaults to 100.
+	CpuLoad uint32 `protobuf:"varint,3,opt,name=cpu_load,json=cpuLoad,proto3" json:"cpu_load,omitempty"`
+	// The number of workers allocating memory.
+	MemoryWorkers uint32 `protobuf:"varint,4,opt,name=memory_workers,json=memoryWorkers,proto3" json:"memory_workers,omitempty"`
+	// The memory allocated by every memory worker in MiB.
+	MemoryMbPerWorker uint32 `protobuf:"varint,5,opt,name=memory_mb_per_worker,json=memoryMbPerWorker,proto3" json:"memory_mb_per_worker,omitempty"`
+	// How long the stress lasts. Defaults to the remaining duration of the experiment, which must then have an end time.
+	// Ephemeral containers can't be removed, so the stress is not stopped if the experiment is cancelled.
+	Duration *durationpb.Duration `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *StressFault) Reset() {
+	*x = StressFault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StressFault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StressFault) ProtoMessage() {}
+
+func (x *StressFault) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StressFault.ProtoReflect.Descriptor instead.
+func (*StressFault) Descriptor() ([]byte, []int) {
+	return file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StressFault) GetPercentage() uint32 {
+	if x != nil {
+		return x.Percentage
+	}
+	return 0
+}
+
+func (x *StressFault) GetCpuWorkers() uint32 {
+	if x != nil {
+		return x.CpuWorkers
+	}
+	return 0
+}
+
+func (x *StressFault) GetCpuLoad() uint32 {
+	if x != nil {
+		return x.CpuLoad
+	}
+	return 0
+}
+
+func (x *StressFault) GetMemoryWorkers() uint32 {
+	if x != nil {
+		return x.MemoryWorkers
+	}
+	return 0
+}
+
+func (x *StressFault) GetMemoryMbPerWorker() uint32 {
+	if x != nil {
+		return x.MemoryMbPerWorker
+	}
+	return 0
+}
+
+func (x *StressFault) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+var File_chaos_k8sexperimentation_v1_k8sexperimentation_proto protoreflect.FileDescriptor
+
+var file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDesc = []byte{
+	0x0a, 0x34, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x38,
+	0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x22, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
+	0x68, 0x61, 0x6f, 0x73, 0x2e, 0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0xa8, 0x02, 0x0a, 0x0e, 0x50, 0x6f, 0x64, 0x46, 0x61, 0x75, 0x6c, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x58, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67,
+	0x12, 0x58, 0x0a, 0x0e, 0x70, 0x6f, 0x64, 0x5f, 0x6b, 0x69, 0x6c, 0x6c, 0x5f, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f,
+	0x64, 0x4b, 0x69, 0x6c, 0x6c, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0c, 0x70, 0x6f,
+	0x64, 0x4b, 0x69, 0x6c, 0x6c, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x54, 0x0a, 0x0c, 0x73, 0x74,
+	0x72, 0x65, 0x73, 0x73, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e,
+	0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x75, 0x6c, 0x74,
+	0x42, 0x0c, 0x0a, 0x05, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xae,
+	0x02, 0x0a, 0x0c, 0x50, 0x6f, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x74, 0x12, 0x21, 0x0a,
+	0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x25, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x09, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x74, 0x0a, 0x0e, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x43, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x6b,
+	0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x64, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e,
+	0x67, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x9a, 0x01, 0x02, 0x08, 0x01, 0x52, 0x0d,
+	0x6c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x40, 0x0a,
+	0x12, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
+	0x7e, 0x0a, 0x0c, 0x50, 0x6f, 0x64, 0x4b, 0x69, 0x6c, 0x6c, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x29, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x64, 0x20, 0x00, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x43, 0x0a, 0x08, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa, 0x01, 0x06, 0x08,
+	0x01, 0x32, 0x02, 0x08, 0x0a, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0xaa, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x73, 0x73, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x29, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x64, 0x20, 0x00, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x63, 0x70,
+	0x75, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x40, 0x52, 0x0a, 0x63, 0x70, 0x75, 0x57, 0x6f, 0x72,
+	0x6b, 0x65, 0x72, 0x73, 0x12, 0x22, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x5f, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x52,
+	0x07, 0x63, 0x70, 0x75, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x2e, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x40, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x5f, 0x6d, 0x62, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x62,
+	0x50, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0xaa, 0x01, 0x04, 0x32, 0x02,
+	0x08, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x55, 0x5a, 0x53,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61,
+	0x70, 0x69, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6b,
+	0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescOnce sync.Once
+	file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescData = file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDesc
+)
+
+func file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescGZIP() []byte {
+	file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescOnce.Do(func() {
+		file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescData = protoimpl.X.CompressGZIP(file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescData)
+	})
+	return file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescData
+}
+
+var file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_goTypes = []interface{}{
+	(*PodFaultConfig)(nil),      // 0: clutch.chaos.k8sexperimentation.v1.PodFaultConfig
+	(*PodTargeting)(nil),        // 1: clutch.chaos.k8sexperimentation.v1.PodTargeting
+	(*PodKillFault)(nil),        // 2: clutch.chaos.k8sexperimentation.v1.PodKillFault
+	(*StressFault)(nil),         // 3: clutch.chaos.k8sexperimentation.v1.StressFault
+	nil,                         // 4: clutch.chaos.k8sexperimentation.v1.PodTargeting.LabelSelectorEntry
+	(*durationpb.Duration)(nil), // 5: google.protobuf.Duration
+}
+var file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_depIdxs = []int32{
+	1, // 0: clutch.chaos.k8sexperimentation.v1.PodFaultConfig.targeting:type_name -> clutch.chaos.k8sexperimentation.v1.PodTargeting
+	2, // 1: clutch.chaos.k8sexperimentation.v1.PodFaultConfig.pod_kill_fault:type_name -> clutch.chaos.k8sexperimentation.v1.PodKillFault
+	3, // 2: clutch.chaos.k8sexperimentation.v1.PodFaultConfig.stress_fault:type_name -> clutch.chaos.k8sexperimentation.v1.StressFault
+	4, // 3: clutch.chaos.k8sexperimentation.v1.PodTargeting.label_selector:type_name -> clutch.chaos.k8sexperimentation.v1.PodTargeting.LabelSelectorEntry
+	5, // 4: clutch.chaos.k8sexperimentation.v1.PodKillFault.interval:type_name -> google.protobuf.Duration
+	5, // 5: clutch.chaos.k8sexperimentation.v1.StressFault.duration:type_name -> google.protobuf.Duration
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_init() }
+func file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_init() {
+	if File_chaos_k8sexperimentation_v1_k8sexperimentation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PodFaultConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PodTargeting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PodKillFault); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StressFault); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[0].OneofWrappers = []interface{}{
+		(*PodFaultConfig_PodKillFault)(nil),
+		(*PodFaultConfig_StressFault)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_goTypes,
+		DependencyIndexes: file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_depIdxs,
+		MessageInfos:      file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes,
+	}.Build()
+	File_chaos_k8sexperimentation_v1_k8sexperimentation_proto = out.File
+	file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDesc = nil
+	file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_goTypes = nil
+	file_chaos_k8sexperimentation_v1_k8sexperimentation_proto_depIdxs = nil
+}
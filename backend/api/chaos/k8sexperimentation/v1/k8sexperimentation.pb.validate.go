@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: chaos/k8sexperimentation/v1/k8sexperimentation.proto
+
+package k8sexperimentationv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on PodFaultConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PodFaultConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PodFaultConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PodFaultConfigMultiError,
+// or nil if none found.
+func (m *PodFaultConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PodFaultConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetTargeting() == nil {
+		err := PodFaultConfigValidationError{
+			field:  "Targeting",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTargeting()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PodFaultConfigValidationError{
+					field:  "Targeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PodFaultConfigValidationError{
+					field:  "Targeting",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTargeting()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PodFaultConfigValidationError{
+				field:  "Targeting",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	oneofFaultPresent := false
+	switch v := m.Fault.(type) {
+	case *PodFaultConfig_PodKillFault:
+		if v == nil {
+			err := PodFaultConfigValidationError{
+				field:  "Fault",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofFaultPresent = true
+
+		if all {
+			switch v := interface{}(m.GetPodKillFault()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PodFaultConfigValidationError{
+						field:  "PodKillFault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PodFaultConfigValidationError{
+						field:  "PodKillFault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPodKillFault()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PodFaultConfigValidationError{
+					field:  "PodKillFault",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *PodFaultConfig_StressFault:
+		if v == nil {
+			err := PodFaultConfigValidationError{
+				field:  "Fault",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofFaultPresent = true
+
+		if all {
+			switch v := interface{}(m.GetStressFault()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PodFaultConfigValidationError{
+						field:  "StressFault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PodFaultConfigValidationError{
+						field:  "StressFault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetStressFault()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PodFaultConfigValidationError{
+					field:  "StressFault",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofFaultPresent {
+		err := PodFaultConfigValidationError{
+			field:  "Fault",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PodFaultConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// PodFaultConfigMultiError is an error wrapping multiple validation errors
+// returned by PodFaultConfig.ValidateAll() if the designated constraints
+// aren't met.
+type PodFaultConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PodFaultConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PodFaultConfigMultiError) AllErrors() []error { return m }
+
+// PodFaultConfigValidationError is the validation error returned by
+// PodFaultConfig.Validate if the designated constraints aren't met.
+type PodFaultConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PodFaultConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PodFaultConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PodFaultConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PodFaultConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PodFaultConfigValidationError) ErrorName() string { return "PodFaultConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PodFaultConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPodFaultConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PodFaultConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PodFaultConfigValidationError{}
+
+// Validate checks the field values on PodTargeting with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PodTargeting) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PodTargeting with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PodTargetingMultiError, or
+// nil if none found.
+func (m *PodTargeting) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PodTargeting) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Clientset
+
+	if len(m.GetCluster()) < 1 {
+		err := PodTargetingValidationError{
+			field:  "Cluster",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetNamespace()) < 1 {
+		err := PodTargetingValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetLabelSelector()) < 1 {
+		err := PodTargetingValidationError{
+			field:  "LabelSelector",
+			reason: "value must contain at least 1 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PodTargetingMultiError(errors)
+	}
+
+	return nil
+}
+
+// PodTargetingMultiError is an error wrapping multiple validation errors
+// returned by PodTargeting.ValidateAll() if the designated constraints aren't met.
+type PodTargetingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PodTargetingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PodTargetingMultiError) AllErrors() []error { return m }
+
+// PodTargetingValidationError is the validation error returned by
+// PodTargeting.Validate if the designated constraints aren't met.
+type PodTargetingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PodTargetingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PodTargetingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PodTargetingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PodTargetingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PodTargetingValidationError) ErrorName() string { return "PodTargetingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PodTargetingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPodTargeting.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PodTargetingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PodTargetingValidationError{}
+
+// Validate checks the field values on PodKillFault with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PodKillFault) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PodKillFault with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PodKillFaultMultiError, or
+// nil if none found.
+func (m *PodKillFault) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PodKillFault) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPercentage(); val <= 0 || val > 100 {
+		err := PodKillFaultValidationError{
+			field:  "Percentage",
+			reason: "value must be inside range (0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetInterval() == nil {
+		err := PodKillFaultValidationError{
+			field:  "Interval",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = PodKillFaultValidationError{
+				field:  "Interval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(10*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := PodKillFaultValidationError{
+					field:  "Interval",
+					reason: "value must be greater than or equal to 10s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return PodKillFaultMultiError(errors)
+	}
+
+	return nil
+}
+
+// PodKillFaultMultiError is an error wrapping multiple validation errors
+// returned by PodKillFault.ValidateAll() if the designated constraints aren't met.
+type PodKillFaultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PodKillFaultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PodKillFaultMultiError) AllErrors() []error { return m }
+
+// PodKillFaultValidationError is the validation error returned by
+// PodKillFault.Validate if the designated constraints aren't met.
+type PodKillFaultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PodKillFaultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PodKillFaultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PodKillFaultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PodKillFaultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PodKillFaultValidationError) ErrorName() string { return "PodKillFaultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PodKillFaultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPodKillFault.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PodKillFaultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PodKillFaultValidationError{}
+
+// Validate checks the field values on StressFault with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StressFault) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StressFault with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StressFaultMultiError, or
+// nil if none found.
+func (m *StressFault) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StressFault) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetPercentage(); val <= 0 || val > 100 {
+		err := StressFaultValidationError{
+			field:  "Percentage",
+			reason: "value must be inside range (0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCpuWorkers() > 64 {
+		err := StressFaultValidationError{
+			field:  "CpuWorkers",
+			reason: "value must be less than or equal to 64",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetCpuLoad() > 100 {
+		err := StressFaultValidationError{
+			field:  "CpuLoad",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetMemoryWorkers() > 64 {
+		err := StressFaultValidationError{
+			field:  "MemoryWorkers",
+			reason: "value must be less than or equal to 64",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for MemoryMbPerWorker
+
+	if d := m.GetDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = StressFaultValidationError{
+				field:  "Duration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := StressFaultValidationError{
+					field:  "Duration",
+					reason: "value must be greater than or equal to 1s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return StressFaultMultiError(errors)
+	}
+
+	return nil
+}
+
+// StressFaultMultiError is an error wrapping multiple validation errors
+// returned by StressFault.ValidateAll() if the designated constraints aren't met.
+type StressFaultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StressFaultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StressFaultMultiError) AllErrors() []error { return m }
+
+// StressFaultValidationError is the validation error returned by
+// StressFault.Validate if the designated constraints aren't met.
+type StressFaultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StressFaultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StressFaultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StressFaultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StressFaultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StressFaultValidationError) ErrorName() string { return "StressFaultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StressFaultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStressFault.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StressFaultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StressFaultValidationError{}
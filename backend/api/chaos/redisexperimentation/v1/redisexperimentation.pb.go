@@ -30,7 +30,6 @@ type FaultConfig struct {
 	// The targeting of the fault describing what redis requests are being considered for faults.
 	FaultTargeting *FaultTargeting `protobuf:"bytes,1,opt,name=fault_targeting,json=faultTargeting,proto3" json:"fault_targeting,omitempty"`
 	// Types that are assignable to Fault:
-	//
 	//	*FaultConfig_ErrorFault
 	//	*FaultConfig_LatencyFault
 	Fault isFaultConfig_Fault `protobuf_oneof:"fault"`
@@ -171,6 +170,10 @@ type LatencyFault struct {
 
 	// The percentage of requests the fault should be applied to.
 	Percentage *FaultPercentage `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// The delay of the commands. If set, the experiment enables the runtime key of the redis proxy fault with this delay,
+	// e.g. "<prefix>.<upstream>.delay.100ms.fixed_delay_percent". If not set, the delay of the fault configured in the
+	// redis proxy of the downstream cluster applies.
+	LatencyDuration *FaultLatencyDuration `protobuf:"bytes,2,opt,name=latency_duration,json=latencyDuration,proto3" json:"latency_duration,omitempty"`
 }
 
 func (x *LatencyFault) Reset() {
@@ -212,6 +215,13 @@ func (x *LatencyFault) GetPercentage() *FaultPercentage {
 	return nil
 }
 
+func (x *LatencyFault) GetLatencyDuration() *FaultLatencyDuration {
+	if x != nil {
+		return x.LatencyDuration
+	}
+	return nil
+}
+
 // Enforce faults on upstream redis cluster.
 type FaultTargeting struct {
 	state         protoimpl.MessageState
@@ -222,6 +232,13 @@ type FaultTargeting struct {
 	UpstreamCluster *SingleCluster `protobuf:"bytes,1,opt,name=upstream_cluster,json=upstreamCluster,proto3" json:"upstream_cluster,omitempty"`
 	// A single downstream cluster sending requests to redis upstream.
 	DownstreamCluster *SingleCluster `protobuf:"bytes,2,opt,name=downstream_cluster,json=downstreamCluster,proto3" json:"downstream_cluster,omitempty"`
+	// The commands the fault is restricted to, e.g. "get". If set, the experiment enables the runtime key of the redis
+	// proxy fault of every command, e.g. "<prefix>.<upstream>.error.get.error_percent". If not set, the fault applies
+	// to all commands other than auth and ping.
+	//
+	// Keys can't be targeted by prefix, since faults of the redis proxy apply regardless of the keys of commands. To
+	// target keys with a given prefix, target the upstream cluster of the prefix route of the keys instead.
+	Commands []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
 }
 
 func (x *FaultTargeting) Reset() {
@@ -270,6 +287,61 @@ func (x *FaultTargeting) GetDownstreamCluster() *SingleCluster {
 	return nil
 }
 
+func (x *FaultTargeting) GetCommands() []string {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+// The fixed delay of a latency fault.
+type FaultLatencyDuration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FixedDurationMs uint32 `protobuf:"varint,1,opt,name=fixed_duration_ms,json=fixedDurationMs,proto3" json:"fixed_duration_ms,omitempty"`
+}
+
+func (x *FaultLatencyDuration) Reset() {
+	*x = FaultLatencyDuration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultLatencyDuration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultLatencyDuration) ProtoMessage() {}
+
+func (x *FaultLatencyDuration) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultLatencyDuration.ProtoReflect.Descriptor instead.
+func (*FaultLatencyDuration) Descriptor() ([]byte, []int) {
+	return file_chaos_redisexperimentation_v1_redisexperimentation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *FaultLatencyDuration) GetFixedDurationMs() uint32 {
+	if x != nil {
+		return x.FixedDurationMs
+	}
+	return 0
+}
+
 // A single cluster that is partaking in the fault injection.
 type SingleCluster struct {
 	state         protoimpl.MessageState
@@ -283,7 +355,7 @@ type SingleCluster struct {
 func (x *SingleCluster) Reset() {
 	*x = SingleCluster{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[4]
+		mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -296,7 +368,7 @@ func (x *SingleCluster) String() string {
 func (*SingleCluster) ProtoMessage() {}
 
 func (x *SingleCluster) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[4]
+	mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -309,7 +381,7 @@ func (x *SingleCluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleCluster.ProtoReflect.Descriptor instead.
 func (*SingleCluster) Descriptor() ([]byte, []int) {
-	return file_chaos_redisexperimentation_v1_redisexperimentation_proto_rawDescGZIP(), []int{4}
+	return file_chaos_redisexperimentation_v1_redisexperimentation_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *SingleCluster) GetName() string {
@@ -334,7 +406,7 @@ type FaultPercentage struct {
 func (x *FaultPercentage) Reset() {
 	*x = FaultPercentage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[5]
+		mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -347,7 +419,7 @@ func (x *FaultPercentage) String() string {
 func (*FaultPercentage) ProtoMessage() {}
 
 func (x *FaultPercentage) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[5]
+	mi := &file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -360,7 +432,7 @@ func (x *FaultPercentage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FaultPercentage.ProtoReflect.Descriptor instead.
 func (*FaultPercentage) Descriptor() ([]byte, []int) {
-	return file_chaos_redisexperimentation_v1_redisexperimentation_proto_rawDescGZIP(), []int{5}
+	return file_chaos_redisexperimentation_v1_redisexperimentation_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *FaultPercentage) GetPercentage() uint32 {
@@ -407,40 +479,55 @@ var file_chaos_redisexperimentation_v1_redisexperimentation_proto_rawDesc = []by
 	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50,
 	0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
 	0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22,
-	0x6f, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12,
-	0x5f, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61,
-	0x6f, 0x73, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74,
-	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x22, 0xd4, 0x01, 0x0a, 0x0e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x69, 0x6e, 0x67, 0x12, 0x5e, 0x0a, 0x10, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e,
-	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x64,
-	0x69, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x12, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x33, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x72,
-	0x65, 0x64, 0x69, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x0d, 0x53, 0x69, 0x6e, 0x67, 0x6c,
-	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0f, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65,
-	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42,
-	0x06, 0x2a, 0x04, 0x18, 0x64, 0x20, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x61, 0x67, 0x65, 0x42, 0x59, 0x5a, 0x57, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-	0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61,
-	0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f,
-	0x72, 0x65, 0x64, 0x69, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x64, 0x69, 0x73, 0x65, 0x78, 0x70,
-	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xd6, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x75, 0x6c, 0x74,
+	0x12, 0x5f, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68,
+	0x61, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x65, 0x0a, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x73,
+	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x8d, 0x02, 0x0a, 0x0e, 0x46, 0x61, 0x75,
+	0x6c, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x5e, 0x0a, 0x10, 0x75,
+	0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
+	0x68, 0x61, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e,
+	0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0f, 0x75, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x62, 0x0a, 0x12, 0x64,
+	0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x72, 0x65, 0x64, 0x69, 0x73, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x11, 0x64, 0x6f,
+	0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
+	0x37, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x1b, 0xfa, 0x42, 0x18, 0x92, 0x01, 0x15, 0x10, 0x14, 0x18, 0x01, 0x22, 0x0f, 0x72,
+	0x0d, 0x32, 0x0b, 0x5e, 0x5b, 0x41, 0x2d, 0x5a, 0x61, 0x2d, 0x7a, 0x5d, 0x2b, 0x24, 0x52, 0x08,
+	0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x22, 0x4b, 0x0a, 0x14, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x33, 0x0a, 0x11, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x2a, 0x02, 0x20, 0x00, 0x52, 0x0f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0x2c, 0x0a, 0x0d, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x3c, 0x0a, 0x0f, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a,
+	0x04, 0x18, 0x64, 0x20, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x42, 0x59, 0x5a, 0x57, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x72, 0x65,
+	0x64, 0x69, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x72, 0x65, 0x64, 0x69, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -455,28 +542,30 @@ func file_chaos_redisexperimentation_v1_redisexperimentation_proto_rawDescGZIP()
 	return file_chaos_redisexperimentation_v1_redisexperimentation_proto_rawDescData
 }
 
-var file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 var file_chaos_redisexperimentation_v1_redisexperimentation_proto_goTypes = []interface{}{
-	(*FaultConfig)(nil),     // 0: clutch.chaos.redisexperimentation.v1.FaultConfig
-	(*ErrorFault)(nil),      // 1: clutch.chaos.redisexperimentation.v1.ErrorFault
-	(*LatencyFault)(nil),    // 2: clutch.chaos.redisexperimentation.v1.LatencyFault
-	(*FaultTargeting)(nil),  // 3: clutch.chaos.redisexperimentation.v1.FaultTargeting
-	(*SingleCluster)(nil),   // 4: clutch.chaos.redisexperimentation.v1.SingleCluster
-	(*FaultPercentage)(nil), // 5: clutch.chaos.redisexperimentation.v1.FaultPercentage
+	(*FaultConfig)(nil),          // 0: clutch.chaos.redisexperimentation.v1.FaultConfig
+	(*ErrorFault)(nil),           // 1: clutch.chaos.redisexperimentation.v1.ErrorFault
+	(*LatencyFault)(nil),         // 2: clutch.chaos.redisexperimentation.v1.LatencyFault
+	(*FaultTargeting)(nil),       // 3: clutch.chaos.redisexperimentation.v1.FaultTargeting
+	(*FaultLatencyDuration)(nil), // 4: clutch.chaos.redisexperimentation.v1.FaultLatencyDuration
+	(*SingleCluster)(nil),        // 5: clutch.chaos.redisexperimentation.v1.SingleCluster
+	(*FaultPercentage)(nil),      // 6: clutch.chaos.redisexperimentation.v1.FaultPercentage
 }
 var file_chaos_redisexperimentation_v1_redisexperimentation_proto_depIdxs = []int32{
 	3, // 0: clutch.chaos.redisexperimentation.v1.FaultConfig.fault_targeting:type_name -> clutch.chaos.redisexperimentation.v1.FaultTargeting
 	1, // 1: clutch.chaos.redisexperimentation.v1.FaultConfig.error_fault:type_name -> clutch.chaos.redisexperimentation.v1.ErrorFault
 	2, // 2: clutch.chaos.redisexperimentation.v1.FaultConfig.latency_fault:type_name -> clutch.chaos.redisexperimentation.v1.LatencyFault
-	5, // 3: clutch.chaos.redisexperimentation.v1.ErrorFault.percentage:type_name -> clutch.chaos.redisexperimentation.v1.FaultPercentage
-	5, // 4: clutch.chaos.redisexperimentation.v1.LatencyFault.percentage:type_name -> clutch.chaos.redisexperimentation.v1.FaultPercentage
-	4, // 5: clutch.chaos.redisexperimentation.v1.FaultTargeting.upstream_cluster:type_name -> clutch.chaos.redisexperimentation.v1.SingleCluster
-	4, // 6: clutch.chaos.redisexperimentation.v1.FaultTargeting.downstream_cluster:type_name -> clutch.chaos.redisexperimentation.v1.SingleCluster
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	6, // 3: clutch.chaos.redisexperimentation.v1.ErrorFault.percentage:type_name -> clutch.chaos.redisexperimentation.v1.FaultPercentage
+	6, // 4: clutch.chaos.redisexperimentation.v1.LatencyFault.percentage:type_name -> clutch.chaos.redisexperimentation.v1.FaultPercentage
+	4, // 5: clutch.chaos.redisexperimentation.v1.LatencyFault.latency_duration:type_name -> clutch.chaos.redisexperimentation.v1.FaultLatencyDuration
+	5, // 6: clutch.chaos.redisexperimentation.v1.FaultTargeting.upstream_cluster:type_name -> clutch.chaos.redisexperimentation.v1.SingleCluster
+	5, // 7: clutch.chaos.redisexperimentation.v1.FaultTargeting.downstream_cluster:type_name -> clutch.chaos.redisexperimentation.v1.SingleCluster
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_chaos_redisexperimentation_v1_redisexperimentation_proto_init() }
@@ -534,7 +623,7 @@ func file_chaos_redisexperimentation_v1_redisexperimentation_proto_init() {
 			}
 		}
 		file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleCluster); i {
+			switch v := v.(*FaultLatencyDuration); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -546,6 +635,18 @@ func file_chaos_redisexperimentation_v1_redisexperimentation_proto_init() {
 			}
 		}
 		file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SingleCluster); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_redisexperimentation_v1_redisexperimentation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FaultPercentage); i {
 			case 0:
 				return &v.state
@@ -568,7 +669,7 @@ func file_chaos_redisexperimentation_v1_redisexperimentation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chaos_redisexperimentation_v1_redisexperimentation_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
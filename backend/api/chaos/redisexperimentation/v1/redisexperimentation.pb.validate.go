@@ -475,6 +475,35 @@ func (m *LatencyFault) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetLatencyDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LatencyFaultValidationError{
+					field:  "LatencyDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LatencyFaultValidationError{
+					field:  "LatencyDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLatencyDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LatencyFaultValidationError{
+				field:  "LatencyDuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return LatencyFaultMultiError(errors)
 	}
@@ -632,6 +661,48 @@ func (m *FaultTargeting) validate(all bool) error {
 		}
 	}
 
+	if len(m.GetCommands()) > 20 {
+		err := FaultTargetingValidationError{
+			field:  "Commands",
+			reason: "value must contain no more than 20 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_FaultTargeting_Commands_Unique := make(map[string]struct{}, len(m.GetCommands()))
+
+	for idx, item := range m.GetCommands() {
+		_, _ = idx, item
+
+		if _, exists := _FaultTargeting_Commands_Unique[item]; exists {
+			err := FaultTargetingValidationError{
+				field:  fmt.Sprintf("Commands[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_FaultTargeting_Commands_Unique[item] = struct{}{}
+		}
+
+		if !_FaultTargeting_Commands_Pattern.MatchString(item) {
+			err := FaultTargetingValidationError{
+				field:  fmt.Sprintf("Commands[%v]", idx),
+				reason: "value does not match regex pattern \"^[A-Za-z]+$\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return FaultTargetingMultiError(errors)
 	}
@@ -710,6 +781,121 @@ var _ interface {
 	ErrorName() string
 } = FaultTargetingValidationError{}
 
+var _FaultTargeting_Commands_Pattern = regexp.MustCompile("^[A-Za-z]+$")
+
+// Validate checks the field values on FaultLatencyDuration with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FaultLatencyDuration) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FaultLatencyDuration with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FaultLatencyDurationMultiError, or nil if none found.
+func (m *FaultLatencyDuration) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FaultLatencyDuration) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFixedDurationMs() <= 0 {
+		err := FaultLatencyDurationValidationError{
+			field:  "FixedDurationMs",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FaultLatencyDurationMultiError(errors)
+	}
+
+	return nil
+}
+
+// FaultLatencyDurationMultiError is an error wrapping multiple validation
+// errors returned by FaultLatencyDuration.ValidateAll() if the designated
+// constraints aren't met.
+type FaultLatencyDurationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FaultLatencyDurationMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FaultLatencyDurationMultiError) AllErrors() []error { return m }
+
+// FaultLatencyDurationValidationError is the validation error returned by
+// FaultLatencyDuration.Validate if the designated constraints aren't met.
+type FaultLatencyDurationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FaultLatencyDurationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FaultLatencyDurationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FaultLatencyDurationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FaultLatencyDurationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FaultLatencyDurationValidationError) ErrorName() string {
+	return "FaultLatencyDurationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FaultLatencyDurationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFaultLatencyDuration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FaultLatencyDurationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FaultLatencyDurationValidationError{}
+
 // Validate checks the field values on SingleCluster with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -81,7 +81,7 @@ func (x FaultPercentage_DenominatorType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FaultPercentage_DenominatorType.Descriptor instead.
 func (FaultPercentage_DenominatorType) EnumDescriptor() ([]byte, []int) {
-	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{8, 0}
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{10, 0}
 }
 
 // The configuration of an HTTP fault.
@@ -96,6 +96,8 @@ type HTTPFaultConfig struct {
 	//
 	//	*HTTPFaultConfig_AbortFault
 	//	*HTTPFaultConfig_LatencyFault
+	//	*HTTPFaultConfig_GrpcAbortFault
+	//	*HTTPFaultConfig_ResponseRateLimitFault
 	Fault isHTTPFaultConfig_Fault `protobuf_oneof:"fault"`
 }
 
@@ -159,6 +161,20 @@ func (x *HTTPFaultConfig) GetLatencyFault() *LatencyFault {
 	return nil
 }
 
+func (x *HTTPFaultConfig) GetGrpcAbortFault() *GrpcAbortFault {
+	if x, ok := x.GetFault().(*HTTPFaultConfig_GrpcAbortFault); ok {
+		return x.GrpcAbortFault
+	}
+	return nil
+}
+
+func (x *HTTPFaultConfig) GetResponseRateLimitFault() *ResponseRateLimitFault {
+	if x, ok := x.GetFault().(*HTTPFaultConfig_ResponseRateLimitFault); ok {
+		return x.ResponseRateLimitFault
+	}
+	return nil
+}
+
 type isHTTPFaultConfig_Fault interface {
 	isHTTPFaultConfig_Fault()
 }
@@ -173,10 +189,26 @@ type HTTPFaultConfig_LatencyFault struct {
 	LatencyFault *LatencyFault `protobuf:"bytes,3,opt,name=latency_fault,json=latencyFault,proto3,oneof"`
 }
 
+type HTTPFaultConfig_GrpcAbortFault struct {
+	// The gRPC abort fault.
+	GrpcAbortFault *GrpcAbortFault `protobuf:"bytes,4,opt,name=grpc_abort_fault,json=grpcAbortFault,proto3,oneof"`
+}
+
+type HTTPFaultConfig_ResponseRateLimitFault struct {
+	// The response rate limit fault. The bandwidth limit can only be delivered over ECDS, the enforcing cluster
+	// must be one of the ECDS enabled clusters of the serverexperimentation module. Experiments enforced by a
+	// cluster served over RTDS are rejected.
+	ResponseRateLimitFault *ResponseRateLimitFault `protobuf:"bytes,5,opt,name=response_rate_limit_fault,json=responseRateLimitFault,proto3,oneof"`
+}
+
 func (*HTTPFaultConfig_AbortFault) isHTTPFaultConfig_Fault() {}
 
 func (*HTTPFaultConfig_LatencyFault) isHTTPFaultConfig_Fault() {}
 
+func (*HTTPFaultConfig_GrpcAbortFault) isHTTPFaultConfig_Fault() {}
+
+func (*HTTPFaultConfig_ResponseRateLimitFault) isHTTPFaultConfig_Fault() {}
+
 // The definition of an abort fault.
 type AbortFault struct {
 	state         protoimpl.MessageState
@@ -293,6 +325,122 @@ func (x *LatencyFault) GetLatencyDuration() *FaultLatencyDuration {
 	return nil
 }
 
+// The definition of a gRPC abort fault.
+type GrpcAbortFault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The percentage of requests the fault should be applied to.
+	Percentage *FaultPercentage `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// The gRPC status code to insert when applying a gRPC abort fault.
+	AbortStatus *FaultGrpcAbortStatus `protobuf:"bytes,2,opt,name=abort_status,json=abortStatus,proto3" json:"abort_status,omitempty"`
+}
+
+func (x *GrpcAbortFault) Reset() {
+	*x = GrpcAbortFault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrpcAbortFault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcAbortFault) ProtoMessage() {}
+
+func (x *GrpcAbortFault) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcAbortFault.ProtoReflect.Descriptor instead.
+func (*GrpcAbortFault) Descriptor() ([]byte, []int) {
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GrpcAbortFault) GetPercentage() *FaultPercentage {
+	if x != nil {
+		return x.Percentage
+	}
+	return nil
+}
+
+func (x *GrpcAbortFault) GetAbortStatus() *FaultGrpcAbortStatus {
+	if x != nil {
+		return x.AbortStatus
+	}
+	return nil
+}
+
+// The definition of a response rate limit fault, which limits the bandwidth of response bodies.
+type ResponseRateLimitFault struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The percentage of requests the fault should be applied to.
+	Percentage *FaultPercentage `protobuf:"bytes,1,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	// The bandwidth limit to apply when applying a response rate limit fault.
+	RateLimit *FaultRateLimit `protobuf:"bytes,2,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"`
+}
+
+func (x *ResponseRateLimitFault) Reset() {
+	*x = ResponseRateLimitFault{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResponseRateLimitFault) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResponseRateLimitFault) ProtoMessage() {}
+
+func (x *ResponseRateLimitFault) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResponseRateLimitFault.ProtoReflect.Descriptor instead.
+func (*ResponseRateLimitFault) Descriptor() ([]byte, []int) {
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ResponseRateLimitFault) GetPercentage() *FaultPercentage {
+	if x != nil {
+		return x.Percentage
+	}
+	return nil
+}
+
+func (x *ResponseRateLimitFault) GetRateLimit() *FaultRateLimit {
+	if x != nil {
+		return x.RateLimit
+	}
+	return nil
+}
+
 // The fault targeting that allows us to control which requests are considered for
 // fault injection and what part of the system is responsible for applying faults.
 // The `enforcer` abstraction allows us to define a different list of matching criteria
@@ -314,7 +462,7 @@ type FaultTargeting struct {
 func (x *FaultTargeting) Reset() {
 	*x = FaultTargeting{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[3]
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -327,7 +475,7 @@ func (x *FaultTargeting) String() string {
 func (*FaultTargeting) ProtoMessage() {}
 
 func (x *FaultTargeting) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[3]
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +488,7 @@ func (x *FaultTargeting) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FaultTargeting.ProtoReflect.Descriptor instead.
 func (*FaultTargeting) Descriptor() ([]byte, []int) {
-	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{3}
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{5}
 }
 
 func (m *FaultTargeting) GetEnforcer() isFaultTargeting_Enforcer {
@@ -407,7 +555,7 @@ type UpstreamEnforcing struct {
 func (x *UpstreamEnforcing) Reset() {
 	*x = UpstreamEnforcing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[4]
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -420,7 +568,7 @@ func (x *UpstreamEnforcing) String() string {
 func (*UpstreamEnforcing) ProtoMessage() {}
 
 func (x *UpstreamEnforcing) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[4]
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -433,7 +581,7 @@ func (x *UpstreamEnforcing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpstreamEnforcing.ProtoReflect.Descriptor instead.
 func (*UpstreamEnforcing) Descriptor() ([]byte, []int) {
-	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{4}
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *UpstreamEnforcing) GetUpstreamType() isUpstreamEnforcing_UpstreamType {
@@ -511,7 +659,7 @@ type DownstreamEnforcing struct {
 func (x *DownstreamEnforcing) Reset() {
 	*x = DownstreamEnforcing{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[5]
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -524,7 +672,7 @@ func (x *DownstreamEnforcing) String() string {
 func (*DownstreamEnforcing) ProtoMessage() {}
 
 func (x *DownstreamEnforcing) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[5]
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -537,7 +685,7 @@ func (x *DownstreamEnforcing) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownstreamEnforcing.ProtoReflect.Descriptor instead.
 func (*DownstreamEnforcing) Descriptor() ([]byte, []int) {
-	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{5}
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{7}
 }
 
 func (m *DownstreamEnforcing) GetUpstreamType() isDownstreamEnforcing_UpstreamType {
@@ -603,7 +751,7 @@ type SingleCluster struct {
 func (x *SingleCluster) Reset() {
 	*x = SingleCluster{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[6]
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -616,7 +764,7 @@ func (x *SingleCluster) String() string {
 func (*SingleCluster) ProtoMessage() {}
 
 func (x *SingleCluster) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[6]
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -629,7 +777,7 @@ func (x *SingleCluster) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SingleCluster.ProtoReflect.Descriptor instead.
 func (*SingleCluster) Descriptor() ([]byte, []int) {
-	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{6}
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *SingleCluster) GetName() string {
@@ -653,7 +801,7 @@ type ClusterPercentage struct {
 func (x *ClusterPercentage) Reset() {
 	*x = ClusterPercentage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[7]
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -666,7 +814,7 @@ func (x *ClusterPercentage) String() string {
 func (*ClusterPercentage) ProtoMessage() {}
 
 func (x *ClusterPercentage) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[7]
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -679,7 +827,7 @@ func (x *ClusterPercentage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterPercentage.ProtoReflect.Descriptor instead.
 func (*ClusterPercentage) Descriptor() ([]byte, []int) {
-	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{7}
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ClusterPercentage) GetPercentage() uint32 {
@@ -707,7 +855,7 @@ type FaultPercentage struct {
 func (x *FaultPercentage) Reset() {
 	*x = FaultPercentage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[8]
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -720,7 +868,7 @@ func (x *FaultPercentage) String() string {
 func (*FaultPercentage) ProtoMessage() {}
 
 func (x *FaultPercentage) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[8]
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -733,7 +881,7 @@ func (x *FaultPercentage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FaultPercentage.ProtoReflect.Descriptor instead.
 func (*FaultPercentage) Descriptor() ([]byte, []int) {
-	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{8}
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *FaultPercentage) GetPercentage() uint32 {
@@ -763,7 +911,7 @@ type FaultAbortStatus struct {
 func (x *FaultAbortStatus) Reset() {
 	*x = FaultAbortStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[9]
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -776,7 +924,7 @@ func (x *FaultAbortStatus) String() string {
 func (*FaultAbortStatus) ProtoMessage() {}
 
 func (x *FaultAbortStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[9]
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -789,7 +937,7 @@ func (x *FaultAbortStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FaultAbortStatus.ProtoReflect.Descriptor instead.
 func (*FaultAbortStatus) Descriptor() ([]byte, []int) {
-	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{9}
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *FaultAbortStatus) GetHttpStatusCode() uint32 {
@@ -799,6 +947,105 @@ func (x *FaultAbortStatus) GetHttpStatusCode() uint32 {
 	return 0
 }
 
+// The abort status to apply as part of a gRPC abort fault.
+type FaultGrpcAbortStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The abort gRPC status, see https://github.com/grpc/grpc/blob/master/doc/statuscodes.md.
+	// OK (0) is not a fault and is therefore not allowed.
+	GrpcStatusCode uint32 `protobuf:"varint,1,opt,name=grpc_status_code,json=grpcStatusCode,proto3" json:"grpc_status_code,omitempty"`
+}
+
+func (x *FaultGrpcAbortStatus) Reset() {
+	*x = FaultGrpcAbortStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultGrpcAbortStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultGrpcAbortStatus) ProtoMessage() {}
+
+func (x *FaultGrpcAbortStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultGrpcAbortStatus.ProtoReflect.Descriptor instead.
+func (*FaultGrpcAbortStatus) Descriptor() ([]byte, []int) {
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FaultGrpcAbortStatus) GetGrpcStatusCode() uint32 {
+	if x != nil {
+		return x.GrpcStatusCode
+	}
+	return 0
+}
+
+// The bandwidth limit to apply as part of a response rate limit fault.
+type FaultRateLimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The fixed limit in KiB/s.
+	FixedLimitKbps uint64 `protobuf:"varint,1,opt,name=fixed_limit_kbps,json=fixedLimitKbps,proto3" json:"fixed_limit_kbps,omitempty"`
+}
+
+func (x *FaultRateLimit) Reset() {
+	*x = FaultRateLimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FaultRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FaultRateLimit) ProtoMessage() {}
+
+func (x *FaultRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FaultRateLimit.ProtoReflect.Descriptor instead.
+func (*FaultRateLimit) Descriptor() ([]byte, []int) {
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FaultRateLimit) GetFixedLimitKbps() uint64 {
+	if x != nil {
+		return x.FixedLimitKbps
+	}
+	return 0
+}
+
 // The latency duration to apply as part of a latency fault.
 type FaultLatencyDuration struct {
 	state         protoimpl.MessageState
@@ -812,7 +1059,7 @@ type FaultLatencyDuration struct {
 func (x *FaultLatencyDuration) Reset() {
 	*x = FaultLatencyDuration{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[10]
+		mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -825,7 +1072,7 @@ func (x *FaultLatencyDuration) String() string {
 func (*FaultLatencyDuration) ProtoMessage() {}
 
 func (x *FaultLatencyDuration) ProtoReflect() protoreflect.Message {
-	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[10]
+	mi := &file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -838,7 +1085,7 @@ func (x *FaultLatencyDuration) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FaultLatencyDuration.ProtoReflect.Descriptor instead.
 func (*FaultLatencyDuration) Descriptor() ([]byte, []int) {
-	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{10}
+	return file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *FaultLatencyDuration) GetFixedDurationMs() uint32 {
@@ -858,7 +1105,7 @@ var file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDesc = []
 	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x2e, 0x76, 0x31, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x02, 0x0a,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9a, 0x04, 0x0a,
 	0x0f, 0x48, 0x54, 0x54, 0x50, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
 	0x12, 0x68, 0x0a, 0x0f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
 	0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6c, 0x75, 0x74,
@@ -877,127 +1124,178 @@ var file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDesc = []
 	0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70,
 	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
 	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0c,
-	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x0c, 0x0a, 0x05,
-	0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xd4, 0x01, 0x0a, 0x0a, 0x41,
-	0x62, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x60, 0x0a, 0x0a, 0x70, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x61, 0x0a, 0x10,
+	0x67, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x72, 0x70, 0x63, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52,
+	0x0e, 0x67, 0x72, 0x70, 0x63, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x7a, 0x0a, 0x19, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f,
+	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
+	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x48, 0x00, 0x52, 0x16, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x42, 0x0c, 0x0a, 0x05, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xd4, 0x01, 0x0a, 0x0a, 0x41, 0x62,
+	0x6f, 0x72, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x60, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x64, 0x0a, 0x0c, 0x61, 0x62,
+	0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x37, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x62,
+	0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x0b, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0xe2, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x12, 0x60, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
+	0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x61, 0x67, 0x65, 0x12, 0x70, 0x0a, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
 	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72,
 	0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52,
-	0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x64, 0x0a, 0x0c, 0x61,
-	0x62, 0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x41,
-	0x62, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
-	0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0xe2, 0x01, 0x0a, 0x0c, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x46, 0x61, 0x75,
-	0x6c, 0x74, 0x12, 0x60, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
-	0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65,
-	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46,
-	0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x42, 0x08,
-	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x12, 0x70, 0x0a, 0x10, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b,
-	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x74, 0x65,
-	0x6e, 0x63, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xfd, 0x01, 0x0a, 0x0e, 0x46, 0x61, 0x75, 0x6c, 0x74,
-	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x69, 0x0a, 0x12, 0x75, 0x70, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a,
+	0x01, 0x02, 0x10, 0x01, 0x52, 0x0f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x62,
+	0x6f, 0x72, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x60, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x68, 0x0a, 0x0c, 0x61, 0x62,
+	0x6f, 0x72, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x3b, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72,
+	0x70, 0x63, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0b, 0x61, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0xda, 0x01, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x12,
+	0x60, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61,
+	0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x5e, 0x0a, 0x0a, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63,
 	0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72,
-	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x48,
-	0x00, 0x52, 0x11, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x66, 0x6f, 0x72,
-	0x63, 0x69, 0x6e, 0x67, 0x12, 0x6f, 0x0a, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f,
-	0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x48, 0x00,
-	0x52, 0x13, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x66, 0x6f,
-	0x72, 0x63, 0x69, 0x6e, 0x67, 0x42, 0x0f, 0x0a, 0x08, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65,
-	0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x8b, 0x02, 0x0a, 0x11, 0x55, 0x70, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x61, 0x0a, 0x10,
-	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61,
+	0x75, 0x6c, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x09, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0xfd, 0x01, 0x0a, 0x0e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x69, 0x6e, 0x67, 0x12, 0x69, 0x0a, 0x12, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x38, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x11, 0x75, 0x70,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x12,
+	0x6f, 0x0a, 0x14, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x65, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3a, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x48, 0x00, 0x52, 0x13, 0x64, 0x6f, 0x77,
+	0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67,
+	0x42, 0x0f, 0x0a, 0x08, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42,
+	0x01, 0x22, 0x8b, 0x02, 0x0a, 0x11, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x61, 0x0a, 0x10, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x12, 0x64, 0x6f,
+	0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
 	0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65,
 	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f,
-	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x65, 0x0a, 0x12, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c,
-	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x48, 0x01, 0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x42, 0x14, 0x0a, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x42, 0x16, 0x0a, 0x0f,
-	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x03, 0xf8, 0x42, 0x01, 0x22, 0x8d, 0x02, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x45, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x61, 0x0a, 0x10,
-	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x01, 0x52, 0x11,
+	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x42, 0x14, 0x0a, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x42, 0x16, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22,
+	0x8d, 0x02, 0x0a, 0x13, 0x44, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e,
+	0x66, 0x6f, 0x72, 0x63, 0x69, 0x6e, 0x67, 0x12, 0x61, 0x0a, 0x10, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+	0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a, 0x12, 0x64, 0x6f,
+	0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
 	0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65,
 	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0f,
-	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12,
-	0x65, 0x0a, 0x12, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c,
-	0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
-	0x72, 0x48, 0x01, 0x52, 0x11, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x42, 0x14, 0x0a, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x42, 0x16, 0x0a, 0x0f,
-	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12,
-	0x03, 0xf8, 0x42, 0x01, 0x22, 0x2c, 0x0a, 0x0d, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x3e, 0x0a, 0x11, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65,
-	0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06,
-	0x2a, 0x04, 0x18, 0x64, 0x20, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
-	0x67, 0x65, 0x22, 0xa8, 0x02, 0x0a, 0x0f, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63,
-	0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e,
-	0x74, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x2a,
-	0x06, 0x18, 0xc0, 0x84, 0x3d, 0x20, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
-	0x61, 0x67, 0x65, 0x12, 0x68, 0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x46, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
-	0x68, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78,
-	0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
-	0x2e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
-	0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x7e, 0x0a,
-	0x0f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x1b, 0x0a, 0x17, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x5f,
-	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a,
-	0x13, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x48, 0x55, 0x4e,
-	0x44, 0x52, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x49,
-	0x4e, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x54, 0x45, 0x4e, 0x5f, 0x54, 0x48, 0x4f, 0x55, 0x53, 0x41,
-	0x4e, 0x44, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x49, 0x4e, 0x41,
-	0x54, 0x4f, 0x52, 0x5f, 0x4d, 0x49, 0x4c, 0x4c, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x48, 0x0a,
-	0x10, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x34, 0x0a, 0x10, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0xfa, 0x42, 0x07,
-	0x2a, 0x05, 0x10, 0xd8, 0x04, 0x20, 0x63, 0x52, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x46, 0x61, 0x75, 0x6c, 0x74,
-	0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x33, 0x0a, 0x11, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
-	0x02, 0x20, 0x00, 0x52, 0x0f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x73, 0x42, 0x5b, 0x5a, 0x59, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62,
-	0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76,
-	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x48, 0x01, 0x52, 0x11,
+	0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x42, 0x14, 0x0a, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x42, 0x16, 0x0a, 0x0f, 0x64, 0x6f, 0x77, 0x6e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22,
+	0x2c, 0x0a, 0x0d, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x3e, 0x0a,
+	0x11, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x12, 0x29, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x64, 0x20,
+	0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x22, 0xa8, 0x02,
+	0x0a, 0x0f, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67,
+	0x65, 0x12, 0x2b, 0x0a, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xfa, 0x42, 0x08, 0x2a, 0x06, 0x18, 0xc0, 0x84, 0x3d,
+	0x20, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x12, 0x68,
+	0x0a, 0x0b, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x46, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x68, 0x61,
+	0x6f, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x7e, 0x0a, 0x0f, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x44,
+	0x45, 0x4e, 0x4f, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45,
+	0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x45, 0x4e, 0x4f,
+	0x4d, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x48, 0x55, 0x4e, 0x44, 0x52, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x1c, 0x0a, 0x18, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52,
+	0x5f, 0x54, 0x45, 0x4e, 0x5f, 0x54, 0x48, 0x4f, 0x55, 0x53, 0x41, 0x4e, 0x44, 0x10, 0x02, 0x12,
+	0x17, 0x0a, 0x13, 0x44, 0x45, 0x4e, 0x4f, 0x4d, 0x49, 0x4e, 0x41, 0x54, 0x4f, 0x52, 0x5f, 0x4d,
+	0x49, 0x4c, 0x4c, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x22, 0x48, 0x0a, 0x10, 0x46, 0x61, 0x75, 0x6c,
+	0x74, 0x41, 0x62, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x34, 0x0a, 0x10,
+	0x68, 0x74, 0x74, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x2a, 0x05, 0x10, 0xd8, 0x04,
+	0x20, 0x63, 0x52, 0x0e, 0x68, 0x74, 0x74, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x22, 0x4b, 0x0a, 0x14, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x70, 0x63, 0x41,
+	0x62, 0x6f, 0x72, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x33, 0x0a, 0x10, 0x67, 0x72,
+	0x70, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0x10, 0x20, 0x00, 0x52,
+	0x0e, 0x67, 0x72, 0x70, 0x63, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0x43, 0x0a, 0x0e, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x31, 0x0a, 0x10, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x6b, 0x62, 0x70, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x32, 0x02, 0x20, 0x00, 0x52, 0x0e, 0x66, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x4b, 0x62, 0x70, 0x73, 0x22, 0x4b, 0x0a, 0x14, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x11,
+	0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00,
+	0x52, 0x0f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x73, 0x42, 0x5b, 0x5a, 0x59, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b,
+	0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1013,41 +1311,51 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDescGZIP
 }
 
 var file_chaos_serverexperimentation_v1_serverexperimentation_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_chaos_serverexperimentation_v1_serverexperimentation_proto_goTypes = []interface{}{
 	(FaultPercentage_DenominatorType)(0), // 0: clutch.chaos.serverexperimentation.v1.FaultPercentage.DenominatorType
 	(*HTTPFaultConfig)(nil),              // 1: clutch.chaos.serverexperimentation.v1.HTTPFaultConfig
 	(*AbortFault)(nil),                   // 2: clutch.chaos.serverexperimentation.v1.AbortFault
 	(*LatencyFault)(nil),                 // 3: clutch.chaos.serverexperimentation.v1.LatencyFault
-	(*FaultTargeting)(nil),               // 4: clutch.chaos.serverexperimentation.v1.FaultTargeting
-	(*UpstreamEnforcing)(nil),            // 5: clutch.chaos.serverexperimentation.v1.UpstreamEnforcing
-	(*DownstreamEnforcing)(nil),          // 6: clutch.chaos.serverexperimentation.v1.DownstreamEnforcing
-	(*SingleCluster)(nil),                // 7: clutch.chaos.serverexperimentation.v1.SingleCluster
-	(*ClusterPercentage)(nil),            // 8: clutch.chaos.serverexperimentation.v1.ClusterPercentage
-	(*FaultPercentage)(nil),              // 9: clutch.chaos.serverexperimentation.v1.FaultPercentage
-	(*FaultAbortStatus)(nil),             // 10: clutch.chaos.serverexperimentation.v1.FaultAbortStatus
-	(*FaultLatencyDuration)(nil),         // 11: clutch.chaos.serverexperimentation.v1.FaultLatencyDuration
+	(*GrpcAbortFault)(nil),               // 4: clutch.chaos.serverexperimentation.v1.GrpcAbortFault
+	(*ResponseRateLimitFault)(nil),       // 5: clutch.chaos.serverexperimentation.v1.ResponseRateLimitFault
+	(*FaultTargeting)(nil),               // 6: clutch.chaos.serverexperimentation.v1.FaultTargeting
+	(*UpstreamEnforcing)(nil),            // 7: clutch.chaos.serverexperimentation.v1.UpstreamEnforcing
+	(*DownstreamEnforcing)(nil),          // 8: clutch.chaos.serverexperimentation.v1.DownstreamEnforcing
+	(*SingleCluster)(nil),                // 9: clutch.chaos.serverexperimentation.v1.SingleCluster
+	(*ClusterPercentage)(nil),            // 10: clutch.chaos.serverexperimentation.v1.ClusterPercentage
+	(*FaultPercentage)(nil),              // 11: clutch.chaos.serverexperimentation.v1.FaultPercentage
+	(*FaultAbortStatus)(nil),             // 12: clutch.chaos.serverexperimentation.v1.FaultAbortStatus
+	(*FaultGrpcAbortStatus)(nil),         // 13: clutch.chaos.serverexperimentation.v1.FaultGrpcAbortStatus
+	(*FaultRateLimit)(nil),               // 14: clutch.chaos.serverexperimentation.v1.FaultRateLimit
+	(*FaultLatencyDuration)(nil),         // 15: clutch.chaos.serverexperimentation.v1.FaultLatencyDuration
 }
 var file_chaos_serverexperimentation_v1_serverexperimentation_proto_depIdxs = []int32{
-	4,  // 0: clutch.chaos.serverexperimentation.v1.HTTPFaultConfig.fault_targeting:type_name -> clutch.chaos.serverexperimentation.v1.FaultTargeting
+	6,  // 0: clutch.chaos.serverexperimentation.v1.HTTPFaultConfig.fault_targeting:type_name -> clutch.chaos.serverexperimentation.v1.FaultTargeting
 	2,  // 1: clutch.chaos.serverexperimentation.v1.HTTPFaultConfig.abort_fault:type_name -> clutch.chaos.serverexperimentation.v1.AbortFault
 	3,  // 2: clutch.chaos.serverexperimentation.v1.HTTPFaultConfig.latency_fault:type_name -> clutch.chaos.serverexperimentation.v1.LatencyFault
-	9,  // 3: clutch.chaos.serverexperimentation.v1.AbortFault.percentage:type_name -> clutch.chaos.serverexperimentation.v1.FaultPercentage
-	10, // 4: clutch.chaos.serverexperimentation.v1.AbortFault.abort_status:type_name -> clutch.chaos.serverexperimentation.v1.FaultAbortStatus
-	9,  // 5: clutch.chaos.serverexperimentation.v1.LatencyFault.percentage:type_name -> clutch.chaos.serverexperimentation.v1.FaultPercentage
-	11, // 6: clutch.chaos.serverexperimentation.v1.LatencyFault.latency_duration:type_name -> clutch.chaos.serverexperimentation.v1.FaultLatencyDuration
-	5,  // 7: clutch.chaos.serverexperimentation.v1.FaultTargeting.upstream_enforcing:type_name -> clutch.chaos.serverexperimentation.v1.UpstreamEnforcing
-	6,  // 8: clutch.chaos.serverexperimentation.v1.FaultTargeting.downstream_enforcing:type_name -> clutch.chaos.serverexperimentation.v1.DownstreamEnforcing
-	7,  // 9: clutch.chaos.serverexperimentation.v1.UpstreamEnforcing.upstream_cluster:type_name -> clutch.chaos.serverexperimentation.v1.SingleCluster
-	7,  // 10: clutch.chaos.serverexperimentation.v1.UpstreamEnforcing.downstream_cluster:type_name -> clutch.chaos.serverexperimentation.v1.SingleCluster
-	7,  // 11: clutch.chaos.serverexperimentation.v1.DownstreamEnforcing.upstream_cluster:type_name -> clutch.chaos.serverexperimentation.v1.SingleCluster
-	7,  // 12: clutch.chaos.serverexperimentation.v1.DownstreamEnforcing.downstream_cluster:type_name -> clutch.chaos.serverexperimentation.v1.SingleCluster
-	0,  // 13: clutch.chaos.serverexperimentation.v1.FaultPercentage.denominator:type_name -> clutch.chaos.serverexperimentation.v1.FaultPercentage.DenominatorType
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	4,  // 3: clutch.chaos.serverexperimentation.v1.HTTPFaultConfig.grpc_abort_fault:type_name -> clutch.chaos.serverexperimentation.v1.GrpcAbortFault
+	5,  // 4: clutch.chaos.serverexperimentation.v1.HTTPFaultConfig.response_rate_limit_fault:type_name -> clutch.chaos.serverexperimentation.v1.ResponseRateLimitFault
+	11, // 5: clutch.chaos.serverexperimentation.v1.AbortFault.percentage:type_name -> clutch.chaos.serverexperimentation.v1.FaultPercentage
+	12, // 6: clutch.chaos.serverexperimentation.v1.AbortFault.abort_status:type_name -> clutch.chaos.serverexperimentation.v1.FaultAbortStatus
+	11, // 7: clutch.chaos.serverexperimentation.v1.LatencyFault.percentage:type_name -> clutch.chaos.serverexperimentation.v1.FaultPercentage
+	15, // 8: clutch.chaos.serverexperimentation.v1.LatencyFault.latency_duration:type_name -> clutch.chaos.serverexperimentation.v1.FaultLatencyDuration
+	11, // 9: clutch.chaos.serverexperimentation.v1.GrpcAbortFault.percentage:type_name -> clutch.chaos.serverexperimentation.v1.FaultPercentage
+	13, // 10: clutch.chaos.serverexperimentation.v1.GrpcAbortFault.abort_status:type_name -> clutch.chaos.serverexperimentation.v1.FaultGrpcAbortStatus
+	11, // 11: clutch.chaos.serverexperimentation.v1.ResponseRateLimitFault.percentage:type_name -> clutch.chaos.serverexperimentation.v1.FaultPercentage
+	14, // 12: clutch.chaos.serverexperimentation.v1.ResponseRateLimitFault.rate_limit:type_name -> clutch.chaos.serverexperimentation.v1.FaultRateLimit
+	7,  // 13: clutch.chaos.serverexperimentation.v1.FaultTargeting.upstream_enforcing:type_name -> clutch.chaos.serverexperimentation.v1.UpstreamEnforcing
+	8,  // 14: clutch.chaos.serverexperimentation.v1.FaultTargeting.downstream_enforcing:type_name -> clutch.chaos.serverexperimentation.v1.DownstreamEnforcing
+	9,  // 15: clutch.chaos.serverexperimentation.v1.UpstreamEnforcing.upstream_cluster:type_name -> clutch.chaos.serverexperimentation.v1.SingleCluster
+	9,  // 16: clutch.chaos.serverexperimentation.v1.UpstreamEnforcing.downstream_cluster:type_name -> clutch.chaos.serverexperimentation.v1.SingleCluster
+	9,  // 17: clutch.chaos.serverexperimentation.v1.DownstreamEnforcing.upstream_cluster:type_name -> clutch.chaos.serverexperimentation.v1.SingleCluster
+	9,  // 18: clutch.chaos.serverexperimentation.v1.DownstreamEnforcing.downstream_cluster:type_name -> clutch.chaos.serverexperimentation.v1.SingleCluster
+	0,  // 19: clutch.chaos.serverexperimentation.v1.FaultPercentage.denominator:type_name -> clutch.chaos.serverexperimentation.v1.FaultPercentage.DenominatorType
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() }
@@ -1093,7 +1401,7 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 			}
 		}
 		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FaultTargeting); i {
+			switch v := v.(*GrpcAbortFault); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1105,7 +1413,7 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 			}
 		}
 		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpstreamEnforcing); i {
+			switch v := v.(*ResponseRateLimitFault); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1117,7 +1425,7 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 			}
 		}
 		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DownstreamEnforcing); i {
+			switch v := v.(*FaultTargeting); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1129,7 +1437,7 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 			}
 		}
 		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SingleCluster); i {
+			switch v := v.(*UpstreamEnforcing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1141,7 +1449,7 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 			}
 		}
 		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ClusterPercentage); i {
+			switch v := v.(*DownstreamEnforcing); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1153,7 +1461,7 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 			}
 		}
 		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FaultPercentage); i {
+			switch v := v.(*SingleCluster); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1165,7 +1473,7 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 			}
 		}
 		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FaultAbortStatus); i {
+			switch v := v.(*ClusterPercentage); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1177,6 +1485,54 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 			}
 		}
 		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultPercentage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultAbortStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultGrpcAbortStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FaultRateLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FaultLatencyDuration); i {
 			case 0:
 				return &v.state
@@ -1192,16 +1548,18 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 	file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[0].OneofWrappers = []interface{}{
 		(*HTTPFaultConfig_AbortFault)(nil),
 		(*HTTPFaultConfig_LatencyFault)(nil),
+		(*HTTPFaultConfig_GrpcAbortFault)(nil),
+		(*HTTPFaultConfig_ResponseRateLimitFault)(nil),
 	}
-	file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[3].OneofWrappers = []interface{}{
+	file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[5].OneofWrappers = []interface{}{
 		(*FaultTargeting_UpstreamEnforcing)(nil),
 		(*FaultTargeting_DownstreamEnforcing)(nil),
 	}
-	file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*UpstreamEnforcing_UpstreamCluster)(nil),
 		(*UpstreamEnforcing_DownstreamCluster)(nil),
 	}
-	file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[5].OneofWrappers = []interface{}{
+	file_chaos_serverexperimentation_v1_serverexperimentation_proto_msgTypes[7].OneofWrappers = []interface{}{
 		(*DownstreamEnforcing_UpstreamCluster)(nil),
 		(*DownstreamEnforcing_DownstreamCluster)(nil),
 	}
@@ -1211,7 +1569,7 @@ func file_chaos_serverexperimentation_v1_serverexperimentation_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_chaos_serverexperimentation_v1_serverexperimentation_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   11,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
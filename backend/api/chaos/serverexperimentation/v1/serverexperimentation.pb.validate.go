@@ -183,6 +183,90 @@ func (m *HTTPFaultConfig) validate(all bool) error {
 			}
 		}
 
+	case *HTTPFaultConfig_GrpcAbortFault:
+		if v == nil {
+			err := HTTPFaultConfigValidationError{
+				field:  "Fault",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofFaultPresent = true
+
+		if all {
+			switch v := interface{}(m.GetGrpcAbortFault()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HTTPFaultConfigValidationError{
+						field:  "GrpcAbortFault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HTTPFaultConfigValidationError{
+						field:  "GrpcAbortFault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGrpcAbortFault()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HTTPFaultConfigValidationError{
+					field:  "GrpcAbortFault",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *HTTPFaultConfig_ResponseRateLimitFault:
+		if v == nil {
+			err := HTTPFaultConfigValidationError{
+				field:  "Fault",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofFaultPresent = true
+
+		if all {
+			switch v := interface{}(m.GetResponseRateLimitFault()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HTTPFaultConfigValidationError{
+						field:  "ResponseRateLimitFault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HTTPFaultConfigValidationError{
+						field:  "ResponseRateLimitFault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetResponseRateLimitFault()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HTTPFaultConfigValidationError{
+					field:  "ResponseRateLimitFault",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
@@ -275,22 +359,380 @@ var _ interface {
 	ErrorName() string
 } = HTTPFaultConfigValidationError{}
 
-// Validate checks the field values on AbortFault with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on AbortFault with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AbortFault) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AbortFault with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AbortFaultMultiError, or
+// nil if none found.
+func (m *AbortFault) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AbortFault) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPercentage() == nil {
+		err := AbortFaultValidationError{
+			field:  "Percentage",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPercentage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AbortFaultValidationError{
+					field:  "Percentage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AbortFaultValidationError{
+					field:  "Percentage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPercentage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AbortFaultValidationError{
+				field:  "Percentage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetAbortStatus() == nil {
+		err := AbortFaultValidationError{
+			field:  "AbortStatus",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAbortStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AbortFaultValidationError{
+					field:  "AbortStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AbortFaultValidationError{
+					field:  "AbortStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAbortStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AbortFaultValidationError{
+				field:  "AbortStatus",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AbortFaultMultiError(errors)
+	}
+
+	return nil
+}
+
+// AbortFaultMultiError is an error wrapping multiple validation errors
+// returned by AbortFault.ValidateAll() if the designated constraints aren't met.
+type AbortFaultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AbortFaultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AbortFaultMultiError) AllErrors() []error { return m }
+
+// AbortFaultValidationError is the validation error returned by
+// AbortFault.Validate if the designated constraints aren't met.
+type AbortFaultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AbortFaultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AbortFaultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AbortFaultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AbortFaultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AbortFaultValidationError) ErrorName() string { return "AbortFaultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AbortFaultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAbortFault.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AbortFaultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AbortFaultValidationError{}
+
+// Validate checks the field values on LatencyFault with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LatencyFault) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LatencyFault with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LatencyFaultMultiError, or
+// nil if none found.
+func (m *LatencyFault) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LatencyFault) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetPercentage() == nil {
+		err := LatencyFaultValidationError{
+			field:  "Percentage",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPercentage()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LatencyFaultValidationError{
+					field:  "Percentage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LatencyFaultValidationError{
+					field:  "Percentage",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPercentage()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LatencyFaultValidationError{
+				field:  "Percentage",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetLatencyDuration() == nil {
+		err := LatencyFaultValidationError{
+			field:  "LatencyDuration",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetLatencyDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, LatencyFaultValidationError{
+					field:  "LatencyDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, LatencyFaultValidationError{
+					field:  "LatencyDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLatencyDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return LatencyFaultValidationError{
+				field:  "LatencyDuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return LatencyFaultMultiError(errors)
+	}
+
+	return nil
+}
+
+// LatencyFaultMultiError is an error wrapping multiple validation errors
+// returned by LatencyFault.ValidateAll() if the designated constraints aren't met.
+type LatencyFaultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LatencyFaultMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LatencyFaultMultiError) AllErrors() []error { return m }
+
+// LatencyFaultValidationError is the validation error returned by
+// LatencyFault.Validate if the designated constraints aren't met.
+type LatencyFaultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LatencyFaultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LatencyFaultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LatencyFaultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LatencyFaultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LatencyFaultValidationError) ErrorName() string { return "LatencyFaultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LatencyFaultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLatencyFault.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LatencyFaultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LatencyFaultValidationError{}
+
+// Validate checks the field values on GrpcAbortFault with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AbortFault) Validate() error {
+func (m *GrpcAbortFault) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AbortFault with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AbortFaultMultiError, or
-// nil if none found.
-func (m *AbortFault) ValidateAll() error {
+// ValidateAll checks the field values on GrpcAbortFault with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in GrpcAbortFaultMultiError,
+// or nil if none found.
+func (m *GrpcAbortFault) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AbortFault) validate(all bool) error {
+func (m *GrpcAbortFault) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -298,7 +740,7 @@ func (m *AbortFault) validate(all bool) error {
 	var errors []error
 
 	if m.GetPercentage() == nil {
-		err := AbortFaultValidationError{
+		err := GrpcAbortFaultValidationError{
 			field:  "Percentage",
 			reason: "value is required",
 		}
@@ -312,7 +754,7 @@ func (m *AbortFault) validate(all bool) error {
 		switch v := interface{}(m.GetPercentage()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AbortFaultValidationError{
+				errors = append(errors, GrpcAbortFaultValidationError{
 					field:  "Percentage",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -320,7 +762,7 @@ func (m *AbortFault) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AbortFaultValidationError{
+				errors = append(errors, GrpcAbortFaultValidationError{
 					field:  "Percentage",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -329,7 +771,7 @@ func (m *AbortFault) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetPercentage()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AbortFaultValidationError{
+			return GrpcAbortFaultValidationError{
 				field:  "Percentage",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -338,7 +780,7 @@ func (m *AbortFault) validate(all bool) error {
 	}
 
 	if m.GetAbortStatus() == nil {
-		err := AbortFaultValidationError{
+		err := GrpcAbortFaultValidationError{
 			field:  "AbortStatus",
 			reason: "value is required",
 		}
@@ -352,7 +794,7 @@ func (m *AbortFault) validate(all bool) error {
 		switch v := interface{}(m.GetAbortStatus()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AbortFaultValidationError{
+				errors = append(errors, GrpcAbortFaultValidationError{
 					field:  "AbortStatus",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -360,7 +802,7 @@ func (m *AbortFault) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AbortFaultValidationError{
+				errors = append(errors, GrpcAbortFaultValidationError{
 					field:  "AbortStatus",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -369,7 +811,7 @@ func (m *AbortFault) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetAbortStatus()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AbortFaultValidationError{
+			return GrpcAbortFaultValidationError{
 				field:  "AbortStatus",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -378,18 +820,19 @@ func (m *AbortFault) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AbortFaultMultiError(errors)
+		return GrpcAbortFaultMultiError(errors)
 	}
 
 	return nil
 }
 
-// AbortFaultMultiError is an error wrapping multiple validation errors
-// returned by AbortFault.ValidateAll() if the designated constraints aren't met.
-type AbortFaultMultiError []error
+// GrpcAbortFaultMultiError is an error wrapping multiple validation errors
+// returned by GrpcAbortFault.ValidateAll() if the designated constraints
+// aren't met.
+type GrpcAbortFaultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AbortFaultMultiError) Error() string {
+func (m GrpcAbortFaultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -398,11 +841,11 @@ func (m AbortFaultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AbortFaultMultiError) AllErrors() []error { return m }
+func (m GrpcAbortFaultMultiError) AllErrors() []error { return m }
 
-// AbortFaultValidationError is the validation error returned by
-// AbortFault.Validate if the designated constraints aren't met.
-type AbortFaultValidationError struct {
+// GrpcAbortFaultValidationError is the validation error returned by
+// GrpcAbortFault.Validate if the designated constraints aren't met.
+type GrpcAbortFaultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -410,22 +853,22 @@ type AbortFaultValidationError struct {
 }
 
 // Field function returns field value.
-func (e AbortFaultValidationError) Field() string { return e.field }
+func (e GrpcAbortFaultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AbortFaultValidationError) Reason() string { return e.reason }
+func (e GrpcAbortFaultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AbortFaultValidationError) Cause() error { return e.cause }
+func (e GrpcAbortFaultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AbortFaultValidationError) Key() bool { return e.key }
+func (e GrpcAbortFaultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AbortFaultValidationError) ErrorName() string { return "AbortFaultValidationError" }
+func (e GrpcAbortFaultValidationError) ErrorName() string { return "GrpcAbortFaultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AbortFaultValidationError) Error() string {
+func (e GrpcAbortFaultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -437,14 +880,14 @@ func (e AbortFaultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAbortFault.%s: %s%s",
+		"invalid %sGrpcAbortFault.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AbortFaultValidationError{}
+var _ error = GrpcAbortFaultValidationError{}
 
 var _ interface {
 	Field() string
@@ -452,24 +895,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AbortFaultValidationError{}
+} = GrpcAbortFaultValidationError{}
 
-// Validate checks the field values on LatencyFault with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *LatencyFault) Validate() error {
+// Validate checks the field values on ResponseRateLimitFault with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ResponseRateLimitFault) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on LatencyFault with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in LatencyFaultMultiError, or
-// nil if none found.
-func (m *LatencyFault) ValidateAll() error {
+// ValidateAll checks the field values on ResponseRateLimitFault with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResponseRateLimitFaultMultiError, or nil if none found.
+func (m *ResponseRateLimitFault) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *LatencyFault) validate(all bool) error {
+func (m *ResponseRateLimitFault) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -477,7 +920,7 @@ func (m *LatencyFault) validate(all bool) error {
 	var errors []error
 
 	if m.GetPercentage() == nil {
-		err := LatencyFaultValidationError{
+		err := ResponseRateLimitFaultValidationError{
 			field:  "Percentage",
 			reason: "value is required",
 		}
@@ -491,7 +934,7 @@ func (m *LatencyFault) validate(all bool) error {
 		switch v := interface{}(m.GetPercentage()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LatencyFaultValidationError{
+				errors = append(errors, ResponseRateLimitFaultValidationError{
 					field:  "Percentage",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -499,7 +942,7 @@ func (m *LatencyFault) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LatencyFaultValidationError{
+				errors = append(errors, ResponseRateLimitFaultValidationError{
 					field:  "Percentage",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -508,7 +951,7 @@ func (m *LatencyFault) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetPercentage()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LatencyFaultValidationError{
+			return ResponseRateLimitFaultValidationError{
 				field:  "Percentage",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -516,9 +959,9 @@ func (m *LatencyFault) validate(all bool) error {
 		}
 	}
 
-	if m.GetLatencyDuration() == nil {
-		err := LatencyFaultValidationError{
-			field:  "LatencyDuration",
+	if m.GetRateLimit() == nil {
+		err := ResponseRateLimitFaultValidationError{
+			field:  "RateLimit",
 			reason: "value is required",
 		}
 		if !all {
@@ -528,28 +971,28 @@ func (m *LatencyFault) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetLatencyDuration()).(type) {
+		switch v := interface{}(m.GetRateLimit()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, LatencyFaultValidationError{
-					field:  "LatencyDuration",
+				errors = append(errors, ResponseRateLimitFaultValidationError{
+					field:  "RateLimit",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, LatencyFaultValidationError{
-					field:  "LatencyDuration",
+				errors = append(errors, ResponseRateLimitFaultValidationError{
+					field:  "RateLimit",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetLatencyDuration()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRateLimit()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return LatencyFaultValidationError{
-				field:  "LatencyDuration",
+			return ResponseRateLimitFaultValidationError{
+				field:  "RateLimit",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -557,18 +1000,19 @@ func (m *LatencyFault) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return LatencyFaultMultiError(errors)
+		return ResponseRateLimitFaultMultiError(errors)
 	}
 
 	return nil
 }
 
-// LatencyFaultMultiError is an error wrapping multiple validation errors
-// returned by LatencyFault.ValidateAll() if the designated constraints aren't met.
-type LatencyFaultMultiError []error
+// ResponseRateLimitFaultMultiError is an error wrapping multiple validation
+// errors returned by ResponseRateLimitFault.ValidateAll() if the designated
+// constraints aren't met.
+type ResponseRateLimitFaultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m LatencyFaultMultiError) Error() string {
+func (m ResponseRateLimitFaultMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -577,11 +1021,11 @@ func (m LatencyFaultMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m LatencyFaultMultiError) AllErrors() []error { return m }
+func (m ResponseRateLimitFaultMultiError) AllErrors() []error { return m }
 
-// LatencyFaultValidationError is the validation error returned by
-// LatencyFault.Validate if the designated constraints aren't met.
-type LatencyFaultValidationError struct {
+// ResponseRateLimitFaultValidationError is the validation error returned by
+// ResponseRateLimitFault.Validate if the designated constraints aren't met.
+type ResponseRateLimitFaultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -589,22 +1033,24 @@ type LatencyFaultValidationError struct {
 }
 
 // Field function returns field value.
-func (e LatencyFaultValidationError) Field() string { return e.field }
+func (e ResponseRateLimitFaultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e LatencyFaultValidationError) Reason() string { return e.reason }
+func (e ResponseRateLimitFaultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e LatencyFaultValidationError) Cause() error { return e.cause }
+func (e ResponseRateLimitFaultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e LatencyFaultValidationError) Key() bool { return e.key }
+func (e ResponseRateLimitFaultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e LatencyFaultValidationError) ErrorName() string { return "LatencyFaultValidationError" }
+func (e ResponseRateLimitFaultValidationError) ErrorName() string {
+	return "ResponseRateLimitFaultValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e LatencyFaultValidationError) Error() string {
+func (e ResponseRateLimitFaultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -616,14 +1062,14 @@ func (e LatencyFaultValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sLatencyFault.%s: %s%s",
+		"invalid %sResponseRateLimitFault.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = LatencyFaultValidationError{}
+var _ error = ResponseRateLimitFaultValidationError{}
 
 var _ interface {
 	Field() string
@@ -631,7 +1077,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = LatencyFaultValidationError{}
+} = ResponseRateLimitFaultValidationError{}
 
 // Validate checks the field values on FaultTargeting with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
@@ -1715,6 +2161,230 @@ var _ interface {
 	ErrorName() string
 } = FaultAbortStatusValidationError{}
 
+// Validate checks the field values on FaultGrpcAbortStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FaultGrpcAbortStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FaultGrpcAbortStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FaultGrpcAbortStatusMultiError, or nil if none found.
+func (m *FaultGrpcAbortStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FaultGrpcAbortStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if val := m.GetGrpcStatusCode(); val <= 0 || val > 16 {
+		err := FaultGrpcAbortStatusValidationError{
+			field:  "GrpcStatusCode",
+			reason: "value must be inside range (0, 16]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FaultGrpcAbortStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// FaultGrpcAbortStatusMultiError is an error wrapping multiple validation
+// errors returned by FaultGrpcAbortStatus.ValidateAll() if the designated
+// constraints aren't met.
+type FaultGrpcAbortStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FaultGrpcAbortStatusMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FaultGrpcAbortStatusMultiError) AllErrors() []error { return m }
+
+// FaultGrpcAbortStatusValidationError is the validation error returned by
+// FaultGrpcAbortStatus.Validate if the designated constraints aren't met.
+type FaultGrpcAbortStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FaultGrpcAbortStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FaultGrpcAbortStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FaultGrpcAbortStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FaultGrpcAbortStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FaultGrpcAbortStatusValidationError) ErrorName() string {
+	return "FaultGrpcAbortStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FaultGrpcAbortStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFaultGrpcAbortStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FaultGrpcAbortStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FaultGrpcAbortStatusValidationError{}
+
+// Validate checks the field values on FaultRateLimit with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *FaultRateLimit) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FaultRateLimit with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in FaultRateLimitMultiError,
+// or nil if none found.
+func (m *FaultRateLimit) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FaultRateLimit) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetFixedLimitKbps() <= 0 {
+		err := FaultRateLimitValidationError{
+			field:  "FixedLimitKbps",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return FaultRateLimitMultiError(errors)
+	}
+
+	return nil
+}
+
+// FaultRateLimitMultiError is an error wrapping multiple validation errors
+// returned by FaultRateLimit.ValidateAll() if the designated constraints
+// aren't met.
+type FaultRateLimitMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FaultRateLimitMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FaultRateLimitMultiError) AllErrors() []error { return m }
+
+// FaultRateLimitValidationError is the validation error returned by
+// FaultRateLimit.Validate if the designated constraints aren't met.
+type FaultRateLimitValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FaultRateLimitValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FaultRateLimitValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FaultRateLimitValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FaultRateLimitValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FaultRateLimitValidationError) ErrorName() string { return "FaultRateLimitValidationError" }
+
+// Error satisfies the builtin error interface
+func (e FaultRateLimitValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFaultRateLimit.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FaultRateLimitValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FaultRateLimitValidationError{}
+
 // Validate checks the field values on FaultLatencyDuration with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
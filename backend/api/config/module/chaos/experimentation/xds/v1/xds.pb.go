@@ -32,7 +32,7 @@ type Config struct {
 	// Name of the RTDS layer in Envoy config i.e. envoy.yaml
 	// https://www.envoyproxy.io/docs/envoy/latest/configuration/operations/runtime#config-runtime-rtds.
 	RtdsLayerName string `protobuf:"bytes,2,opt,name=rtds_layer_name,json=rtdsLayerName,proto3" json:"rtds_layer_name,omitempty"`
-	// The resource TTL to set for xDS resources.
+	// The resource TTL to set for xDS resources, over both state of the world and delta xDS streams.
 	ResourceTtl *durationpb.Duration `protobuf:"bytes,3,opt,name=resource_ttl,json=resourceTtl,proto3" json:"resource_ttl,omitempty"`
 	// The interval at which to send heartbeat responses for TTL'd resources.
 	HeartbeatInterval *durationpb.Duration `protobuf:"bytes,4,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
@@ -0,0 +1,178 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.17.3
+// source: config/module/chaos/k8sexperimentation/v1/k8sexperimentation.proto
+
+package k8sexperimentationv1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The interval at which running experiments are polled, defaults to 10 seconds. Cancelled experiments stop
+	// injecting faults at the latest after one interval.
+	PollInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=poll_interval,json=pollInterval,proto3" json:"poll_interval,omitempty"`
+	// The image of the ephemeral containers of stress faults, which must have stress-ng on its path. Defaults to
+	// ghcr.io/colinianking/stress-ng.
+	StressImage string `protobuf:"bytes,2,opt,name=stress_image,json=stressImage,proto3" json:"stress_image,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetPollInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PollInterval
+	}
+	return nil
+}
+
+func (x *Config) GetStressImage() string {
+	if x != nil {
+		return x.StressImage
+	}
+	return ""
+}
+
+var File_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto protoreflect.FileDescriptor
+
+var file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDesc = []byte{
+	0x0a, 0x42, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f,
+	0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d,
+	0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x38, 0x73, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x30, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73,
+	0x2e, 0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x75, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x0d, 0x70, 0x6f, 0x6c,
+	0x6c, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0xaa, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x0c, 0x70, 0x6f, 0x6c, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x73,
+	0x73, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x42, 0x63, 0x5a, 0x61, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73,
+	0x2f, 0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x6b, 0x38, 0x73, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescOnce sync.Once
+	file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescData = file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDesc
+)
+
+func file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescGZIP() []byte {
+	file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescOnce.Do(func() {
+		file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescData)
+	})
+	return file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDescData
+}
+
+var file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_goTypes = []interface{}{
+	(*Config)(nil),              // 0: clutch.config.module.chaos.k8sexperimentation.v1.Config
+	(*durationpb.Duration)(nil), // 1: google.protobuf.Duration
+}
+var file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_depIdxs = []int32{
+	1, // 0: clutch.config.module.chaos.k8sexperimentation.v1.Config.poll_interval:type_name -> google.protobuf.Duration
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_init() }
+func file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_init() {
+	if File_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_goTypes,
+		DependencyIndexes: file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_depIdxs,
+		MessageInfos:      file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_msgTypes,
+	}.Build()
+	File_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto = out.File
+	file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_rawDesc = nil
+	file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_goTypes = nil
+	file_config_module_chaos_k8sexperimentation_v1_k8sexperimentation_proto_depIdxs = nil
+}
@@ -465,6 +465,10 @@ func (m *Config) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for HashChain
+
+	// no validation rules for MaxDeliveryAttempts
+
 	switch v := m.StorageProvider.(type) {
 	case *Config_DbProvider:
 		if v == nil {
@@ -0,0 +1,253 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: config/service/auditsink/webhook/v1/webhook.proto
+
+package webhookv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on WebhookConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *WebhookConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WebhookConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in WebhookConfigMultiError, or
+// nil if none found.
+func (m *WebhookConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WebhookConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if !strings.HasPrefix(m.GetUrl(), "http") {
+		err := WebhookConfigValidationError{
+			field:  "Url",
+			reason: "value does not have prefix \"http\"",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if uri, err := url.Parse(m.GetUrl()); err != nil {
+		err = WebhookConfigValidationError{
+			field:  "Url",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := WebhookConfigValidationError{
+			field:  "Url",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetFilter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WebhookConfigValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WebhookConfigValidationError{
+					field:  "Filter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFilter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WebhookConfigValidationError{
+				field:  "Filter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Headers
+
+	// no validation rules for SigningSecret
+
+	if m.GetBatchSize() > 100 {
+		err := WebhookConfigValidationError{
+			field:  "BatchSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = WebhookConfigValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := WebhookConfigValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.GetMaxAttempts() > 10 {
+		err := WebhookConfigValidationError{
+			field:  "MaxAttempts",
+			reason: "value must be less than or equal to 10",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return WebhookConfigMultiError(errors)
+	}
+
+	return nil
+}
+
+// WebhookConfigMultiError is an error wrapping multiple validation errors
+// returned by WebhookConfig.ValidateAll() if the designated constraints
+// aren't met.
+type WebhookConfigMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WebhookConfigMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WebhookConfigMultiError) AllErrors() []error { return m }
+
+// WebhookConfigValidationError is the validation error returned by
+// WebhookConfig.Validate if the designated constraints aren't met.
+type WebhookConfigValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WebhookConfigValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WebhookConfigValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WebhookConfigValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WebhookConfigValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WebhookConfigValidationError) ErrorName() string { return "WebhookConfigValidationError" }
+
+// Error satisfies the builtin error interface
+func (e WebhookConfigValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWebhookConfig.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WebhookConfigValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WebhookConfigValidationError{}
@@ -163,6 +163,64 @@ func (m *Config) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetAssumeRoleChain()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "AssumeRoleChain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "AssumeRoleChain",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAssumeRoleChain()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "AssumeRoleChain",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOrganizationsDiscovery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "OrganizationsDiscovery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "OrganizationsDiscovery",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOrganizationsDiscovery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "OrganizationsDiscovery",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ConfigMultiError(errors)
 	}
@@ -755,3 +813,298 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AWSAccountValidationError{}
+
+// Validate checks the field values on AssumeRoleChain with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AssumeRoleChain) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AssumeRoleChain with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AssumeRoleChainMultiError, or nil if none found.
+func (m *AssumeRoleChain) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AssumeRoleChain) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetHubRoleArn()) < 1 {
+		err := AssumeRoleChainValidationError{
+			field:  "HubRoleArn",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetTargetRoleArnTemplate() != "" {
+
+		if !strings.Contains(m.GetTargetRoleArnTemplate(), "{account_number}") {
+			err := AssumeRoleChainValidationError{
+				field:  "TargetRoleArnTemplate",
+				reason: "value does not contain substring \"{account_number}\"",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	// no validation rules for ExternalId
+
+	// no validation rules for UsernameSessionTagKey
+
+	if d := m.GetSessionDuration(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AssumeRoleChainValidationError{
+				field:  "SessionDuration",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(3600*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(900*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := AssumeRoleChainValidationError{
+					field:  "SessionDuration",
+					reason: "value must be inside range [15m0s, 1h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetRefreshWindow(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AssumeRoleChainValidationError{
+				field:  "RefreshWindow",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := AssumeRoleChainValidationError{
+					field:  "RefreshWindow",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return AssumeRoleChainMultiError(errors)
+	}
+
+	return nil
+}
+
+// AssumeRoleChainMultiError is an error wrapping multiple validation errors
+// returned by AssumeRoleChain.ValidateAll() if the designated constraints
+// aren't met.
+type AssumeRoleChainMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AssumeRoleChainMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AssumeRoleChainMultiError) AllErrors() []error { return m }
+
+// AssumeRoleChainValidationError is the validation error returned by
+// AssumeRoleChain.Validate if the designated constraints aren't met.
+type AssumeRoleChainValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AssumeRoleChainValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AssumeRoleChainValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AssumeRoleChainValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AssumeRoleChainValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AssumeRoleChainValidationError) ErrorName() string { return "AssumeRoleChainValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AssumeRoleChainValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAssumeRoleChain.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AssumeRoleChainValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AssumeRoleChainValidationError{}
+
+// Validate checks the field values on OrganizationsDiscovery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *OrganizationsDiscovery) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrganizationsDiscovery with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrganizationsDiscoveryMultiError, or nil if none found.
+func (m *OrganizationsDiscovery) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrganizationsDiscovery) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AliasFilter
+
+	if len(errors) > 0 {
+		return OrganizationsDiscoveryMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrganizationsDiscoveryMultiError is an error wrapping multiple validation
+// errors returned by OrganizationsDiscovery.ValidateAll() if the designated
+// constraints aren't met.
+type OrganizationsDiscoveryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrganizationsDiscoveryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrganizationsDiscoveryMultiError) AllErrors() []error { return m }
+
+// OrganizationsDiscoveryValidationError is the validation error returned by
+// OrganizationsDiscovery.Validate if the designated constraints aren't met.
+type OrganizationsDiscoveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationsDiscoveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationsDiscoveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationsDiscoveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationsDiscoveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationsDiscoveryValidationError) ErrorName() string {
+	return "OrganizationsDiscoveryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationsDiscoveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationsDiscovery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationsDiscoveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationsDiscoveryValidationError{}
@@ -0,0 +1,379 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        v3.17.3
+// source: config/service/chaos/experimentation/experimentstore/v1/experimentstore.proto
+
+package experimentstorev1
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Limits the blast radius of experiments. Experiments are not checked against any limits if unset.
+	Guardrails *Guardrails `protobuf:"bytes,1,opt,name=guardrails,proto3" json:"guardrails,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Config) GetGuardrails() *Guardrails {
+	if x != nil {
+		return x.Guardrails
+	}
+	return nil
+}
+
+// Guardrails are enforced when an experiment is created, against the runs that are not cancelled and whose execution
+// time overlaps with the new one. Only experiment types that register their targets with the experiment store are
+// checked. Kubernetes experiments have the Kubernetes cluster of their pods as upstream cluster and no downstream
+// cluster.
+type Guardrails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of experiments that target the same upstream cluster at the same time. Unlimited if zero.
+	MaxConcurrentPerUpstreamCluster uint32 `protobuf:"varint,1,opt,name=max_concurrent_per_upstream_cluster,json=maxConcurrentPerUpstreamCluster,proto3" json:"max_concurrent_per_upstream_cluster,omitempty"`
+	// The maximum number of experiments that target the same downstream cluster at the same time. Unlimited if zero.
+	MaxConcurrentPerDownstreamCluster uint32             `protobuf:"varint,2,opt,name=max_concurrent_per_downstream_cluster,json=maxConcurrentPerDownstreamCluster,proto3" json:"max_concurrent_per_downstream_cluster,omitempty"`
+	Tiers                             []*Guardrails_Tier `protobuf:"bytes,3,rep,name=tiers,proto3" json:"tiers,omitempty"`
+	// Clusters that may not be targeted by experiments, neither as upstream nor as downstream.
+	DeniedClusters []string `protobuf:"bytes,4,rep,name=denied_clusters,json=deniedClusters,proto3" json:"denied_clusters,omitempty"`
+	// By default, an experiment conflicts with the experiments that target the same upstream and downstream clusters.
+	AllowOverlappingTargets bool `protobuf:"varint,5,opt,name=allow_overlapping_targets,json=allowOverlappingTargets,proto3" json:"allow_overlapping_targets,omitempty"`
+}
+
+func (x *Guardrails) Reset() {
+	*x = Guardrails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Guardrails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guardrails) ProtoMessage() {}
+
+func (x *Guardrails) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guardrails.ProtoReflect.Descriptor instead.
+func (*Guardrails) Descriptor() ([]byte, []int) {
+	return file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Guardrails) GetMaxConcurrentPerUpstreamCluster() uint32 {
+	if x != nil {
+		return x.MaxConcurrentPerUpstreamCluster
+	}
+	return 0
+}
+
+func (x *Guardrails) GetMaxConcurrentPerDownstreamCluster() uint32 {
+	if x != nil {
+		return x.MaxConcurrentPerDownstreamCluster
+	}
+	return 0
+}
+
+func (x *Guardrails) GetTiers() []*Guardrails_Tier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+func (x *Guardrails) GetDeniedClusters() []string {
+	if x != nil {
+		return x.DeniedClusters
+	}
+	return nil
+}
+
+func (x *Guardrails) GetAllowOverlappingTargets() bool {
+	if x != nil {
+		return x.AllowOverlappingTargets
+	}
+	return false
+}
+
+type Guardrails_Tier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The clusters of the tier. An experiment belongs to the tier if either its upstream or downstream cluster does.
+	Clusters []string `protobuf:"bytes,2,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	// The maximum percentage of requests that experiments in the tier may apply a fault to.
+	MaxFaultPercentage float64 `protobuf:"fixed64,3,opt,name=max_fault_percentage,json=maxFaultPercentage,proto3" json:"max_fault_percentage,omitempty"`
+}
+
+func (x *Guardrails_Tier) Reset() {
+	*x = Guardrails_Tier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Guardrails_Tier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Guardrails_Tier) ProtoMessage() {}
+
+func (x *Guardrails_Tier) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Guardrails_Tier.ProtoReflect.Descriptor instead.
+func (*Guardrails_Tier) Descriptor() ([]byte, []int) {
+	return file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *Guardrails_Tier) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Guardrails_Tier) GetClusters() []string {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *Guardrails_Tier) GetMaxFaultPercentage() float64 {
+	if x != nil {
+		return x.MaxFaultPercentage
+	}
+	return 0
+}
+
+var File_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto protoreflect.FileDescriptor
+
+var file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDesc = []byte{
+	0x0a, 0x4d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x3e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x65, 0x78, 0x70,
+	0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x1a,
+	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x74, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x6a, 0x0a, 0x0a, 0x67, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4a, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63,
+	0x68, 0x61, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x0a, 0x67, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x8f,
+	0x04, 0x0a, 0x0a, 0x47, 0x75, 0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x4c, 0x0a,
+	0x23, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1f, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x55, 0x70, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x25, 0x6d,
+	0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x64, 0x6f, 0x77, 0x6e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x21, 0x6d, 0x61, 0x78, 0x43,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x65, 0x72, 0x44, 0x6f, 0x77, 0x6e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x65, 0x0a,
+	0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x4f, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x65, 0x78, 0x70, 0x65, 0x72,
+	0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x72, 0x61, 0x69, 0x6c, 0x73, 0x2e, 0x54, 0x69, 0x65, 0x72, 0x52, 0x05, 0x74,
+	0x69, 0x65, 0x72, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x65, 0x6e, 0x69, 0x65, 0x64, 0x5f, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x64,
+	0x65, 0x6e, 0x69, 0x65, 0x64, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a,
+	0x19, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x17, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x4f, 0x76, 0x65, 0x72, 0x6c, 0x61, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x1a, 0x94, 0x01, 0x0a, 0x04, 0x54, 0x69,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x24, 0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x92, 0x01, 0x02, 0x08, 0x01, 0x52, 0x08, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x49, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x42, 0x17, 0xfa, 0x42, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x59, 0x40, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x12, 0x6d, 0x61,
+	0x78, 0x46, 0x61, 0x75, 0x6c, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65,
+	0x42, 0x6e, 0x5a, 0x6c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c,
+	0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x65, 0x78, 0x70, 0x65,
+	0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x76, 0x31,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescOnce sync.Once
+	file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescData = file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDesc
+)
+
+func file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescGZIP() []byte {
+	file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescOnce.Do(func() {
+		file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescData = protoimpl.X.CompressGZIP(file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescData)
+	})
+	return file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDescData
+}
+
+var file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_goTypes = []interface{}{
+	(*Config)(nil),          // 0: clutch.config.service.chaos.experimentation.experimentstore.v1.Config
+	(*Guardrails)(nil),      // 1: clutch.config.service.chaos.experimentation.experimentstore.v1.Guardrails
+	(*Guardrails_Tier)(nil), // 2: clutch.config.service.chaos.experimentation.experimentstore.v1.Guardrails.Tier
+}
+var file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_depIdxs = []int32{
+	1, // 0: clutch.config.service.chaos.experimentation.experimentstore.v1.Config.guardrails:type_name -> clutch.config.service.chaos.experimentation.experimentstore.v1.Guardrails
+	2, // 1: clutch.config.service.chaos.experimentation.experimentstore.v1.Guardrails.tiers:type_name -> clutch.config.service.chaos.experimentation.experimentstore.v1.Guardrails.Tier
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() {
+	file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_init()
+}
+func file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_init() {
+	if File_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Guardrails); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Guardrails_Tier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_goTypes,
+		DependencyIndexes: file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_depIdxs,
+		MessageInfos:      file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_msgTypes,
+	}.Build()
+	File_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto = out.File
+	file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_rawDesc = nil
+	file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_goTypes = nil
+	file_config_service_chaos_experimentation_experimentstore_v1_experimentstore_proto_depIdxs = nil
+}
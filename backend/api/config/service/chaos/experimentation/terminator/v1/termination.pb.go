@@ -23,6 +23,57 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MetricThresholdTerminationCriterion_Comparison int32
+
+const (
+	MetricThresholdTerminationCriterion_UNSPECIFIED MetricThresholdTerminationCriterion_Comparison = 0
+	// Terminate if any series is below the threshold.
+	MetricThresholdTerminationCriterion_BELOW MetricThresholdTerminationCriterion_Comparison = 1
+	// Terminate if any series is above the threshold.
+	MetricThresholdTerminationCriterion_ABOVE MetricThresholdTerminationCriterion_Comparison = 2
+)
+
+// Enum value maps for MetricThresholdTerminationCriterion_Comparison.
+var (
+	MetricThresholdTerminationCriterion_Comparison_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "BELOW",
+		2: "ABOVE",
+	}
+	MetricThresholdTerminationCriterion_Comparison_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"BELOW":       1,
+		"ABOVE":       2,
+	}
+)
+
+func (x MetricThresholdTerminationCriterion_Comparison) Enum() *MetricThresholdTerminationCriterion_Comparison {
+	p := new(MetricThresholdTerminationCriterion_Comparison)
+	*p = x
+	return p
+}
+
+func (x MetricThresholdTerminationCriterion_Comparison) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MetricThresholdTerminationCriterion_Comparison) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_service_chaos_experimentation_terminator_v1_termination_proto_enumTypes[0].Descriptor()
+}
+
+func (MetricThresholdTerminationCriterion_Comparison) Type() protoreflect.EnumType {
+	return &file_config_service_chaos_experimentation_terminator_v1_termination_proto_enumTypes[0]
+}
+
+func (x MetricThresholdTerminationCriterion_Comparison) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MetricThresholdTerminationCriterion_Comparison.Descriptor instead.
+func (MetricThresholdTerminationCriterion_Comparison) EnumDescriptor() ([]byte, []int) {
+	return file_config_service_chaos_experimentation_terminator_v1_termination_proto_rawDescGZIP(), []int{2, 0}
+}
+
 type Config struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -140,6 +191,113 @@ func (x *MaxTimeTerminationCriterion) GetMaxDuration() *durationpb.Duration {
 	return nil
 }
 
+// Termination criterion that terminates an experiment when the result of a query against a Prometheus-compatible API
+// crosses a threshold, e.g. when the success rate of the targeted downstream cluster drops below 95%.
+type MetricThresholdTerminationCriterion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The base URL of the API, e.g. http://prometheus:9090.
+	Endpoint string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	// An instant query rendered as a Go template with the experiment, e.g.
+	// sum(rate(requests_success{cluster="{{.Config.faultTargeting.upstreamEnforcing.downstreamCluster.name}}"}[{{.Window}}]))
+	// / sum(rate(requests_total{...}[{{.Window}}])). The template has the fields:
+	//   - RunID: the ID of the experiment run.
+	//   - Window: the window in Prometheus duration format, e.g. 5m.
+	//   - Config: the experiment config in its JSON representation.
+	Query string `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	// A short description of the metric used in the termination reason, e.g. "downstream success rate".
+	Description string                                         `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Comparison  MetricThresholdTerminationCriterion_Comparison `protobuf:"varint,4,opt,name=comparison,proto3,enum=clutch.config.service.chaos.experimentation.terminator.v1.MetricThresholdTerminationCriterion_Comparison" json:"comparison,omitempty"`
+	Threshold   float64                                        `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// The window the query aggregates over.
+	Window *durationpb.Duration `protobuf:"bytes,6,opt,name=window,proto3" json:"window,omitempty"`
+	// The timeout of each query, defaults to 10 seconds.
+	Timeout *durationpb.Duration `protobuf:"bytes,7,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (x *MetricThresholdTerminationCriterion) Reset() {
+	*x = MetricThresholdTerminationCriterion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MetricThresholdTerminationCriterion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricThresholdTerminationCriterion) ProtoMessage() {}
+
+func (x *MetricThresholdTerminationCriterion) ProtoReflect() protoreflect.Message {
+	mi := &file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricThresholdTerminationCriterion.ProtoReflect.Descriptor instead.
+func (*MetricThresholdTerminationCriterion) Descriptor() ([]byte, []int) {
+	return file_config_service_chaos_experimentation_terminator_v1_termination_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MetricThresholdTerminationCriterion) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *MetricThresholdTerminationCriterion) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *MetricThresholdTerminationCriterion) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MetricThresholdTerminationCriterion) GetComparison() MetricThresholdTerminationCriterion_Comparison {
+	if x != nil {
+		return x.Comparison
+	}
+	return MetricThresholdTerminationCriterion_UNSPECIFIED
+}
+
+func (x *MetricThresholdTerminationCriterion) GetThreshold() float64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *MetricThresholdTerminationCriterion) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *MetricThresholdTerminationCriterion) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
 type Config_PerConfigTypeConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -152,7 +310,7 @@ type Config_PerConfigTypeConfig struct {
 func (x *Config_PerConfigTypeConfig) Reset() {
 	*x = Config_PerConfigTypeConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes[2]
+		mi := &file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -165,7 +323,7 @@ func (x *Config_PerConfigTypeConfig) String() string {
 func (*Config_PerConfigTypeConfig) ProtoMessage() {}
 
 func (x *Config_PerConfigTypeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes[2]
+	mi := &file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -250,14 +408,46 @@ var file_config_service_chaos_experimentation_terminator_v1_termination_proto_ra
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x2a, 0x00, 0x52,
-	0x0b, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x64, 0x5a, 0x62,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f,
-	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x2f, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65,
-	0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
-	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x0b, 0x6d, 0x61, 0x78, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf7, 0x03, 0x0a,
+	0x23, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x88, 0x01, 0x01,
+	0x52, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x95, 0x01, 0x0a, 0x0a,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x69, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x63, 0x68, 0x61, 0x6f, 0x73, 0x2e, 0x65,
+	0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x74,
+	0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x54, 0x65, 0x72, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x6f, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x82, 0x01, 0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69,
+	0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x3f, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42,
+	0x09, 0xaa, 0x01, 0x06, 0x08, 0x01, 0x32, 0x02, 0x08, 0x01, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x3d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0xaa, 0x01, 0x02, 0x2a, 0x00, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x22, 0x33, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x69, 0x73, 0x6f, 0x6e, 0x12,
+	0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x41,
+	0x42, 0x4f, 0x56, 0x45, 0x10, 0x02, 0x42, 0x64, 0x5a, 0x62, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2f, 0x63, 0x68, 0x61, 0x6f,
+	0x73, 0x2f, 0x65, 0x78, 0x70, 0x65, 0x72, 0x69, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x76, 0x31, 0x3b,
+	0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -272,27 +462,33 @@ func file_config_service_chaos_experimentation_terminator_v1_termination_proto_r
 	return file_config_service_chaos_experimentation_terminator_v1_termination_proto_rawDescData
 }
 
-var file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_config_service_chaos_experimentation_terminator_v1_termination_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_config_service_chaos_experimentation_terminator_v1_termination_proto_goTypes = []interface{}{
-	(*Config)(nil),                      // 0: clutch.config.service.chaos.experimentation.terminator.v1.Config
-	(*MaxTimeTerminationCriterion)(nil), // 1: clutch.config.service.chaos.experimentation.terminator.v1.MaxTimeTerminationCriterion
-	(*Config_PerConfigTypeConfig)(nil),  // 2: clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfig
-	nil,                                 // 3: clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfigurationEntry
-	(*durationpb.Duration)(nil),         // 4: google.protobuf.Duration
-	(*anypb.Any)(nil),                   // 5: google.protobuf.Any
+	(MetricThresholdTerminationCriterion_Comparison)(0), // 0: clutch.config.service.chaos.experimentation.terminator.v1.MetricThresholdTerminationCriterion.Comparison
+	(*Config)(nil),                              // 1: clutch.config.service.chaos.experimentation.terminator.v1.Config
+	(*MaxTimeTerminationCriterion)(nil),         // 2: clutch.config.service.chaos.experimentation.terminator.v1.MaxTimeTerminationCriterion
+	(*MetricThresholdTerminationCriterion)(nil), // 3: clutch.config.service.chaos.experimentation.terminator.v1.MetricThresholdTerminationCriterion
+	(*Config_PerConfigTypeConfig)(nil),          // 4: clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfig
+	nil,                                         // 5: clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfigurationEntry
+	(*durationpb.Duration)(nil),                 // 6: google.protobuf.Duration
+	(*anypb.Any)(nil),                           // 7: google.protobuf.Any
 }
 var file_config_service_chaos_experimentation_terminator_v1_termination_proto_depIdxs = []int32{
-	3, // 0: clutch.config.service.chaos.experimentation.terminator.v1.Config.per_config_type_configuration:type_name -> clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfigurationEntry
-	4, // 1: clutch.config.service.chaos.experimentation.terminator.v1.Config.outer_loop_interval:type_name -> google.protobuf.Duration
-	4, // 2: clutch.config.service.chaos.experimentation.terminator.v1.Config.per_experiment_check_interval:type_name -> google.protobuf.Duration
-	4, // 3: clutch.config.service.chaos.experimentation.terminator.v1.MaxTimeTerminationCriterion.max_duration:type_name -> google.protobuf.Duration
-	5, // 4: clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfig.termination_criteria:type_name -> google.protobuf.Any
-	2, // 5: clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfigurationEntry.value:type_name -> clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfig
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	5, // 0: clutch.config.service.chaos.experimentation.terminator.v1.Config.per_config_type_configuration:type_name -> clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfigurationEntry
+	6, // 1: clutch.config.service.chaos.experimentation.terminator.v1.Config.outer_loop_interval:type_name -> google.protobuf.Duration
+	6, // 2: clutch.config.service.chaos.experimentation.terminator.v1.Config.per_experiment_check_interval:type_name -> google.protobuf.Duration
+	6, // 3: clutch.config.service.chaos.experimentation.terminator.v1.MaxTimeTerminationCriterion.max_duration:type_name -> google.protobuf.Duration
+	0, // 4: clutch.config.service.chaos.experimentation.terminator.v1.MetricThresholdTerminationCriterion.comparison:type_name -> clutch.config.service.chaos.experimentation.terminator.v1.MetricThresholdTerminationCriterion.Comparison
+	6, // 5: clutch.config.service.chaos.experimentation.terminator.v1.MetricThresholdTerminationCriterion.window:type_name -> google.protobuf.Duration
+	6, // 6: clutch.config.service.chaos.experimentation.terminator.v1.MetricThresholdTerminationCriterion.timeout:type_name -> google.protobuf.Duration
+	7, // 7: clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfig.termination_criteria:type_name -> google.protobuf.Any
+	4, // 8: clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfigurationEntry.value:type_name -> clutch.config.service.chaos.experimentation.terminator.v1.Config.PerConfigTypeConfig
+	9, // [9:9] is the sub-list for method output_type
+	9, // [9:9] is the sub-list for method input_type
+	9, // [9:9] is the sub-list for extension type_name
+	9, // [9:9] is the sub-list for extension extendee
+	0, // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_config_service_chaos_experimentation_terminator_v1_termination_proto_init() }
@@ -326,6 +522,18 @@ func file_config_service_chaos_experimentation_terminator_v1_termination_proto_i
 			}
 		}
 		file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricThresholdTerminationCriterion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config_PerConfigTypeConfig); i {
 			case 0:
 				return &v.state
@@ -343,13 +551,14 @@ func file_config_service_chaos_experimentation_terminator_v1_termination_proto_i
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_service_chaos_experimentation_terminator_v1_termination_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   4,
+			NumEnums:      1,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_config_service_chaos_experimentation_terminator_v1_termination_proto_goTypes,
 		DependencyIndexes: file_config_service_chaos_experimentation_terminator_v1_termination_proto_depIdxs,
+		EnumInfos:         file_config_service_chaos_experimentation_terminator_v1_termination_proto_enumTypes,
 		MessageInfos:      file_config_service_chaos_experimentation_terminator_v1_termination_proto_msgTypes,
 	}.Build()
 	File_config_service_chaos_experimentation_terminator_v1_termination_proto = out.File
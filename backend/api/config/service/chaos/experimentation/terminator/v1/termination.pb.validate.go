@@ -372,6 +372,244 @@ var _ interface {
 	ErrorName() string
 } = MaxTimeTerminationCriterionValidationError{}
 
+// Validate checks the field values on MetricThresholdTerminationCriterion with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *MetricThresholdTerminationCriterion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricThresholdTerminationCriterion
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// MetricThresholdTerminationCriterionMultiError, or nil if none found.
+func (m *MetricThresholdTerminationCriterion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricThresholdTerminationCriterion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if uri, err := url.Parse(m.GetEndpoint()); err != nil {
+		err = MetricThresholdTerminationCriterionValidationError{
+			field:  "Endpoint",
+			reason: "value must be a valid URI",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	} else if !uri.IsAbs() {
+		err := MetricThresholdTerminationCriterionValidationError{
+			field:  "Endpoint",
+			reason: "value must be absolute",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQuery()) < 1 {
+		err := MetricThresholdTerminationCriterionValidationError{
+			field:  "Query",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	if _, ok := _MetricThresholdTerminationCriterion_Comparison_NotInLookup[m.GetComparison()]; ok {
+		err := MetricThresholdTerminationCriterionValidationError{
+			field:  "Comparison",
+			reason: "value must not be in list [UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MetricThresholdTerminationCriterion_Comparison_name[int32(m.GetComparison())]; !ok {
+		err := MetricThresholdTerminationCriterionValidationError{
+			field:  "Comparison",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Threshold
+
+	if m.GetWindow() == nil {
+		err := MetricThresholdTerminationCriterionValidationError{
+			field:  "Window",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetWindow(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = MetricThresholdTerminationCriterionValidationError{
+				field:  "Window",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := MetricThresholdTerminationCriterionValidationError{
+					field:  "Window",
+					reason: "value must be greater than or equal to 1s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = MetricThresholdTerminationCriterionValidationError{
+				field:  "Timeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := MetricThresholdTerminationCriterionValidationError{
+					field:  "Timeout",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if len(errors) > 0 {
+		return MetricThresholdTerminationCriterionMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricThresholdTerminationCriterionMultiError is an error wrapping multiple
+// validation errors returned by
+// MetricThresholdTerminationCriterion.ValidateAll() if the designated
+// constraints aren't met.
+type MetricThresholdTerminationCriterionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricThresholdTerminationCriterionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricThresholdTerminationCriterionMultiError) AllErrors() []error { return m }
+
+// MetricThresholdTerminationCriterionValidationError is the validation error
+// returned by MetricThresholdTerminationCriterion.Validate if the designated
+// constraints aren't met.
+type MetricThresholdTerminationCriterionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricThresholdTerminationCriterionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricThresholdTerminationCriterionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricThresholdTerminationCriterionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricThresholdTerminationCriterionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricThresholdTerminationCriterionValidationError) ErrorName() string {
+	return "MetricThresholdTerminationCriterionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricThresholdTerminationCriterionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricThresholdTerminationCriterion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MetricThresholdTerminationCriterionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricThresholdTerminationCriterionValidationError{}
+
+var _MetricThresholdTerminationCriterion_Comparison_NotInLookup = map[MetricThresholdTerminationCriterion_Comparison]struct{}{
+	0: {},
+}
+
 // Validate checks the field values on Config_PerConfigTypeConfig with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
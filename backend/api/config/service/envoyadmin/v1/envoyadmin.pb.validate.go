@@ -78,6 +78,35 @@ func (m *Config) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetConfigSnapshots()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "ConfigSnapshots",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigValidationError{
+					field:  "ConfigSnapshots",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfigSnapshots()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigValidationError{
+				field:  "ConfigSnapshots",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ConfigMultiError(errors)
 	}
@@ -154,3 +183,135 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ConfigValidationError{}
+
+// Validate checks the field values on ConfigSnapshots with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConfigSnapshots) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigSnapshots with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfigSnapshotsMultiError, or nil if none found.
+func (m *ConfigSnapshots) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigSnapshots) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if d := m.GetRetention(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ConfigSnapshotsValidationError{
+				field:  "Retention",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur <= gt {
+				err := ConfigSnapshotsValidationError{
+					field:  "Retention",
+					reason: "value must be greater than 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	// no validation rules for MaxPerAddress
+
+	if len(errors) > 0 {
+		return ConfigSnapshotsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigSnapshotsMultiError is an error wrapping multiple validation errors
+// returned by ConfigSnapshots.ValidateAll() if the designated constraints
+// aren't met.
+type ConfigSnapshotsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigSnapshotsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigSnapshotsMultiError) AllErrors() []error { return m }
+
+// ConfigSnapshotsValidationError is the validation error returned by
+// ConfigSnapshots.Validate if the designated constraints aren't met.
+type ConfigSnapshotsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigSnapshotsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigSnapshotsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigSnapshotsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigSnapshotsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigSnapshotsValidationError) ErrorName() string { return "ConfigSnapshotsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigSnapshotsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigSnapshots.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigSnapshotsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigSnapshotsValidationError{}
@@ -12,6 +12,10 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -23,6 +27,177 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ConfigDiff_ResourceType int32
+
+const (
+	ConfigDiff_UNSPECIFIED ConfigDiff_ResourceType = 0
+	ConfigDiff_LISTENER    ConfigDiff_ResourceType = 1
+	ConfigDiff_CLUSTER     ConfigDiff_ResourceType = 2
+	ConfigDiff_ROUTE       ConfigDiff_ResourceType = 3
+	ConfigDiff_SECRET      ConfigDiff_ResourceType = 4
+)
+
+// Enum value maps for ConfigDiff_ResourceType.
+var (
+	ConfigDiff_ResourceType_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "LISTENER",
+		2: "CLUSTER",
+		3: "ROUTE",
+		4: "SECRET",
+	}
+	ConfigDiff_ResourceType_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"LISTENER":    1,
+		"CLUSTER":     2,
+		"ROUTE":       3,
+		"SECRET":      4,
+	}
+)
+
+func (x ConfigDiff_ResourceType) Enum() *ConfigDiff_ResourceType {
+	p := new(ConfigDiff_ResourceType)
+	*p = x
+	return p
+}
+
+func (x ConfigDiff_ResourceType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigDiff_ResourceType) Descriptor() protoreflect.EnumDescriptor {
+	return file_envoytriage_v1_envoytriage_api_proto_enumTypes[0].Descriptor()
+}
+
+func (ConfigDiff_ResourceType) Type() protoreflect.EnumType {
+	return &file_envoytriage_v1_envoytriage_api_proto_enumTypes[0]
+}
+
+func (x ConfigDiff_ResourceType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigDiff_ResourceType.Descriptor instead.
+func (ConfigDiff_ResourceType) EnumDescriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{12, 0}
+}
+
+type ConfigDiff_Change int32
+
+const (
+	ConfigDiff_CHANGE_UNSPECIFIED ConfigDiff_Change = 0
+	ConfigDiff_ADDED              ConfigDiff_Change = 1
+	ConfigDiff_REMOVED            ConfigDiff_Change = 2
+	ConfigDiff_MODIFIED           ConfigDiff_Change = 3
+)
+
+// Enum value maps for ConfigDiff_Change.
+var (
+	ConfigDiff_Change_name = map[int32]string{
+		0: "CHANGE_UNSPECIFIED",
+		1: "ADDED",
+		2: "REMOVED",
+		3: "MODIFIED",
+	}
+	ConfigDiff_Change_value = map[string]int32{
+		"CHANGE_UNSPECIFIED": 0,
+		"ADDED":              1,
+		"REMOVED":            2,
+		"MODIFIED":           3,
+	}
+)
+
+func (x ConfigDiff_Change) Enum() *ConfigDiff_Change {
+	p := new(ConfigDiff_Change)
+	*p = x
+	return p
+}
+
+func (x ConfigDiff_Change) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ConfigDiff_Change) Descriptor() protoreflect.EnumDescriptor {
+	return file_envoytriage_v1_envoytriage_api_proto_enumTypes[1].Descriptor()
+}
+
+func (ConfigDiff_Change) Type() protoreflect.EnumType {
+	return &file_envoytriage_v1_envoytriage_api_proto_enumTypes[1]
+}
+
+func (x ConfigDiff_Change) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ConfigDiff_Change.Descriptor instead.
+func (ConfigDiff_Change) EnumDescriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{12, 1}
+}
+
+type SetLogLevelRequest_Level int32
+
+const (
+	SetLogLevelRequest_UNSPECIFIED SetLogLevelRequest_Level = 0
+	SetLogLevelRequest_TRACE       SetLogLevelRequest_Level = 1
+	SetLogLevelRequest_DEBUG       SetLogLevelRequest_Level = 2
+	SetLogLevelRequest_INFO        SetLogLevelRequest_Level = 3
+	SetLogLevelRequest_WARNING     SetLogLevelRequest_Level = 4
+	SetLogLevelRequest_ERROR       SetLogLevelRequest_Level = 5
+	SetLogLevelRequest_CRITICAL    SetLogLevelRequest_Level = 6
+	SetLogLevelRequest_OFF         SetLogLevelRequest_Level = 7
+)
+
+// Enum value maps for SetLogLevelRequest_Level.
+var (
+	SetLogLevelRequest_Level_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "TRACE",
+		2: "DEBUG",
+		3: "INFO",
+		4: "WARNING",
+		5: "ERROR",
+		6: "CRITICAL",
+		7: "OFF",
+	}
+	SetLogLevelRequest_Level_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"TRACE":       1,
+		"DEBUG":       2,
+		"INFO":        3,
+		"WARNING":     4,
+		"ERROR":       5,
+		"CRITICAL":    6,
+		"OFF":         7,
+	}
+)
+
+func (x SetLogLevelRequest_Level) Enum() *SetLogLevelRequest_Level {
+	p := new(SetLogLevelRequest_Level)
+	*p = x
+	return p
+}
+
+func (x SetLogLevelRequest_Level) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SetLogLevelRequest_Level) Descriptor() protoreflect.EnumDescriptor {
+	return file_envoytriage_v1_envoytriage_api_proto_enumTypes[2].Descriptor()
+}
+
+func (SetLogLevelRequest_Level) Type() protoreflect.EnumType {
+	return &file_envoytriage_v1_envoytriage_api_proto_enumTypes[2]
+}
+
+func (x SetLogLevelRequest_Level) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SetLogLevelRequest_Level.Descriptor instead.
+func (SetLogLevelRequest_Level) EnumDescriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{24, 0}
+}
+
 type ReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -177,8 +352,11 @@ type Address struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// The host is required unless a pod is provided.
 	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
-	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// The admin port of the host, or the pod if the pod is provided.
+	Port uint32       `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Pod  *Address_Pod `protobuf:"bytes,3,opt,name=pod,proto3" json:"pod,omitempty"`
 }
 
 func (x *Address) Reset() {
@@ -227,6 +405,13 @@ func (x *Address) GetPort() uint32 {
 	return 0
 }
 
+func (x *Address) GetPod() *Address_Pod {
+	if x != nil {
+		return x.Pod
+	}
+	return nil
+}
+
 type Result struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -361,21 +546,29 @@ func (x *NodeMetadata) GetVersion() string {
 	return ""
 }
 
-type ReadOperation_Include struct {
+// Reads the same outputs from many hosts at once and aggregates them.
+type BatchReadRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Clusters   bool `protobuf:"varint,1,opt,name=clusters,proto3" json:"clusters,omitempty"`
-	ConfigDump bool `protobuf:"varint,2,opt,name=config_dump,json=configDump,proto3" json:"config_dump,omitempty"`
-	Listeners  bool `protobuf:"varint,3,opt,name=listeners,proto3" json:"listeners,omitempty"`
-	Runtime    bool `protobuf:"varint,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	Stats      bool `protobuf:"varint,5,opt,name=stats,proto3" json:"stats,omitempty"`
-	ServerInfo bool `protobuf:"varint,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	Addresses []*Address `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// Pods located with the Kubernetes resolver, e.g. "my-namespace/my-pod" or "my-cluster/my-namespace/my-pod". The
+	// admin interface is reached through the proxy subresource of the API server, which requires the k8s service.
+	Pods []string `protobuf:"bytes,2,rep,name=pods,proto3" json:"pods,omitempty"`
+	// The admin port of the pods, the default port of the service is used if unset.
+	PodAdminPort uint32                 `protobuf:"varint,3,opt,name=pod_admin_port,json=podAdminPort,proto3" json:"pod_admin_port,omitempty"`
+	Include      *ReadOperation_Include `protobuf:"bytes,4,opt,name=include,proto3" json:"include,omitempty"`
+	// The maximum number of hosts read at the same time, defaults to 10.
+	MaxConcurrency uint32 `protobuf:"varint,5,opt,name=max_concurrency,json=maxConcurrency,proto3" json:"max_concurrency,omitempty"`
+	// The timeout for reading each host, defaults to 5 seconds.
+	PerHostTimeout *durationpb.Duration `protobuf:"bytes,6,opt,name=per_host_timeout,json=perHostTimeout,proto3" json:"per_host_timeout,omitempty"`
+	// Certificates expiring within the window are flagged in the aggregate, defaults to 30 days. Requires certs.
+	CertExpiryWindow *durationpb.Duration `protobuf:"bytes,7,opt,name=cert_expiry_window,json=certExpiryWindow,proto3" json:"cert_expiry_window,omitempty"`
 }
 
-func (x *ReadOperation_Include) Reset() {
-	*x = ReadOperation_Include{}
+func (x *BatchReadRequest) Reset() {
+	*x = BatchReadRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,13 +576,13 @@ func (x *ReadOperation_Include) Reset() {
 	}
 }
 
-func (x *ReadOperation_Include) String() string {
+func (x *BatchReadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadOperation_Include) ProtoMessage() {}
+func (*BatchReadRequest) ProtoMessage() {}
 
-func (x *ReadOperation_Include) ProtoReflect() protoreflect.Message {
+func (x *BatchReadRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -401,68 +594,74 @@ func (x *ReadOperation_Include) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadOperation_Include.ProtoReflect.Descriptor instead.
-func (*ReadOperation_Include) Descriptor() ([]byte, []int) {
-	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use BatchReadRequest.ProtoReflect.Descriptor instead.
+func (*BatchReadRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *ReadOperation_Include) GetClusters() bool {
+func (x *BatchReadRequest) GetAddresses() []*Address {
 	if x != nil {
-		return x.Clusters
+		return x.Addresses
 	}
-	return false
+	return nil
 }
 
-func (x *ReadOperation_Include) GetConfigDump() bool {
+func (x *BatchReadRequest) GetPods() []string {
 	if x != nil {
-		return x.ConfigDump
+		return x.Pods
 	}
-	return false
+	return nil
 }
 
-func (x *ReadOperation_Include) GetListeners() bool {
+func (x *BatchReadRequest) GetPodAdminPort() uint32 {
 	if x != nil {
-		return x.Listeners
+		return x.PodAdminPort
 	}
-	return false
+	return 0
 }
 
-func (x *ReadOperation_Include) GetRuntime() bool {
+func (x *BatchReadRequest) GetInclude() *ReadOperation_Include {
 	if x != nil {
-		return x.Runtime
+		return x.Include
 	}
-	return false
+	return nil
 }
 
-func (x *ReadOperation_Include) GetStats() bool {
+func (x *BatchReadRequest) GetMaxConcurrency() uint32 {
 	if x != nil {
-		return x.Stats
+		return x.MaxConcurrency
 	}
-	return false
+	return 0
 }
 
-func (x *ReadOperation_Include) GetServerInfo() bool {
+func (x *BatchReadRequest) GetPerHostTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.ServerInfo
+		return x.PerHostTimeout
 	}
-	return false
+	return nil
 }
 
-type Result_Output struct {
+func (x *BatchReadRequest) GetCertExpiryWindow() *durationpb.Duration {
+	if x != nil {
+		return x.CertExpiryWindow
+	}
+	return nil
+}
+
+type BatchReadResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Clusters   *Clusters   `protobuf:"bytes,1,opt,name=clusters,proto3" json:"clusters,omitempty"`
-	ConfigDump *ConfigDump `protobuf:"bytes,2,opt,name=config_dump,json=configDump,proto3" json:"config_dump,omitempty"`
-	Listeners  *Listeners  `protobuf:"bytes,3,opt,name=listeners,proto3" json:"listeners,omitempty"`
-	Runtime    *Runtime    `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
-	Stats      *Stats      `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
-	ServerInfo *ServerInfo `protobuf:"bytes,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	// Results of the hosts that were read successfully.
+	Results []*Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Hosts that could not be resolved or read.
+	Failures  []*BatchReadResponse_Failure `protobuf:"bytes,2,rep,name=failures,proto3" json:"failures,omitempty"`
+	Aggregate *Aggregate                   `protobuf:"bytes,3,opt,name=aggregate,proto3" json:"aggregate,omitempty"`
 }
 
-func (x *Result_Output) Reset() {
-	*x = Result_Output{}
+func (x *BatchReadResponse) Reset() {
+	*x = BatchReadResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -470,13 +669,13 @@ func (x *Result_Output) Reset() {
 	}
 }
 
-func (x *Result_Output) String() string {
+func (x *BatchReadResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Result_Output) ProtoMessage() {}
+func (*BatchReadResponse) ProtoMessage() {}
 
-func (x *Result_Output) ProtoReflect() protoreflect.Message {
+func (x *BatchReadResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -488,253 +687,3241 @@ func (x *Result_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Result_Output.ProtoReflect.Descriptor instead.
-func (*Result_Output) Descriptor() ([]byte, []int) {
-	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{4, 0}
+// Deprecated: Use BatchReadResponse.ProtoReflect.Descriptor instead.
+func (*BatchReadResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Result_Output) GetClusters() *Clusters {
+func (x *BatchReadResponse) GetResults() []*Result {
 	if x != nil {
-		return x.Clusters
+		return x.Results
 	}
 	return nil
 }
 
-func (x *Result_Output) GetConfigDump() *ConfigDump {
+func (x *BatchReadResponse) GetFailures() []*BatchReadResponse_Failure {
 	if x != nil {
-		return x.ConfigDump
+		return x.Failures
 	}
 	return nil
 }
 
-func (x *Result_Output) GetListeners() *Listeners {
+func (x *BatchReadResponse) GetAggregate() *Aggregate {
 	if x != nil {
-		return x.Listeners
+		return x.Aggregate
 	}
 	return nil
 }
 
-func (x *Result_Output) GetRuntime() *Runtime {
+// Outputs aggregated across the results of a batch read. Each part is only populated if the corresponding output
+// was included in the read.
+type Aggregate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Requires clusters.
+	UpstreamHealth []*Aggregate_UpstreamHealth `protobuf:"bytes,1,rep,name=upstream_health,json=upstreamHealth,proto3" json:"upstream_health,omitempty"`
+	// Outlier detection stats, e.g. cluster.my-upstream.outlier_detection.ejections_active, summed across hosts.
+	// Requires stats.
+	OutlierStats []*Stats_Stat `protobuf:"bytes,2,rep,name=outlier_stats,json=outlierStats,proto3" json:"outlier_stats,omitempty"`
+	// Requires config_dump.
+	ConfigDrift *Aggregate_ConfigDrift `protobuf:"bytes,3,opt,name=config_drift,json=configDrift,proto3" json:"config_drift,omitempty"`
+	// Certificates that expire within the expiry window, soonest first. Requires certs.
+	ExpiringCertificates []*Aggregate_ExpiringCertificate `protobuf:"bytes,4,rep,name=expiring_certificates,json=expiringCertificates,proto3" json:"expiring_certificates,omitempty"`
+}
+
+func (x *Aggregate) Reset() {
+	*x = Aggregate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aggregate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregate) ProtoMessage() {}
+
+func (x *Aggregate) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregate.ProtoReflect.Descriptor instead.
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Aggregate) GetUpstreamHealth() []*Aggregate_UpstreamHealth {
 	if x != nil {
-		return x.Runtime
+		return x.UpstreamHealth
 	}
 	return nil
 }
 
-func (x *Result_Output) GetStats() *Stats {
+func (x *Aggregate) GetOutlierStats() []*Stats_Stat {
 	if x != nil {
-		return x.Stats
+		return x.OutlierStats
 	}
 	return nil
 }
 
-func (x *Result_Output) GetServerInfo() *ServerInfo {
+func (x *Aggregate) GetConfigDrift() *Aggregate_ConfigDrift {
 	if x != nil {
-		return x.ServerInfo
+		return x.ConfigDrift
 	}
 	return nil
 }
 
-var File_envoytriage_v1_envoytriage_api_proto protoreflect.FileDescriptor
+func (x *Aggregate) GetExpiringCertificates() []*Aggregate_ExpiringCertificate {
+	if x != nil {
+		return x.ExpiringCertificates
+	}
+	return nil
+}
 
-var file_envoytriage_v1_envoytriage_api_proto_rawDesc = []byte{
-	0x0a, 0x24, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31,
-	0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
-	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x61,
-	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
-	0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x0b, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0a, 0x6f, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24,
-	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69,
-	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x22, 0xd3, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
-	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
-	0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x1a, 0xb5,
-	0x01, 0x0a, 0x07, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74,
-	0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x47, 0x0a, 0x0c, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
-	0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
-	0x45, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x68, 0x6f,
-	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x70,
-	0x01, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03,
-	0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xc8, 0x04, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x12, 0x38, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
-	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x6e,
-	0x6f, 0x64, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
-	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x3c, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
-	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74,
-	0x70, 0x75, 0x74, 0x1a, 0xfb, 0x02, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3b,
-	0x0a, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74,
-	0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
-	0x73, 0x52, 0x08, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74,
-	0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44,
-	0x75, 0x6d, 0x70, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x12,
-	0x3e, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
-	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65,
-	0x6e, 0x65, 0x72, 0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12,
-	0x38, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74,
-	0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65,
-	0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
-	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x42, 0x0a,
-	0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
-	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x21,
-	0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5a, 0x6f, 0x6e,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x32, 0x88, 0x01, 0x0a, 0x0e,
-	0x45, 0x6e, 0x76, 0x6f, 0x79, 0x54, 0x72, 0x69, 0x61, 0x67, 0x65, 0x41, 0x50, 0x49, 0x12, 0x76,
-	0x0a, 0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
-	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
-	0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6c, 0x75,
-	0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x25, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a,
-	0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
-	0x65, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x42, 0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
-	0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x76,
-	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x76, 0x6f,
-	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+// Stats of a host sampled over an interval.
+type StatsSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	End   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Ordered by rate, highest first.
+	Counters []*StatsSample_Counter `protobuf:"bytes,3,rep,name=counters,proto3" json:"counters,omitempty"`
+	// Ordered by value, highest first.
+	Gauges []*StatsSample_Gauge `protobuf:"bytes,4,rep,name=gauges,proto3" json:"gauges,omitempty"`
+	// Histograms at the end of the sample, ordered by name.
+	Histograms []*StatsSample_Histogram `protobuf:"bytes,5,rep,name=histograms,proto3" json:"histograms,omitempty"`
 }
 
-var (
-	file_envoytriage_v1_envoytriage_api_proto_rawDescOnce sync.Once
-	file_envoytriage_v1_envoytriage_api_proto_rawDescData = file_envoytriage_v1_envoytriage_api_proto_rawDesc
-)
+func (x *StatsSample) Reset() {
+	*x = StatsSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP() []byte {
-	file_envoytriage_v1_envoytriage_api_proto_rawDescOnce.Do(func() {
-		file_envoytriage_v1_envoytriage_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_envoytriage_v1_envoytriage_api_proto_rawDescData)
-	})
-	return file_envoytriage_v1_envoytriage_api_proto_rawDescData
+func (x *StatsSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_envoytriage_v1_envoytriage_api_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
-var file_envoytriage_v1_envoytriage_api_proto_goTypes = []interface{}{
-	(*ReadRequest)(nil),           // 0: clutch.envoytriage.v1.ReadRequest
-	(*ReadOperation)(nil),         // 1: clutch.envoytriage.v1.ReadOperation
-	(*ReadResponse)(nil),          // 2: clutch.envoytriage.v1.ReadResponse
-	(*Address)(nil),               // 3: clutch.envoytriage.v1.Address
-	(*Result)(nil),                // 4: clutch.envoytriage.v1.Result
-	(*NodeMetadata)(nil),          // 5: clutch.envoytriage.v1.NodeMetadata
-	(*ReadOperation_Include)(nil), // 6: clutch.envoytriage.v1.ReadOperation.Include
-	(*Result_Output)(nil),         // 7: clutch.envoytriage.v1.Result.Output
-	(*Clusters)(nil),              // 8: clutch.envoytriage.v1.Clusters
-	(*ConfigDump)(nil),            // 9: clutch.envoytriage.v1.ConfigDump
-	(*Listeners)(nil),             // 10: clutch.envoytriage.v1.Listeners
-	(*Runtime)(nil),               // 11: clutch.envoytriage.v1.Runtime
-	(*Stats)(nil),                 // 12: clutch.envoytriage.v1.Stats
-	(*ServerInfo)(nil),            // 13: clutch.envoytriage.v1.ServerInfo
+func (*StatsSample) ProtoMessage() {}
+
+func (x *StatsSample) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_envoytriage_v1_envoytriage_api_proto_depIdxs = []int32{
-	1,  // 0: clutch.envoytriage.v1.ReadRequest.operations:type_name -> clutch.envoytriage.v1.ReadOperation
-	3,  // 1: clutch.envoytriage.v1.ReadOperation.address:type_name -> clutch.envoytriage.v1.Address
-	6,  // 2: clutch.envoytriage.v1.ReadOperation.include:type_name -> clutch.envoytriage.v1.ReadOperation.Include
-	4,  // 3: clutch.envoytriage.v1.ReadResponse.results:type_name -> clutch.envoytriage.v1.Result
-	3,  // 4: clutch.envoytriage.v1.Result.address:type_name -> clutch.envoytriage.v1.Address
-	5,  // 5: clutch.envoytriage.v1.Result.node_metadata:type_name -> clutch.envoytriage.v1.NodeMetadata
-	7,  // 6: clutch.envoytriage.v1.Result.output:type_name -> clutch.envoytriage.v1.Result.Output
-	8,  // 7: clutch.envoytriage.v1.Result.Output.clusters:type_name -> clutch.envoytriage.v1.Clusters
-	9,  // 8: clutch.envoytriage.v1.Result.Output.config_dump:type_name -> clutch.envoytriage.v1.ConfigDump
-	10, // 9: clutch.envoytriage.v1.Result.Output.listeners:type_name -> clutch.envoytriage.v1.Listeners
-	11, // 10: clutch.envoytriage.v1.Result.Output.runtime:type_name -> clutch.envoytriage.v1.Runtime
-	12, // 11: clutch.envoytriage.v1.Result.Output.stats:type_name -> clutch.envoytriage.v1.Stats
-	13, // 12: clutch.envoytriage.v1.Result.Output.server_info:type_name -> clutch.envoytriage.v1.ServerInfo
-	0,  // 13: clutch.envoytriage.v1.EnvoyTriageAPI.Read:input_type -> clutch.envoytriage.v1.ReadRequest
-	2,  // 14: clutch.envoytriage.v1.EnvoyTriageAPI.Read:output_type -> clutch.envoytriage.v1.ReadResponse
-	14, // [14:15] is the sub-list for method output_type
-	13, // [13:14] is the sub-list for method input_type
-	13, // [13:13] is the sub-list for extension type_name
-	13, // [13:13] is the sub-list for extension extendee
-	0,  // [0:13] is the sub-list for field type_name
+
+// Deprecated: Use StatsSample.ProtoReflect.Descriptor instead.
+func (*StatsSample) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{9}
 }
 
-func init() { file_envoytriage_v1_envoytriage_api_proto_init() }
-func file_envoytriage_v1_envoytriage_api_proto_init() {
-	if File_envoytriage_v1_envoytriage_api_proto != nil {
-		return
+func (x *StatsSample) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
 	}
-	file_envoytriage_v1_output_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_envoytriage_v1_envoytriage_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_envoytriage_v1_envoytriage_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadOperation); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_envoytriage_v1_envoytriage_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadResponse); i {
-			case 0:
-				return &v.state
-			case 1:
+	return nil
+}
+
+func (x *StatsSample) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *StatsSample) GetCounters() []*StatsSample_Counter {
+	if x != nil {
+		return x.Counters
+	}
+	return nil
+}
+
+func (x *StatsSample) GetGauges() []*StatsSample_Gauge {
+	if x != nil {
+		return x.Gauges
+	}
+	return nil
+}
+
+func (x *StatsSample) GetHistograms() []*StatsSample_Histogram {
+	if x != nil {
+		return x.Histograms
+	}
+	return nil
+}
+
+// A config dump of a host stored at a point in time.
+type ConfigSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address   *Address               `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *ConfigSnapshot) Reset() {
+	*x = ConfigSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSnapshot) ProtoMessage() {}
+
+func (x *ConfigSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSnapshot.ProtoReflect.Descriptor instead.
+func (*ConfigSnapshot) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ConfigSnapshot) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ConfigSnapshot) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ConfigSnapshot) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Where to read a config dump from, either live from a host or from a stored snapshot.
+type ConfigSource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Source:
+	//	*ConfigSource_Address
+	//	*ConfigSource_SnapshotId
+	Source isConfigSource_Source `protobuf_oneof:"source"`
+}
+
+func (x *ConfigSource) Reset() {
+	*x = ConfigSource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigSource) ProtoMessage() {}
+
+func (x *ConfigSource) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigSource.ProtoReflect.Descriptor instead.
+func (*ConfigSource) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *ConfigSource) GetSource() isConfigSource_Source {
+	if m != nil {
+		return m.Source
+	}
+	return nil
+}
+
+func (x *ConfigSource) GetAddress() *Address {
+	if x, ok := x.GetSource().(*ConfigSource_Address); ok {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ConfigSource) GetSnapshotId() int64 {
+	if x, ok := x.GetSource().(*ConfigSource_SnapshotId); ok {
+		return x.SnapshotId
+	}
+	return 0
+}
+
+type isConfigSource_Source interface {
+	isConfigSource_Source()
+}
+
+type ConfigSource_Address struct {
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3,oneof"`
+}
+
+type ConfigSource_SnapshotId struct {
+	SnapshotId int64 `protobuf:"varint,2,opt,name=snapshot_id,json=snapshotId,proto3,oneof"`
+}
+
+func (*ConfigSource_Address) isConfigSource_Source() {}
+
+func (*ConfigSource_SnapshotId) isConfigSource_Source() {}
+
+type ConfigDiff struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ResourceType ConfigDiff_ResourceType `protobuf:"varint,1,opt,name=resource_type,json=resourceType,proto3,enum=clutch.envoytriage.v1.ConfigDiff_ResourceType" json:"resource_type,omitempty"`
+	Name         string                  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Relative to the base config, e.g. ADDED resources are only present in the target config.
+	Change ConfigDiff_Change `protobuf:"varint,3,opt,name=change,proto3,enum=clutch.envoytriage.v1.ConfigDiff_Change" json:"change,omitempty"`
+	// The fields that differ in a MODIFIED resource. The contents of secrets are never returned, a digest of the secret
+	// is compared instead.
+	Fields []*ConfigDiff_Field `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	// Set if the number of differing fields exceeded the limit and only the first fields are returned.
+	FieldsTruncated bool `protobuf:"varint,5,opt,name=fields_truncated,json=fieldsTruncated,proto3" json:"fields_truncated,omitempty"`
+}
+
+func (x *ConfigDiff) Reset() {
+	*x = ConfigDiff{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDiff) ProtoMessage() {}
+
+func (x *ConfigDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDiff.ProtoReflect.Descriptor instead.
+func (*ConfigDiff) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ConfigDiff) GetResourceType() ConfigDiff_ResourceType {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ConfigDiff_UNSPECIFIED
+}
+
+func (x *ConfigDiff) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigDiff) GetChange() ConfigDiff_Change {
+	if x != nil {
+		return x.Change
+	}
+	return ConfigDiff_CHANGE_UNSPECIFIED
+}
+
+func (x *ConfigDiff) GetFields() []*ConfigDiff_Field {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *ConfigDiff) GetFieldsTruncated() bool {
+	if x != nil {
+		return x.FieldsTruncated
+	}
+	return false
+}
+
+// Compares the config dumps of two sources by resource. Version info and update times are ignored.
+type CompareConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Base   *ConfigSource `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Target *ConfigSource `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *CompareConfigRequest) Reset() {
+	*x = CompareConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareConfigRequest) ProtoMessage() {}
+
+func (x *CompareConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareConfigRequest.ProtoReflect.Descriptor instead.
+func (*CompareConfigRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CompareConfigRequest) GetBase() *ConfigSource {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *CompareConfigRequest) GetTarget() *ConfigSource {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+type CompareConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Differing resources ordered by type and name. Empty if the configs are equivalent.
+	Diffs []*ConfigDiff `protobuf:"bytes,1,rep,name=diffs,proto3" json:"diffs,omitempty"`
+}
+
+func (x *CompareConfigResponse) Reset() {
+	*x = CompareConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CompareConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompareConfigResponse) ProtoMessage() {}
+
+func (x *CompareConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompareConfigResponse.ProtoReflect.Descriptor instead.
+func (*CompareConfigResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CompareConfigResponse) GetDiffs() []*ConfigDiff {
+	if x != nil {
+		return x.Diffs
+	}
+	return nil
+}
+
+type CreateConfigSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *CreateConfigSnapshotRequest) Reset() {
+	*x = CreateConfigSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateConfigSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConfigSnapshotRequest) ProtoMessage() {}
+
+func (x *CreateConfigSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConfigSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*CreateConfigSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateConfigSnapshotRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type CreateConfigSnapshotResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Snapshot *ConfigSnapshot `protobuf:"bytes,1,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+}
+
+func (x *CreateConfigSnapshotResponse) Reset() {
+	*x = CreateConfigSnapshotResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateConfigSnapshotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateConfigSnapshotResponse) ProtoMessage() {}
+
+func (x *CreateConfigSnapshotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateConfigSnapshotResponse.ProtoReflect.Descriptor instead.
+func (*CreateConfigSnapshotResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateConfigSnapshotResponse) GetSnapshot() *ConfigSnapshot {
+	if x != nil {
+		return x.Snapshot
+	}
+	return nil
+}
+
+type ListConfigSnapshotsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *ListConfigSnapshotsRequest) Reset() {
+	*x = ListConfigSnapshotsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigSnapshotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigSnapshotsRequest) ProtoMessage() {}
+
+func (x *ListConfigSnapshotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigSnapshotsRequest.ProtoReflect.Descriptor instead.
+func (*ListConfigSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListConfigSnapshotsRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type ListConfigSnapshotsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The most recent snapshots of the address, newest first.
+	Snapshots []*ConfigSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+}
+
+func (x *ListConfigSnapshotsResponse) Reset() {
+	*x = ListConfigSnapshotsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListConfigSnapshotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListConfigSnapshotsResponse) ProtoMessage() {}
+
+func (x *ListConfigSnapshotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListConfigSnapshotsResponse.ProtoReflect.Descriptor instead.
+func (*ListConfigSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListConfigSnapshotsResponse) GetSnapshots() []*ConfigSnapshot {
+	if x != nil {
+		return x.Snapshots
+	}
+	return nil
+}
+
+// Takes snapshots of the stats of a host over an interval to compute counter rates. Requires Envoy 1.25 or later for
+// stats to be filtered by type. The time between the first and the last snapshot, (samples - 1) * interval, is at most
+// one minute and must end a few seconds before the request times out, requests sampling for longer are rejected.
+type SampleStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The time between consecutive snapshots.
+	Interval *durationpb.Duration `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The number of snapshots to take, defaults to two. Rates are computed from the first to the last snapshot.
+	Samples uint32 `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
+	// A regular expression (RE2 syntax) that stat names must match.
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Limit the counters to the N with the highest rates and the gauges to the N with the highest values. All matching
+	// stats are returned if unset.
+	TopN uint32 `protobuf:"varint,5,opt,name=top_n,json=topN,proto3" json:"top_n,omitempty"`
+}
+
+func (x *SampleStatsRequest) Reset() {
+	*x = SampleStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SampleStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleStatsRequest) ProtoMessage() {}
+
+func (x *SampleStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleStatsRequest.ProtoReflect.Descriptor instead.
+func (*SampleStatsRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *SampleStatsRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *SampleStatsRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *SampleStatsRequest) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *SampleStatsRequest) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *SampleStatsRequest) GetTopN() uint32 {
+	if x != nil {
+		return x.TopN
+	}
+	return 0
+}
+
+type SampleStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sample *StatsSample `protobuf:"bytes,1,opt,name=sample,proto3" json:"sample,omitempty"`
+}
+
+func (x *SampleStatsResponse) Reset() {
+	*x = SampleStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SampleStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SampleStatsResponse) ProtoMessage() {}
+
+func (x *SampleStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SampleStatsResponse.ProtoReflect.Descriptor instead.
+func (*SampleStatsResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SampleStatsResponse) GetSample() *StatsSample {
+	if x != nil {
+		return x.Sample
+	}
+	return nil
+}
+
+type ModifyRuntimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Runtime overrides to apply in the admin layer, keyed by runtime key. An empty value removes the override.
+	Overrides map[string]string `protobuf:"bytes,2,rep,name=overrides,proto3" json:"overrides,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *ModifyRuntimeRequest) Reset() {
+	*x = ModifyRuntimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyRuntimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyRuntimeRequest) ProtoMessage() {}
+
+func (x *ModifyRuntimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyRuntimeRequest.ProtoReflect.Descriptor instead.
+func (*ModifyRuntimeRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ModifyRuntimeRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *ModifyRuntimeRequest) GetOverrides() map[string]string {
+	if x != nil {
+		return x.Overrides
+	}
+	return nil
+}
+
+type ModifyRuntimeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ModifyRuntimeResponse) Reset() {
+	*x = ModifyRuntimeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModifyRuntimeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModifyRuntimeResponse) ProtoMessage() {}
+
+func (x *ModifyRuntimeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModifyRuntimeResponse.ProtoReflect.Descriptor instead.
+func (*ModifyRuntimeResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{22}
+}
+
+type Logger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Level string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *Logger) Reset() {
+	*x = Logger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Logger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Logger) ProtoMessage() {}
+
+func (x *Logger) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Logger.ProtoReflect.Descriptor instead.
+func (*Logger) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Logger) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Logger) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The logger to change the level of, e.g. http or upstream. All loggers are changed if empty.
+	Logger string                   `protobuf:"bytes,2,opt,name=logger,proto3" json:"logger,omitempty"`
+	Level  SetLogLevelRequest_Level `protobuf:"varint,3,opt,name=level,proto3,enum=clutch.envoytriage.v1.SetLogLevelRequest_Level" json:"level,omitempty"`
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetLogLevelRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *SetLogLevelRequest) GetLogger() string {
+	if x != nil {
+		return x.Logger
+	}
+	return ""
+}
+
+func (x *SetLogLevelRequest) GetLevel() SetLogLevelRequest_Level {
+	if x != nil {
+		return x.Level
+	}
+	return SetLogLevelRequest_UNSPECIFIED
+}
+
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The levels of all loggers after the change.
+	Loggers []*Logger `protobuf:"bytes,1,rep,name=loggers,proto3" json:"loggers,omitempty"`
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetLogLevelResponse) GetLoggers() []*Logger {
+	if x != nil {
+		return x.Loggers
+	}
+	return nil
+}
+
+// Fails the health check of the server, e.g. to remove it from load balancer rotation ahead of maintenance.
+type FailHealthcheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *FailHealthcheckRequest) Reset() {
+	*x = FailHealthcheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailHealthcheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailHealthcheckRequest) ProtoMessage() {}
+
+func (x *FailHealthcheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailHealthcheckRequest.ProtoReflect.Descriptor instead.
+func (*FailHealthcheckRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FailHealthcheckRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type FailHealthcheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *FailHealthcheckResponse) Reset() {
+	*x = FailHealthcheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailHealthcheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailHealthcheckResponse) ProtoMessage() {}
+
+func (x *FailHealthcheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailHealthcheckResponse.ProtoReflect.Descriptor instead.
+func (*FailHealthcheckResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{27}
+}
+
+// Reverts a previous FailHealthcheck.
+type PassHealthcheckRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *PassHealthcheckRequest) Reset() {
+	*x = PassHealthcheckRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PassHealthcheckRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassHealthcheckRequest) ProtoMessage() {}
+
+func (x *PassHealthcheckRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassHealthcheckRequest.ProtoReflect.Descriptor instead.
+func (*PassHealthcheckRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PassHealthcheckRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type PassHealthcheckResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PassHealthcheckResponse) Reset() {
+	*x = PassHealthcheckResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PassHealthcheckResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PassHealthcheckResponse) ProtoMessage() {}
+
+func (x *PassHealthcheckResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PassHealthcheckResponse.ProtoReflect.Descriptor instead.
+func (*PassHealthcheckResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{29}
+}
+
+type DrainListenersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Only drain inbound listeners.
+	InboundOnly bool `protobuf:"varint,2,opt,name=inbound_only,json=inboundOnly,proto3" json:"inbound_only,omitempty"`
+	// Stop accepting connections gradually over the drain period of the server instead of closing listeners immediately.
+	Graceful bool `protobuf:"varint,3,opt,name=graceful,proto3" json:"graceful,omitempty"`
+}
+
+func (x *DrainListenersRequest) Reset() {
+	*x = DrainListenersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainListenersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainListenersRequest) ProtoMessage() {}
+
+func (x *DrainListenersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainListenersRequest.ProtoReflect.Descriptor instead.
+func (*DrainListenersRequest) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DrainListenersRequest) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *DrainListenersRequest) GetInboundOnly() bool {
+	if x != nil {
+		return x.InboundOnly
+	}
+	return false
+}
+
+func (x *DrainListenersRequest) GetGraceful() bool {
+	if x != nil {
+		return x.Graceful
+	}
+	return false
+}
+
+type DrainListenersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DrainListenersResponse) Reset() {
+	*x = DrainListenersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DrainListenersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DrainListenersResponse) ProtoMessage() {}
+
+func (x *DrainListenersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DrainListenersResponse.ProtoReflect.Descriptor instead.
+func (*DrainListenersResponse) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{31}
+}
+
+type ReadOperation_Include struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clusters   bool `protobuf:"varint,1,opt,name=clusters,proto3" json:"clusters,omitempty"`
+	ConfigDump bool `protobuf:"varint,2,opt,name=config_dump,json=configDump,proto3" json:"config_dump,omitempty"`
+	Listeners  bool `protobuf:"varint,3,opt,name=listeners,proto3" json:"listeners,omitempty"`
+	Runtime    bool `protobuf:"varint,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Stats      bool `protobuf:"varint,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	ServerInfo bool `protobuf:"varint,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	Certs      bool `protobuf:"varint,7,opt,name=certs,proto3" json:"certs,omitempty"`
+}
+
+func (x *ReadOperation_Include) Reset() {
+	*x = ReadOperation_Include{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadOperation_Include) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadOperation_Include) ProtoMessage() {}
+
+func (x *ReadOperation_Include) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadOperation_Include.ProtoReflect.Descriptor instead.
+func (*ReadOperation_Include) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *ReadOperation_Include) GetClusters() bool {
+	if x != nil {
+		return x.Clusters
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetConfigDump() bool {
+	if x != nil {
+		return x.ConfigDump
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetListeners() bool {
+	if x != nil {
+		return x.Listeners
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetRuntime() bool {
+	if x != nil {
+		return x.Runtime
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetStats() bool {
+	if x != nil {
+		return x.Stats
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetServerInfo() bool {
+	if x != nil {
+		return x.ServerInfo
+	}
+	return false
+}
+
+func (x *ReadOperation_Include) GetCerts() bool {
+	if x != nil {
+		return x.Certs
+	}
+	return false
+}
+
+// A Kubernetes pod whose admin port is reached through the proxy subresource of the API server, for pods on networks
+// that are not reachable from Clutch. Requires the k8s service.
+type Address_Pod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clientset string `protobuf:"bytes,1,opt,name=clientset,proto3" json:"clientset,omitempty"`
+	Cluster   string `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Name      string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *Address_Pod) Reset() {
+	*x = Address_Pod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Address_Pod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address_Pod) ProtoMessage() {}
+
+func (x *Address_Pod) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address_Pod.ProtoReflect.Descriptor instead.
+func (*Address_Pod) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *Address_Pod) GetClientset() string {
+	if x != nil {
+		return x.Clientset
+	}
+	return ""
+}
+
+func (x *Address_Pod) GetCluster() string {
+	if x != nil {
+		return x.Cluster
+	}
+	return ""
+}
+
+func (x *Address_Pod) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *Address_Pod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type Result_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Clusters     *Clusters     `protobuf:"bytes,1,opt,name=clusters,proto3" json:"clusters,omitempty"`
+	ConfigDump   *ConfigDump   `protobuf:"bytes,2,opt,name=config_dump,json=configDump,proto3" json:"config_dump,omitempty"`
+	Listeners    *Listeners    `protobuf:"bytes,3,opt,name=listeners,proto3" json:"listeners,omitempty"`
+	Runtime      *Runtime      `protobuf:"bytes,4,opt,name=runtime,proto3" json:"runtime,omitempty"`
+	Stats        *Stats        `protobuf:"bytes,5,opt,name=stats,proto3" json:"stats,omitempty"`
+	ServerInfo   *ServerInfo   `protobuf:"bytes,6,opt,name=server_info,json=serverInfo,proto3" json:"server_info,omitempty"`
+	Certificates *Certificates `protobuf:"bytes,7,opt,name=certificates,proto3" json:"certificates,omitempty"`
+}
+
+func (x *Result_Output) Reset() {
+	*x = Result_Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Result_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result_Output) ProtoMessage() {}
+
+func (x *Result_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result_Output.ProtoReflect.Descriptor instead.
+func (*Result_Output) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{4, 0}
+}
+
+func (x *Result_Output) GetClusters() *Clusters {
+	if x != nil {
+		return x.Clusters
+	}
+	return nil
+}
+
+func (x *Result_Output) GetConfigDump() *ConfigDump {
+	if x != nil {
+		return x.ConfigDump
+	}
+	return nil
+}
+
+func (x *Result_Output) GetListeners() *Listeners {
+	if x != nil {
+		return x.Listeners
+	}
+	return nil
+}
+
+func (x *Result_Output) GetRuntime() *Runtime {
+	if x != nil {
+		return x.Runtime
+	}
+	return nil
+}
+
+func (x *Result_Output) GetStats() *Stats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *Result_Output) GetServerInfo() *ServerInfo {
+	if x != nil {
+		return x.ServerInfo
+	}
+	return nil
+}
+
+func (x *Result_Output) GetCertificates() *Certificates {
+	if x != nil {
+		return x.Certificates
+	}
+	return nil
+}
+
+type BatchReadResponse_Failure struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The pod the address was resolved from, if any.
+	Pod   string `protobuf:"bytes,2,opt,name=pod,proto3" json:"pod,omitempty"`
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *BatchReadResponse_Failure) Reset() {
+	*x = BatchReadResponse_Failure{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BatchReadResponse_Failure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchReadResponse_Failure) ProtoMessage() {}
+
+func (x *BatchReadResponse_Failure) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchReadResponse_Failure.ProtoReflect.Descriptor instead.
+func (*BatchReadResponse_Failure) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{7, 0}
+}
+
+func (x *BatchReadResponse_Failure) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *BatchReadResponse_Failure) GetPod() string {
+	if x != nil {
+		return x.Pod
+	}
+	return ""
+}
+
+func (x *BatchReadResponse_Failure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type Aggregate_UpstreamHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the upstream cluster.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of hosts that reported the cluster.
+	ReportingHosts uint32 `protobuf:"varint,2,opt,name=reporting_hosts,json=reportingHosts,proto3" json:"reporting_hosts,omitempty"`
+	// Upstream host counts summed across reporting hosts.
+	Healthy   uint32 `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Unhealthy uint32 `protobuf:"varint,4,opt,name=unhealthy,proto3" json:"unhealthy,omitempty"`
+}
+
+func (x *Aggregate_UpstreamHealth) Reset() {
+	*x = Aggregate_UpstreamHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aggregate_UpstreamHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregate_UpstreamHealth) ProtoMessage() {}
+
+func (x *Aggregate_UpstreamHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregate_UpstreamHealth.ProtoReflect.Descriptor instead.
+func (*Aggregate_UpstreamHealth) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8, 0}
+}
+
+func (x *Aggregate_UpstreamHealth) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Aggregate_UpstreamHealth) GetReportingHosts() uint32 {
+	if x != nil {
+		return x.ReportingHosts
+	}
+	return 0
+}
+
+func (x *Aggregate_UpstreamHealth) GetHealthy() uint32 {
+	if x != nil {
+		return x.Healthy
+	}
+	return 0
+}
+
+func (x *Aggregate_UpstreamHealth) GetUnhealthy() uint32 {
+	if x != nil {
+		return x.Unhealthy
+	}
+	return 0
+}
+
+type Aggregate_ConfigDrift struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The config dump hash shared by most hosts. Version info and update times are ignored when hashing.
+	MajorityHash  string `protobuf:"bytes,1,opt,name=majority_hash,json=majorityHash,proto3" json:"majority_hash,omitempty"`
+	MajorityHosts uint32 `protobuf:"varint,2,opt,name=majority_hosts,json=majorityHosts,proto3" json:"majority_hosts,omitempty"`
+	// Hosts whose config dump hash differs from the majority.
+	DifferingHosts []*Aggregate_ConfigDrift_Host `protobuf:"bytes,3,rep,name=differing_hosts,json=differingHosts,proto3" json:"differing_hosts,omitempty"`
+}
+
+func (x *Aggregate_ConfigDrift) Reset() {
+	*x = Aggregate_ConfigDrift{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aggregate_ConfigDrift) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregate_ConfigDrift) ProtoMessage() {}
+
+func (x *Aggregate_ConfigDrift) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregate_ConfigDrift.ProtoReflect.Descriptor instead.
+func (*Aggregate_ConfigDrift) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8, 1}
+}
+
+func (x *Aggregate_ConfigDrift) GetMajorityHash() string {
+	if x != nil {
+		return x.MajorityHash
+	}
+	return ""
+}
+
+func (x *Aggregate_ConfigDrift) GetMajorityHosts() uint32 {
+	if x != nil {
+		return x.MajorityHosts
+	}
+	return 0
+}
+
+func (x *Aggregate_ConfigDrift) GetDifferingHosts() []*Aggregate_ConfigDrift_Host {
+	if x != nil {
+		return x.DifferingHosts
+	}
+	return nil
+}
+
+type Aggregate_ExpiringCertificate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     *Address     `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Certificate *Certificate `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *Aggregate_ExpiringCertificate) Reset() {
+	*x = Aggregate_ExpiringCertificate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aggregate_ExpiringCertificate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregate_ExpiringCertificate) ProtoMessage() {}
+
+func (x *Aggregate_ExpiringCertificate) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregate_ExpiringCertificate.ProtoReflect.Descriptor instead.
+func (*Aggregate_ExpiringCertificate) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8, 2}
+}
+
+func (x *Aggregate_ExpiringCertificate) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Aggregate_ExpiringCertificate) GetCertificate() *Certificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type Aggregate_ConfigDrift_Host struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address *Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Hash    string   `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (x *Aggregate_ConfigDrift_Host) Reset() {
+	*x = Aggregate_ConfigDrift_Host{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aggregate_ConfigDrift_Host) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aggregate_ConfigDrift_Host) ProtoMessage() {}
+
+func (x *Aggregate_ConfigDrift_Host) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aggregate_ConfigDrift_Host.ProtoReflect.Descriptor instead.
+func (*Aggregate_ConfigDrift_Host) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{8, 1, 0}
+}
+
+func (x *Aggregate_ConfigDrift_Host) GetAddress() *Address {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+func (x *Aggregate_ConfigDrift_Host) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type StatsSample_Counter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The value at the end of the sample.
+	Value uint64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The increase over the sample. Counters are reset by a hot restart of the server, in which case the value after
+	// the reset is counted as the increase.
+	Delta         uint64  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	RatePerSecond float64 `protobuf:"fixed64,4,opt,name=rate_per_second,json=ratePerSecond,proto3" json:"rate_per_second,omitempty"`
+}
+
+func (x *StatsSample_Counter) Reset() {
+	*x = StatsSample_Counter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsSample_Counter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsSample_Counter) ProtoMessage() {}
+
+func (x *StatsSample_Counter) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsSample_Counter.ProtoReflect.Descriptor instead.
+func (*StatsSample_Counter) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{9, 0}
+}
+
+func (x *StatsSample_Counter) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatsSample_Counter) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+func (x *StatsSample_Counter) GetDelta() uint64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StatsSample_Counter) GetRatePerSecond() float64 {
+	if x != nil {
+		return x.RatePerSecond
+	}
+	return 0
+}
+
+type StatsSample_Gauge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// The value at the end of the sample.
+	Value uint64 `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *StatsSample_Gauge) Reset() {
+	*x = StatsSample_Gauge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsSample_Gauge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsSample_Gauge) ProtoMessage() {}
+
+func (x *StatsSample_Gauge) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsSample_Gauge.ProtoReflect.Descriptor instead.
+func (*StatsSample_Gauge) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{9, 1}
+}
+
+func (x *StatsSample_Gauge) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *StatsSample_Gauge) GetValue() uint64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type StatsSample_Histogram struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string                            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Quantiles []*StatsSample_Histogram_Quantile `protobuf:"bytes,2,rep,name=quantiles,proto3" json:"quantiles,omitempty"`
+}
+
+func (x *StatsSample_Histogram) Reset() {
+	*x = StatsSample_Histogram{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsSample_Histogram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsSample_Histogram) ProtoMessage() {}
+
+func (x *StatsSample_Histogram) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsSample_Histogram.ProtoReflect.Descriptor instead.
+func (*StatsSample_Histogram) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{9, 2}
+}
+
+func (x *StatsSample_Histogram) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StatsSample_Histogram) GetQuantiles() []*StatsSample_Histogram_Quantile {
+	if x != nil {
+		return x.Quantiles
+	}
+	return nil
+}
+
+type StatsSample_Histogram_Quantile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// e.g. 99.9 for the 99.9th percentile.
+	Quantile float64 `protobuf:"fixed64,1,opt,name=quantile,proto3" json:"quantile,omitempty"`
+	// The value over the last stats flush interval of the server, unset if there were no samples in the interval.
+	Interval *wrapperspb.DoubleValue `protobuf:"bytes,2,opt,name=interval,proto3" json:"interval,omitempty"`
+	// The value since the server started, unset if there were no samples.
+	Cumulative *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=cumulative,proto3" json:"cumulative,omitempty"`
+}
+
+func (x *StatsSample_Histogram_Quantile) Reset() {
+	*x = StatsSample_Histogram_Quantile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StatsSample_Histogram_Quantile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StatsSample_Histogram_Quantile) ProtoMessage() {}
+
+func (x *StatsSample_Histogram_Quantile) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StatsSample_Histogram_Quantile.ProtoReflect.Descriptor instead.
+func (*StatsSample_Histogram_Quantile) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{9, 2, 0}
+}
+
+func (x *StatsSample_Histogram_Quantile) GetQuantile() float64 {
+	if x != nil {
+		return x.Quantile
+	}
+	return 0
+}
+
+func (x *StatsSample_Histogram_Quantile) GetInterval() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *StatsSample_Histogram_Quantile) GetCumulative() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Cumulative
+	}
+	return nil
+}
+
+type ConfigDiff_Field struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path of the field in the resource, e.g. filter_chains[0].filters[1].name.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Unset if the field is only present in the other config.
+	Base   *structpb.Value `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	Target *structpb.Value `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *ConfigDiff_Field) Reset() {
+	*x = ConfigDiff_Field{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigDiff_Field) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigDiff_Field) ProtoMessage() {}
+
+func (x *ConfigDiff_Field) ProtoReflect() protoreflect.Message {
+	mi := &file_envoytriage_v1_envoytriage_api_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigDiff_Field.ProtoReflect.Descriptor instead.
+func (*ConfigDiff_Field) Descriptor() ([]byte, []int) {
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP(), []int{12, 0}
+}
+
+func (x *ConfigDiff_Field) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ConfigDiff_Field) GetBase() *structpb.Value {
+	if x != nil {
+		return x.Base
+	}
+	return nil
+}
+
+func (x *ConfigDiff_Field) GetTarget() *structpb.Value {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
+var File_envoytriage_v1_envoytriage_api_proto protoreflect.FileDescriptor
+
+var file_envoytriage_v1_envoytriage_api_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x18, 0x61,
+	0x70, 0x69, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x53, 0x0a, 0x0b, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0a, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22,
+	0xe9, 0x02, 0x0a, 0x0d, 0x52, 0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x42, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x46, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x1a, 0xcb, 0x01,
+	0x0a, 0x07, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x5f,
+	0x64, 0x75, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x69,
+	0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x65, 0x72, 0x74, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x63, 0x65, 0x72, 0x74, 0x73, 0x22, 0x47, 0x0a, 0x0c, 0x52,
+	0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x73, 0x22, 0x8c, 0x03, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1e, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a,
+	0xfa, 0x42, 0x07, 0x72, 0x05, 0xd0, 0x01, 0x01, 0x70, 0x01, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74,
+	0x12, 0x1d, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x34, 0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x50, 0x6f, 0x64,
+	0x52, 0x03, 0x70, 0x6f, 0x64, 0x1a, 0x81, 0x01, 0x0a, 0x03, 0x50, 0x6f, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x20,
+	0x01, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x20, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x3a, 0x87, 0x01, 0xb2, 0xe1, 0x1c, 0x82,
+	0x01, 0x0a, 0x2e, 0x0a, 0x1d, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x0d, 0x7b, 0x68, 0x6f, 0x73, 0x74, 0x7d, 0x3a, 0x7b, 0x70, 0x6f, 0x72, 0x74,
+	0x7d, 0x0a, 0x50, 0x0a, 0x1d, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2f, 0x7b, 0x70, 0x6f, 0x64, 0x2e, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x7d, 0x2f, 0x7b, 0x70, 0x6f, 0x64, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x7d, 0x2f, 0x7b, 0x70, 0x6f, 0x64, 0x2e, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x3a, 0x7b, 0x70, 0x6f,
+	0x72, 0x74, 0x7d, 0x22, 0x91, 0x05, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x38,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0x12, 0x3c, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x1a, 0xc4, 0x03, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x3b, 0x0a, 0x08, 0x63,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x52, 0x08,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x5f, 0x64, 0x75, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70,
+	0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x75, 0x6d, 0x70, 0x12, 0x3e, 0x0a, 0x09,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x20, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x73, 0x52, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x38, 0x0a, 0x07,
+	0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x07, 0x72,
+	0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x0b, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0a, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x47,
+	0x0a, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x72,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x52, 0x0c, 0x63, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0c, 0x4e, 0x6f, 0x64, 0x65,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x5a, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x22, 0xd8, 0x03, 0x0a, 0x10, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x47, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x92, 0x01,
+	0x03, 0x10, 0xf4, 0x03, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x23, 0x0a, 0x04, 0x70, 0x6f, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0f, 0xfa,
+	0x42, 0x0c, 0x92, 0x01, 0x09, 0x10, 0xf4, 0x03, 0x22, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04,
+	0x70, 0x6f, 0x64, 0x73, 0x12, 0x2f, 0x0a, 0x0e, 0x70, 0x6f, 0x64, 0x5f, 0x61, 0x64, 0x6d, 0x69,
+	0x6e, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42,
+	0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03, 0x52, 0x0c, 0x70, 0x6f, 0x64, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x46, 0x0a, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x49, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x30, 0x0a,
+	0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x64, 0x52,
+	0x0e, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12,
+	0x51, 0x0a, 0x10, 0x70, 0x65, 0x72, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0xfa, 0x42, 0x09, 0xaa, 0x01, 0x06, 0x22, 0x02, 0x08, 0x3c,
+	0x32, 0x00, 0x52, 0x0e, 0x70, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x12, 0x58, 0x0a, 0x12, 0x63, 0x65, 0x72, 0x74, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0f, 0xfa, 0x42, 0x0c, 0xaa, 0x01,
+	0x09, 0x22, 0x05, 0x08, 0x80, 0xe7, 0x84, 0x0f, 0x32, 0x00, 0x52, 0x10, 0x63, 0x65, 0x72, 0x74,
+	0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xc7, 0x02, 0x0a,
+	0x11, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x4c, 0x0a, 0x08, 0x66,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52,
+	0x08, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x09, 0x61, 0x67, 0x67,
+	0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x52, 0x09,
+	0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x1a, 0x6b, 0x0a, 0x07, 0x46, 0x61, 0x69,
+	0x6c, 0x75, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x70, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x70, 0x6f, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x97, 0x07, 0x0a, 0x09, 0x41, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e,
+	0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x0e,
+	0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x46,
+	0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0c, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x5f, 0x64, 0x72, 0x69, 0x66, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x72, 0x69, 0x66, 0x74, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x69, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x65, 0x2e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e,
+	0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x14, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x73, 0x1a, 0x85, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x09, 0x75, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x1a, 0x8b, 0x02, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61,
+	0x6a, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x25, 0x0a, 0x0e, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x5f, 0x68, 0x6f, 0x73, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x6a, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x5a, 0x0a, 0x0f, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x72, 0x69, 0x66, 0x74, 0x2e, 0x48, 0x6f,
+	0x73, 0x74, 0x52, 0x0e, 0x64, 0x69, 0x66, 0x66, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x48, 0x6f, 0x73,
+	0x74, 0x73, 0x1a, 0x54, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x38, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x1a, 0x95, 0x01, 0x0a, 0x13, 0x45, 0x78, 0x70,
+	0x69, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x12, 0x38, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x63, 0x65,
+	0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x22, 0xff, 0x05, 0x0a, 0x0b, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64,
+	0x12, 0x46, 0x0a, 0x08, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x08,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x06, 0x67, 0x61, 0x75, 0x67,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x2e, 0x47, 0x61, 0x75,
+	0x67, 0x65, 0x52, 0x06, 0x67, 0x61, 0x75, 0x67, 0x65, 0x73, 0x12, 0x4c, 0x0a, 0x0a, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x0a, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x6f, 0x0a, 0x07, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6c, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x64, 0x65, 0x6c, 0x74,
+	0x61, 0x12, 0x26, 0x0a, 0x0f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x72, 0x61, 0x74, 0x65,
+	0x50, 0x65, 0x72, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x1a, 0x2f, 0x0a, 0x05, 0x47, 0x61, 0x75,
+	0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x95, 0x02, 0x0a, 0x09, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x53, 0x0a, 0x09,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x67, 0x72, 0x61, 0x6d, 0x2e, 0x51, 0x75,
+	0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x52, 0x09, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65,
+	0x73, 0x1a, 0x9e, 0x01, 0x0a, 0x08, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x6c, 0x65, 0x12, 0x38, 0x0a, 0x08, 0x69, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x6f, 0x75, 0x62, 0x6c,
+	0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0a, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x3a, 0x32, 0xb2, 0xe1, 0x1c, 0x2e,
+	0x0a, 0x2c, 0x0a, 0x24, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x04, 0x7b, 0x69, 0x64, 0x7d, 0x22, 0x7c,
+	0x0a, 0x0c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x3a,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48,
+	0x00, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0b, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x48,
+	0x00, 0x52, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49, 0x64, 0x42, 0x0d, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xb7, 0x04, 0x0a,
+	0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x66, 0x66, 0x12, 0x53, 0x0a, 0x0d, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x06,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x44, 0x69, 0x66, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x52,
+	0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x66, 0x69, 0x65, 0x6c, 0x64,
+	0x73, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x1a, 0x77, 0x0a, 0x05, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12,
+	0x2a, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x62, 0x61, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x51, 0x0a, 0x0c, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x4c, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x43, 0x4c,
+	0x55, 0x53, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x52, 0x4f, 0x55, 0x54, 0x45,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x10, 0x04, 0x22, 0x46,
+	0x0a, 0x06, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x48, 0x41, 0x4e,
+	0x47, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x52,
+	0x45, 0x4d, 0x4f, 0x56, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x4d, 0x4f, 0x44, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x03, 0x22, 0xa0, 0x01, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x70, 0x61,
+	0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x41, 0x0a, 0x04, 0x62, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x62, 0x61,
+	0x73, 0x65, 0x12, 0x45, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x50, 0x0a, 0x15, 0x43, 0x6f, 0x6d,
+	0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x37, 0x0a, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x64, 0x69, 0x66, 0x66, 0x73, 0x22, 0x70, 0x0a, 0x1b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c,
+	0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x0d,
+	0xaa, 0xe1, 0x1c, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x71, 0x0a,
+	0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x3a, 0x0e, 0xaa, 0xe1, 0x1c, 0x0a, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x22, 0x6f, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x3a, 0x0d, 0xaa, 0xe1, 0x1c, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x62, 0x0a, 0x1b, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x43, 0x0a, 0x09, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x09, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x22, 0x8a, 0x02, 0x0a, 0x12, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x47, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x10, 0xfa,
+	0x42, 0x0d, 0xaa, 0x01, 0x0a, 0x08, 0x01, 0x22, 0x02, 0x08, 0x3c, 0x32, 0x02, 0x08, 0x01, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x07, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a,
+	0x02, 0x18, 0x0a, 0x52, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x05, 0x74, 0x6f, 0x70, 0x5f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x2a, 0x03, 0x18, 0xe8, 0x07, 0x52, 0x04, 0x74,
+	0x6f, 0x70, 0x4e, 0x3a, 0x0d, 0xaa, 0xe1, 0x1c, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x22, 0x51, 0x0a, 0x13, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x06, 0x73, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x06, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x22, 0x91, 0x02, 0x0a, 0x14, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x68, 0x0a, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f,
+	0x64, 0x69, 0x66, 0x79, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x2e, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x0e, 0xfa, 0x42, 0x0b, 0x9a, 0x01, 0x08, 0x08, 0x01, 0x22, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x09, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x1a, 0x3c, 0x0a, 0x0e,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x0d, 0xaa, 0xe1, 0x1c, 0x09,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x32, 0x0a, 0x06, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0xbb, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69,
+	0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x12, 0x51, 0x0a, 0x05, 0x6c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01,
+	0x04, 0x10, 0x01, 0x20, 0x00, 0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x67, 0x0a, 0x05,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49,
+	0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x09, 0x0a, 0x05, 0x54, 0x52, 0x41, 0x43, 0x45, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x02, 0x12, 0x08, 0x0a, 0x04,
+	0x49, 0x4e, 0x46, 0x4f, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x57, 0x41, 0x52, 0x4e, 0x49, 0x4e,
+	0x47, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x05, 0x12, 0x0c,
+	0x0a, 0x08, 0x43, 0x52, 0x49, 0x54, 0x49, 0x43, 0x41, 0x4c, 0x10, 0x06, 0x12, 0x07, 0x0a, 0x03,
+	0x4f, 0x46, 0x46, 0x10, 0x07, 0x3a, 0x0d, 0xaa, 0xe1, 0x1c, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x4e, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x6c,
+	0x6f, 0x67, 0x67, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x52, 0x07, 0x6c, 0x6f, 0x67,
+	0x67, 0x65, 0x72, 0x73, 0x22, 0x6b, 0x0a, 0x16, 0x46, 0x61, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x3a, 0x0d, 0xaa, 0xe1, 0x1c, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x19, 0x0a, 0x17, 0x46, 0x61, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6b, 0x0a, 0x16,
+	0x50, 0x61, 0x73, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x3a, 0x0d, 0xaa, 0xe1, 0x1c, 0x09,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x50, 0x61, 0x73,
+	0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x15, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6f, 0x6e,
+	0x6c, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x67, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x67, 0x72, 0x61, 0x63, 0x65, 0x66, 0x75,
+	0x6c, 0x3a, 0x0d, 0xaa, 0xe1, 0x1c, 0x09, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x22, 0x18, 0x0a, 0x16, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xd2, 0x0d, 0x0a, 0x0e, 0x45,
+	0x6e, 0x76, 0x6f, 0x79, 0x54, 0x72, 0x69, 0x61, 0x67, 0x65, 0x41, 0x50, 0x49, 0x12, 0x76, 0x0a,
+	0x04, 0x52, 0x65, 0x61, 0x64, 0x12, 0x22, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25,
+	0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x3a, 0x01, 0x2a, 0x22,
+	0x14, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x2f, 0x72, 0x65, 0x61, 0x64, 0x12, 0x8a, 0x01, 0x0a, 0x09, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x27, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1e, 0x3a, 0x01, 0x2a, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x61, 0x64, 0x12, 0x92, 0x01, 0x0a, 0x0b, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x29, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f,
+	0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0xaa, 0xe1, 0x1c, 0x02, 0x08,
+	0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x70,
+	0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x63, 0x6c, 0x75, 0x74,
+	0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e,
+	0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2e, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x22, 0x3a, 0x01, 0x2a, 0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x72, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0xb6, 0x01, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x32, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x35, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x01, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x29, 0x3a, 0x01, 0x2a, 0x22, 0x24, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0xb2, 0x01,
+	0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65,
+	0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0xaa, 0xe1,
+	0x1c, 0x02, 0x08, 0x02, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x28, 0x3a, 0x01, 0x2a, 0x22, 0x23, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x6c,
+	0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x73, 0x12, 0x9a, 0x01, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x75, 0x6e,
+	0x74, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64,
+	0x69, 0x66, 0x79, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79,
+	0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x2e, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x3a, 0x01, 0x2a,
+	0x22, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x52, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x92, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x29, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x63, 0x6c, 0x75,
+	0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x03, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x20, 0x3a, 0x01, 0x2a, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76,
+	0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0xa2, 0x01, 0x0a, 0x0f, 0x46, 0x61, 0x69, 0x6c, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63,
+	0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x46, 0x61, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68,
+	0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x61, 0x69, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x03, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e,
+	0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x66, 0x61, 0x69, 0x6c, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0xa2, 0x01, 0x0a, 0x0f, 0x50, 0x61,
+	0x73, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x2d, 0x2e,
+	0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61,
+	0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x63,
+	0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0xaa, 0xe1,
+	0x1c, 0x02, 0x08, 0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x3a, 0x01, 0x2a, 0x22, 0x1f, 0x2f,
+	0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2f, 0x70,
+	0x61, 0x73, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x9e,
+	0x01, 0x0a, 0x0e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x2c, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79,
+	0x74, 0x72, 0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2e, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72,
+	0x69, 0x61, 0x67, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x72, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f,
+	0xaa, 0xe1, 0x1c, 0x02, 0x08, 0x03, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x3a, 0x01, 0x2a, 0x22,
+	0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x2f, 0x64, 0x72, 0x61, 0x69, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x73, 0x42,
+	0x41, 0x5a, 0x3f, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79,
+	0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e,
+	0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67,
+	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x76, 0x6f, 0x79, 0x74, 0x72, 0x69, 0x61, 0x67, 0x65,
+	0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_envoytriage_v1_envoytriage_api_proto_rawDescOnce sync.Once
+	file_envoytriage_v1_envoytriage_api_proto_rawDescData = file_envoytriage_v1_envoytriage_api_proto_rawDesc
+)
+
+func file_envoytriage_v1_envoytriage_api_proto_rawDescGZIP() []byte {
+	file_envoytriage_v1_envoytriage_api_proto_rawDescOnce.Do(func() {
+		file_envoytriage_v1_envoytriage_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_envoytriage_v1_envoytriage_api_proto_rawDescData)
+	})
+	return file_envoytriage_v1_envoytriage_api_proto_rawDescData
+}
+
+var file_envoytriage_v1_envoytriage_api_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_envoytriage_v1_envoytriage_api_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_envoytriage_v1_envoytriage_api_proto_goTypes = []interface{}{
+	(ConfigDiff_ResourceType)(0),           // 0: clutch.envoytriage.v1.ConfigDiff.ResourceType
+	(ConfigDiff_Change)(0),                 // 1: clutch.envoytriage.v1.ConfigDiff.Change
+	(SetLogLevelRequest_Level)(0),          // 2: clutch.envoytriage.v1.SetLogLevelRequest.Level
+	(*ReadRequest)(nil),                    // 3: clutch.envoytriage.v1.ReadRequest
+	(*ReadOperation)(nil),                  // 4: clutch.envoytriage.v1.ReadOperation
+	(*ReadResponse)(nil),                   // 5: clutch.envoytriage.v1.ReadResponse
+	(*Address)(nil),                        // 6: clutch.envoytriage.v1.Address
+	(*Result)(nil),                         // 7: clutch.envoytriage.v1.Result
+	(*NodeMetadata)(nil),                   // 8: clutch.envoytriage.v1.NodeMetadata
+	(*BatchReadRequest)(nil),               // 9: clutch.envoytriage.v1.BatchReadRequest
+	(*BatchReadResponse)(nil),              // 10: clutch.envoytriage.v1.BatchReadResponse
+	(*Aggregate)(nil),                      // 11: clutch.envoytriage.v1.Aggregate
+	(*StatsSample)(nil),                    // 12: clutch.envoytriage.v1.StatsSample
+	(*ConfigSnapshot)(nil),                 // 13: clutch.envoytriage.v1.ConfigSnapshot
+	(*ConfigSource)(nil),                   // 14: clutch.envoytriage.v1.ConfigSource
+	(*ConfigDiff)(nil),                     // 15: clutch.envoytriage.v1.ConfigDiff
+	(*CompareConfigRequest)(nil),           // 16: clutch.envoytriage.v1.CompareConfigRequest
+	(*CompareConfigResponse)(nil),          // 17: clutch.envoytriage.v1.CompareConfigResponse
+	(*CreateConfigSnapshotRequest)(nil),    // 18: clutch.envoytriage.v1.CreateConfigSnapshotRequest
+	(*CreateConfigSnapshotResponse)(nil),   // 19: clutch.envoytriage.v1.CreateConfigSnapshotResponse
+	(*ListConfigSnapshotsRequest)(nil),     // 20: clutch.envoytriage.v1.ListConfigSnapshotsRequest
+	(*ListConfigSnapshotsResponse)(nil),    // 21: clutch.envoytriage.v1.ListConfigSnapshotsResponse
+	(*SampleStatsRequest)(nil),             // 22: clutch.envoytriage.v1.SampleStatsRequest
+	(*SampleStatsResponse)(nil),            // 23: clutch.envoytriage.v1.SampleStatsResponse
+	(*ModifyRuntimeRequest)(nil),           // 24: clutch.envoytriage.v1.ModifyRuntimeRequest
+	(*ModifyRuntimeResponse)(nil),          // 25: clutch.envoytriage.v1.ModifyRuntimeResponse
+	(*Logger)(nil),                         // 26: clutch.envoytriage.v1.Logger
+	(*SetLogLevelRequest)(nil),             // 27: clutch.envoytriage.v1.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),            // 28: clutch.envoytriage.v1.SetLogLevelResponse
+	(*FailHealthcheckRequest)(nil),         // 29: clutch.envoytriage.v1.FailHealthcheckRequest
+	(*FailHealthcheckResponse)(nil),        // 30: clutch.envoytriage.v1.FailHealthcheckResponse
+	(*PassHealthcheckRequest)(nil),         // 31: clutch.envoytriage.v1.PassHealthcheckRequest
+	(*PassHealthcheckResponse)(nil),        // 32: clutch.envoytriage.v1.PassHealthcheckResponse
+	(*DrainListenersRequest)(nil),          // 33: clutch.envoytriage.v1.DrainListenersRequest
+	(*DrainListenersResponse)(nil),         // 34: clutch.envoytriage.v1.DrainListenersResponse
+	(*ReadOperation_Include)(nil),          // 35: clutch.envoytriage.v1.ReadOperation.Include
+	(*Address_Pod)(nil),                    // 36: clutch.envoytriage.v1.Address.Pod
+	(*Result_Output)(nil),                  // 37: clutch.envoytriage.v1.Result.Output
+	(*BatchReadResponse_Failure)(nil),      // 38: clutch.envoytriage.v1.BatchReadResponse.Failure
+	(*Aggregate_UpstreamHealth)(nil),       // 39: clutch.envoytriage.v1.Aggregate.UpstreamHealth
+	(*Aggregate_ConfigDrift)(nil),          // 40: clutch.envoytriage.v1.Aggregate.ConfigDrift
+	(*Aggregate_ExpiringCertificate)(nil),  // 41: clutch.envoytriage.v1.Aggregate.ExpiringCertificate
+	(*Aggregate_ConfigDrift_Host)(nil),     // 42: clutch.envoytriage.v1.Aggregate.ConfigDrift.Host
+	(*StatsSample_Counter)(nil),            // 43: clutch.envoytriage.v1.StatsSample.Counter
+	(*StatsSample_Gauge)(nil),              // 44: clutch.envoytriage.v1.StatsSample.Gauge
+	(*StatsSample_Histogram)(nil),          // 45: clutch.envoytriage.v1.StatsSample.Histogram
+	(*StatsSample_Histogram_Quantile)(nil), // 46: clutch.envoytriage.v1.StatsSample.Histogram.Quantile
+	(*ConfigDiff_Field)(nil),               // 47: clutch.envoytriage.v1.ConfigDiff.Field
+	nil,                                    // 48: clutch.envoytriage.v1.ModifyRuntimeRequest.OverridesEntry
+	(*durationpb.Duration)(nil),            // 49: google.protobuf.Duration
+	(*Stats_Stat)(nil),                     // 50: clutch.envoytriage.v1.Stats.Stat
+	(*timestamppb.Timestamp)(nil),          // 51: google.protobuf.Timestamp
+	(*Clusters)(nil),                       // 52: clutch.envoytriage.v1.Clusters
+	(*ConfigDump)(nil),                     // 53: clutch.envoytriage.v1.ConfigDump
+	(*Listeners)(nil),                      // 54: clutch.envoytriage.v1.Listeners
+	(*Runtime)(nil),                        // 55: clutch.envoytriage.v1.Runtime
+	(*Stats)(nil),                          // 56: clutch.envoytriage.v1.Stats
+	(*ServerInfo)(nil),                     // 57: clutch.envoytriage.v1.ServerInfo
+	(*Certificates)(nil),                   // 58: clutch.envoytriage.v1.Certificates
+	(*Certificate)(nil),                    // 59: clutch.envoytriage.v1.Certificate
+	(*wrapperspb.DoubleValue)(nil),         // 60: google.protobuf.DoubleValue
+	(*structpb.Value)(nil),                 // 61: google.protobuf.Value
+}
+var file_envoytriage_v1_envoytriage_api_proto_depIdxs = []int32{
+	4,  // 0: clutch.envoytriage.v1.ReadRequest.operations:type_name -> clutch.envoytriage.v1.ReadOperation
+	6,  // 1: clutch.envoytriage.v1.ReadOperation.address:type_name -> clutch.envoytriage.v1.Address
+	35, // 2: clutch.envoytriage.v1.ReadOperation.include:type_name -> clutch.envoytriage.v1.ReadOperation.Include
+	7,  // 3: clutch.envoytriage.v1.ReadResponse.results:type_name -> clutch.envoytriage.v1.Result
+	36, // 4: clutch.envoytriage.v1.Address.pod:type_name -> clutch.envoytriage.v1.Address.Pod
+	6,  // 5: clutch.envoytriage.v1.Result.address:type_name -> clutch.envoytriage.v1.Address
+	8,  // 6: clutch.envoytriage.v1.Result.node_metadata:type_name -> clutch.envoytriage.v1.NodeMetadata
+	37, // 7: clutch.envoytriage.v1.Result.output:type_name -> clutch.envoytriage.v1.Result.Output
+	6,  // 8: clutch.envoytriage.v1.BatchReadRequest.addresses:type_name -> clutch.envoytriage.v1.Address
+	35, // 9: clutch.envoytriage.v1.BatchReadRequest.include:type_name -> clutch.envoytriage.v1.ReadOperation.Include
+	49, // 10: clutch.envoytriage.v1.BatchReadRequest.per_host_timeout:type_name -> google.protobuf.Duration
+	49, // 11: clutch.envoytriage.v1.BatchReadRequest.cert_expiry_window:type_name -> google.protobuf.Duration
+	7,  // 12: clutch.envoytriage.v1.BatchReadResponse.results:type_name -> clutch.envoytriage.v1.Result
+	38, // 13: clutch.envoytriage.v1.BatchReadResponse.failures:type_name -> clutch.envoytriage.v1.BatchReadResponse.Failure
+	11, // 14: clutch.envoytriage.v1.BatchReadResponse.aggregate:type_name -> clutch.envoytriage.v1.Aggregate
+	39, // 15: clutch.envoytriage.v1.Aggregate.upstream_health:type_name -> clutch.envoytriage.v1.Aggregate.UpstreamHealth
+	50, // 16: clutch.envoytriage.v1.Aggregate.outlier_stats:type_name -> clutch.envoytriage.v1.Stats.Stat
+	40, // 17: clutch.envoytriage.v1.Aggregate.config_drift:type_name -> clutch.envoytriage.v1.Aggregate.ConfigDrift
+	41, // 18: clutch.envoytriage.v1.Aggregate.expiring_certificates:type_name -> clutch.envoytriage.v1.Aggregate.ExpiringCertificate
+	51, // 19: clutch.envoytriage.v1.StatsSample.start:type_name -> google.protobuf.Timestamp
+	51, // 20: clutch.envoytriage.v1.StatsSample.end:type_name -> google.protobuf.Timestamp
+	43, // 21: clutch.envoytriage.v1.StatsSample.counters:type_name -> clutch.envoytriage.v1.StatsSample.Counter
+	44, // 22: clutch.envoytriage.v1.StatsSample.gauges:type_name -> clutch.envoytriage.v1.StatsSample.Gauge
+	45, // 23: clutch.envoytriage.v1.StatsSample.histograms:type_name -> clutch.envoytriage.v1.StatsSample.Histogram
+	6,  // 24: clutch.envoytriage.v1.ConfigSnapshot.address:type_name -> clutch.envoytriage.v1.Address
+	51, // 25: clutch.envoytriage.v1.ConfigSnapshot.created_at:type_name -> google.protobuf.Timestamp
+	6,  // 26: clutch.envoytriage.v1.ConfigSource.address:type_name -> clutch.envoytriage.v1.Address
+	0,  // 27: clutch.envoytriage.v1.ConfigDiff.resource_type:type_name -> clutch.envoytriage.v1.ConfigDiff.ResourceType
+	1,  // 28: clutch.envoytriage.v1.ConfigDiff.change:type_name -> clutch.envoytriage.v1.ConfigDiff.Change
+	47, // 29: clutch.envoytriage.v1.ConfigDiff.fields:type_name -> clutch.envoytriage.v1.ConfigDiff.Field
+	14, // 30: clutch.envoytriage.v1.CompareConfigRequest.base:type_name -> clutch.envoytriage.v1.ConfigSource
+	14, // 31: clutch.envoytriage.v1.CompareConfigRequest.target:type_name -> clutch.envoytriage.v1.ConfigSource
+	15, // 32: clutch.envoytriage.v1.CompareConfigResponse.diffs:type_name -> clutch.envoytriage.v1.ConfigDiff
+	6,  // 33: clutch.envoytriage.v1.CreateConfigSnapshotRequest.address:type_name -> clutch.envoytriage.v1.Address
+	13, // 34: clutch.envoytriage.v1.CreateConfigSnapshotResponse.snapshot:type_name -> clutch.envoytriage.v1.ConfigSnapshot
+	6,  // 35: clutch.envoytriage.v1.ListConfigSnapshotsRequest.address:type_name -> clutch.envoytriage.v1.Address
+	13, // 36: clutch.envoytriage.v1.ListConfigSnapshotsResponse.snapshots:type_name -> clutch.envoytriage.v1.ConfigSnapshot
+	6,  // 37: clutch.envoytriage.v1.SampleStatsRequest.address:type_name -> clutch.envoytriage.v1.Address
+	49, // 38: clutch.envoytriage.v1.SampleStatsRequest.interval:type_name -> google.protobuf.Duration
+	12, // 39: clutch.envoytriage.v1.SampleStatsResponse.sample:type_name -> clutch.envoytriage.v1.StatsSample
+	6,  // 40: clutch.envoytriage.v1.ModifyRuntimeRequest.address:type_name -> clutch.envoytriage.v1.Address
+	48, // 41: clutch.envoytriage.v1.ModifyRuntimeRequest.overrides:type_name -> clutch.envoytriage.v1.ModifyRuntimeRequest.OverridesEntry
+	6,  // 42: clutch.envoytriage.v1.SetLogLevelRequest.address:type_name -> clutch.envoytriage.v1.Address
+	2,  // 43: clutch.envoytriage.v1.SetLogLevelRequest.level:type_name -> clutch.envoytriage.v1.SetLogLevelRequest.Level
+	26, // 44: clutch.envoytriage.v1.SetLogLevelResponse.loggers:type_name -> clutch.envoytriage.v1.Logger
+	6,  // 45: clutch.envoytriage.v1.FailHealthcheckRequest.address:type_name -> clutch.envoytriage.v1.Address
+	6,  // 46: clutch.envoytriage.v1.PassHealthcheckRequest.address:type_name -> clutch.envoytriage.v1.Address
+	6,  // 47: clutch.envoytriage.v1.DrainListenersRequest.address:type_name -> clutch.envoytriage.v1.Address
+	52, // 48: clutch.envoytriage.v1.Result.Output.clusters:type_name -> clutch.envoytriage.v1.Clusters
+	53, // 49: clutch.envoytriage.v1.Result.Output.config_dump:type_name -> clutch.envoytriage.v1.ConfigDump
+	54, // 50: clutch.envoytriage.v1.Result.Output.listeners:type_name -> clutch.envoytriage.v1.Listeners
+	55, // 51: clutch.envoytriage.v1.Result.Output.runtime:type_name -> clutch.envoytriage.v1.Runtime
+	56, // 52: clutch.envoytriage.v1.Result.Output.stats:type_name -> clutch.envoytriage.v1.Stats
+	57, // 53: clutch.envoytriage.v1.Result.Output.server_info:type_name -> clutch.envoytriage.v1.ServerInfo
+	58, // 54: clutch.envoytriage.v1.Result.Output.certificates:type_name -> clutch.envoytriage.v1.Certificates
+	6,  // 55: clutch.envoytriage.v1.BatchReadResponse.Failure.address:type_name -> clutch.envoytriage.v1.Address
+	42, // 56: clutch.envoytriage.v1.Aggregate.ConfigDrift.differing_hosts:type_name -> clutch.envoytriage.v1.Aggregate.ConfigDrift.Host
+	6,  // 57: clutch.envoytriage.v1.Aggregate.ExpiringCertificate.address:type_name -> clutch.envoytriage.v1.Address
+	59, // 58: clutch.envoytriage.v1.Aggregate.ExpiringCertificate.certificate:type_name -> clutch.envoytriage.v1.Certificate
+	6,  // 59: clutch.envoytriage.v1.Aggregate.ConfigDrift.Host.address:type_name -> clutch.envoytriage.v1.Address
+	46, // 60: clutch.envoytriage.v1.StatsSample.Histogram.quantiles:type_name -> clutch.envoytriage.v1.StatsSample.Histogram.Quantile
+	60, // 61: clutch.envoytriage.v1.StatsSample.Histogram.Quantile.interval:type_name -> google.protobuf.DoubleValue
+	60, // 62: clutch.envoytriage.v1.StatsSample.Histogram.Quantile.cumulative:type_name -> google.protobuf.DoubleValue
+	61, // 63: clutch.envoytriage.v1.ConfigDiff.Field.base:type_name -> google.protobuf.Value
+	61, // 64: clutch.envoytriage.v1.ConfigDiff.Field.target:type_name -> google.protobuf.Value
+	3,  // 65: clutch.envoytriage.v1.EnvoyTriageAPI.Read:input_type -> clutch.envoytriage.v1.ReadRequest
+	9,  // 66: clutch.envoytriage.v1.EnvoyTriageAPI.BatchRead:input_type -> clutch.envoytriage.v1.BatchReadRequest
+	22, // 67: clutch.envoytriage.v1.EnvoyTriageAPI.SampleStats:input_type -> clutch.envoytriage.v1.SampleStatsRequest
+	16, // 68: clutch.envoytriage.v1.EnvoyTriageAPI.CompareConfig:input_type -> clutch.envoytriage.v1.CompareConfigRequest
+	18, // 69: clutch.envoytriage.v1.EnvoyTriageAPI.CreateConfigSnapshot:input_type -> clutch.envoytriage.v1.CreateConfigSnapshotRequest
+	20, // 70: clutch.envoytriage.v1.EnvoyTriageAPI.ListConfigSnapshots:input_type -> clutch.envoytriage.v1.ListConfigSnapshotsRequest
+	24, // 71: clutch.envoytriage.v1.EnvoyTriageAPI.ModifyRuntime:input_type -> clutch.envoytriage.v1.ModifyRuntimeRequest
+	27, // 72: clutch.envoytriage.v1.EnvoyTriageAPI.SetLogLevel:input_type -> clutch.envoytriage.v1.SetLogLevelRequest
+	29, // 73: clutch.envoytriage.v1.EnvoyTriageAPI.FailHealthcheck:input_type -> clutch.envoytriage.v1.FailHealthcheckRequest
+	31, // 74: clutch.envoytriage.v1.EnvoyTriageAPI.PassHealthcheck:input_type -> clutch.envoytriage.v1.PassHealthcheckRequest
+	33, // 75: clutch.envoytriage.v1.EnvoyTriageAPI.DrainListeners:input_type -> clutch.envoytriage.v1.DrainListenersRequest
+	5,  // 76: clutch.envoytriage.v1.EnvoyTriageAPI.Read:output_type -> clutch.envoytriage.v1.ReadResponse
+	10, // 77: clutch.envoytriage.v1.EnvoyTriageAPI.BatchRead:output_type -> clutch.envoytriage.v1.BatchReadResponse
+	23, // 78: clutch.envoytriage.v1.EnvoyTriageAPI.SampleStats:output_type -> clutch.envoytriage.v1.SampleStatsResponse
+	17, // 79: clutch.envoytriage.v1.EnvoyTriageAPI.CompareConfig:output_type -> clutch.envoytriage.v1.CompareConfigResponse
+	19, // 80: clutch.envoytriage.v1.EnvoyTriageAPI.CreateConfigSnapshot:output_type -> clutch.envoytriage.v1.CreateConfigSnapshotResponse
+	21, // 81: clutch.envoytriage.v1.EnvoyTriageAPI.ListConfigSnapshots:output_type -> clutch.envoytriage.v1.ListConfigSnapshotsResponse
+	25, // 82: clutch.envoytriage.v1.EnvoyTriageAPI.ModifyRuntime:output_type -> clutch.envoytriage.v1.ModifyRuntimeResponse
+	28, // 83: clutch.envoytriage.v1.EnvoyTriageAPI.SetLogLevel:output_type -> clutch.envoytriage.v1.SetLogLevelResponse
+	30, // 84: clutch.envoytriage.v1.EnvoyTriageAPI.FailHealthcheck:output_type -> clutch.envoytriage.v1.FailHealthcheckResponse
+	32, // 85: clutch.envoytriage.v1.EnvoyTriageAPI.PassHealthcheck:output_type -> clutch.envoytriage.v1.PassHealthcheckResponse
+	34, // 86: clutch.envoytriage.v1.EnvoyTriageAPI.DrainListeners:output_type -> clutch.envoytriage.v1.DrainListenersResponse
+	76, // [76:87] is the sub-list for method output_type
+	65, // [65:76] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
+}
+
+func init() { file_envoytriage_v1_envoytriage_api_proto_init() }
+func file_envoytriage_v1_envoytriage_api_proto_init() {
+	if File_envoytriage_v1_envoytriage_api_proto != nil {
+		return
+	}
+	file_envoytriage_v1_output_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadOperation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigSource); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigDiff); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CompareConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateConfigSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
 				return &v.sizeCache
 			case 2:
 				return &v.unknownFields
@@ -742,8 +3929,8 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 				return nil
 			}
 		}
-		file_envoytriage_v1_envoytriage_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Address); i {
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateConfigSnapshotResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -754,8 +3941,8 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 				return nil
 			}
 		}
-		file_envoytriage_v1_envoytriage_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Result); i {
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigSnapshotsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -766,8 +3953,8 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 				return nil
 			}
 		}
-		file_envoytriage_v1_envoytriage_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeMetadata); i {
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListConfigSnapshotsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -778,7 +3965,163 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 				return nil
 			}
 		}
-		file_envoytriage_v1_envoytriage_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SampleStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SampleStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyRuntimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModifyRuntimeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Logger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailHealthcheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailHealthcheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PassHealthcheckRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PassHealthcheckResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainListenersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DrainListenersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ReadOperation_Include); i {
 			case 0:
 				return &v.state
@@ -790,7 +4133,19 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 				return nil
 			}
 		}
-		file_envoytriage_v1_envoytriage_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Address_Pod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Result_Output); i {
 			case 0:
 				return &v.state
@@ -802,19 +4157,144 @@ func file_envoytriage_v1_envoytriage_api_proto_init() {
 				return nil
 			}
 		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BatchReadResponse_Failure); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregate_UpstreamHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregate_ConfigDrift); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregate_ExpiringCertificate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aggregate_ConfigDrift_Host); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsSample_Counter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsSample_Gauge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsSample_Histogram); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StatsSample_Histogram_Quantile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_envoytriage_v1_envoytriage_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigDiff_Field); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_envoytriage_v1_envoytriage_api_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*ConfigSource_Address)(nil),
+		(*ConfigSource_SnapshotId)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_envoytriage_v1_envoytriage_api_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_envoytriage_v1_envoytriage_api_proto_goTypes,
 		DependencyIndexes: file_envoytriage_v1_envoytriage_api_proto_depIdxs,
+		EnumInfos:         file_envoytriage_v1_envoytriage_api_proto_enumTypes,
 		MessageInfos:      file_envoytriage_v1_envoytriage_api_proto_msgTypes,
 	}.Build()
 	File_envoytriage_v1_envoytriage_api_proto = out.File
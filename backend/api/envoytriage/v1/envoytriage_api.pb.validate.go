@@ -491,15 +491,19 @@ func (m *Address) validate(all bool) error {
 
 	var errors []error
 
-	if ip := net.ParseIP(m.GetHost()); ip == nil {
-		err := AddressValidationError{
-			field:  "Host",
-			reason: "value must be a valid IP address",
-		}
-		if !all {
-			return err
+	if m.GetHost() != "" {
+
+		if ip := net.ParseIP(m.GetHost()); ip == nil {
+			err := AddressValidationError{
+				field:  "Host",
+				reason: "value must be a valid IP address",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
+
 	}
 
 	if m.GetPort() > 65535 {
@@ -513,6 +517,35 @@ func (m *Address) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetPod()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddressValidationError{
+					field:  "Pod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddressValidationError{
+					field:  "Pod",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPod()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddressValidationError{
+				field:  "Pod",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return AddressMultiError(errors)
 	}
@@ -882,54 +915,227 @@ var _ interface {
 	ErrorName() string
 } = NodeMetadataValidationError{}
 
-// Validate checks the field values on ReadOperation_Include with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ReadOperation_Include) Validate() error {
+// Validate checks the field values on BatchReadRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchReadRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ReadOperation_Include with the rules
+// ValidateAll checks the field values on BatchReadRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ReadOperation_IncludeMultiError, or nil if none found.
-func (m *ReadOperation_Include) ValidateAll() error {
+// BatchReadRequestMultiError, or nil if none found.
+func (m *BatchReadRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ReadOperation_Include) validate(all bool) error {
+func (m *BatchReadRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Clusters
+	if len(m.GetAddresses()) > 500 {
+		err := BatchReadRequestValidationError{
+			field:  "Addresses",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for ConfigDump
+	for idx, item := range m.GetAddresses() {
+		_, _ = idx, item
 
-	// no validation rules for Listeners
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchReadRequestValidationError{
+						field:  fmt.Sprintf("Addresses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchReadRequestValidationError{
+						field:  fmt.Sprintf("Addresses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchReadRequestValidationError{
+					field:  fmt.Sprintf("Addresses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	// no validation rules for Runtime
+	}
 
-	// no validation rules for Stats
+	if len(m.GetPods()) > 500 {
+		err := BatchReadRequestValidationError{
+			field:  "Pods",
+			reason: "value must contain no more than 500 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for ServerInfo
+	for idx, item := range m.GetPods() {
+		_, _ = idx, item
+
+		if utf8.RuneCountInString(item) < 1 {
+			err := BatchReadRequestValidationError{
+				field:  fmt.Sprintf("Pods[%v]", idx),
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPodAdminPort() > 65535 {
+		err := BatchReadRequestValidationError{
+			field:  "PodAdminPort",
+			reason: "value must be less than or equal to 65535",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetInclude()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchReadRequestValidationError{
+					field:  "Include",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchReadRequestValidationError{
+					field:  "Include",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetInclude()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchReadRequestValidationError{
+				field:  "Include",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetMaxConcurrency() > 100 {
+		err := BatchReadRequestValidationError{
+			field:  "MaxConcurrency",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetPerHostTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = BatchReadRequestValidationError{
+				field:  "PerHostTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(60*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := BatchReadRequestValidationError{
+					field:  "PerHostTimeout",
+					reason: "value must be inside range [0s, 1m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if d := m.GetCertExpiryWindow(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = BatchReadRequestValidationError{
+				field:  "CertExpiryWindow",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(31536000*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := BatchReadRequestValidationError{
+					field:  "CertExpiryWindow",
+					reason: "value must be inside range [0s, 8760h0m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
 
 	if len(errors) > 0 {
-		return ReadOperation_IncludeMultiError(errors)
+		return BatchReadRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ReadOperation_IncludeMultiError is an error wrapping multiple validation
-// errors returned by ReadOperation_Include.ValidateAll() if the designated
-// constraints aren't met.
-type ReadOperation_IncludeMultiError []error
+// BatchReadRequestMultiError is an error wrapping multiple validation errors
+// returned by BatchReadRequest.ValidateAll() if the designated constraints
+// aren't met.
+type BatchReadRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ReadOperation_IncludeMultiError) Error() string {
+func (m BatchReadRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -938,11 +1144,11 @@ func (m ReadOperation_IncludeMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ReadOperation_IncludeMultiError) AllErrors() []error { return m }
+func (m BatchReadRequestMultiError) AllErrors() []error { return m }
 
-// ReadOperation_IncludeValidationError is the validation error returned by
-// ReadOperation_Include.Validate if the designated constraints aren't met.
-type ReadOperation_IncludeValidationError struct {
+// BatchReadRequestValidationError is the validation error returned by
+// BatchReadRequest.Validate if the designated constraints aren't met.
+type BatchReadRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -950,24 +1156,22 @@ type ReadOperation_IncludeValidationError struct {
 }
 
 // Field function returns field value.
-func (e ReadOperation_IncludeValidationError) Field() string { return e.field }
+func (e BatchReadRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ReadOperation_IncludeValidationError) Reason() string { return e.reason }
+func (e BatchReadRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ReadOperation_IncludeValidationError) Cause() error { return e.cause }
+func (e BatchReadRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ReadOperation_IncludeValidationError) Key() bool { return e.key }
+func (e BatchReadRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ReadOperation_IncludeValidationError) ErrorName() string {
-	return "ReadOperation_IncludeValidationError"
-}
+func (e BatchReadRequestValidationError) ErrorName() string { return "BatchReadRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ReadOperation_IncludeValidationError) Error() string {
+func (e BatchReadRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -979,14 +1183,14 @@ func (e ReadOperation_IncludeValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sReadOperation_Include.%s: %s%s",
+		"invalid %sBatchReadRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ReadOperation_IncludeValidationError{}
+var _ error = BatchReadRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -994,111 +1198,5428 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ReadOperation_IncludeValidationError{}
+} = BatchReadRequestValidationError{}
 
-// Validate checks the field values on Result_Output with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Result_Output) Validate() error {
+// Validate checks the field values on BatchReadResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BatchReadResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Result_Output with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in Result_OutputMultiError, or
-// nil if none found.
-func (m *Result_Output) ValidateAll() error {
+// ValidateAll checks the field values on BatchReadResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchReadResponseMultiError, or nil if none found.
+func (m *BatchReadResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Result_Output) validate(all bool) error {
+func (m *BatchReadResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchReadResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchReadResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchReadResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetFailures() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchReadResponseValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchReadResponseValidationError{
+						field:  fmt.Sprintf("Failures[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchReadResponseValidationError{
+					field:  fmt.Sprintf("Failures[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if all {
-		switch v := interface{}(m.GetClusters()).(type) {
+		switch v := interface{}(m.GetAggregate()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "Clusters",
+				errors = append(errors, BatchReadResponseValidationError{
+					field:  "Aggregate",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "Clusters",
+				errors = append(errors, BatchReadResponseValidationError{
+					field:  "Aggregate",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetClusters()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetAggregate()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Result_OutputValidationError{
-				field:  "Clusters",
+			return BatchReadResponseValidationError{
+				field:  "Aggregate",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if all {
-		switch v := interface{}(m.GetConfigDump()).(type) {
+	if len(errors) > 0 {
+		return BatchReadResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchReadResponseMultiError is an error wrapping multiple validation errors
+// returned by BatchReadResponse.ValidateAll() if the designated constraints
+// aren't met.
+type BatchReadResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchReadResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchReadResponseMultiError) AllErrors() []error { return m }
+
+// BatchReadResponseValidationError is the validation error returned by
+// BatchReadResponse.Validate if the designated constraints aren't met.
+type BatchReadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchReadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchReadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchReadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchReadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchReadResponseValidationError) ErrorName() string {
+	return "BatchReadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchReadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchReadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchReadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchReadResponseValidationError{}
+
+// Validate checks the field values on Aggregate with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Aggregate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Aggregate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AggregateMultiError, or nil
+// if none found.
+func (m *Aggregate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Aggregate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUpstreamHealth() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AggregateValidationError{
+						field:  fmt.Sprintf("UpstreamHealth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AggregateValidationError{
+						field:  fmt.Sprintf("UpstreamHealth[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AggregateValidationError{
+					field:  fmt.Sprintf("UpstreamHealth[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetOutlierStats() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AggregateValidationError{
+						field:  fmt.Sprintf("OutlierStats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AggregateValidationError{
+						field:  fmt.Sprintf("OutlierStats[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AggregateValidationError{
+					field:  fmt.Sprintf("OutlierStats[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetConfigDrift()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AggregateValidationError{
+					field:  "ConfigDrift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AggregateValidationError{
+					field:  "ConfigDrift",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfigDrift()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AggregateValidationError{
+				field:  "ConfigDrift",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetExpiringCertificates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AggregateValidationError{
+						field:  fmt.Sprintf("ExpiringCertificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AggregateValidationError{
+						field:  fmt.Sprintf("ExpiringCertificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AggregateValidationError{
+					field:  fmt.Sprintf("ExpiringCertificates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AggregateMultiError(errors)
+	}
+
+	return nil
+}
+
+// AggregateMultiError is an error wrapping multiple validation errors returned
+// by Aggregate.ValidateAll() if the designated constraints aren't met.
+type AggregateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AggregateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AggregateMultiError) AllErrors() []error { return m }
+
+// AggregateValidationError is the validation error returned by
+// Aggregate.Validate if the designated constraints aren't met.
+type AggregateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AggregateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AggregateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AggregateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AggregateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AggregateValidationError) ErrorName() string { return "AggregateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AggregateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AggregateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AggregateValidationError{}
+
+// Validate checks the field values on StatsSample with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StatsSample) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsSample with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StatsSampleMultiError, or
+// nil if none found.
+func (m *StatsSample) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsSample) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatsSampleValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatsSampleValidationError{
+					field:  "Start",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatsSampleValidationError{
+				field:  "Start",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatsSampleValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatsSampleValidationError{
+					field:  "End",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatsSampleValidationError{
+				field:  "End",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetCounters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatsSampleValidationError{
+						field:  fmt.Sprintf("Counters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatsSampleValidationError{
+						field:  fmt.Sprintf("Counters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatsSampleValidationError{
+					field:  fmt.Sprintf("Counters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetGauges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatsSampleValidationError{
+						field:  fmt.Sprintf("Gauges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatsSampleValidationError{
+						field:  fmt.Sprintf("Gauges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatsSampleValidationError{
+					field:  fmt.Sprintf("Gauges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetHistograms() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatsSampleValidationError{
+						field:  fmt.Sprintf("Histograms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatsSampleValidationError{
+						field:  fmt.Sprintf("Histograms[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StatsSampleValidationError{
+					field:  fmt.Sprintf("Histograms[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return StatsSampleMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsSampleMultiError is an error wrapping multiple validation errors
+// returned by StatsSample.ValidateAll() if the designated constraints aren't met.
+type StatsSampleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsSampleMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsSampleMultiError) AllErrors() []error { return m }
+
+// StatsSampleValidationError is the validation error returned by
+// StatsSample.Validate if the designated constraints aren't met.
+type StatsSampleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsSampleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsSampleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsSampleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsSampleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsSampleValidationError) ErrorName() string { return "StatsSampleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatsSampleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsSample.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsSampleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsSampleValidationError{}
+
+// Validate checks the field values on ConfigSnapshot with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfigSnapshot) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigSnapshot with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfigSnapshotMultiError,
+// or nil if none found.
+func (m *ConfigSnapshot) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigSnapshot) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigSnapshotValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigSnapshotValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigSnapshotValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ConfigSnapshotValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ConfigSnapshotValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ConfigSnapshotValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ConfigSnapshotMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigSnapshotMultiError is an error wrapping multiple validation errors
+// returned by ConfigSnapshot.ValidateAll() if the designated constraints
+// aren't met.
+type ConfigSnapshotMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigSnapshotMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigSnapshotMultiError) AllErrors() []error { return m }
+
+// ConfigSnapshotValidationError is the validation error returned by
+// ConfigSnapshot.Validate if the designated constraints aren't met.
+type ConfigSnapshotValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigSnapshotValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigSnapshotValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigSnapshotValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigSnapshotValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigSnapshotValidationError) ErrorName() string { return "ConfigSnapshotValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigSnapshotValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigSnapshot.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigSnapshotValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigSnapshotValidationError{}
+
+// Validate checks the field values on ConfigSource with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfigSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigSource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfigSourceMultiError, or
+// nil if none found.
+func (m *ConfigSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofSourcePresent := false
+	switch v := m.Source.(type) {
+	case *ConfigSource_Address:
+		if v == nil {
+			err := ConfigSourceValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSourcePresent = true
+
+		if all {
+			switch v := interface{}(m.GetAddress()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigSourceValidationError{
+						field:  "Address",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigSourceValidationError{
+						field:  "Address",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigSourceValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ConfigSource_SnapshotId:
+		if v == nil {
+			err := ConfigSourceValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofSourcePresent = true
+		// no validation rules for SnapshotId
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofSourcePresent {
+		err := ConfigSourceValidationError{
+			field:  "Source",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ConfigSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigSourceMultiError is an error wrapping multiple validation errors
+// returned by ConfigSource.ValidateAll() if the designated constraints aren't met.
+type ConfigSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigSourceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigSourceMultiError) AllErrors() []error { return m }
+
+// ConfigSourceValidationError is the validation error returned by
+// ConfigSource.Validate if the designated constraints aren't met.
+type ConfigSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigSourceValidationError) ErrorName() string { return "ConfigSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigSourceValidationError{}
+
+// Validate checks the field values on ConfigDiff with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ConfigDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ConfigDiffMultiError, or
+// nil if none found.
+func (m *ConfigDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceType
+
+	// no validation rules for Name
+
+	// no validation rules for Change
+
+	for idx, item := range m.GetFields() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ConfigDiffValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ConfigDiffValidationError{
+						field:  fmt.Sprintf("Fields[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ConfigDiffValidationError{
+					field:  fmt.Sprintf("Fields[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for FieldsTruncated
+
+	if len(errors) > 0 {
+		return ConfigDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// ConfigDiffMultiError is an error wrapping multiple validation errors
+// returned by ConfigDiff.ValidateAll() if the designated constraints aren't met.
+type ConfigDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ConfigDiffMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ConfigDiffMultiError) AllErrors() []error { return m }
+
+// ConfigDiffValidationError is the validation error returned by
+// ConfigDiff.Validate if the designated constraints aren't met.
+type ConfigDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfigDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfigDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfigDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfigDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfigDiffValidationError) ErrorName() string { return "ConfigDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ConfigDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfigDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfigDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfigDiffValidationError{}
+
+// Validate checks the field values on CompareConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompareConfigRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareConfigRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompareConfigRequestMultiError, or nil if none found.
+func (m *CompareConfigRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareConfigRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetBase() == nil {
+		err := CompareConfigRequestValidationError{
+			field:  "Base",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetBase()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareConfigRequestValidationError{
+					field:  "Base",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareConfigRequestValidationError{
+					field:  "Base",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBase()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareConfigRequestValidationError{
+				field:  "Base",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetTarget() == nil {
+		err := CompareConfigRequestValidationError{
+			field:  "Target",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CompareConfigRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CompareConfigRequestValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CompareConfigRequestValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CompareConfigRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareConfigRequestMultiError is an error wrapping multiple validation
+// errors returned by CompareConfigRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CompareConfigRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareConfigRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareConfigRequestMultiError) AllErrors() []error { return m }
+
+// CompareConfigRequestValidationError is the validation error returned by
+// CompareConfigRequest.Validate if the designated constraints aren't met.
+type CompareConfigRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareConfigRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareConfigRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareConfigRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareConfigRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareConfigRequestValidationError) ErrorName() string {
+	return "CompareConfigRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompareConfigRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareConfigRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareConfigRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareConfigRequestValidationError{}
+
+// Validate checks the field values on CompareConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CompareConfigResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CompareConfigResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CompareConfigResponseMultiError, or nil if none found.
+func (m *CompareConfigResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CompareConfigResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDiffs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CompareConfigResponseValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CompareConfigResponseValidationError{
+						field:  fmt.Sprintf("Diffs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CompareConfigResponseValidationError{
+					field:  fmt.Sprintf("Diffs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CompareConfigResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CompareConfigResponseMultiError is an error wrapping multiple validation
+// errors returned by CompareConfigResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CompareConfigResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CompareConfigResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CompareConfigResponseMultiError) AllErrors() []error { return m }
+
+// CompareConfigResponseValidationError is the validation error returned by
+// CompareConfigResponse.Validate if the designated constraints aren't met.
+type CompareConfigResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CompareConfigResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CompareConfigResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CompareConfigResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CompareConfigResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CompareConfigResponseValidationError) ErrorName() string {
+	return "CompareConfigResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CompareConfigResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCompareConfigResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CompareConfigResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CompareConfigResponseValidationError{}
+
+// Validate checks the field values on CreateConfigSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConfigSnapshotRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConfigSnapshotRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConfigSnapshotRequestMultiError, or nil if none found.
+func (m *CreateConfigSnapshotRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConfigSnapshotRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() == nil {
+		err := CreateConfigSnapshotRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigSnapshotRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigSnapshotRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigSnapshotRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateConfigSnapshotRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConfigSnapshotRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateConfigSnapshotRequest.ValidateAll() if
+// the designated constraints aren't met.
+type CreateConfigSnapshotRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConfigSnapshotRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConfigSnapshotRequestMultiError) AllErrors() []error { return m }
+
+// CreateConfigSnapshotRequestValidationError is the validation error returned
+// by CreateConfigSnapshotRequest.Validate if the designated constraints
+// aren't met.
+type CreateConfigSnapshotRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConfigSnapshotRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConfigSnapshotRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConfigSnapshotRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConfigSnapshotRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConfigSnapshotRequestValidationError) ErrorName() string {
+	return "CreateConfigSnapshotRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConfigSnapshotRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConfigSnapshotRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConfigSnapshotRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConfigSnapshotRequestValidationError{}
+
+// Validate checks the field values on CreateConfigSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateConfigSnapshotResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateConfigSnapshotResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateConfigSnapshotResponseMultiError, or nil if none found.
+func (m *CreateConfigSnapshotResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateConfigSnapshotResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSnapshot()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateConfigSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateConfigSnapshotResponseValidationError{
+					field:  "Snapshot",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshot()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateConfigSnapshotResponseValidationError{
+				field:  "Snapshot",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateConfigSnapshotResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateConfigSnapshotResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateConfigSnapshotResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateConfigSnapshotResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateConfigSnapshotResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateConfigSnapshotResponseMultiError) AllErrors() []error { return m }
+
+// CreateConfigSnapshotResponseValidationError is the validation error returned
+// by CreateConfigSnapshotResponse.Validate if the designated constraints
+// aren't met.
+type CreateConfigSnapshotResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateConfigSnapshotResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateConfigSnapshotResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateConfigSnapshotResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateConfigSnapshotResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateConfigSnapshotResponseValidationError) ErrorName() string {
+	return "CreateConfigSnapshotResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateConfigSnapshotResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateConfigSnapshotResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateConfigSnapshotResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateConfigSnapshotResponseValidationError{}
+
+// Validate checks the field values on ListConfigSnapshotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConfigSnapshotsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConfigSnapshotsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConfigSnapshotsRequestMultiError, or nil if none found.
+func (m *ListConfigSnapshotsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConfigSnapshotsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() == nil {
+		err := ListConfigSnapshotsRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListConfigSnapshotsRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListConfigSnapshotsRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListConfigSnapshotsRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListConfigSnapshotsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConfigSnapshotsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListConfigSnapshotsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListConfigSnapshotsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConfigSnapshotsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConfigSnapshotsRequestMultiError) AllErrors() []error { return m }
+
+// ListConfigSnapshotsRequestValidationError is the validation error returned
+// by ListConfigSnapshotsRequest.Validate if the designated constraints aren't met.
+type ListConfigSnapshotsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConfigSnapshotsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConfigSnapshotsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConfigSnapshotsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConfigSnapshotsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConfigSnapshotsRequestValidationError) ErrorName() string {
+	return "ListConfigSnapshotsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConfigSnapshotsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConfigSnapshotsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConfigSnapshotsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConfigSnapshotsRequestValidationError{}
+
+// Validate checks the field values on ListConfigSnapshotsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListConfigSnapshotsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListConfigSnapshotsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListConfigSnapshotsResponseMultiError, or nil if none found.
+func (m *ListConfigSnapshotsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListConfigSnapshotsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSnapshots() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListConfigSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListConfigSnapshotsResponseValidationError{
+						field:  fmt.Sprintf("Snapshots[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListConfigSnapshotsResponseValidationError{
+					field:  fmt.Sprintf("Snapshots[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListConfigSnapshotsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListConfigSnapshotsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListConfigSnapshotsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListConfigSnapshotsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListConfigSnapshotsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListConfigSnapshotsResponseMultiError) AllErrors() []error { return m }
+
+// ListConfigSnapshotsResponseValidationError is the validation error returned
+// by ListConfigSnapshotsResponse.Validate if the designated constraints
+// aren't met.
+type ListConfigSnapshotsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListConfigSnapshotsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListConfigSnapshotsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListConfigSnapshotsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListConfigSnapshotsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListConfigSnapshotsResponseValidationError) ErrorName() string {
+	return "ListConfigSnapshotsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListConfigSnapshotsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListConfigSnapshotsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListConfigSnapshotsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListConfigSnapshotsResponseValidationError{}
+
+// Validate checks the field values on SampleStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SampleStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SampleStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SampleStatsRequestMultiError, or nil if none found.
+func (m *SampleStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SampleStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() == nil {
+		err := SampleStatsRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SampleStatsRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SampleStatsRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SampleStatsRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetInterval() == nil {
+		err := SampleStatsRequestValidationError{
+			field:  "Interval",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if d := m.GetInterval(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = SampleStatsRequestValidationError{
+				field:  "Interval",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			lte := time.Duration(60*time.Second + 0*time.Nanosecond)
+			gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+			if dur < gte || dur > lte {
+				err := SampleStatsRequestValidationError{
+					field:  "Interval",
+					reason: "value must be inside range [1s, 1m0s]",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
+	if m.GetSamples() > 10 {
+		err := SampleStatsRequestValidationError{
+			field:  "Samples",
+			reason: "value must be less than or equal to 10",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Filter
+
+	if m.GetTopN() > 1000 {
+		err := SampleStatsRequestValidationError{
+			field:  "TopN",
+			reason: "value must be less than or equal to 1000",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SampleStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SampleStatsRequestMultiError is an error wrapping multiple validation errors
+// returned by SampleStatsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SampleStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SampleStatsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SampleStatsRequestMultiError) AllErrors() []error { return m }
+
+// SampleStatsRequestValidationError is the validation error returned by
+// SampleStatsRequest.Validate if the designated constraints aren't met.
+type SampleStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SampleStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SampleStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SampleStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SampleStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SampleStatsRequestValidationError) ErrorName() string {
+	return "SampleStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SampleStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSampleStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SampleStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SampleStatsRequestValidationError{}
+
+// Validate checks the field values on SampleStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SampleStatsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SampleStatsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SampleStatsResponseMultiError, or nil if none found.
+func (m *SampleStatsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SampleStatsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSample()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SampleStatsResponseValidationError{
+					field:  "Sample",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SampleStatsResponseValidationError{
+					field:  "Sample",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSample()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SampleStatsResponseValidationError{
+				field:  "Sample",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SampleStatsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SampleStatsResponseMultiError is an error wrapping multiple validation
+// errors returned by SampleStatsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SampleStatsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SampleStatsResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SampleStatsResponseMultiError) AllErrors() []error { return m }
+
+// SampleStatsResponseValidationError is the validation error returned by
+// SampleStatsResponse.Validate if the designated constraints aren't met.
+type SampleStatsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SampleStatsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SampleStatsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SampleStatsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SampleStatsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SampleStatsResponseValidationError) ErrorName() string {
+	return "SampleStatsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SampleStatsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSampleStatsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SampleStatsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SampleStatsResponseValidationError{}
+
+// Validate checks the field values on ModifyRuntimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModifyRuntimeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModifyRuntimeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModifyRuntimeRequestMultiError, or nil if none found.
+func (m *ModifyRuntimeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModifyRuntimeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() == nil {
+		err := ModifyRuntimeRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ModifyRuntimeRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ModifyRuntimeRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ModifyRuntimeRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(m.GetOverrides()) < 1 {
+		err := ModifyRuntimeRequestValidationError{
+			field:  "Overrides",
+			reason: "value must contain at least 1 pair(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	{
+		sorted_keys := make([]string, len(m.GetOverrides()))
+		i := 0
+		for key := range m.GetOverrides() {
+			sorted_keys[i] = key
+			i++
+		}
+		sort.Slice(sorted_keys, func(i, j int) bool { return sorted_keys[i] < sorted_keys[j] })
+		for _, key := range sorted_keys {
+			val := m.GetOverrides()[key]
+			_ = val
+
+			if utf8.RuneCountInString(key) < 1 {
+				err := ModifyRuntimeRequestValidationError{
+					field:  fmt.Sprintf("Overrides[%v]", key),
+					reason: "value length must be at least 1 runes",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+			// no validation rules for Overrides[key]
+		}
+	}
+
+	if len(errors) > 0 {
+		return ModifyRuntimeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModifyRuntimeRequestMultiError is an error wrapping multiple validation
+// errors returned by ModifyRuntimeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ModifyRuntimeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModifyRuntimeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModifyRuntimeRequestMultiError) AllErrors() []error { return m }
+
+// ModifyRuntimeRequestValidationError is the validation error returned by
+// ModifyRuntimeRequest.Validate if the designated constraints aren't met.
+type ModifyRuntimeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModifyRuntimeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModifyRuntimeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModifyRuntimeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModifyRuntimeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModifyRuntimeRequestValidationError) ErrorName() string {
+	return "ModifyRuntimeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModifyRuntimeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModifyRuntimeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModifyRuntimeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModifyRuntimeRequestValidationError{}
+
+// Validate checks the field values on ModifyRuntimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ModifyRuntimeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ModifyRuntimeResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ModifyRuntimeResponseMultiError, or nil if none found.
+func (m *ModifyRuntimeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ModifyRuntimeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ModifyRuntimeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ModifyRuntimeResponseMultiError is an error wrapping multiple validation
+// errors returned by ModifyRuntimeResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ModifyRuntimeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ModifyRuntimeResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ModifyRuntimeResponseMultiError) AllErrors() []error { return m }
+
+// ModifyRuntimeResponseValidationError is the validation error returned by
+// ModifyRuntimeResponse.Validate if the designated constraints aren't met.
+type ModifyRuntimeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ModifyRuntimeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ModifyRuntimeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ModifyRuntimeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ModifyRuntimeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ModifyRuntimeResponseValidationError) ErrorName() string {
+	return "ModifyRuntimeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ModifyRuntimeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sModifyRuntimeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ModifyRuntimeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ModifyRuntimeResponseValidationError{}
+
+// Validate checks the field values on Logger with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Logger) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Logger with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in LoggerMultiError, or nil if none found.
+func (m *Logger) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Logger) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Level
+
+	if len(errors) > 0 {
+		return LoggerMultiError(errors)
+	}
+
+	return nil
+}
+
+// LoggerMultiError is an error wrapping multiple validation errors returned by
+// Logger.ValidateAll() if the designated constraints aren't met.
+type LoggerMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LoggerMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LoggerMultiError) AllErrors() []error { return m }
+
+// LoggerValidationError is the validation error returned by Logger.Validate if
+// the designated constraints aren't met.
+type LoggerValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LoggerValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LoggerValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LoggerValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LoggerValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LoggerValidationError) ErrorName() string { return "LoggerValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LoggerValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogger.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LoggerValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LoggerValidationError{}
+
+// Validate checks the field values on SetLogLevelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetLogLevelRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetLogLevelRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetLogLevelRequestMultiError, or nil if none found.
+func (m *SetLogLevelRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetLogLevelRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() == nil {
+		err := SetLogLevelRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetLogLevelRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetLogLevelRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetLogLevelRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Logger
+
+	if _, ok := _SetLogLevelRequest_Level_NotInLookup[m.GetLevel()]; ok {
+		err := SetLogLevelRequestValidationError{
+			field:  "Level",
+			reason: "value must not be in list [UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := SetLogLevelRequest_Level_name[int32(m.GetLevel())]; !ok {
+		err := SetLogLevelRequestValidationError{
+			field:  "Level",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetLogLevelRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetLogLevelRequestMultiError is an error wrapping multiple validation errors
+// returned by SetLogLevelRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SetLogLevelRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetLogLevelRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetLogLevelRequestMultiError) AllErrors() []error { return m }
+
+// SetLogLevelRequestValidationError is the validation error returned by
+// SetLogLevelRequest.Validate if the designated constraints aren't met.
+type SetLogLevelRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLogLevelRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLogLevelRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLogLevelRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLogLevelRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLogLevelRequestValidationError) ErrorName() string {
+	return "SetLogLevelRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLogLevelRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLogLevelRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLogLevelRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLogLevelRequestValidationError{}
+
+var _SetLogLevelRequest_Level_NotInLookup = map[SetLogLevelRequest_Level]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on SetLogLevelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetLogLevelResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetLogLevelResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetLogLevelResponseMultiError, or nil if none found.
+func (m *SetLogLevelResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetLogLevelResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetLoggers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetLogLevelResponseValidationError{
+						field:  fmt.Sprintf("Loggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetLogLevelResponseValidationError{
+						field:  fmt.Sprintf("Loggers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetLogLevelResponseValidationError{
+					field:  fmt.Sprintf("Loggers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SetLogLevelResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetLogLevelResponseMultiError is an error wrapping multiple validation
+// errors returned by SetLogLevelResponse.ValidateAll() if the designated
+// constraints aren't met.
+type SetLogLevelResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetLogLevelResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetLogLevelResponseMultiError) AllErrors() []error { return m }
+
+// SetLogLevelResponseValidationError is the validation error returned by
+// SetLogLevelResponse.Validate if the designated constraints aren't met.
+type SetLogLevelResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLogLevelResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLogLevelResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLogLevelResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLogLevelResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLogLevelResponseValidationError) ErrorName() string {
+	return "SetLogLevelResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetLogLevelResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLogLevelResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLogLevelResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLogLevelResponseValidationError{}
+
+// Validate checks the field values on FailHealthcheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FailHealthcheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FailHealthcheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FailHealthcheckRequestMultiError, or nil if none found.
+func (m *FailHealthcheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FailHealthcheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() == nil {
+		err := FailHealthcheckRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, FailHealthcheckRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, FailHealthcheckRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return FailHealthcheckRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return FailHealthcheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// FailHealthcheckRequestMultiError is an error wrapping multiple validation
+// errors returned by FailHealthcheckRequest.ValidateAll() if the designated
+// constraints aren't met.
+type FailHealthcheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FailHealthcheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FailHealthcheckRequestMultiError) AllErrors() []error { return m }
+
+// FailHealthcheckRequestValidationError is the validation error returned by
+// FailHealthcheckRequest.Validate if the designated constraints aren't met.
+type FailHealthcheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailHealthcheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailHealthcheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailHealthcheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailHealthcheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailHealthcheckRequestValidationError) ErrorName() string {
+	return "FailHealthcheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FailHealthcheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailHealthcheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailHealthcheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailHealthcheckRequestValidationError{}
+
+// Validate checks the field values on FailHealthcheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FailHealthcheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FailHealthcheckResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FailHealthcheckResponseMultiError, or nil if none found.
+func (m *FailHealthcheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FailHealthcheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return FailHealthcheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// FailHealthcheckResponseMultiError is an error wrapping multiple validation
+// errors returned by FailHealthcheckResponse.ValidateAll() if the designated
+// constraints aren't met.
+type FailHealthcheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m FailHealthcheckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m FailHealthcheckResponseMultiError) AllErrors() []error { return m }
+
+// FailHealthcheckResponseValidationError is the validation error returned by
+// FailHealthcheckResponse.Validate if the designated constraints aren't met.
+type FailHealthcheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e FailHealthcheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e FailHealthcheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e FailHealthcheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e FailHealthcheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e FailHealthcheckResponseValidationError) ErrorName() string {
+	return "FailHealthcheckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e FailHealthcheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sFailHealthcheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = FailHealthcheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = FailHealthcheckResponseValidationError{}
+
+// Validate checks the field values on PassHealthcheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PassHealthcheckRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PassHealthcheckRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PassHealthcheckRequestMultiError, or nil if none found.
+func (m *PassHealthcheckRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PassHealthcheckRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() == nil {
+		err := PassHealthcheckRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PassHealthcheckRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PassHealthcheckRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PassHealthcheckRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PassHealthcheckRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PassHealthcheckRequestMultiError is an error wrapping multiple validation
+// errors returned by PassHealthcheckRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PassHealthcheckRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PassHealthcheckRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PassHealthcheckRequestMultiError) AllErrors() []error { return m }
+
+// PassHealthcheckRequestValidationError is the validation error returned by
+// PassHealthcheckRequest.Validate if the designated constraints aren't met.
+type PassHealthcheckRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PassHealthcheckRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PassHealthcheckRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PassHealthcheckRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PassHealthcheckRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PassHealthcheckRequestValidationError) ErrorName() string {
+	return "PassHealthcheckRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PassHealthcheckRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPassHealthcheckRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PassHealthcheckRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PassHealthcheckRequestValidationError{}
+
+// Validate checks the field values on PassHealthcheckResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PassHealthcheckResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PassHealthcheckResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PassHealthcheckResponseMultiError, or nil if none found.
+func (m *PassHealthcheckResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PassHealthcheckResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return PassHealthcheckResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PassHealthcheckResponseMultiError is an error wrapping multiple validation
+// errors returned by PassHealthcheckResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PassHealthcheckResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PassHealthcheckResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PassHealthcheckResponseMultiError) AllErrors() []error { return m }
+
+// PassHealthcheckResponseValidationError is the validation error returned by
+// PassHealthcheckResponse.Validate if the designated constraints aren't met.
+type PassHealthcheckResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PassHealthcheckResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PassHealthcheckResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PassHealthcheckResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PassHealthcheckResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PassHealthcheckResponseValidationError) ErrorName() string {
+	return "PassHealthcheckResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PassHealthcheckResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPassHealthcheckResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PassHealthcheckResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PassHealthcheckResponseValidationError{}
+
+// Validate checks the field values on DrainListenersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DrainListenersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DrainListenersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DrainListenersRequestMultiError, or nil if none found.
+func (m *DrainListenersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DrainListenersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAddress() == nil {
+		err := DrainListenersRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DrainListenersRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DrainListenersRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DrainListenersRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InboundOnly
+
+	// no validation rules for Graceful
+
+	if len(errors) > 0 {
+		return DrainListenersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DrainListenersRequestMultiError is an error wrapping multiple validation
+// errors returned by DrainListenersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DrainListenersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DrainListenersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DrainListenersRequestMultiError) AllErrors() []error { return m }
+
+// DrainListenersRequestValidationError is the validation error returned by
+// DrainListenersRequest.Validate if the designated constraints aren't met.
+type DrainListenersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DrainListenersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DrainListenersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DrainListenersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DrainListenersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DrainListenersRequestValidationError) ErrorName() string {
+	return "DrainListenersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DrainListenersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDrainListenersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DrainListenersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DrainListenersRequestValidationError{}
+
+// Validate checks the field values on DrainListenersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DrainListenersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DrainListenersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DrainListenersResponseMultiError, or nil if none found.
+func (m *DrainListenersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DrainListenersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return DrainListenersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DrainListenersResponseMultiError is an error wrapping multiple validation
+// errors returned by DrainListenersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DrainListenersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DrainListenersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DrainListenersResponseMultiError) AllErrors() []error { return m }
+
+// DrainListenersResponseValidationError is the validation error returned by
+// DrainListenersResponse.Validate if the designated constraints aren't met.
+type DrainListenersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DrainListenersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DrainListenersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DrainListenersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DrainListenersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DrainListenersResponseValidationError) ErrorName() string {
+	return "DrainListenersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DrainListenersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDrainListenersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DrainListenersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DrainListenersResponseValidationError{}
+
+// Validate checks the field values on ReadOperation_Include with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReadOperation_Include) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReadOperation_Include with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReadOperation_IncludeMultiError, or nil if none found.
+func (m *ReadOperation_Include) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReadOperation_Include) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Clusters
+
+	// no validation rules for ConfigDump
+
+	// no validation rules for Listeners
+
+	// no validation rules for Runtime
+
+	// no validation rules for Stats
+
+	// no validation rules for ServerInfo
+
+	// no validation rules for Certs
+
+	if len(errors) > 0 {
+		return ReadOperation_IncludeMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReadOperation_IncludeMultiError is an error wrapping multiple validation
+// errors returned by ReadOperation_Include.ValidateAll() if the designated
+// constraints aren't met.
+type ReadOperation_IncludeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReadOperation_IncludeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReadOperation_IncludeMultiError) AllErrors() []error { return m }
+
+// ReadOperation_IncludeValidationError is the validation error returned by
+// ReadOperation_Include.Validate if the designated constraints aren't met.
+type ReadOperation_IncludeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReadOperation_IncludeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReadOperation_IncludeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReadOperation_IncludeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReadOperation_IncludeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReadOperation_IncludeValidationError) ErrorName() string {
+	return "ReadOperation_IncludeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReadOperation_IncludeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReadOperation_Include.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReadOperation_IncludeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReadOperation_IncludeValidationError{}
+
+// Validate checks the field values on Address_Pod with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Address_Pod) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Address_Pod with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Address_PodMultiError, or
+// nil if none found.
+func (m *Address_Pod) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Address_Pod) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Clientset
+
+	// no validation rules for Cluster
+
+	if len(m.GetNamespace()) < 1 {
+		err := Address_PodValidationError{
+			field:  "Namespace",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetName()) < 1 {
+		err := Address_PodValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 bytes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return Address_PodMultiError(errors)
+	}
+
+	return nil
+}
+
+// Address_PodMultiError is an error wrapping multiple validation errors
+// returned by Address_Pod.ValidateAll() if the designated constraints aren't met.
+type Address_PodMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Address_PodMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Address_PodMultiError) AllErrors() []error { return m }
+
+// Address_PodValidationError is the validation error returned by
+// Address_Pod.Validate if the designated constraints aren't met.
+type Address_PodValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Address_PodValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Address_PodValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Address_PodValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Address_PodValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Address_PodValidationError) ErrorName() string { return "Address_PodValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Address_PodValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddress_Pod.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Address_PodValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Address_PodValidationError{}
+
+// Validate checks the field values on Result_Output with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Result_Output) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Result_Output with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in Result_OutputMultiError, or
+// nil if none found.
+func (m *Result_Output) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Result_Output) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClusters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Clusters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Clusters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClusters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Result_OutputValidationError{
+				field:  "Clusters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetConfigDump()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "ConfigDump",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "ConfigDump",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConfigDump()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Result_OutputValidationError{
+				field:  "ConfigDump",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetListeners()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Listeners",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Listeners",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetListeners()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Result_OutputValidationError{
+				field:  "Listeners",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRuntime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Runtime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Runtime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRuntime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Result_OutputValidationError{
+				field:  "Runtime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Stats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Result_OutputValidationError{
+				field:  "Stats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetServerInfo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "ServerInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "ServerInfo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetServerInfo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Result_OutputValidationError{
+				field:  "ServerInfo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCertificates()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Certificates",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Result_OutputValidationError{
+					field:  "Certificates",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCertificates()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Result_OutputValidationError{
+				field:  "Certificates",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Result_OutputMultiError(errors)
+	}
+
+	return nil
+}
+
+// Result_OutputMultiError is an error wrapping multiple validation errors
+// returned by Result_Output.ValidateAll() if the designated constraints
+// aren't met.
+type Result_OutputMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Result_OutputMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Result_OutputMultiError) AllErrors() []error { return m }
+
+// Result_OutputValidationError is the validation error returned by
+// Result_Output.Validate if the designated constraints aren't met.
+type Result_OutputValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Result_OutputValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Result_OutputValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Result_OutputValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Result_OutputValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Result_OutputValidationError) ErrorName() string { return "Result_OutputValidationError" }
+
+// Error satisfies the builtin error interface
+func (e Result_OutputValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResult_Output.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Result_OutputValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Result_OutputValidationError{}
+
+// Validate checks the field values on BatchReadResponse_Failure with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchReadResponse_Failure) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchReadResponse_Failure with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchReadResponse_FailureMultiError, or nil if none found.
+func (m *BatchReadResponse_Failure) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchReadResponse_Failure) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, BatchReadResponse_FailureValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, BatchReadResponse_FailureValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return BatchReadResponse_FailureValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Pod
+
+	// no validation rules for Error
+
+	if len(errors) > 0 {
+		return BatchReadResponse_FailureMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchReadResponse_FailureMultiError is an error wrapping multiple validation
+// errors returned by BatchReadResponse_Failure.ValidateAll() if the
+// designated constraints aren't met.
+type BatchReadResponse_FailureMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchReadResponse_FailureMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchReadResponse_FailureMultiError) AllErrors() []error { return m }
+
+// BatchReadResponse_FailureValidationError is the validation error returned by
+// BatchReadResponse_Failure.Validate if the designated constraints aren't met.
+type BatchReadResponse_FailureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchReadResponse_FailureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchReadResponse_FailureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchReadResponse_FailureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchReadResponse_FailureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchReadResponse_FailureValidationError) ErrorName() string {
+	return "BatchReadResponse_FailureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchReadResponse_FailureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchReadResponse_Failure.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchReadResponse_FailureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchReadResponse_FailureValidationError{}
+
+// Validate checks the field values on Aggregate_UpstreamHealth with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Aggregate_UpstreamHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Aggregate_UpstreamHealth with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Aggregate_UpstreamHealthMultiError, or nil if none found.
+func (m *Aggregate_UpstreamHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Aggregate_UpstreamHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ReportingHosts
+
+	// no validation rules for Healthy
+
+	// no validation rules for Unhealthy
+
+	if len(errors) > 0 {
+		return Aggregate_UpstreamHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// Aggregate_UpstreamHealthMultiError is an error wrapping multiple validation
+// errors returned by Aggregate_UpstreamHealth.ValidateAll() if the designated
+// constraints aren't met.
+type Aggregate_UpstreamHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Aggregate_UpstreamHealthMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Aggregate_UpstreamHealthMultiError) AllErrors() []error { return m }
+
+// Aggregate_UpstreamHealthValidationError is the validation error returned by
+// Aggregate_UpstreamHealth.Validate if the designated constraints aren't met.
+type Aggregate_UpstreamHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Aggregate_UpstreamHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Aggregate_UpstreamHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Aggregate_UpstreamHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Aggregate_UpstreamHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Aggregate_UpstreamHealthValidationError) ErrorName() string {
+	return "Aggregate_UpstreamHealthValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Aggregate_UpstreamHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregate_UpstreamHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Aggregate_UpstreamHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Aggregate_UpstreamHealthValidationError{}
+
+// Validate checks the field values on Aggregate_ConfigDrift with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Aggregate_ConfigDrift) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Aggregate_ConfigDrift with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Aggregate_ConfigDriftMultiError, or nil if none found.
+func (m *Aggregate_ConfigDrift) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Aggregate_ConfigDrift) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MajorityHash
+
+	// no validation rules for MajorityHosts
+
+	for idx, item := range m.GetDifferingHosts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, Aggregate_ConfigDriftValidationError{
+						field:  fmt.Sprintf("DifferingHosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, Aggregate_ConfigDriftValidationError{
+						field:  fmt.Sprintf("DifferingHosts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return Aggregate_ConfigDriftValidationError{
+					field:  fmt.Sprintf("DifferingHosts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return Aggregate_ConfigDriftMultiError(errors)
+	}
+
+	return nil
+}
+
+// Aggregate_ConfigDriftMultiError is an error wrapping multiple validation
+// errors returned by Aggregate_ConfigDrift.ValidateAll() if the designated
+// constraints aren't met.
+type Aggregate_ConfigDriftMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Aggregate_ConfigDriftMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Aggregate_ConfigDriftMultiError) AllErrors() []error { return m }
+
+// Aggregate_ConfigDriftValidationError is the validation error returned by
+// Aggregate_ConfigDrift.Validate if the designated constraints aren't met.
+type Aggregate_ConfigDriftValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Aggregate_ConfigDriftValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Aggregate_ConfigDriftValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Aggregate_ConfigDriftValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Aggregate_ConfigDriftValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Aggregate_ConfigDriftValidationError) ErrorName() string {
+	return "Aggregate_ConfigDriftValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Aggregate_ConfigDriftValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregate_ConfigDrift.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Aggregate_ConfigDriftValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Aggregate_ConfigDriftValidationError{}
+
+// Validate checks the field values on Aggregate_ExpiringCertificate with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Aggregate_ExpiringCertificate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Aggregate_ExpiringCertificate with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// Aggregate_ExpiringCertificateMultiError, or nil if none found.
+func (m *Aggregate_ExpiringCertificate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Aggregate_ExpiringCertificate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Aggregate_ExpiringCertificateValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Aggregate_ExpiringCertificateValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Aggregate_ExpiringCertificateValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCertificate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, Aggregate_ExpiringCertificateValidationError{
+					field:  "Certificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Aggregate_ExpiringCertificateValidationError{
+					field:  "Certificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCertificate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Aggregate_ExpiringCertificateValidationError{
+				field:  "Certificate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return Aggregate_ExpiringCertificateMultiError(errors)
+	}
+
+	return nil
+}
+
+// Aggregate_ExpiringCertificateMultiError is an error wrapping multiple
+// validation errors returned by Aggregate_ExpiringCertificate.ValidateAll()
+// if the designated constraints aren't met.
+type Aggregate_ExpiringCertificateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Aggregate_ExpiringCertificateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Aggregate_ExpiringCertificateMultiError) AllErrors() []error { return m }
+
+// Aggregate_ExpiringCertificateValidationError is the validation error
+// returned by Aggregate_ExpiringCertificate.Validate if the designated
+// constraints aren't met.
+type Aggregate_ExpiringCertificateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Aggregate_ExpiringCertificateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Aggregate_ExpiringCertificateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Aggregate_ExpiringCertificateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Aggregate_ExpiringCertificateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Aggregate_ExpiringCertificateValidationError) ErrorName() string {
+	return "Aggregate_ExpiringCertificateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Aggregate_ExpiringCertificateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregate_ExpiringCertificate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Aggregate_ExpiringCertificateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Aggregate_ExpiringCertificateValidationError{}
+
+// Validate checks the field values on Aggregate_ConfigDrift_Host with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *Aggregate_ConfigDrift_Host) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Aggregate_ConfigDrift_Host with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// Aggregate_ConfigDrift_HostMultiError, or nil if none found.
+func (m *Aggregate_ConfigDrift_Host) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Aggregate_ConfigDrift_Host) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "ConfigDump",
+				errors = append(errors, Aggregate_ConfigDrift_HostValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, Aggregate_ConfigDrift_HostValidationError{
+					field:  "Address",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
-		case interface{ Validate() error }:
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return Aggregate_ConfigDrift_HostValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Hash
+
+	if len(errors) > 0 {
+		return Aggregate_ConfigDrift_HostMultiError(errors)
+	}
+
+	return nil
+}
+
+// Aggregate_ConfigDrift_HostMultiError is an error wrapping multiple
+// validation errors returned by Aggregate_ConfigDrift_Host.ValidateAll() if
+// the designated constraints aren't met.
+type Aggregate_ConfigDrift_HostMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m Aggregate_ConfigDrift_HostMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m Aggregate_ConfigDrift_HostMultiError) AllErrors() []error { return m }
+
+// Aggregate_ConfigDrift_HostValidationError is the validation error returned
+// by Aggregate_ConfigDrift_Host.Validate if the designated constraints aren't met.
+type Aggregate_ConfigDrift_HostValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e Aggregate_ConfigDrift_HostValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e Aggregate_ConfigDrift_HostValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e Aggregate_ConfigDrift_HostValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e Aggregate_ConfigDrift_HostValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e Aggregate_ConfigDrift_HostValidationError) ErrorName() string {
+	return "Aggregate_ConfigDrift_HostValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e Aggregate_ConfigDrift_HostValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAggregate_ConfigDrift_Host.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = Aggregate_ConfigDrift_HostValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = Aggregate_ConfigDrift_HostValidationError{}
+
+// Validate checks the field values on StatsSample_Counter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StatsSample_Counter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsSample_Counter with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatsSample_CounterMultiError, or nil if none found.
+func (m *StatsSample_Counter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsSample_Counter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Value
+
+	// no validation rules for Delta
+
+	// no validation rules for RatePerSecond
+
+	if len(errors) > 0 {
+		return StatsSample_CounterMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsSample_CounterMultiError is an error wrapping multiple validation
+// errors returned by StatsSample_Counter.ValidateAll() if the designated
+// constraints aren't met.
+type StatsSample_CounterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsSample_CounterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsSample_CounterMultiError) AllErrors() []error { return m }
+
+// StatsSample_CounterValidationError is the validation error returned by
+// StatsSample_Counter.Validate if the designated constraints aren't met.
+type StatsSample_CounterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsSample_CounterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsSample_CounterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsSample_CounterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsSample_CounterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsSample_CounterValidationError) ErrorName() string {
+	return "StatsSample_CounterValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StatsSample_CounterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsSample_Counter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsSample_CounterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsSample_CounterValidationError{}
+
+// Validate checks the field values on StatsSample_Gauge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StatsSample_Gauge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsSample_Gauge with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatsSample_GaugeMultiError, or nil if none found.
+func (m *StatsSample_Gauge) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsSample_Gauge) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return StatsSample_GaugeMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsSample_GaugeMultiError is an error wrapping multiple validation errors
+// returned by StatsSample_Gauge.ValidateAll() if the designated constraints
+// aren't met.
+type StatsSample_GaugeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsSample_GaugeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsSample_GaugeMultiError) AllErrors() []error { return m }
+
+// StatsSample_GaugeValidationError is the validation error returned by
+// StatsSample_Gauge.Validate if the designated constraints aren't met.
+type StatsSample_GaugeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsSample_GaugeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsSample_GaugeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsSample_GaugeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsSample_GaugeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsSample_GaugeValidationError) ErrorName() string {
+	return "StatsSample_GaugeValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StatsSample_GaugeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsSample_Gauge.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsSample_GaugeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsSample_GaugeValidationError{}
+
+// Validate checks the field values on StatsSample_Histogram with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StatsSample_Histogram) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsSample_Histogram with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatsSample_HistogramMultiError, or nil if none found.
+func (m *StatsSample_Histogram) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsSample_Histogram) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	for idx, item := range m.GetQuantiles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StatsSample_HistogramValidationError{
+						field:  fmt.Sprintf("Quantiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StatsSample_HistogramValidationError{
+						field:  fmt.Sprintf("Quantiles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "ConfigDump",
+				return StatsSample_HistogramValidationError{
+					field:  fmt.Sprintf("Quantiles[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetConfigDump()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return Result_OutputValidationError{
-				field:  "ConfigDump",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	}
+
+	if len(errors) > 0 {
+		return StatsSample_HistogramMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsSample_HistogramMultiError is an error wrapping multiple validation
+// errors returned by StatsSample_Histogram.ValidateAll() if the designated
+// constraints aren't met.
+type StatsSample_HistogramMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsSample_HistogramMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsSample_HistogramMultiError) AllErrors() []error { return m }
+
+// StatsSample_HistogramValidationError is the validation error returned by
+// StatsSample_Histogram.Validate if the designated constraints aren't met.
+type StatsSample_HistogramValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsSample_HistogramValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsSample_HistogramValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsSample_HistogramValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsSample_HistogramValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsSample_HistogramValidationError) ErrorName() string {
+	return "StatsSample_HistogramValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StatsSample_HistogramValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsSample_Histogram.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsSample_HistogramValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsSample_HistogramValidationError{}
+
+// Validate checks the field values on StatsSample_Histogram_Quantile with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StatsSample_Histogram_Quantile) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatsSample_Histogram_Quantile with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StatsSample_Histogram_QuantileMultiError, or nil if none found.
+func (m *StatsSample_Histogram_Quantile) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatsSample_Histogram_Quantile) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Quantile
+
 	if all {
-		switch v := interface{}(m.GetListeners()).(type) {
+		switch v := interface{}(m.GetInterval()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "Listeners",
+				errors = append(errors, StatsSample_Histogram_QuantileValidationError{
+					field:  "Interval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "Listeners",
+				errors = append(errors, StatsSample_Histogram_QuantileValidationError{
+					field:  "Interval",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetListeners()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetInterval()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Result_OutputValidationError{
-				field:  "Listeners",
+			return StatsSample_Histogram_QuantileValidationError{
+				field:  "Interval",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1106,57 +6627,162 @@ func (m *Result_Output) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetRuntime()).(type) {
+		switch v := interface{}(m.GetCumulative()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "Runtime",
+				errors = append(errors, StatsSample_Histogram_QuantileValidationError{
+					field:  "Cumulative",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "Runtime",
+				errors = append(errors, StatsSample_Histogram_QuantileValidationError{
+					field:  "Cumulative",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetRuntime()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetCumulative()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Result_OutputValidationError{
-				field:  "Runtime",
+			return StatsSample_Histogram_QuantileValidationError{
+				field:  "Cumulative",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	if len(errors) > 0 {
+		return StatsSample_Histogram_QuantileMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatsSample_Histogram_QuantileMultiError is an error wrapping multiple
+// validation errors returned by StatsSample_Histogram_Quantile.ValidateAll()
+// if the designated constraints aren't met.
+type StatsSample_Histogram_QuantileMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatsSample_Histogram_QuantileMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatsSample_Histogram_QuantileMultiError) AllErrors() []error { return m }
+
+// StatsSample_Histogram_QuantileValidationError is the validation error
+// returned by StatsSample_Histogram_Quantile.Validate if the designated
+// constraints aren't met.
+type StatsSample_Histogram_QuantileValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatsSample_Histogram_QuantileValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatsSample_Histogram_QuantileValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatsSample_Histogram_QuantileValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatsSample_Histogram_QuantileValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatsSample_Histogram_QuantileValidationError) ErrorName() string {
+	return "StatsSample_Histogram_QuantileValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StatsSample_Histogram_QuantileValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatsSample_Histogram_Quantile.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatsSample_Histogram_QuantileValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatsSample_Histogram_QuantileValidationError{}
+
+// Validate checks the field values on ConfigDiff_Field with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ConfigDiff_Field) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ConfigDiff_Field with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ConfigDiff_FieldMultiError, or nil if none found.
+func (m *ConfigDiff_Field) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ConfigDiff_Field) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
 	if all {
-		switch v := interface{}(m.GetStats()).(type) {
+		switch v := interface{}(m.GetBase()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "Stats",
+				errors = append(errors, ConfigDiff_FieldValidationError{
+					field:  "Base",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "Stats",
+				errors = append(errors, ConfigDiff_FieldValidationError{
+					field:  "Base",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetStats()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetBase()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Result_OutputValidationError{
-				field:  "Stats",
+			return ConfigDiff_FieldValidationError{
+				field:  "Base",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1164,28 +6790,28 @@ func (m *Result_Output) validate(all bool) error {
 	}
 
 	if all {
-		switch v := interface{}(m.GetServerInfo()).(type) {
+		switch v := interface{}(m.GetTarget()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "ServerInfo",
+				errors = append(errors, ConfigDiff_FieldValidationError{
+					field:  "Target",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, Result_OutputValidationError{
-					field:  "ServerInfo",
+				errors = append(errors, ConfigDiff_FieldValidationError{
+					field:  "Target",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetServerInfo()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return Result_OutputValidationError{
-				field:  "ServerInfo",
+			return ConfigDiff_FieldValidationError{
+				field:  "Target",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -1193,19 +6819,19 @@ func (m *Result_Output) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return Result_OutputMultiError(errors)
+		return ConfigDiff_FieldMultiError(errors)
 	}
 
 	return nil
 }
 
-// Result_OutputMultiError is an error wrapping multiple validation errors
-// returned by Result_Output.ValidateAll() if the designated constraints
+// ConfigDiff_FieldMultiError is an error wrapping multiple validation errors
+// returned by ConfigDiff_Field.ValidateAll() if the designated constraints
 // aren't met.
-type Result_OutputMultiError []error
+type ConfigDiff_FieldMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m Result_OutputMultiError) Error() string {
+func (m ConfigDiff_FieldMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1214,11 +6840,11 @@ func (m Result_OutputMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m Result_OutputMultiError) AllErrors() []error { return m }
+func (m ConfigDiff_FieldMultiError) AllErrors() []error { return m }
 
-// Result_OutputValidationError is the validation error returned by
-// Result_Output.Validate if the designated constraints aren't met.
-type Result_OutputValidationError struct {
+// ConfigDiff_FieldValidationError is the validation error returned by
+// ConfigDiff_Field.Validate if the designated constraints aren't met.
+type ConfigDiff_FieldValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1226,22 +6852,22 @@ type Result_OutputValidationError struct {
 }
 
 // Field function returns field value.
-func (e Result_OutputValidationError) Field() string { return e.field }
+func (e ConfigDiff_FieldValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e Result_OutputValidationError) Reason() string { return e.reason }
+func (e ConfigDiff_FieldValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e Result_OutputValidationError) Cause() error { return e.cause }
+func (e ConfigDiff_FieldValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e Result_OutputValidationError) Key() bool { return e.key }
+func (e ConfigDiff_FieldValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e Result_OutputValidationError) ErrorName() string { return "Result_OutputValidationError" }
+func (e ConfigDiff_FieldValidationError) ErrorName() string { return "ConfigDiff_FieldValidationError" }
 
 // Error satisfies the builtin error interface
-func (e Result_OutputValidationError) Error() string {
+func (e ConfigDiff_FieldValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1253,14 +6879,14 @@ func (e Result_OutputValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sResult_Output.%s: %s%s",
+		"invalid %sConfigDiff_Field.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = Result_OutputValidationError{}
+var _ error = ConfigDiff_FieldValidationError{}
 
 var _ interface {
 	Field() string
@@ -1268,4 +6894,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = Result_OutputValidationError{}
+} = ConfigDiff_FieldValidationError{}
@@ -19,7 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	EnvoyTriageAPI_Read_FullMethodName = "/clutch.envoytriage.v1.EnvoyTriageAPI/Read"
+	EnvoyTriageAPI_Read_FullMethodName                 = "/clutch.envoytriage.v1.EnvoyTriageAPI/Read"
+	EnvoyTriageAPI_BatchRead_FullMethodName            = "/clutch.envoytriage.v1.EnvoyTriageAPI/BatchRead"
+	EnvoyTriageAPI_SampleStats_FullMethodName          = "/clutch.envoytriage.v1.EnvoyTriageAPI/SampleStats"
+	EnvoyTriageAPI_CompareConfig_FullMethodName        = "/clutch.envoytriage.v1.EnvoyTriageAPI/CompareConfig"
+	EnvoyTriageAPI_CreateConfigSnapshot_FullMethodName = "/clutch.envoytriage.v1.EnvoyTriageAPI/CreateConfigSnapshot"
+	EnvoyTriageAPI_ListConfigSnapshots_FullMethodName  = "/clutch.envoytriage.v1.EnvoyTriageAPI/ListConfigSnapshots"
+	EnvoyTriageAPI_ModifyRuntime_FullMethodName        = "/clutch.envoytriage.v1.EnvoyTriageAPI/ModifyRuntime"
+	EnvoyTriageAPI_SetLogLevel_FullMethodName          = "/clutch.envoytriage.v1.EnvoyTriageAPI/SetLogLevel"
+	EnvoyTriageAPI_FailHealthcheck_FullMethodName      = "/clutch.envoytriage.v1.EnvoyTriageAPI/FailHealthcheck"
+	EnvoyTriageAPI_PassHealthcheck_FullMethodName      = "/clutch.envoytriage.v1.EnvoyTriageAPI/PassHealthcheck"
+	EnvoyTriageAPI_DrainListeners_FullMethodName       = "/clutch.envoytriage.v1.EnvoyTriageAPI/DrainListeners"
 )
 
 // EnvoyTriageAPIClient is the client API for EnvoyTriageAPI service.
@@ -27,6 +37,16 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type EnvoyTriageAPIClient interface {
 	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (*ReadResponse, error)
+	BatchRead(ctx context.Context, in *BatchReadRequest, opts ...grpc.CallOption) (*BatchReadResponse, error)
+	SampleStats(ctx context.Context, in *SampleStatsRequest, opts ...grpc.CallOption) (*SampleStatsResponse, error)
+	CompareConfig(ctx context.Context, in *CompareConfigRequest, opts ...grpc.CallOption) (*CompareConfigResponse, error)
+	CreateConfigSnapshot(ctx context.Context, in *CreateConfigSnapshotRequest, opts ...grpc.CallOption) (*CreateConfigSnapshotResponse, error)
+	ListConfigSnapshots(ctx context.Context, in *ListConfigSnapshotsRequest, opts ...grpc.CallOption) (*ListConfigSnapshotsResponse, error)
+	ModifyRuntime(ctx context.Context, in *ModifyRuntimeRequest, opts ...grpc.CallOption) (*ModifyRuntimeResponse, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	FailHealthcheck(ctx context.Context, in *FailHealthcheckRequest, opts ...grpc.CallOption) (*FailHealthcheckResponse, error)
+	PassHealthcheck(ctx context.Context, in *PassHealthcheckRequest, opts ...grpc.CallOption) (*PassHealthcheckResponse, error)
+	DrainListeners(ctx context.Context, in *DrainListenersRequest, opts ...grpc.CallOption) (*DrainListenersResponse, error)
 }
 
 type envoyTriageAPIClient struct {
@@ -46,11 +66,111 @@ func (c *envoyTriageAPIClient) Read(ctx context.Context, in *ReadRequest, opts .
 	return out, nil
 }
 
+func (c *envoyTriageAPIClient) BatchRead(ctx context.Context, in *BatchReadRequest, opts ...grpc.CallOption) (*BatchReadResponse, error) {
+	out := new(BatchReadResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_BatchRead_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) SampleStats(ctx context.Context, in *SampleStatsRequest, opts ...grpc.CallOption) (*SampleStatsResponse, error) {
+	out := new(SampleStatsResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_SampleStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) CompareConfig(ctx context.Context, in *CompareConfigRequest, opts ...grpc.CallOption) (*CompareConfigResponse, error) {
+	out := new(CompareConfigResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_CompareConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) CreateConfigSnapshot(ctx context.Context, in *CreateConfigSnapshotRequest, opts ...grpc.CallOption) (*CreateConfigSnapshotResponse, error) {
+	out := new(CreateConfigSnapshotResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_CreateConfigSnapshot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) ListConfigSnapshots(ctx context.Context, in *ListConfigSnapshotsRequest, opts ...grpc.CallOption) (*ListConfigSnapshotsResponse, error) {
+	out := new(ListConfigSnapshotsResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_ListConfigSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) ModifyRuntime(ctx context.Context, in *ModifyRuntimeRequest, opts ...grpc.CallOption) (*ModifyRuntimeResponse, error) {
+	out := new(ModifyRuntimeResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_ModifyRuntime_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) FailHealthcheck(ctx context.Context, in *FailHealthcheckRequest, opts ...grpc.CallOption) (*FailHealthcheckResponse, error) {
+	out := new(FailHealthcheckResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_FailHealthcheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) PassHealthcheck(ctx context.Context, in *PassHealthcheckRequest, opts ...grpc.CallOption) (*PassHealthcheckResponse, error) {
+	out := new(PassHealthcheckResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_PassHealthcheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *envoyTriageAPIClient) DrainListeners(ctx context.Context, in *DrainListenersRequest, opts ...grpc.CallOption) (*DrainListenersResponse, error) {
+	out := new(DrainListenersResponse)
+	err := c.cc.Invoke(ctx, EnvoyTriageAPI_DrainListeners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EnvoyTriageAPIServer is the server API for EnvoyTriageAPI service.
 // All implementations should embed UnimplementedEnvoyTriageAPIServer
 // for forward compatibility
 type EnvoyTriageAPIServer interface {
 	Read(context.Context, *ReadRequest) (*ReadResponse, error)
+	BatchRead(context.Context, *BatchReadRequest) (*BatchReadResponse, error)
+	SampleStats(context.Context, *SampleStatsRequest) (*SampleStatsResponse, error)
+	CompareConfig(context.Context, *CompareConfigRequest) (*CompareConfigResponse, error)
+	CreateConfigSnapshot(context.Context, *CreateConfigSnapshotRequest) (*CreateConfigSnapshotResponse, error)
+	ListConfigSnapshots(context.Context, *ListConfigSnapshotsRequest) (*ListConfigSnapshotsResponse, error)
+	ModifyRuntime(context.Context, *ModifyRuntimeRequest) (*ModifyRuntimeResponse, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	FailHealthcheck(context.Context, *FailHealthcheckRequest) (*FailHealthcheckResponse, error)
+	PassHealthcheck(context.Context, *PassHealthcheckRequest) (*PassHealthcheckResponse, error)
+	DrainListeners(context.Context, *DrainListenersRequest) (*DrainListenersResponse, error)
 }
 
 // UnimplementedEnvoyTriageAPIServer should be embedded to have forward compatible implementations.
@@ -60,6 +180,36 @@ type UnimplementedEnvoyTriageAPIServer struct {
 func (UnimplementedEnvoyTriageAPIServer) Read(context.Context, *ReadRequest) (*ReadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Read not implemented")
 }
+func (UnimplementedEnvoyTriageAPIServer) BatchRead(context.Context, *BatchReadRequest) (*BatchReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchRead not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) SampleStats(context.Context, *SampleStatsRequest) (*SampleStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SampleStats not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) CompareConfig(context.Context, *CompareConfigRequest) (*CompareConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompareConfig not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) CreateConfigSnapshot(context.Context, *CreateConfigSnapshotRequest) (*CreateConfigSnapshotResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateConfigSnapshot not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) ListConfigSnapshots(context.Context, *ListConfigSnapshotsRequest) (*ListConfigSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConfigSnapshots not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) ModifyRuntime(context.Context, *ModifyRuntimeRequest) (*ModifyRuntimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyRuntime not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) FailHealthcheck(context.Context, *FailHealthcheckRequest) (*FailHealthcheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailHealthcheck not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) PassHealthcheck(context.Context, *PassHealthcheckRequest) (*PassHealthcheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PassHealthcheck not implemented")
+}
+func (UnimplementedEnvoyTriageAPIServer) DrainListeners(context.Context, *DrainListenersRequest) (*DrainListenersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainListeners not implemented")
+}
 
 // UnsafeEnvoyTriageAPIServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EnvoyTriageAPIServer will
@@ -90,6 +240,186 @@ func _EnvoyTriageAPI_Read_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EnvoyTriageAPI_BatchRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).BatchRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_BatchRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).BatchRead(ctx, req.(*BatchReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_SampleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SampleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).SampleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_SampleStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).SampleStats(ctx, req.(*SampleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_CompareConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompareConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).CompareConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_CompareConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).CompareConfig(ctx, req.(*CompareConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_CreateConfigSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateConfigSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).CreateConfigSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_CreateConfigSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).CreateConfigSnapshot(ctx, req.(*CreateConfigSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_ListConfigSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListConfigSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).ListConfigSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_ListConfigSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).ListConfigSnapshots(ctx, req.(*ListConfigSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_ModifyRuntime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyRuntimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).ModifyRuntime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_ModifyRuntime_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).ModifyRuntime(ctx, req.(*ModifyRuntimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_FailHealthcheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailHealthcheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).FailHealthcheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_FailHealthcheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).FailHealthcheck(ctx, req.(*FailHealthcheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_PassHealthcheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PassHealthcheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).PassHealthcheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_PassHealthcheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).PassHealthcheck(ctx, req.(*PassHealthcheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EnvoyTriageAPI_DrainListeners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainListenersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EnvoyTriageAPIServer).DrainListeners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EnvoyTriageAPI_DrainListeners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EnvoyTriageAPIServer).DrainListeners(ctx, req.(*DrainListenersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EnvoyTriageAPI_ServiceDesc is the grpc.ServiceDesc for EnvoyTriageAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -101,6 +431,46 @@ var EnvoyTriageAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Read",
 			Handler:    _EnvoyTriageAPI_Read_Handler,
 		},
+		{
+			MethodName: "BatchRead",
+			Handler:    _EnvoyTriageAPI_BatchRead_Handler,
+		},
+		{
+			MethodName: "SampleStats",
+			Handler:    _EnvoyTriageAPI_SampleStats_Handler,
+		},
+		{
+			MethodName: "CompareConfig",
+			Handler:    _EnvoyTriageAPI_CompareConfig_Handler,
+		},
+		{
+			MethodName: "CreateConfigSnapshot",
+			Handler:    _EnvoyTriageAPI_CreateConfigSnapshot_Handler,
+		},
+		{
+			MethodName: "ListConfigSnapshots",
+			Handler:    _EnvoyTriageAPI_ListConfigSnapshots_Handler,
+		},
+		{
+			MethodName: "ModifyRuntime",
+			Handler:    _EnvoyTriageAPI_ModifyRuntime_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _EnvoyTriageAPI_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "FailHealthcheck",
+			Handler:    _EnvoyTriageAPI_FailHealthcheck_Handler,
+		},
+		{
+			MethodName: "PassHealthcheck",
+			Handler:    _EnvoyTriageAPI_PassHealthcheck_Handler,
+		},
+		{
+			MethodName: "DrainListeners",
+			Handler:    _EnvoyTriageAPI_DrainListeners_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "envoytriage/v1/envoytriage_api.proto",
@@ -61,6 +61,159 @@ func (m *HostStatus) validate(all bool) error {
 
 	// no validation rules for Healthy
 
+	// no validation rules for Hostname
+
+	// no validation rules for Priority
+
+	// no validation rules for Weight
+
+	if all {
+		switch v := interface{}(m.GetLocality()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "Locality",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "Locality",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocality()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HostStatusValidationError{
+				field:  "Locality",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetSuccessRate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "SuccessRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "SuccessRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSuccessRate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HostStatusValidationError{
+				field:  "SuccessRate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalOriginSuccessRate()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "LocalOriginSuccessRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "LocalOriginSuccessRate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalOriginSuccessRate()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HostStatusValidationError{
+				field:  "LocalOriginSuccessRate",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealthFlags()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "HealthFlags",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "HealthFlags",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealthFlags()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HostStatusValidationError{
+				field:  "HealthFlags",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetOutlierEjection()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "OutlierEjection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, HostStatusValidationError{
+					field:  "OutlierEjection",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetOutlierEjection()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return HostStatusValidationError{
+				field:  "OutlierEjection",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Stats
+
 	if len(errors) > 0 {
 		return HostStatusMultiError(errors)
 	}
@@ -196,6 +349,98 @@ func (m *ClusterStatus) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetSuccessRateEjectionThreshold()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterStatusValidationError{
+					field:  "SuccessRateEjectionThreshold",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterStatusValidationError{
+					field:  "SuccessRateEjectionThreshold",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSuccessRateEjectionThreshold()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterStatusValidationError{
+				field:  "SuccessRateEjectionThreshold",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLocalOriginSuccessRateEjectionThreshold()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterStatusValidationError{
+					field:  "LocalOriginSuccessRateEjectionThreshold",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterStatusValidationError{
+					field:  "LocalOriginSuccessRateEjectionThreshold",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLocalOriginSuccessRateEjectionThreshold()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterStatusValidationError{
+				field:  "LocalOriginSuccessRateEjectionThreshold",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetZoneSummaries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClusterStatusValidationError{
+						field:  fmt.Sprintf("ZoneSummaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClusterStatusValidationError{
+						field:  fmt.Sprintf("ZoneSummaries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClusterStatusValidationError{
+					field:  fmt.Sprintf("ZoneSummaries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ClusterStatusMultiError(errors)
 	}
@@ -1164,6 +1409,750 @@ var _ interface {
 	ErrorName() string
 } = StatsValidationError{}
 
+// Validate checks the field values on Certificate with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Certificate) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Certificate with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CertificateMultiError, or
+// nil if none found.
+func (m *Certificate) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Certificate) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Path
+
+	// no validation rules for SerialNumber
+
+	// no validation rules for Subject
+
+	// no validation rules for DaysUntilExpiration
+
+	if all {
+		switch v := interface{}(m.GetValidFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CertificateValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CertificateValidationError{
+					field:  "ValidFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValidFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CertificateValidationError{
+				field:  "ValidFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetExpirationTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CertificateValidationError{
+					field:  "ExpirationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CertificateValidationError{
+					field:  "ExpirationTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpirationTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CertificateValidationError{
+				field:  "ExpirationTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CertificateMultiError(errors)
+	}
+
+	return nil
+}
+
+// CertificateMultiError is an error wrapping multiple validation errors
+// returned by Certificate.ValidateAll() if the designated constraints aren't met.
+type CertificateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CertificateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CertificateMultiError) AllErrors() []error { return m }
+
+// CertificateValidationError is the validation error returned by
+// Certificate.Validate if the designated constraints aren't met.
+type CertificateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CertificateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CertificateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CertificateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CertificateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CertificateValidationError) ErrorName() string { return "CertificateValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CertificateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCertificate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CertificateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CertificateValidationError{}
+
+// Validate checks the field values on Certificates with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Certificates) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Certificates with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CertificatesMultiError, or
+// nil if none found.
+func (m *Certificates) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Certificates) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCertificates() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CertificatesValidationError{
+						field:  fmt.Sprintf("Certificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CertificatesValidationError{
+						field:  fmt.Sprintf("Certificates[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CertificatesValidationError{
+					field:  fmt.Sprintf("Certificates[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CertificatesMultiError(errors)
+	}
+
+	return nil
+}
+
+// CertificatesMultiError is an error wrapping multiple validation errors
+// returned by Certificates.ValidateAll() if the designated constraints aren't met.
+type CertificatesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CertificatesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CertificatesMultiError) AllErrors() []error { return m }
+
+// CertificatesValidationError is the validation error returned by
+// Certificates.Validate if the designated constraints aren't met.
+type CertificatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CertificatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CertificatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CertificatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CertificatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CertificatesValidationError) ErrorName() string { return "CertificatesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CertificatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCertificates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CertificatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CertificatesValidationError{}
+
+// Validate checks the field values on HostStatus_Locality with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HostStatus_Locality) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HostStatus_Locality with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HostStatus_LocalityMultiError, or nil if none found.
+func (m *HostStatus_Locality) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HostStatus_Locality) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Region
+
+	// no validation rules for Zone
+
+	// no validation rules for SubZone
+
+	if len(errors) > 0 {
+		return HostStatus_LocalityMultiError(errors)
+	}
+
+	return nil
+}
+
+// HostStatus_LocalityMultiError is an error wrapping multiple validation
+// errors returned by HostStatus_Locality.ValidateAll() if the designated
+// constraints aren't met.
+type HostStatus_LocalityMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HostStatus_LocalityMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HostStatus_LocalityMultiError) AllErrors() []error { return m }
+
+// HostStatus_LocalityValidationError is the validation error returned by
+// HostStatus_Locality.Validate if the designated constraints aren't met.
+type HostStatus_LocalityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostStatus_LocalityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostStatus_LocalityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostStatus_LocalityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostStatus_LocalityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostStatus_LocalityValidationError) ErrorName() string {
+	return "HostStatus_LocalityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HostStatus_LocalityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHostStatus_Locality.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostStatus_LocalityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostStatus_LocalityValidationError{}
+
+// Validate checks the field values on HostStatus_HealthFlags with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HostStatus_HealthFlags) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HostStatus_HealthFlags with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HostStatus_HealthFlagsMultiError, or nil if none found.
+func (m *HostStatus_HealthFlags) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HostStatus_HealthFlags) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for FailedActiveHealthCheck
+
+	// no validation rules for FailedOutlierCheck
+
+	// no validation rules for FailedActiveDegradedCheck
+
+	// no validation rules for PendingDynamicRemoval
+
+	// no validation rules for PendingActiveHealthCheck
+
+	// no validation rules for ExcludedViaImmediateHealthCheckFail
+
+	// no validation rules for ActiveHealthCheckTimeout
+
+	// no validation rules for EdsHealthStatus
+
+	if len(errors) > 0 {
+		return HostStatus_HealthFlagsMultiError(errors)
+	}
+
+	return nil
+}
+
+// HostStatus_HealthFlagsMultiError is an error wrapping multiple validation
+// errors returned by HostStatus_HealthFlags.ValidateAll() if the designated
+// constraints aren't met.
+type HostStatus_HealthFlagsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HostStatus_HealthFlagsMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HostStatus_HealthFlagsMultiError) AllErrors() []error { return m }
+
+// HostStatus_HealthFlagsValidationError is the validation error returned by
+// HostStatus_HealthFlags.Validate if the designated constraints aren't met.
+type HostStatus_HealthFlagsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostStatus_HealthFlagsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostStatus_HealthFlagsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostStatus_HealthFlagsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostStatus_HealthFlagsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostStatus_HealthFlagsValidationError) ErrorName() string {
+	return "HostStatus_HealthFlagsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HostStatus_HealthFlagsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHostStatus_HealthFlags.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostStatus_HealthFlagsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostStatus_HealthFlagsValidationError{}
+
+// Validate checks the field values on HostStatus_OutlierEjection with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *HostStatus_OutlierEjection) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HostStatus_OutlierEjection with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// HostStatus_OutlierEjectionMultiError, or nil if none found.
+func (m *HostStatus_OutlierEjection) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HostStatus_OutlierEjection) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Ejected
+
+	// no validation rules for Reason
+
+	if len(errors) > 0 {
+		return HostStatus_OutlierEjectionMultiError(errors)
+	}
+
+	return nil
+}
+
+// HostStatus_OutlierEjectionMultiError is an error wrapping multiple
+// validation errors returned by HostStatus_OutlierEjection.ValidateAll() if
+// the designated constraints aren't met.
+type HostStatus_OutlierEjectionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HostStatus_OutlierEjectionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HostStatus_OutlierEjectionMultiError) AllErrors() []error { return m }
+
+// HostStatus_OutlierEjectionValidationError is the validation error returned
+// by HostStatus_OutlierEjection.Validate if the designated constraints aren't met.
+type HostStatus_OutlierEjectionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HostStatus_OutlierEjectionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HostStatus_OutlierEjectionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HostStatus_OutlierEjectionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HostStatus_OutlierEjectionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HostStatus_OutlierEjectionValidationError) ErrorName() string {
+	return "HostStatus_OutlierEjectionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e HostStatus_OutlierEjectionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHostStatus_OutlierEjection.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HostStatus_OutlierEjectionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HostStatus_OutlierEjectionValidationError{}
+
+// Validate checks the field values on ClusterStatus_ZoneSummary with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ClusterStatus_ZoneSummary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterStatus_ZoneSummary with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClusterStatus_ZoneSummaryMultiError, or nil if none found.
+func (m *ClusterStatus_ZoneSummary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterStatus_ZoneSummary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Region
+
+	// no validation rules for Zone
+
+	// no validation rules for Hosts
+
+	// no validation rules for Healthy
+
+	// no validation rules for Ejected
+
+	if len(errors) > 0 {
+		return ClusterStatus_ZoneSummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterStatus_ZoneSummaryMultiError is an error wrapping multiple validation
+// errors returned by ClusterStatus_ZoneSummary.ValidateAll() if the
+// designated constraints aren't met.
+type ClusterStatus_ZoneSummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterStatus_ZoneSummaryMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterStatus_ZoneSummaryMultiError) AllErrors() []error { return m }
+
+// ClusterStatus_ZoneSummaryValidationError is the validation error returned by
+// ClusterStatus_ZoneSummary.Validate if the designated constraints aren't met.
+type ClusterStatus_ZoneSummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterStatus_ZoneSummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterStatus_ZoneSummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterStatus_ZoneSummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterStatus_ZoneSummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterStatus_ZoneSummaryValidationError) ErrorName() string {
+	return "ClusterStatus_ZoneSummaryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ClusterStatus_ZoneSummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterStatus_ZoneSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterStatus_ZoneSummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterStatus_ZoneSummaryValidationError{}
+
 // Validate checks the field values on Runtime_Entry with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
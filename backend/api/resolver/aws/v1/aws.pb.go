@@ -462,6 +462,196 @@ func (x *IAMRoleName) GetAccount() string {
 	return ""
 }
 
+type RDSInstanceIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Region     string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account    string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *RDSInstanceIdentifier) Reset() {
+	*x = RDSInstanceIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resolver_aws_v1_aws_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RDSInstanceIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RDSInstanceIdentifier) ProtoMessage() {}
+
+func (x *RDSInstanceIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_resolver_aws_v1_aws_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RDSInstanceIdentifier.ProtoReflect.Descriptor instead.
+func (*RDSInstanceIdentifier) Descriptor() ([]byte, []int) {
+	return file_resolver_aws_v1_aws_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RDSInstanceIdentifier) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *RDSInstanceIdentifier) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RDSInstanceIdentifier) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type RDSClusterIdentifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Identifier string `protobuf:"bytes,1,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Region     string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account    string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *RDSClusterIdentifier) Reset() {
+	*x = RDSClusterIdentifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resolver_aws_v1_aws_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RDSClusterIdentifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RDSClusterIdentifier) ProtoMessage() {}
+
+func (x *RDSClusterIdentifier) ProtoReflect() protoreflect.Message {
+	mi := &file_resolver_aws_v1_aws_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RDSClusterIdentifier.ProtoReflect.Descriptor instead.
+func (*RDSClusterIdentifier) Descriptor() ([]byte, []int) {
+	return file_resolver_aws_v1_aws_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RDSClusterIdentifier) GetIdentifier() string {
+	if x != nil {
+		return x.Identifier
+	}
+	return ""
+}
+
+func (x *RDSClusterIdentifier) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *RDSClusterIdentifier) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
+type LambdaFunctionName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name or ARN of the function.
+	Name    string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Region  string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	Account string `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+}
+
+func (x *LambdaFunctionName) Reset() {
+	*x = LambdaFunctionName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_resolver_aws_v1_aws_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LambdaFunctionName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LambdaFunctionName) ProtoMessage() {}
+
+func (x *LambdaFunctionName) ProtoReflect() protoreflect.Message {
+	mi := &file_resolver_aws_v1_aws_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LambdaFunctionName.ProtoReflect.Descriptor instead.
+func (*LambdaFunctionName) Descriptor() ([]byte, []int) {
+	return file_resolver_aws_v1_aws_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LambdaFunctionName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LambdaFunctionName) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *LambdaFunctionName) GetAccount() string {
+	if x != nil {
+		return x.Account
+	}
+	return ""
+}
+
 var File_resolver_aws_v1_aws_proto protoreflect.FileDescriptor
 
 var file_resolver_aws_v1_aws_proto_rawDesc = []byte{
@@ -557,11 +747,52 @@ var file_resolver_aws_v1_aws_proto_rawDesc = []byte{
 	0x09, 0x42, 0x1b, 0xea, 0x9f, 0x1d, 0x17, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x22, 0x0c, 0x08, 0x01, 0x12, 0x08, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x07,
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0e, 0xea, 0x9f, 0x1d, 0x0a, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x1a, 0x02, 0x08, 0x01, 0x42, 0x3a, 0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66, 0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63,
-	0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x77, 0x73, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x77,
-	0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x6d, 0x65, 0x1a, 0x02, 0x08, 0x01, 0x22, 0xdd, 0x01, 0x0a, 0x15, 0x52, 0x44, 0x53, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x12, 0x44, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x24, 0xea, 0x9f, 0x1d, 0x20, 0x0a, 0x0a, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x10, 0x01, 0x1a, 0x10, 0x0a, 0x0e, 0x6d, 0x79, 0x2d,
+	0x64, 0x62, 0x2d, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x0a, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xea, 0x9f, 0x1d, 0x15, 0x0a, 0x06, 0x52,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x22, 0x0b, 0x08, 0x01, 0x12, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0x9f, 0x1d,
+	0x17, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0c, 0x08, 0x01, 0x12, 0x08,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x3a, 0x14, 0xea, 0x9f, 0x1d, 0x10, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x1a, 0x02, 0x08, 0x01, 0x22, 0xdb, 0x01, 0x0a, 0x14, 0x52, 0x44, 0x53, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x43, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x23, 0xea, 0x9f, 0x1d, 0x1f, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x10, 0x01, 0x1a, 0x0f, 0x0a, 0x0d, 0x6d, 0x79, 0x2d, 0x64,
+	0x62, 0x2d, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xea, 0x9f, 0x1d, 0x15, 0x0a, 0x06, 0x52, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x22, 0x0b, 0x08, 0x01, 0x12, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0x9f, 0x1d, 0x17, 0x0a,
+	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0c, 0x08, 0x01, 0x12, 0x08, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
+	0x14, 0xea, 0x9f, 0x1d, 0x10, 0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65,
+	0x72, 0x1a, 0x02, 0x08, 0x01, 0x22, 0xc6, 0x01, 0x0a, 0x12, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61,
+	0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0xea, 0x9f, 0x1d, 0x1e,
+	0x0a, 0x0b, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x72, 0x20, 0x41, 0x52, 0x4e, 0x10, 0x01, 0x1a,
+	0x0d, 0x0a, 0x0b, 0x6d, 0x79, 0x2d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x19, 0xea, 0x9f, 0x1d, 0x15, 0x0a, 0x06, 0x52, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x22, 0x0b, 0x08, 0x01, 0x12, 0x07, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1b, 0xea, 0x9f, 0x1d, 0x17, 0x0a, 0x07,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x0c, 0x08, 0x01, 0x12, 0x08, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0e,
+	0xea, 0x9f, 0x1d, 0x0a, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x1a, 0x02, 0x08, 0x01, 0x42, 0x3a,
+	0x5a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6c, 0x79, 0x66,
+	0x74, 0x2f, 0x63, 0x6c, 0x75, 0x74, 0x63, 0x68, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x77,
+	0x73, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x77, 0x73, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -576,15 +807,18 @@ func file_resolver_aws_v1_aws_proto_rawDescGZIP() []byte {
 	return file_resolver_aws_v1_aws_proto_rawDescData
 }
 
-var file_resolver_aws_v1_aws_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_resolver_aws_v1_aws_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_resolver_aws_v1_aws_proto_goTypes = []interface{}{
-	(*InstanceID)(nil),           // 0: clutch.resolver.aws.v1.InstanceID
-	(*AutoscalingGroupName)(nil), // 1: clutch.resolver.aws.v1.AutoscalingGroupName
-	(*KinesisStreamName)(nil),    // 2: clutch.resolver.aws.v1.KinesisStreamName
-	(*DynamodbTableName)(nil),    // 3: clutch.resolver.aws.v1.DynamodbTableName
-	(*S3BucketName)(nil),         // 4: clutch.resolver.aws.v1.S3BucketName
-	(*S3AccessPointName)(nil),    // 5: clutch.resolver.aws.v1.S3AccessPointName
-	(*IAMRoleName)(nil),          // 6: clutch.resolver.aws.v1.IAMRoleName
+	(*InstanceID)(nil),            // 0: clutch.resolver.aws.v1.InstanceID
+	(*AutoscalingGroupName)(nil),  // 1: clutch.resolver.aws.v1.AutoscalingGroupName
+	(*KinesisStreamName)(nil),     // 2: clutch.resolver.aws.v1.KinesisStreamName
+	(*DynamodbTableName)(nil),     // 3: clutch.resolver.aws.v1.DynamodbTableName
+	(*S3BucketName)(nil),          // 4: clutch.resolver.aws.v1.S3BucketName
+	(*S3AccessPointName)(nil),     // 5: clutch.resolver.aws.v1.S3AccessPointName
+	(*IAMRoleName)(nil),           // 6: clutch.resolver.aws.v1.IAMRoleName
+	(*RDSInstanceIdentifier)(nil), // 7: clutch.resolver.aws.v1.RDSInstanceIdentifier
+	(*RDSClusterIdentifier)(nil),  // 8: clutch.resolver.aws.v1.RDSClusterIdentifier
+	(*LambdaFunctionName)(nil),    // 9: clutch.resolver.aws.v1.LambdaFunctionName
 }
 var file_resolver_aws_v1_aws_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -684,6 +918,42 @@ func file_resolver_aws_v1_aws_proto_init() {
 				return nil
 			}
 		}
+		file_resolver_aws_v1_aws_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RDSInstanceIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resolver_aws_v1_aws_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RDSClusterIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_resolver_aws_v1_aws_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LambdaFunctionName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -691,7 +961,7 @@ func file_resolver_aws_v1_aws_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_resolver_aws_v1_aws_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
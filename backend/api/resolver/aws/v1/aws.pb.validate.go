@@ -781,3 +781,327 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = IAMRoleNameValidationError{}
+
+// Validate checks the field values on RDSInstanceIdentifier with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RDSInstanceIdentifier) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RDSInstanceIdentifier with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RDSInstanceIdentifierMultiError, or nil if none found.
+func (m *RDSInstanceIdentifier) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RDSInstanceIdentifier) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Identifier
+
+	// no validation rules for Region
+
+	// no validation rules for Account
+
+	if len(errors) > 0 {
+		return RDSInstanceIdentifierMultiError(errors)
+	}
+
+	return nil
+}
+
+// RDSInstanceIdentifierMultiError is an error wrapping multiple validation
+// errors returned by RDSInstanceIdentifier.ValidateAll() if the designated
+// constraints aren't met.
+type RDSInstanceIdentifierMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RDSInstanceIdentifierMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RDSInstanceIdentifierMultiError) AllErrors() []error { return m }
+
+// RDSInstanceIdentifierValidationError is the validation error returned by
+// RDSInstanceIdentifier.Validate if the designated constraints aren't met.
+type RDSInstanceIdentifierValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RDSInstanceIdentifierValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RDSInstanceIdentifierValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RDSInstanceIdentifierValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RDSInstanceIdentifierValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RDSInstanceIdentifierValidationError) ErrorName() string {
+	return "RDSInstanceIdentifierValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RDSInstanceIdentifierValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRDSInstanceIdentifier.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RDSInstanceIdentifierValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RDSInstanceIdentifierValidationError{}
+
+// Validate checks the field values on RDSClusterIdentifier with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RDSClusterIdentifier) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RDSClusterIdentifier with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RDSClusterIdentifierMultiError, or nil if none found.
+func (m *RDSClusterIdentifier) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RDSClusterIdentifier) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Identifier
+
+	// no validation rules for Region
+
+	// no validation rules for Account
+
+	if len(errors) > 0 {
+		return RDSClusterIdentifierMultiError(errors)
+	}
+
+	return nil
+}
+
+// RDSClusterIdentifierMultiError is an error wrapping multiple validation
+// errors returned by RDSClusterIdentifier.ValidateAll() if the designated
+// constraints aren't met.
+type RDSClusterIdentifierMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RDSClusterIdentifierMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RDSClusterIdentifierMultiError) AllErrors() []error { return m }
+
+// RDSClusterIdentifierValidationError is the validation error returned by
+// RDSClusterIdentifier.Validate if the designated constraints aren't met.
+type RDSClusterIdentifierValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RDSClusterIdentifierValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RDSClusterIdentifierValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RDSClusterIdentifierValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RDSClusterIdentifierValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RDSClusterIdentifierValidationError) ErrorName() string {
+	return "RDSClusterIdentifierValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RDSClusterIdentifierValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRDSClusterIdentifier.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RDSClusterIdentifierValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RDSClusterIdentifierValidationError{}
+
+// Validate checks the field values on LambdaFunctionName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *LambdaFunctionName) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LambdaFunctionName with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LambdaFunctionNameMultiError, or nil if none found.
+func (m *LambdaFunctionName) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LambdaFunctionName) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Region
+
+	// no validation rules for Account
+
+	if len(errors) > 0 {
+		return LambdaFunctionNameMultiError(errors)
+	}
+
+	return nil
+}
+
+// LambdaFunctionNameMultiError is an error wrapping multiple validation errors
+// returned by LambdaFunctionName.ValidateAll() if the designated constraints
+// aren't met.
+type LambdaFunctionNameMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LambdaFunctionNameMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LambdaFunctionNameMultiError) AllErrors() []error { return m }
+
+// LambdaFunctionNameValidationError is the validation error returned by
+// LambdaFunctionName.Validate if the designated constraints aren't met.
+type LambdaFunctionNameValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LambdaFunctionNameValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LambdaFunctionNameValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LambdaFunctionNameValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LambdaFunctionNameValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LambdaFunctionNameValidationError) ErrorName() string {
+	return "LambdaFunctionNameValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e LambdaFunctionNameValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLambdaFunctionName.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LambdaFunctionNameValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LambdaFunctionNameValidationError{}
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS envoy_config_snapshot_address_idx;
+DROP TABLE IF EXISTS envoy_config_snapshot;
@@ -0,0 +1,10 @@
+CREATE TABLE IF NOT EXISTS envoy_config_snapshot (
+  id BIGSERIAL PRIMARY KEY,
+  -- the admin address the config dump was read from
+  host TEXT NOT NULL,
+  port INTEGER NOT NULL,
+  created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  config_dump JSONB NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS envoy_config_snapshot_address_idx ON envoy_config_snapshot (host, port, created_at DESC);
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS experiment_run_changed ON experiment_run;
+DROP FUNCTION IF EXISTS notify_experiment_run_changed();
@@ -0,0 +1,10 @@
+CREATE OR REPLACE FUNCTION notify_experiment_run_changed() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('experiment_run_changed', NEW.id);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER experiment_run_changed
+    AFTER INSERT OR UPDATE OF cancellation_time ON experiment_run
+    FOR EACH ROW EXECUTE PROCEDURE notify_experiment_run_changed();
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS experiment_schedule (
+  id varchar(100) PRIMARY KEY,
+  -- the experiment config of the runs created by the schedule
+  details JSONB NOT NULL,
+  cron TEXT NOT NULL,
+  duration_seconds BIGINT NOT NULL,
+  blackout_windows JSONB NOT NULL DEFAULT '[]',
+  paused BOOLEAN NOT NULL DEFAULT FALSE,
+  creation_time TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+  -- the last occurrence that was handled by the scheduler, by either creating a run or skipping it
+  last_occurrence_time TIMESTAMP WITH TIME ZONE,
+  last_run_id varchar(100)
+);
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS experiment_template (
+  id varchar(100) PRIMARY KEY,
+  description TEXT NOT NULL DEFAULT '',
+  -- the experiment config in its JSON form, with references to the parameters
+  config JSONB NOT NULL,
+  parameters JSONB NOT NULL DEFAULT '[]',
+  creation_time TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
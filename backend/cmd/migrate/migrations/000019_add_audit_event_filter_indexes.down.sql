@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS audit_events_user_name;
+DROP INDEX IF EXISTS audit_events_service_method;
@@ -0,0 +1,3 @@
+-- indexes for filtering audit events by user, and by service and method, within a time range
+CREATE INDEX IF NOT EXISTS audit_events_user_name ON audit_events ((details->>'user_name'), occurred_at);
+CREATE INDEX IF NOT EXISTS audit_events_service_method ON audit_events ((details->>'service_name'), (details->>'method_name'), occurred_at);
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS audit_events_cursor;
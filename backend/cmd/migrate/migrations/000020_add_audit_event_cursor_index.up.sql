@@ -0,0 +1,2 @@
+-- index for reading audit events in order of occurrence with keyset pagination
+CREATE INDEX IF NOT EXISTS audit_events_cursor ON audit_events (occurred_at, id);
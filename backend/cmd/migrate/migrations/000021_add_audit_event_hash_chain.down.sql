@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS unchained_audit_events;
+DROP INDEX IF EXISTS audit_events_chain_index;
+ALTER TABLE audit_events
+    DROP COLUMN IF EXISTS hash,
+    DROP COLUMN IF EXISTS chain_index,
+    DROP COLUMN IF EXISTS completed;
@@ -0,0 +1,6 @@
+ALTER TABLE audit_events
+    ADD COLUMN IF NOT EXISTS completed BOOLEAN DEFAULT FALSE,
+    ADD COLUMN IF NOT EXISTS chain_index BIGINT,
+    ADD COLUMN IF NOT EXISTS hash BYTEA;
+CREATE UNIQUE INDEX IF NOT EXISTS audit_events_chain_index ON audit_events (chain_index);
+CREATE INDEX IF NOT EXISTS unchained_audit_events ON audit_events (id) WHERE chain_index IS NULL;
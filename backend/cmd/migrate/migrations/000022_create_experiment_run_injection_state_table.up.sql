@@ -0,0 +1,7 @@
+CREATE TABLE IF NOT EXISTS experiment_run_injection_state (
+  experiment_run_id varchar(100) PRIMARY KEY REFERENCES experiment_run (id) ON DELETE CASCADE,
+  -- the last time the fault of the run was injected and the targets it was injected into
+  last_injection_time TIMESTAMP WITH TIME ZONE,
+  targets JSONB NOT NULL DEFAULT '[]',
+  update_time TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
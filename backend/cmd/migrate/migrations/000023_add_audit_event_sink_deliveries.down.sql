@@ -0,0 +1,3 @@
+ALTER TABLE audit_events
+    DROP COLUMN IF EXISTS failed_deliveries,
+    DROP COLUMN IF EXISTS sent_sinks;
@@ -0,0 +1,3 @@
+ALTER TABLE audit_events
+    ADD COLUMN IF NOT EXISTS sent_sinks TEXT[] NOT NULL DEFAULT '{}',
+    ADD COLUMN IF NOT EXISTS failed_deliveries INT NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS envoy_config_snapshot_created_at_idx;
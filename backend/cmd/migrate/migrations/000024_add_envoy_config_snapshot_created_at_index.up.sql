@@ -0,0 +1,2 @@
+-- index for deleting expired snapshots of all addresses by age
+CREATE INDEX IF NOT EXISTS envoy_config_snapshot_created_at_idx ON envoy_config_snapshot (created_at);
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS audit_events_request_resources;
+DROP INDEX IF EXISTS audit_events_response_resources;
@@ -0,0 +1,3 @@
+-- indexes for filtering audit events by the type of their resources
+CREATE INDEX IF NOT EXISTS audit_events_request_resources ON audit_events USING GIN ((details->'request_resources') jsonb_path_ops);
+CREATE INDEX IF NOT EXISTS audit_events_response_resources ON audit_events USING GIN ((details->'response_resources') jsonb_path_ops);
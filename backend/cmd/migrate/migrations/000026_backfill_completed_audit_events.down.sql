@@ -0,0 +1 @@
+-- the backfilled events are completed, so they are left as they are
@@ -0,0 +1,2 @@
+-- events recorded before the completed column was added have a status if their response was recorded
+UPDATE audit_events SET completed = TRUE WHERE completed IS NOT TRUE AND details ? 'status';
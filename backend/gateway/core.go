@@ -16,6 +16,7 @@ import (
 	slackbotmod "github.com/lyft/clutch/backend/module/bot/slackbot"
 	experimentationapi "github.com/lyft/clutch/backend/module/chaos/experimentation/api"
 	xdsmod "github.com/lyft/clutch/backend/module/chaos/experimentation/xds"
+	"github.com/lyft/clutch/backend/module/chaos/k8sexperimentation"
 	"github.com/lyft/clutch/backend/module/chaos/redisexperimentation"
 	"github.com/lyft/clutch/backend/module/chaos/serverexperimentation"
 	dynamodbmod "github.com/lyft/clutch/backend/module/dynamodb"
@@ -25,8 +26,10 @@ import (
 	"github.com/lyft/clutch/backend/module/healthcheck"
 	k8smod "github.com/lyft/clutch/backend/module/k8s"
 	kinesismod "github.com/lyft/clutch/backend/module/kinesis"
+	lambdamod "github.com/lyft/clutch/backend/module/lambda"
 	projectmod "github.com/lyft/clutch/backend/module/project"
 	proxymod "github.com/lyft/clutch/backend/module/proxy"
+	rdsmod "github.com/lyft/clutch/backend/module/rds"
 	resolvermod "github.com/lyft/clutch/backend/module/resolver"
 	shortlinkmod "github.com/lyft/clutch/backend/module/shortlink"
 	"github.com/lyft/clutch/backend/module/sourcecontrol"
@@ -39,11 +42,13 @@ import (
 	auditservice "github.com/lyft/clutch/backend/service/audit"
 	loggingsink "github.com/lyft/clutch/backend/service/auditsink/logger"
 	"github.com/lyft/clutch/backend/service/auditsink/slack"
+	"github.com/lyft/clutch/backend/service/auditsink/webhook"
 	authnservice "github.com/lyft/clutch/backend/service/authn"
 	authzservice "github.com/lyft/clutch/backend/service/authz"
 	awsservice "github.com/lyft/clutch/backend/service/aws"
 	"github.com/lyft/clutch/backend/service/bot"
 	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/scheduler"
 	"github.com/lyft/clutch/backend/service/chaos/experimentation/terminator"
 	pgservice "github.com/lyft/clutch/backend/service/db/postgres"
 	"github.com/lyft/clutch/backend/service/envoyadmin"
@@ -77,10 +82,13 @@ var Modules = module.Factory{
 	featureflag.Name:           featureflag.New,
 	feedbackmod.Name:           feedbackmod.New,
 	healthcheck.Name:           healthcheck.New,
+	k8sexperimentation.Name:    k8sexperimentation.New,
 	k8smod.Name:                k8smod.New,
 	kinesismod.Name:            kinesismod.New,
+	lambdamod.Name:             lambdamod.New,
 	projectmod.Name:            projectmod.New,
 	proxymod.Name:              proxymod.New,
+	rdsmod.Name:                rdsmod.New,
 	redisexperimentation.Name:  redisexperimentation.New,
 	resolvermod.Name:           resolvermod.New,
 	serverexperimentation.Name: serverexperimentation.New,
@@ -106,12 +114,14 @@ var Services = service.Factory{
 	loggingsink.Name:         loggingsink.New,
 	pgservice.Name:           pgservice.New,
 	project.Name:             project.New,
+	scheduler.Name:           scheduler.New,
 	shortlinkservice.Name:    shortlinkservice.New,
 	slack.Name:               slack.New,
 	sourcegraphservice.Name:  sourcegraphservice.New,
 	temporal.Name:            temporal.New,
 	terminator.Name:          terminator.New,
 	topologyservice.Name:     topologyservice.New,
+	webhook.Name:             webhook.New,
 }
 
 var Resolvers = resolver.Factory{
@@ -16,7 +16,9 @@ import (
 	tallyprom "github.com/uber-go/tally/v4/prometheus"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
 	"github.com/lyft/clutch/backend/gateway/meta"
@@ -160,9 +162,11 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 	}
 
 	var interceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
 
 	// Error interceptors should be first on the stack (last in chain).
 	interceptors = append(interceptors, errorInterceptMiddleware.UnaryInterceptor())
+	streamInterceptors = append(streamInterceptors, errorInterceptMiddleware.StreamInterceptor())
 
 	// Access log.
 	if cfg.Gateway.Accesslog != nil {
@@ -173,7 +177,7 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		interceptors = append(interceptors, a.UnaryInterceptor())
 	}
 
-	// Timeouts.
+	// Timeouts. They don't apply to streams, which are expected to outlive the timeouts of unary calls.
 	timeoutInterceptor, err := timeouts.New(cfg.Gateway.Timeouts, logger, scope)
 	if err != nil {
 		logger.Fatal("could not create timeout interceptor", zap.Error(err))
@@ -203,10 +207,17 @@ func RunWithConfig(f *Flags, cfg *gatewayv1.Config, cf *ComponentFactory, assets
 		}
 
 		interceptors = append(interceptors, m.UnaryInterceptor())
+
+		if sm, ok := m.(middleware.StreamMiddleware); ok {
+			streamInterceptors = append(streamInterceptors, sm.StreamInterceptor())
+		} else {
+			logger.Warn("middleware does not intercept streams, streaming calls will be rejected")
+			streamInterceptors = append(streamInterceptors, rejectStreams(mCfg.Name))
+		}
 	}
 
 	// Instantiate and register modules listed in the configuration.
-	rpcMux, err := mux.New(interceptors, assets, metricsHandler, cfg.Gateway)
+	rpcMux, err := mux.New(interceptors, streamInterceptors, assets, metricsHandler, cfg.Gateway)
 	if err != nil {
 		panic(err)
 	}
@@ -388,3 +399,10 @@ func getStatsReporterConfiguration(cfg *gatewayv1.Config, logger *zap.Logger) (t
 		return tally.ScopeOptions{}, nil
 	}
 }
+
+// Rejects streaming calls, for middleware that can't intercept them.
+func rejectStreams(name string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return status.Errorf(codes.Unimplemented, "streaming calls are not supported by middleware '%s'", name)
+	}
+}
@@ -23,7 +23,7 @@ var (
 	// TODO: Lock after startup.
 	methodDescriptors map[string]*desc.MethodDescriptor
 
-	fieldNameRegexp = regexp.MustCompile(`{(\w+)}`)
+	fieldNameRegexp = regexp.MustCompile(`{([\w.]+)}`)
 
 	actionTypeDescriptor          = apiv1.E_Action.TypeDescriptor()
 	auditDisabledTypeDescriptor   = apiv1.E_DisableAudit.TypeDescriptor()
@@ -132,6 +132,11 @@ func ResourceNames(pb proto.Message) []*auditv1.Resource {
 
 		names := make([]*auditv1.Resource, 0, len(id.Patterns))
 		for _, pattern := range id.Patterns {
+			// Messages with several patterns identify a resource in alternative ways, e.g. by address or by pod, so
+			// patterns whose own fields are all unset don't identify the resource.
+			if len(id.Patterns) > 1 && !hasOwnFieldSet(pb, pattern, id.Patterns) {
+				continue
+			}
 			if newName := resolvePattern(pb, pattern); newName != nil {
 				names = append(names, newName)
 			}
@@ -172,9 +177,15 @@ func HydratedPatternForProto(pb proto.Message) (string, error) {
 		id := v.Message().Interface().(*apiv1.Identifier)
 
 		for _, pattern := range id.Patterns {
+			if len(id.Patterns) > 1 && !hasOwnFieldSet(pb, pattern, id.Patterns) {
+				continue
+			}
 			rs := resolvePattern(pb, pattern)
 			populatedPattern = append(populatedPattern, rs.Id)
 		}
+		if len(populatedPattern) == 0 {
+			return "", fmt.Errorf("none of the patterns of the supplied proto are populated: [%T]", pb)
+		}
 
 		// At the time of writing there is only support for a single pattern
 		// this list should only have one item to return
@@ -286,23 +297,61 @@ func resolveSlice(list protoreflect.List) []*auditv1.Resource {
 }
 
 func resolvePattern(pb proto.Message, pattern *apiv1.Pattern) *auditv1.Resource {
-	m := pb.ProtoReflect()
-	fields := m.Descriptor().Fields()
-
 	resourceName := pattern.Pattern
 
 	substitutions := fieldNameRegexp.FindAllStringSubmatch(pattern.Pattern, -1)
 	for _, name := range substitutions {
-		fd := fields.ByName(protoreflect.Name(name[1]))
+		m, fd := resolvePath(pb.ProtoReflect(), name[1])
 		if fd == nil {
 			continue
 		}
-		v := m.Get(fd)
-		resourceName = strings.Replace(resourceName, name[0], v.String(), 1)
+		resourceName = strings.Replace(resourceName, name[0], m.Get(fd).String(), 1)
 	}
 	return &auditv1.Resource{TypeUrl: pattern.TypeUrl, Id: resourceName}
 }
 
+// Returns the message containing a field and the field's descriptor by the field's name, which may be a dot separated
+// path to a field of a nested message.
+func resolvePath(m protoreflect.Message, path string) (protoreflect.Message, protoreflect.FieldDescriptor) {
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+		if fd == nil || fd.Message() == nil || fd.IsList() || fd.IsMap() {
+			return nil, nil
+		}
+		m = m.Get(fd).Message()
+	}
+
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(names[len(names)-1]))
+	if fd == nil {
+		return nil, nil
+	}
+	return m, fd
+}
+
+// Returns whether any field of a pattern that is not also in one of the other patterns is set.
+func hasOwnFieldSet(pb proto.Message, pattern *apiv1.Pattern, patterns []*apiv1.Pattern) bool {
+	shared := map[string]bool{}
+	for _, other := range patterns {
+		if other == pattern {
+			continue
+		}
+		for _, name := range extractProtoPatternFieldNames(other) {
+			shared[name] = true
+		}
+	}
+
+	for _, name := range extractProtoPatternFieldNames(pattern) {
+		if shared[name] {
+			continue
+		}
+		if m, fd := resolvePath(pb.ProtoReflect(), name); fd != nil && m.Has(fd) {
+			return true
+		}
+	}
+	return false
+}
+
 // APIBody returns a API request/response interface as an anypb.Any message, with any redaction or clearing based on
 // message or field annotations.
 func APIBody(body interface{}) (*anypb.Any, error) {
@@ -14,6 +14,7 @@ import (
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	authnv1 "github.com/lyft/clutch/backend/api/authn/v1"
 	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
 	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 	testpb "github.com/lyft/clutch/backend/internal/test/pb"
@@ -102,6 +103,25 @@ func TestResourceNames(t *testing.T) {
 				Id:      typeUrl,
 			}},
 		},
+		{
+			id:      "alternative patterns by host",
+			message: &envoytriagev1.Address{Host: "10.0.0.1", Port: 9901},
+			names: []*auditv1.Resource{{
+				TypeUrl: "clutch.envoytriage.v1.Address",
+				Id:      "10.0.0.1:9901",
+			}},
+		},
+		{
+			id: "alternative patterns by nested fields",
+			message: &envoytriagev1.Address{
+				Port: 9901,
+				Pod:  &envoytriagev1.Address_Pod{Cluster: "cluster-a", Namespace: "envoy", Name: "envoy-1"},
+			},
+			names: []*auditv1.Resource{{
+				TypeUrl: "clutch.envoytriage.v1.Address",
+				Id:      "cluster-a/envoy/envoy-1:9901",
+			}},
+		},
 	}
 
 	for _, tt := range tests {
@@ -278,6 +298,15 @@ func TestExtractProtoPatternsValues(t *testing.T) {
 			expect:      "default/us-east-1/i-000000000",
 			shouldError: false,
 		},
+		{
+			id: "envoy address of a pod",
+			pb: &envoytriagev1.Address{
+				Port: 9901,
+				Pod:  &envoytriagev1.Address_Pod{Cluster: "foo", Namespace: "bar", Name: "cat"},
+			},
+			expect:      "foo/bar/cat:9901",
+			shouldError: false,
+		},
 		{
 			id:          "no pattern found",
 			pb:          &anypb.Any{},
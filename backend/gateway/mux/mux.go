@@ -13,17 +13,21 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	"google.golang.org/genproto/googleapis/api/annotations"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
 
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
 	"github.com/lyft/clutch/backend/service"
@@ -264,27 +268,43 @@ func customErrorHandler(ctx context.Context, mux *runtime.ServeMux, m runtime.Ma
 	runtime.DefaultHTTPErrorHandler(ctx, mux, m, w, req, err)
 }
 
-func New(unaryInterceptors []grpc.UnaryServerInterceptor, assets http.FileSystem, metricsHandler http.Handler, gatewayCfg *gatewayv1.GatewayOptions) (*Mux, error) {
+// Streamed HttpBody responses are chunks of a single body, e.g. of an exported file, so unlike streamed messages
+// they aren't delimited.
+type httpBodyMarshaler struct {
+	*runtime.HTTPBodyMarshaler
+}
+
+func (*httpBodyMarshaler) Delimiter() []byte {
+	return nil
+}
+
+func New(unaryInterceptors []grpc.UnaryServerInterceptor, streamInterceptors []grpc.StreamServerInterceptor, assets http.FileSystem, metricsHandler http.Handler, gatewayCfg *gatewayv1.GatewayOptions) (*Mux, error) {
 	secureCookies := true
 	if gatewayCfg.SecureCookies != nil {
 		secureCookies = gatewayCfg.SecureCookies.Value
 	}
 
-	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors...))
+	grpcServer := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryInterceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+	)
 	jsonGateway := runtime.NewServeMux(
 		runtime.WithForwardResponseOption(newCustomResponseForwarder(secureCookies)),
 		runtime.WithErrorHandler(customErrorHandler),
 		runtime.WithMarshalerOption(
 			runtime.MIMEWildcard,
-			&runtime.JSONPb{
-				MarshalOptions: protojson.MarshalOptions{
-					// Use camelCase for the JSON version.
-					UseProtoNames: false,
-					// Transmit zero-values over the wire.
-					EmitUnpopulated: true,
+			// HttpBody responses, e.g. exports, are sent as is with their own content type.
+			&httpBodyMarshaler{&runtime.HTTPBodyMarshaler{
+				Marshaler: &runtime.JSONPb{
+					MarshalOptions: protojson.MarshalOptions{
+						// Use camelCase for the JSON version.
+						UseProtoNames: false,
+						// Transmit zero-values over the wire.
+						EmitUnpopulated: true,
+					},
+					UnmarshalOptions: protojson.UnmarshalOptions{},
 				},
-				UnmarshalOptions: protojson.UnmarshalOptions{},
-			},
+			}},
 		),
 		runtime.WithIncomingHeaderMatcher(customHeaderMatcher),
 	)
@@ -337,10 +357,20 @@ type Mux struct {
 	JSONGateway *runtime.ServeMux
 	HTTPMux     http.Handler
 	GRPCServer  *grpc.Server
+
+	streamingRoutesOnce sync.Once
+	streamingRoutes     map[string]bool
 }
 
 // Adapted from https://github.com/grpc/grpc-go/blob/197c621/server.go#L760-L778.
 func (m *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// The write timeout of the server bounds unary responses, but streamed responses such as exports may take longer.
+	// n.b. JSON requests are proxied to the gRPC server, so both the JSON and the gRPC response are streamed.
+	if m.isStreamingRoute(r.URL.Path) {
+		// Not all response writers support deadlines, e.g. in tests, in which case there is no deadline to clear.
+		_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+	}
+
 	if r.ProtoMajor == 2 && strings.HasPrefix(r.Header.Get("Content-Type"), "application/grpc") {
 		m.GRPCServer.ServeHTTP(w, r)
 	} else {
@@ -348,6 +378,70 @@ func (m *Mux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Returns whether the path is the gRPC method or the JSON route of a server streaming method. Services are registered
+// after the mux is created, so the routes are collected on the first request.
+func (m *Mux) isStreamingRoute(path string) bool {
+	m.streamingRoutesOnce.Do(func() {
+		m.streamingRoutes = streamingRoutes(m.GRPCServer)
+	})
+	return m.streamingRoutes[path]
+}
+
+func streamingRoutes(s *grpc.Server) map[string]bool {
+	routes := make(map[string]bool)
+	if s == nil {
+		return routes
+	}
+
+	for service, info := range s.GetServiceInfo() {
+		for _, method := range info.Methods {
+			if !method.IsServerStream {
+				continue
+			}
+			routes[fmt.Sprintf("/%s/%s", service, method.Name)] = true
+
+			desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service + "." + method.Name))
+			if err != nil {
+				continue
+			}
+			methodDesc, ok := desc.(protoreflect.MethodDescriptor)
+			if !ok {
+				continue
+			}
+			rule, ok := proto.GetExtension(methodDesc.Options(), annotations.E_Http).(*annotations.HttpRule)
+			if !ok || rule == nil {
+				continue
+			}
+			for _, r := range append([]*annotations.HttpRule{rule}, rule.AdditionalBindings...) {
+				// n.b. routes with path parameters aren't matched.
+				if path := httpRulePath(r); path != "" && !strings.Contains(path, "{") {
+					routes[path] = true
+				}
+			}
+		}
+	}
+	return routes
+}
+
+func httpRulePath(rule *annotations.HttpRule) string {
+	switch p := rule.Pattern.(type) {
+	case *annotations.HttpRule_Get:
+		return p.Get
+	case *annotations.HttpRule_Put:
+		return p.Put
+	case *annotations.HttpRule_Post:
+		return p.Post
+	case *annotations.HttpRule_Delete:
+		return p.Delete
+	case *annotations.HttpRule_Patch:
+		return p.Patch
+	case *annotations.HttpRule_Custom:
+		return p.Custom.GetPath()
+	default:
+		return ""
+	}
+}
+
 func (m *Mux) EnableGRPCReflection() {
 	reflection.Register(m.GRPCServer)
 }
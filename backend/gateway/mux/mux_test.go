@@ -8,13 +8,19 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	gatewayv1 "github.com/lyft/clutch/backend/api/config/gateway/v1"
 	healthcheckv1 "github.com/lyft/clutch/backend/api/healthcheck/v1"
 )
@@ -299,3 +305,57 @@ func TestRoutableStaticPathEnabled(t *testing.T) {
 		assert.Equal(t, test.expected, assetHandler.isStaticPathRoutable(test.urlPath), test.id)
 	}
 }
+
+type exportServer struct {
+	auditv1.UnimplementedAuditAPIServer
+
+	delay time.Duration
+}
+
+func (s *exportServer) ExportEvents(_ *auditv1.ExportEventsRequest, stream auditv1.AuditAPI_ExportEventsServer) error {
+	for _, chunk := range []string{"first\n", "second\n"} {
+		if err := stream.Send(&httpbody.HttpBody{ContentType: "application/x-ndjson", Data: []byte(chunk)}); err != nil {
+			return err
+		}
+		time.Sleep(s.delay)
+	}
+	return nil
+}
+
+func TestStreamingResponseOutlastsWriteTimeout(t *testing.T) {
+	m, err := New(nil, nil, nil, nil, &gatewayv1.GatewayOptions{})
+	require.NoError(t, err)
+	auditv1.RegisterAuditAPIServer(m.GRPCServer, &exportServer{delay: 500 * time.Millisecond})
+
+	srv := httptest.NewUnstartedServer(InsecureHandler(m))
+	srv.Config.WriteTimeout = 200 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+
+	// The JSON gateway proxies requests to the gRPC server through the same listener, as it does in the gateway.
+	conn, err := grpc.Dial(srv.Listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, auditv1.RegisterAuditAPIHandler(context.Background(), m.JSONGateway, conn))
+
+	resp, err := http.Post(srv.URL+"/v1/audit/exportEvents", "application/json", strings.NewReader("{}"))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+	assert.Equal(t, "first\nsecond\n", string(body))
+	assert.Equal(t, "application/x-ndjson", resp.Header.Get("Content-Type"))
+}
+
+func TestStreamingRoutes(t *testing.T) {
+	s := grpc.NewServer()
+	auditv1.RegisterAuditAPIServer(s, &exportServer{})
+	healthcheckv1.RegisterHealthcheckAPIServer(s, healthcheckv1.UnimplementedHealthcheckAPIServer{})
+
+	routes := streamingRoutes(s)
+	assert.Equal(t, map[string]bool{
+		"/clutch.audit.v1.AuditAPI/ExportEvents": true,
+		"/v1/audit/exportEvents":                 true,
+	}, routes)
+}
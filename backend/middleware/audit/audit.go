@@ -56,7 +56,7 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 			return handler(ctx, req)
 		}
 
-		event, err := m.eventFromRequest(ctx, req, info)
+		event, err := m.eventFromRequest(ctx, req, info.FullMethod)
 		if err != nil {
 			return nil, err
 		}
@@ -88,10 +88,56 @@ func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	}
 }
 
-func (m *mid) eventFromRequest(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) (*auditv1.RequestEvent, error) {
-	svc, method, ok := middleware.SplitFullMethod(info.FullMethod)
+// Streams are audited with the request they receive and the status they end with, but not with the messages they
+// send, since streams may send arbitrarily many messages. Only the first request received is audited.
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if meta.IsAuditDisabled(info.FullMethod) {
+			return handler(srv, ss)
+		}
+
+		ctx := ss.Context()
+		var id int64 = -1
+		var audited bool
+		err := handler(srv, &middleware.ServerStream{
+			ServerStream: ss,
+			OnRecvMsg: func(req interface{}) error {
+				if audited {
+					return nil
+				}
+				audited = true
+
+				event, err := m.eventFromRequest(ctx, req, info.FullMethod)
+				if err != nil {
+					return err
+				}
+
+				id, err = m.audit.WriteRequestEvent(ctx, event)
+				if err != nil && !errors.Is(err, auditservice.ErrFailedFilters) {
+					return fmt.Errorf("could not make call %s because failed to audit: %w", info.FullMethod, err)
+				}
+				return nil
+			},
+		})
+
+		if id != -1 {
+			update := eventFromStreamStatus(err)
+			if auditErr := m.audit.UpdateRequestEvent(ctx, id, update); auditErr != nil {
+				m.logger.Warn("error updating audit event",
+					zap.Int64("auditID", id),
+					log.ProtoField("updateEvent", update),
+				)
+			}
+		}
+
+		return err
+	}
+}
+
+func (m *mid) eventFromRequest(ctx context.Context, req interface{}, fullMethod string) (*auditv1.RequestEvent, error) {
+	svc, method, ok := middleware.SplitFullMethod(fullMethod)
 	if !ok {
-		m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", info.FullMethod))
+		m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", fullMethod))
 	}
 
 	username := "UNKNOWN"
@@ -108,7 +154,7 @@ func (m *mid) eventFromRequest(ctx context.Context, req interface{}, info *grpc.
 		Username:    username,
 		ServiceName: svc,
 		MethodName:  method,
-		Type:        meta.GetAction(info.FullMethod),
+		Type:        meta.GetAction(fullMethod),
 		Resources:   meta.ResourceNames(req.(proto.Message)),
 		RequestMetadata: &auditv1.RequestMetadata{
 			Body: reqBody,
@@ -116,6 +162,18 @@ func (m *mid) eventFromRequest(ctx context.Context, req interface{}, info *grpc.
 	}, nil
 }
 
+func eventFromStreamStatus(err error) *auditv1.RequestEvent {
+	s := status.Convert(err)
+	if s == nil {
+		s = status.New(codes.OK, "")
+	}
+
+	return &auditv1.RequestEvent{
+		Status:           s.Proto(),
+		ResponseMetadata: &auditv1.ResponseMetadata{},
+	}
+}
+
 func (m *mid) eventFromResponse(resp interface{}, err error) (*auditv1.RequestEvent, error) {
 	s := status.Convert(err)
 	if s == nil {
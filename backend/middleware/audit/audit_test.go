@@ -84,6 +84,34 @@ func TestInterceptor(t *testing.T) {
 	assert.EqualValues(t, 1, a.updateCount)
 }
 
+type streamMock struct {
+	grpc.ServerStream
+}
+
+func (s *streamMock) Context() context.Context { return context.Background() }
+
+func (s *streamMock) RecvMsg(interface{}) error { return nil }
+
+func TestStreamInterceptor(t *testing.T) {
+	a := &mockAuditor{}
+	m := &mid{
+		audit: a,
+	}
+
+	interceptor := m.StreamInterceptor()
+	err := interceptor(nil, &streamMock{}, &grpc.StreamServerInfo{FullMethod: "/foo/bar"},
+		func(srv interface{}, stream grpc.ServerStream) error {
+			// Only the first request is audited.
+			assert.NoError(t, stream.RecvMsg(&healthcheckv1.HealthcheckRequest{}))
+			assert.NoError(t, stream.RecvMsg(&healthcheckv1.HealthcheckRequest{}))
+			return errors.New("error")
+		})
+
+	assert.Error(t, err)
+	assert.EqualValues(t, 1, a.writeCount)
+	assert.EqualValues(t, 1, a.updateCount)
+}
+
 func TestInterceptorShortCircuitDisabled(t *testing.T) {
 	a := &mockAuditor{}
 	m := &mid{
@@ -49,33 +49,52 @@ type mid struct {
 
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		// Check for auth.
-		authenticatedCtx, authErr := m.authenticate(ctx)
-
-		// Determine if it's on the allow list.
-		checkRequired := true
-		for _, allow := range authn.AlwaysAllowedMethods {
-			if middleware.MatchMethodOrResource(allow, info.FullMethod) {
-				checkRequired = false
-				break
-			}
+		ctx, err := m.claimsContext(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		// Assert auth if required.
-		if checkRequired {
-			if authErr != nil {
-				return nil, status.New(codes.Unauthenticated, authErr.Error()).Err()
-			}
-			return handler(authenticatedCtx, req)
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := m.claimsContext(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
 		}
+		return handler(srv, &middleware.ServerStream{ServerStream: ss, Ctx: ctx})
+	}
+}
 
-		// If auth not required, we still append claims for logging purposes or anonymously accessible APIs.
-		if _, err := authn.ClaimsFromContext(authenticatedCtx); err != nil {
-			// Anonymous claims if there weren't any authenticated claims.
-			return handler(authn.ContextWithAnonymousClaims(ctx), req)
+// Returns the context with the claims of the caller to pass to the handler of a method.
+func (m *mid) claimsContext(ctx context.Context, fullMethod string) (context.Context, error) {
+	// Check for auth.
+	authenticatedCtx, authErr := m.authenticate(ctx)
+
+	// Determine if it's on the allow list.
+	checkRequired := true
+	for _, allow := range authn.AlwaysAllowedMethods {
+		if middleware.MatchMethodOrResource(allow, fullMethod) {
+			checkRequired = false
+			break
 		}
-		return handler(authenticatedCtx, req)
 	}
+
+	// Assert auth if required.
+	if checkRequired {
+		if authErr != nil {
+			return nil, status.New(codes.Unauthenticated, authErr.Error()).Err()
+		}
+		return authenticatedCtx, nil
+	}
+
+	// If auth not required, we still append claims for logging purposes or anonymously accessible APIs.
+	if _, err := authn.ClaimsFromContext(authenticatedCtx); err != nil {
+		// Anonymous claims if there weren't any authenticated claims.
+		return authn.ContextWithAnonymousClaims(ctx), nil
+	}
+	return authenticatedCtx, nil
 }
 
 // getToken looks for the token in the authorization header or cookies.
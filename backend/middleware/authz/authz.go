@@ -59,50 +59,69 @@ func (m *mid) evaluate(ctx context.Context, r *authzv1.CheckRequest) error {
 
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
-		// Never interfere with allowlisted flows.
-		for _, allow := range authn.AlwaysAllowedMethods {
-			if middleware.MatchMethodOrResource(allow, info.FullMethod) {
-				return handler(ctx, req)
-			}
-		}
-
-		claims, err := authn.ClaimsFromContext(ctx)
-		if err != nil {
+		if err := m.authorize(ctx, info.FullMethod, req); err != nil {
 			return nil, err
 		}
+		return handler(ctx, req)
+	}
+}
 
-		actionType := meta.GetAction(info.FullMethod)
-		resources := meta.ResourceNames(req.(proto.Message))
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Every request received on the stream is authorized before the handler gets it.
+		return handler(srv, &middleware.ServerStream{
+			ServerStream: ss,
+			OnRecvMsg: func(req interface{}) error {
+				return m.authorize(ss.Context(), info.FullMethod, req)
+			},
+		})
+	}
+}
 
-		subject := &authzv1.Subject{
-			User:   claims.Subject,
-			Groups: claims.Groups,
+func (m *mid) authorize(ctx context.Context, fullMethod string, req interface{}) error {
+	// Never interfere with allowlisted flows.
+	for _, allow := range authn.AlwaysAllowedMethods {
+		if middleware.MatchMethodOrResource(allow, fullMethod) {
+			return nil
 		}
+	}
 
-		if len(resources) == 0 {
-			check := &authzv1.CheckRequest{
-				Subject:    subject,
-				Method:     info.FullMethod,
-				ActionType: actionType,
-			}
-			if err := m.evaluate(ctx, check); err != nil {
-				return nil, err
-			}
+	claims, err := authn.ClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	actionType := meta.GetAction(fullMethod)
+	resources := meta.ResourceNames(req.(proto.Message))
+
+	subject := &authzv1.Subject{
+		User:   claims.Subject,
+		Groups: claims.Groups,
+	}
+
+	if len(resources) == 0 {
+		check := &authzv1.CheckRequest{
+			Subject:    subject,
+			Method:     fullMethod,
+			ActionType: actionType,
+		}
+		if err := m.evaluate(ctx, check); err != nil {
+			return err
 		}
+	}
 
-		for _, resource := range resources {
-			check := &authzv1.CheckRequest{
-				Subject:    subject,
-				Method:     info.FullMethod,
-				ActionType: actionType,
-				Resource:   resource.Id,
-			}
-
-			if err := m.evaluate(ctx, check); err != nil {
-				return nil, err
-			}
+	for _, resource := range resources {
+		check := &authzv1.CheckRequest{
+			Subject:    subject,
+			Method:     fullMethod,
+			ActionType: actionType,
+			Resource:   resource.Id,
 		}
 
-		return handler(ctx, req)
+		if err := m.evaluate(ctx, check); err != nil {
+			return err
+		}
 	}
+
+	return nil
 }
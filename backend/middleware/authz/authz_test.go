@@ -107,3 +107,40 @@ func TestNoResources(t *testing.T) {
 	assert.Equal(t, claims.Subject, s.lastSubject.User)
 	assert.EqualValues(t, claims.Groups, s.lastSubject.Groups)
 }
+
+type streamMock struct {
+	grpc.ServerStream
+
+	ctx context.Context
+}
+
+func (s *streamMock) Context() context.Context { return s.ctx }
+
+func (s *streamMock) RecvMsg(interface{}) error { return nil }
+
+func TestStream(t *testing.T) {
+	s := &svcMock{}
+	m, _ := newWithMock(s)
+	interceptor := m.(middleware.StreamMiddleware).StreamInterceptor()
+
+	info := &grpc.StreamServerInfo{FullMethod: "/clutch.foo/Bar", IsServerStream: true}
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return stream.RecvMsg(&healthcheckv1.HealthcheckRequest{})
+	}
+
+	// The request is not authorized without claims.
+	err := interceptor(nil, &streamMock{ctx: context.Background()}, info, handler)
+	assert.Error(t, err)
+	assert.EqualValues(t, 0, s.called)
+
+	claims := &authn.Claims{
+		StandardClaims: &jwt.StandardClaims{Subject: "foo@example.com"},
+		Groups:         []string{"group-a"},
+	}
+	ctx := authn.ContextWithClaims(context.Background(), claims)
+
+	err = interceptor(nil, &streamMock{ctx: ctx}, info, handler)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, s.called)
+	assert.Equal(t, claims.Subject, s.lastSubject.User)
+}
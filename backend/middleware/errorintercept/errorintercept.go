@@ -45,15 +45,24 @@ func (m *Middleware) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 		// Invoke handler.
 		resp, err := handler(ctx, req)
+		return resp, m.intercept(err)
+	}
+}
+
+func (m *Middleware) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return m.intercept(handler(srv, ss))
+	}
+}
 
-		// Attempt to transform error if there was one.
-		if err != nil {
-			// Iterate in reverse order over each interceptor so the 'significant' foundational service's interceptors get applied last.
-			for i := len(m.interceptors) - 1; i >= 0; i-- {
-				// Apply interceptor and overwrite error.
-				err = m.interceptors[i](err)
-			}
+func (m *Middleware) intercept(err error) error {
+	// Attempt to transform error if there was one.
+	if err != nil {
+		// Iterate in reverse order over each interceptor so the 'significant' foundational service's interceptors get applied last.
+		for i := len(m.interceptors) - 1; i >= 0; i-- {
+			// Apply interceptor and overwrite error.
+			err = m.interceptors[i](err)
 		}
-		return resp, err
 	}
+	return err
 }
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"strings"
 
 	"github.com/gobwas/glob"
@@ -16,6 +17,41 @@ type Middleware interface {
 	UnaryInterceptor() grpc.UnaryServerInterceptor
 }
 
+// StreamMiddleware is implemented by middleware that also intercepts streaming RPCs. The gateway rejects streaming
+// RPCs if any of the configured middleware doesn't implement it, since they would bypass the middleware otherwise.
+type StreamMiddleware interface {
+	StreamInterceptor() grpc.StreamServerInterceptor
+}
+
+// ServerStream wraps the stream passed to the handler of a streaming RPC, to override its context or to inspect the
+// messages received by the handler, e.g. the request of a server streaming RPC.
+type ServerStream struct {
+	grpc.ServerStream
+
+	// The context of the stream, if it's set.
+	Ctx context.Context
+	// Called with every message received by the handler. If it returns an error, the error is returned to the
+	// handler instead of the message.
+	OnRecvMsg func(m interface{}) error
+}
+
+func (s *ServerStream) Context() context.Context {
+	if s.Ctx != nil {
+		return s.Ctx
+	}
+	return s.ServerStream.Context()
+}
+
+func (s *ServerStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.OnRecvMsg != nil {
+		return s.OnRecvMsg(m)
+	}
+	return nil
+}
+
 func SplitFullMethod(fullMethod string) (service string, method string, ok bool) {
 	s := strings.SplitN(fullMethod, "/", 3)
 	if len(s) != 3 {
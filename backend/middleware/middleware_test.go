@@ -1,10 +1,13 @@
 package middleware
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
 )
 
 func TestSplitFullMethod(t *testing.T) {
@@ -121,3 +124,37 @@ func TestDeeplyNestedMatching(t *testing.T) {
 		})
 	}
 }
+
+type streamMock struct {
+	grpc.ServerStream
+}
+
+func (s *streamMock) Context() context.Context { return context.Background() }
+
+func (s *streamMock) RecvMsg(interface{}) error { return nil }
+
+func TestServerStream(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "value")
+
+	var received []interface{}
+	s := &ServerStream{
+		ServerStream: &streamMock{},
+		Ctx:          ctx,
+		OnRecvMsg: func(m interface{}) error {
+			received = append(received, m)
+			if m == "denied" {
+				return errors.New("denied")
+			}
+			return nil
+		},
+	}
+
+	assert.Equal(t, ctx, s.Context())
+	assert.NoError(t, s.RecvMsg("allowed"))
+	assert.Error(t, s.RecvMsg("denied"))
+	assert.Equal(t, []interface{}{"allowed", "denied"}, received)
+
+	// The context of the wrapped stream is used unless it's overridden.
+	assert.Equal(t, context.Background(), (&ServerStream{ServerStream: &streamMock{}}).Context())
+}
@@ -32,24 +32,42 @@ type mid struct {
 
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		service, method, ok := middleware.SplitFullMethod(info.FullMethod)
-		if !ok {
-			m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", info.FullMethod))
-		}
-
-		grpcScope := m.scope.Tagged(map[string]string{
-			"grpc_service": service,
-			"grpc_method":  method,
+		var resp interface{}
+		err := m.record(info.FullMethod, func() (err error) {
+			resp, err = handler(ctx, req)
+			return err
 		})
+		return resp, err
+	}
+}
 
-		t := grpcScope.Timer("rpc_latency").Start()
-		resp, err := handler(ctx, req)
-		t.Stop()
-
-		grpcScope.Tagged(map[string]string{
-			"grpc_status": status.Convert(err).Code().String(),
-		}).Counter("rpc_total").Inc(1)
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return m.record(info.FullMethod, func() error {
+			return handler(srv, ss)
+		})
+	}
+}
 
-		return resp, err
+// Records the latency and status of a call to the handler of a method.
+func (m *mid) record(fullMethod string, handle func() error) error {
+	service, method, ok := middleware.SplitFullMethod(fullMethod)
+	if !ok {
+		m.logger.Warn("could not parse gRPC method", zap.String("fullMethod", fullMethod))
 	}
+
+	grpcScope := m.scope.Tagged(map[string]string{
+		"grpc_service": service,
+		"grpc_method":  method,
+	})
+
+	t := grpcScope.Timer("rpc_latency").Start()
+	err := handle()
+	t.Stop()
+
+	grpcScope.Tagged(map[string]string{
+		"grpc_status": status.Convert(err).Code().String(),
+	}).Counter("rpc_total").Inc(1)
+
+	return err
 }
@@ -25,3 +25,7 @@ type mid struct{}
 func (m *mid) UnaryInterceptor() grpc.UnaryServerInterceptor {
 	return validator.UnaryServerInterceptor()
 }
+
+func (m *mid) StreamInterceptor() grpc.StreamServerInterceptor {
+	return validator.StreamServerInterceptor()
+}
@@ -0,0 +1,109 @@
+package prometheusmock
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// Server is a fake Prometheus-compatible API that answers instant queries with the results set for each query.
+// Queries without a result return an empty vector.
+type Server struct {
+	*httptest.Server
+
+	mu      sync.Mutex
+	results map[string][]Sample
+	errors  map[string]string
+	queries []string
+}
+
+func NewServer() *Server {
+	s := &Server{
+		results: make(map[string][]Sample),
+		errors:  make(map[string]string),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handleQuery))
+	return s
+}
+
+// SetResult sets the vector returned for a query.
+func (s *Server) SetResult(query string, samples ...Sample) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.results[query] = samples
+	delete(s.errors, query)
+}
+
+// SetError makes a query fail with a bad_data error.
+func (s *Server) SetError(query, message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.errors[query] = message
+}
+
+// Queries returns the queries received so far, in order.
+func (s *Server) Queries() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.queries...)
+}
+
+type vectorSample struct {
+	Metric map[string]string `json:"metric"`
+	Value  []interface{}     `json:"value"`
+}
+
+func (s *Server) handleQuery(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if r.URL.Path != "/api/v1/query" {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	query := r.FormValue("query")
+
+	s.mu.Lock()
+	s.queries = append(s.queries, query)
+	samples := s.results[query]
+	message, failed := s.errors[query]
+	s.mu.Unlock()
+
+	if failed {
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"status":    "error",
+			"errorType": "bad_data",
+			"error":     message,
+		})
+		return
+	}
+
+	now := float64(time.Now().UnixNano()) / 1e9
+	result := make([]*vectorSample, len(samples))
+	for idx, sample := range samples {
+		labels := sample.Labels
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		result[idx] = &vectorSample{
+			Metric: labels,
+			Value:  []interface{}{now, strconv.FormatFloat(sample.Value, 'f', -1, 64)},
+		}
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"status": "success",
+		"data": map[string]interface{}{
+			"resultType": "vector",
+			"result":     result,
+		},
+	})
+}
@@ -15,6 +15,7 @@ import (
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	"github.com/lyft/clutch/backend/service"
 	"github.com/lyft/clutch/backend/service/audit"
+	"github.com/lyft/clutch/backend/service/audit/storage"
 )
 
 type svc struct {
@@ -68,6 +69,9 @@ func (s *svc) ReadEvents(_ context.Context, start time.Time, end *time.Time, opt
 		if stop.Before(eventTime) {
 			break
 		}
+		if options != nil && (!storage.MatchesFilter(event, options.Filter) || !options.After.Precedes(event)) {
+			continue
+		}
 		events = append(events, event)
 	}
 
@@ -19,12 +19,17 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	dynamodbv1 "github.com/lyft/clutch/backend/api/aws/dynamodb/v1"
 	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+	elbv2v1 "github.com/lyft/clutch/backend/api/aws/elbv2/v1"
 	iamv1 "github.com/lyft/clutch/backend/api/aws/iam/v1"
 	kinesisv1 "github.com/lyft/clutch/backend/api/aws/kinesis/v1"
+	lambdav1 "github.com/lyft/clutch/backend/api/aws/lambda/v1"
+	rdsv1 "github.com/lyft/clutch/backend/api/aws/rds/v1"
 	s3v1 "github.com/lyft/clutch/backend/api/aws/s3/v1"
 	"github.com/lyft/clutch/backend/service"
 	clutchawsclient "github.com/lyft/clutch/backend/service/aws"
@@ -54,6 +59,71 @@ func (s *svc) ResizeAutoscalingGroup(ctx context.Context, account, region, name
 	return nil
 }
 
+func (s *svc) DescribeAutoscalingScheduledActions(ctx context.Context, account, region, groupName string) ([]*ec2v1.ScheduledAction, error) {
+	return []*ec2v1.ScheduledAction{
+		{
+			Name:                 "weekday-scale-up",
+			AutoscalingGroupName: groupName,
+			Region:               region,
+			Account:              account,
+			Recurrence:           "0 13 * * 1-5",
+			TimeZone:             "America/Los_Angeles",
+			MinSize:              wrapperspb.Int32(50),
+			DesiredCapacity:      wrapperspb.Int32(150),
+		},
+		{
+			Name:                 "launch-event",
+			AutoscalingGroupName: groupName,
+			Region:               region,
+			Account:              account,
+			StartTime:            timestamppb.New(time.Now().Add(72 * time.Hour).Truncate(time.Hour)),
+			MinSize:              wrapperspb.Int32(100),
+			MaxSize:              wrapperspb.Int32(300),
+		},
+	}, nil
+}
+
+func (s *svc) CreateAutoscalingScheduledAction(ctx context.Context, account, region, groupName string, action *ec2v1.ScheduledAction) (*ec2v1.ScheduledAction, error) {
+	ret := &ec2v1.ScheduledAction{
+		Name:                 action.Name,
+		AutoscalingGroupName: groupName,
+		Region:               region,
+		Account:              account,
+		Recurrence:           action.Recurrence,
+		StartTime:            action.StartTime,
+		EndTime:              action.EndTime,
+		TimeZone:             action.TimeZone,
+		MinSize:              action.MinSize,
+		MaxSize:              action.MaxSize,
+		DesiredCapacity:      action.DesiredCapacity,
+	}
+	return ret, nil
+}
+
+func (s *svc) DeleteAutoscalingScheduledAction(ctx context.Context, account, region, groupName, actionName string) error {
+	return nil
+}
+
+func (s *svc) DescribeAutoscalingWarmPool(ctx context.Context, account, region, groupName string) (*ec2v1.WarmPool, error) {
+	return &ec2v1.WarmPool{
+		MinSize:        10,
+		PoolState:      ec2v1.WarmPool_STOPPED,
+		ReuseOnScaleIn: true,
+		Instances: []*ec2v1.WarmPool_Instance{
+			{
+				Id:             "i-32345892010",
+				Zone:           region + "a",
+				LifecycleState: "Warmed:Stopped",
+				Healthy:        true,
+			},
+		},
+	}, nil
+}
+
+func (s *svc) UpdateAutoscalingWarmPool(ctx context.Context, account, region, groupName string, warmPool *ec2v1.WarmPool) error {
+	return nil
+}
+
 func (s *svc) DescribeAutoscalingGroups(ctx context.Context, account, region string, names []string) ([]*ec2v1.AutoscalingGroup, error) {
 	ret := make([]*ec2v1.AutoscalingGroup, len(names))
 	for idx, name := range names {
@@ -92,6 +162,104 @@ func (s *svc) DescribeAutoscalingGroups(ctx context.Context, account, region str
 	return ret, nil
 }
 
+func (s *svc) DescribeLoadBalancers(ctx context.Context, account, region string, names []string) ([]*elbv2v1.LoadBalancer, error) {
+	if len(names) == 0 {
+		names = []string{"my-load-balancer"}
+	}
+
+	ret := make([]*elbv2v1.LoadBalancer, len(names))
+	for idx, name := range names {
+		ret[idx] = &elbv2v1.LoadBalancer{
+			Name:    name,
+			Region:  region,
+			Account: account,
+			Arn:     fmt.Sprintf("arn:aws:elasticloadbalancing:%s:000000000000:loadbalancer/app/%s/50dc6c495c0c9188", region, name),
+			DnsName: fmt.Sprintf("%s-1234567890.%s.elb.amazonaws.com", name, region),
+			Type:    elbv2v1.LoadBalancer_APPLICATION,
+			Scheme:  elbv2v1.LoadBalancer_INTERNAL,
+			State:   elbv2v1.LoadBalancer_ACTIVE,
+			VpcId:   "vpc-3ac0fb5f",
+			Zones:   []string{region + "a", region + "b"},
+		}
+	}
+	return ret, nil
+}
+
+func (s *svc) DescribeListeners(ctx context.Context, account, region, loadBalancerName string) ([]*elbv2v1.Listener, error) {
+	return []*elbv2v1.Listener{
+		{
+			Arn:                    fmt.Sprintf("arn:aws:elasticloadbalancing:%s:000000000000:listener/app/%s/50dc6c495c0c9188/f2f7dc8efc522ab2", region, loadBalancerName),
+			LoadBalancerArn:        fmt.Sprintf("arn:aws:elasticloadbalancing:%s:000000000000:loadbalancer/app/%s/50dc6c495c0c9188", region, loadBalancerName),
+			Port:                   443,
+			Protocol:               "HTTPS",
+			DefaultTargetGroupArns: []string{fmt.Sprintf("arn:aws:elasticloadbalancing:%s:000000000000:targetgroup/my-targets/73e2d6bc24d8a067", region)},
+		},
+	}, nil
+}
+
+func (s *svc) DescribeTargetGroups(ctx context.Context, account, region string, names []string) ([]*elbv2v1.TargetGroup, error) {
+	if len(names) == 0 {
+		names = []string{"my-targets"}
+	}
+
+	ret := make([]*elbv2v1.TargetGroup, len(names))
+	for idx, name := range names {
+		ret[idx] = &elbv2v1.TargetGroup{
+			Name:             name,
+			Region:           region,
+			Account:          account,
+			Arn:              fmt.Sprintf("arn:aws:elasticloadbalancing:%s:000000000000:targetgroup/%s/73e2d6bc24d8a067", region, name),
+			Protocol:         "HTTP",
+			Port:             80,
+			VpcId:            "vpc-3ac0fb5f",
+			TargetType:       elbv2v1.TargetGroup_INSTANCE,
+			LoadBalancerArns: []string{fmt.Sprintf("arn:aws:elasticloadbalancing:%s:000000000000:loadbalancer/app/my-load-balancer/50dc6c495c0c9188", region)},
+			HealthCheck: &elbv2v1.HealthCheck{
+				Enabled:                 true,
+				Protocol:                "HTTP",
+				Port:                    "traffic-port",
+				Path:                    "/healthcheck",
+				IntervalSeconds:         30,
+				TimeoutSeconds:          5,
+				HealthyThresholdCount:   5,
+				UnhealthyThresholdCount: 2,
+				Matcher:                 "200",
+			},
+		}
+	}
+	return ret, nil
+}
+
+func (s *svc) DescribeTargetHealth(ctx context.Context, account, region, targetGroupName string) ([]*elbv2v1.TargetHealth, error) {
+	arn := fmt.Sprintf("arn:aws:elasticloadbalancing:%s:000000000000:targetgroup/%s/73e2d6bc24d8a067", region, targetGroupName)
+	return []*elbv2v1.TargetHealth{
+		{
+			TargetGroupName: targetGroupName,
+			TargetGroupArn:  arn,
+			Target:          &elbv2v1.Target{Id: "i-12345892010", Port: 80},
+			HealthCheckPort: "80",
+			State:           elbv2v1.TargetHealth_HEALTHY,
+		},
+		{
+			TargetGroupName: targetGroupName,
+			TargetGroupArn:  arn,
+			Target:          &elbv2v1.Target{Id: "i-22345892010", Port: 80},
+			HealthCheckPort: "80",
+			State:           elbv2v1.TargetHealth_UNHEALTHY,
+			Reason:          elbv2v1.TargetHealth_TARGET_RESPONSE_CODE_MISMATCH,
+			Description:     "Health checks failed with these codes: [503]",
+		},
+	}, nil
+}
+
+func (s *svc) RegisterTargets(ctx context.Context, account, region, targetGroupName string, targets []*elbv2v1.Target) error {
+	return nil
+}
+
+func (s *svc) DeregisterTargets(ctx context.Context, account, region, targetGroupName string, targets []*elbv2v1.Target) error {
+	return nil
+}
+
 func (s *svc) DescribeInstances(ctx context.Context, account, region string, ids []string) ([]*ec2v1.Instance, error) {
 	var ret []*ec2v1.Instance
 	for _, id := range ids {
@@ -110,6 +278,17 @@ func (s *svc) DescribeInstances(ctx context.Context, account, region string, ids
 	return ret, nil
 }
 
+func (s *svc) DescribeInstancesTargetHealth(ctx context.Context, account, region string, instances []*ec2v1.Instance) error {
+	for _, i := range instances {
+		i.TargetHealth = append(i.TargetHealth, &elbv2v1.TargetHealth{
+			TargetGroupName: "my-targets",
+			Target:          &elbv2v1.Target{Id: i.InstanceId},
+			State:           elbv2v1.TargetHealth_HEALTHY,
+		})
+	}
+	return nil
+}
+
 func (s *svc) TerminateInstances(ctx context.Context, account, region string, ids []string) error {
 	return nil
 }
@@ -302,6 +481,147 @@ func (s *svc) GetPrimaryAccountAlias() string {
 	return "default"
 }
 
+func (s *svc) DescribeDBInstance(ctx context.Context, account, region, identifier string) (*rdsv1.DBInstance, error) {
+	return &rdsv1.DBInstance{
+		Identifier:                identifier,
+		Region:                    region,
+		Account:                   account,
+		Arn:                       fmt.Sprintf("arn:aws:rds:%s:000000000000:db:%s", region, identifier),
+		Engine:                    "aurora-postgresql",
+		EngineVersion:             "15.4",
+		InstanceClass:             "db.r6g.large",
+		Status:                    "available",
+		AvailabilityZone:          region + "a",
+		SecondaryAvailabilityZone: region + "b",
+		MultiAz:                   true,
+		Endpoint: &rdsv1.Endpoint{
+			Address: fmt.Sprintf("%s.abcdefghijkl.%s.rds.amazonaws.com", identifier, region),
+			Port:    5432,
+		},
+		DbClusterIdentifier:        "my-db-cluster",
+		ReadReplicaIdentifiers:     []string{identifier + "-replica"},
+		PreferredMaintenanceWindow: "sun:05:00-sun:06:00",
+		PendingMaintenanceActions: []*rdsv1.PendingMaintenanceAction{
+			{
+				Action:               "system-update",
+				Description:          "New Operating System update is available",
+				AutoAppliedAfterDate: timestamppb.New(time.Now().Add(7 * 24 * time.Hour)),
+			},
+		},
+	}, nil
+}
+
+func (s *svc) DescribeDBCluster(ctx context.Context, account, region, identifier string) (*rdsv1.DBCluster, error) {
+	return &rdsv1.DBCluster{
+		Identifier:     identifier,
+		Region:         region,
+		Account:        account,
+		Arn:            fmt.Sprintf("arn:aws:rds:%s:000000000000:cluster:%s", region, identifier),
+		Engine:         "aurora-postgresql",
+		EngineVersion:  "15.4",
+		Status:         "available",
+		MultiAz:        true,
+		Endpoint:       fmt.Sprintf("%s.cluster-abcdefghijkl.%s.rds.amazonaws.com", identifier, region),
+		ReaderEndpoint: fmt.Sprintf("%s.cluster-ro-abcdefghijkl.%s.rds.amazonaws.com", identifier, region),
+		Port:           5432,
+		Members: []*rdsv1.DBClusterMember{
+			{DbInstanceIdentifier: identifier + "-1", Writer: true, PromotionTier: 1},
+			{DbInstanceIdentifier: identifier + "-2", Writer: false, PromotionTier: 1},
+		},
+		PreferredMaintenanceWindow: "sun:05:00-sun:06:00",
+	}, nil
+}
+
+func (s *svc) RebootDBInstance(ctx context.Context, account, region, identifier string, forceFailover bool) error {
+	return nil
+}
+
+func (s *svc) FailoverDBCluster(ctx context.Context, account, region, identifier, targetInstanceIdentifier string) error {
+	return nil
+}
+
+func (s *svc) DescribeRDSEvents(ctx context.Context, account, region, sourceIdentifier string, sourceType rdsv1.Event_SourceType, duration time.Duration) ([]*rdsv1.Event, error) {
+	if sourceIdentifier == "" {
+		sourceIdentifier = "my-db-instance"
+		sourceType = rdsv1.Event_DB_INSTANCE
+	}
+
+	return []*rdsv1.Event{
+		{
+			SourceIdentifier: sourceIdentifier,
+			SourceType:       sourceType,
+			Message:          "DB instance restarted",
+			Categories:       []string{"availability"},
+			Date:             timestamppb.New(time.Now().Add(-10 * time.Minute)),
+		},
+		{
+			SourceIdentifier: sourceIdentifier,
+			SourceType:       sourceType,
+			Message:          "Multi-AZ instance failover completed",
+			Categories:       []string{"failover"},
+			Date:             timestamppb.New(time.Now().Add(-5 * time.Minute)),
+		},
+	}, nil
+}
+
+func (s *svc) DescribeLambdaFunction(ctx context.Context, account, region, name string) (*lambdav1.Function, error) {
+	return &lambdav1.Function{
+		Name:                         name,
+		Region:                       region,
+		Account:                      account,
+		Arn:                          fmt.Sprintf("arn:aws:lambda:%s:000000000000:function:%s", region, name),
+		Runtime:                      "python3.12",
+		Handler:                      "main.handler",
+		PackageType:                  "Zip",
+		Architectures:                []string{"arm64"},
+		MemorySizeMb:                 512,
+		TimeoutSeconds:               30,
+		State:                        lambdav1.Function_ACTIVE,
+		EnvironmentVariableKeys:      []string{"API_TOKEN", "LOG_LEVEL"},
+		ReservedConcurrentExecutions: wrapperspb.Int32(100),
+		Aliases: []*lambdav1.Function_Alias{
+			{
+				Name:                     "live",
+				FunctionVersion:          "2",
+				AdditionalVersionWeights: map[string]float64{"3": 0.1},
+			},
+		},
+		Versions: []*lambdav1.Function_Version{
+			{Version: "2"},
+			{Version: "3"},
+		},
+	}, nil
+}
+
+func (s *svc) GetLambdaInvocationSummary(ctx context.Context, account, region, name string, window time.Duration) (*lambdav1.InvocationSummary, error) {
+	ret := &lambdav1.InvocationSummary{Period: durationpb.New(time.Minute)}
+	end := time.Now().Truncate(time.Minute)
+	for i := 10; i > 0; i-- {
+		dp := &lambdav1.InvocationSummary_Datapoint{
+			Timestamp:   timestamppb.New(end.Add(-time.Duration(i) * time.Minute)),
+			Invocations: float64(rand.Intn(1000)),
+			Errors:      float64(rand.Intn(50)),
+			Throttles:   float64(rand.Intn(20)),
+		}
+		ret.Invocations += dp.Invocations
+		ret.Errors += dp.Errors
+		ret.Throttles += dp.Throttles
+		ret.Datapoints = append(ret.Datapoints, dp)
+	}
+	if ret.Invocations > 0 {
+		ret.ErrorRate = ret.Errors / ret.Invocations
+	}
+	return ret, nil
+}
+
+func (s *svc) PutLambdaFunctionConcurrency(ctx context.Context, account, region, name string, reservedConcurrentExecutions int32) error {
+	return nil
+}
+
+func (s *svc) DeleteLambdaFunctionConcurrency(ctx context.Context, account, region, name string) error {
+	return nil
+}
+
 func (s *svc) GetCallerIdentity(ctx context.Context, account, region string) (*sts.GetCallerIdentityOutput, error) {
 	return &sts.GetCallerIdentityOutput{
 		Account: aws.String("000000000000"),
@@ -4,6 +4,10 @@ import (
 	"context"
 	"strconv"
 	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -16,9 +20,37 @@ type SimpleStorer struct {
 	experiments []*experimentstore.Experiment
 	idGenerator int
 
+	schedules []*experimentstore.ExperimentSchedule
+	templates []*experimentstore.ExperimentTemplate
+	locked    bool
+
+	injectionStates map[string]*experimentstore.InjectionState
+
+	subscribers []chan struct{}
+
 	sync.Mutex
 }
 
+// Subscribe returns a channel that receives a value whenever an experiment is created or cancelled.
+func (s *SimpleStorer) Subscribe(ctx context.Context) (<-chan struct{}, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	c := make(chan struct{}, 1)
+	s.subscribers = append(s.subscribers, c)
+	return c, nil
+}
+
+// Must be called with the lock held.
+func (s *SimpleStorer) notify() {
+	for _, c := range s.subscribers {
+		select {
+		case c <- struct{}{}:
+		default:
+		}
+	}
+}
+
 func (s *SimpleStorer) CreateExperiment(ctx context.Context, es *experimentstore.ExperimentSpecification) (*experimentstore.Experiment, error) {
 	s.Lock()
 	defer s.Unlock()
@@ -42,6 +74,7 @@ func (s *SimpleStorer) CreateExperiment(ctx context.Context, es *experimentstore
 	}
 	s.experiments = append(s.experiments, e)
 	s.idGenerator++
+	s.notify()
 	return s.experiments[len(s.experiments)-1], nil
 }
 
@@ -71,6 +104,7 @@ func (s *SimpleStorer) CancelExperimentRun(ctx context.Context, runId string, re
 	}
 
 	s.experiments = newExperiments
+	s.notify()
 	return nil
 }
 
@@ -95,4 +129,172 @@ func (s *SimpleStorer) RegisterTransformation(transformation experimentstore.Tra
 
 func (s *SimpleStorer) Close() {}
 
-var _ experimentstore.Storer = &SimpleStorer{}
+func (s *SimpleStorer) CreateExperimentSchedule(ctx context.Context, schedule *experimentstore.ExperimentSchedule) (*experimentstore.ExperimentSchedule, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, existing := range s.schedules {
+		if existing.Id == schedule.Id {
+			return nil, status.Errorf(codes.AlreadyExists, "experiment schedule '%s' already exists", schedule.Id)
+		}
+	}
+
+	if schedule.CreationTime.IsZero() {
+		schedule.CreationTime = time.Now()
+	}
+	s.schedules = append(s.schedules, schedule)
+	return schedule, nil
+}
+
+func (s *SimpleStorer) GetExperimentSchedules(ctx context.Context) ([]*experimentstore.ExperimentSchedule, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	var schedules []*experimentstore.ExperimentSchedule
+	schedules = append(schedules, s.schedules...)
+	return schedules, nil
+}
+
+func (s *SimpleStorer) SetExperimentSchedulePaused(ctx context.Context, id string, paused bool) (*experimentstore.ExperimentSchedule, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, schedule := range s.schedules {
+		if schedule.Id == id {
+			schedule.Paused = paused
+			return schedule, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "experiment schedule '%s' not found", id)
+}
+
+func (s *SimpleStorer) DeleteExperimentSchedule(ctx context.Context, id string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	var schedules []*experimentstore.ExperimentSchedule
+	for _, schedule := range s.schedules {
+		if schedule.Id != id {
+			schedules = append(schedules, schedule)
+		}
+	}
+	s.schedules = schedules
+	return nil
+}
+
+func (s *SimpleStorer) SetExperimentScheduleLastOccurrence(ctx context.Context, id string, occurrence time.Time, runId string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, schedule := range s.schedules {
+		if schedule.Id == id {
+			schedule.LastOccurrenceTime = &occurrence
+			schedule.LastRunId = runId
+		}
+	}
+	return nil
+}
+
+func (s *SimpleStorer) AttemptLock(ctx context.Context, lockID uint32) (bool, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.locked {
+		return false, nil
+	}
+	s.locked = true
+	return true, nil
+}
+
+func (s *SimpleStorer) ReleaseLock(ctx context.Context, lockID uint32) (bool, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	unlocked := s.locked
+	s.locked = false
+	return unlocked, nil
+}
+
+func (s *SimpleStorer) CreateExperimentTemplate(ctx context.Context, template *experimentstore.ExperimentTemplate) (*experimentstore.ExperimentTemplate, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, existing := range s.templates {
+		if existing.Id == template.Id {
+			return nil, status.Errorf(codes.AlreadyExists, "experiment template '%s' already exists", template.Id)
+		}
+	}
+
+	if template.CreationTime.IsZero() {
+		template.CreationTime = time.Now()
+	}
+	s.templates = append(s.templates, template)
+	return template, nil
+}
+
+func (s *SimpleStorer) GetExperimentTemplates(ctx context.Context) ([]*experimentstore.ExperimentTemplate, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	var templates []*experimentstore.ExperimentTemplate
+	templates = append(templates, s.templates...)
+	return templates, nil
+}
+
+func (s *SimpleStorer) GetExperimentTemplate(ctx context.Context, id string) (*experimentstore.ExperimentTemplate, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, template := range s.templates {
+		if template.Id == id {
+			return template, nil
+		}
+	}
+	return nil, status.Errorf(codes.NotFound, "experiment template '%s' not found", id)
+}
+
+func (s *SimpleStorer) DeleteExperimentTemplate(ctx context.Context, id string) error {
+	s.Lock()
+	defer s.Unlock()
+
+	var templates []*experimentstore.ExperimentTemplate
+	for _, template := range s.templates {
+		if template.Id != id {
+			templates = append(templates, template)
+		}
+	}
+	s.templates = templates
+	return nil
+}
+
+func (s *SimpleStorer) GetInjectionState(ctx context.Context, runId string) (*experimentstore.InjectionState, error) {
+	s.Lock()
+	defer s.Unlock()
+
+	state, ok := s.injectionStates[runId]
+	if !ok {
+		return nil, nil
+	}
+	c := *state
+	return &c, nil
+}
+
+func (s *SimpleStorer) SetInjectionState(ctx context.Context, runId string, state *experimentstore.InjectionState) error {
+	s.Lock()
+	defer s.Unlock()
+
+	if s.injectionStates == nil {
+		s.injectionStates = map[string]*experimentstore.InjectionState{}
+	}
+	c := *state
+	s.injectionStates[runId] = &c
+	return nil
+}
+
+var (
+	_ experimentstore.Storer               = &SimpleStorer{}
+	_ experimentstore.Notifier             = &SimpleStorer{}
+	_ experimentstore.ScheduleStorer       = &SimpleStorer{}
+	_ experimentstore.TemplateStorer       = &SimpleStorer{}
+	_ experimentstore.InjectionStateStorer = &SimpleStorer{}
+)
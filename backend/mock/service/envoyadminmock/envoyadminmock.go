@@ -32,6 +32,15 @@ func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 		resp = serverInfoResponse
 	case "/stats":
 		resp = statsResponse
+		if req.URL.Query().Get("format") == "json" {
+			resp = statsJSONResponses[req.URL.Query().Get("type")]
+		}
+	case "/certs":
+		resp = certsResponse
+	case "/logging":
+		resp = loggingResponse
+	case "/runtime_modify", "/healthcheck/fail", "/healthcheck/ok", "/drain_listeners":
+		resp = "OK\n"
 	default:
 		return nil, fmt.Errorf("path '%s' was not implemented in mock transport", req.URL.Path)
 	}
@@ -44,8 +53,12 @@ func (m *mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	}, nil
 }
 
+// NewHTTPClient returns an HTTP client that serves the mock responses of the admin interface for any host.
+func NewHTTPClient() *http.Client {
+	return &http.Client{Transport: &mockTransport{}}
+}
+
 func NewAsService(*anypb.Any, *zap.Logger, tally.Scope) (service.Service, error) {
-	httpClient := &http.Client{Transport: &mockTransport{}}
 	a, _ := anypb.New(&envoyadminv1.Config{Secure: false, DefaultRemotePort: 9999})
-	return envoyadmin.NewWithHTTPClient(a, nil, nil, httpClient)
+	return envoyadmin.NewWithHTTPClient(a, nil, nil, NewHTTPClient())
 }
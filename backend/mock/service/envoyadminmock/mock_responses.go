@@ -188,3 +188,75 @@ server.memory_allocated: 9981232
 cluster.local_service.upstream_cx_connect_ms: No recorded values
 server.initialization_time_ms: No recorded values
 `
+
+// JSON stats keyed by the requested stat type.
+var statsJSONResponses = map[string]string{
+	"Counters": `{"stats": [
+  {"name": "cluster.local_service.upstream_rq_2xx", "value": 1024},
+  {"name": "cluster.local_service.upstream_rq_5xx", "value": 3},
+  {"name": "filesystem.flushed_by_timer", "value": 1},
+  {"name": "filesystem.write_completed", "value": 1}
+]}`,
+	"Gauges": `{"stats": [
+  {"name": "http.admin.downstream_cx_active", "value": 1},
+  {"name": "runtime.num_keys", "value": 362},
+  {"name": "server.memory_allocated", "value": 9981232}
+]}`,
+	"Histograms": `{"stats": [{"histograms": {
+  "supported_quantiles": [0, 25, 50, 75, 90, 95, 99, 99.5, 99.9, 100],
+  "computed_quantiles": [{"name": "cluster.local_service.upstream_rq_time", "values": [
+    {"interval": null, "cumulative": 1},
+    {"interval": null, "cumulative": 2.025},
+    {"interval": null, "cumulative": 3.05},
+    {"interval": null, "cumulative": 5.075},
+    {"interval": null, "cumulative": 9.09},
+    {"interval": null, "cumulative": 12.095},
+    {"interval": null, "cumulative": 24.099},
+    {"interval": null, "cumulative": 30.0995},
+    {"interval": null, "cumulative": 41.0999},
+    {"interval": null, "cumulative": 45}
+  ]}]
+}}]}`,
+}
+
+const certsResponse = `{
+ "certificates": [
+  {
+   "ca_cert": [
+    {
+     "path": "/etc/ssl/certs/ca.pem",
+     "serial_number": "5f2a0c1e",
+     "subject_alt_names": [],
+     "days_until_expiration": "412",
+     "valid_from": "2024-01-01T00:00:00Z",
+     "expiration_time": "2027-12-05T00:00:00Z"
+    }
+   ],
+   "cert_chain": [
+    {
+     "path": "/etc/ssl/certs/server.pem",
+     "serial_number": "1d9c3b",
+     "subject_alt_names": [
+      {
+       "dns": "local-service.example.com"
+      }
+     ],
+     "days_until_expiration": "12",
+     "valid_from": "2026-07-01T00:00:00Z",
+     "expiration_time": "2026-10-31T00:00:00Z"
+    }
+   ]
+  }
+ ]
+}
+`
+
+const loggingResponse = `active loggers:
+  admin: info
+  config: info
+  connection: info
+  http: debug
+  router: info
+  runtime: info
+  upstream: info
+`
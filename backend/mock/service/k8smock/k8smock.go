@@ -2,12 +2,15 @@ package k8smock
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"net/http"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 
 	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 	"github.com/lyft/clutch/backend/service"
@@ -25,6 +28,10 @@ func (s *svc) GetPodLogs(ctx context.Context, clientset, cluster, namespace, nam
 	}}, nil
 }
 
+func (s *svc) PodProxy(ctx context.Context, clientset, cluster, namespace, name string, port uint32) (*http.Client, string, error) {
+	return &http.Client{}, fmt.Sprintf("http://%s.%s:%d", name, namespace, port), nil
+}
+
 func (s *svc) DescribeHPA(ctx context.Context, clientset, cluster, namespace, name string) (*k8sv1.HPA, error) {
 	hpa := &k8sv1.HPA{
 		Cluster:   "fake-cluster-name",
@@ -175,6 +182,10 @@ func (*svc) DeletePod(ctx context.Context, clientset, cluster, namespace, name s
 	return nil
 }
 
+func (*svc) AddEphemeralContainer(ctx context.Context, clientset, cluster, namespace, name string, container *corev1.EphemeralContainer) error {
+	return nil
+}
+
 func (s *svc) UpdatePod(ctx context.Context, clientset, cluster, namespace, name string, expectedObjectMetaFields *k8sv1.ExpectedObjectMetaFields, objectMetaFields *k8sv1.ObjectMetaFields, removeObjectMetaFields *k8sv1.RemoveObjectMetaFields) error {
 	return nil
 }
@@ -6,19 +6,25 @@ package audit
 
 import (
 	"context"
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	"github.com/lyft/clutch/backend/module"
 	"github.com/lyft/clutch/backend/service"
 	"github.com/lyft/clutch/backend/service/audit"
+	"github.com/lyft/clutch/backend/service/audit/storage"
 )
 
 const Name = "clutch.module.audit"
@@ -53,65 +59,43 @@ func (m *mod) Register(r module.Registrar) error {
 func (m *mod) GetEvents(ctx context.Context, req *auditv1.GetEventsRequest) (*auditv1.GetEventsResponse, error) {
 	resp := &auditv1.GetEventsResponse{}
 
-	var start time.Time
-	var end *time.Time
-
-	switch req.GetWindow().(type) {
-	case *auditv1.GetEventsRequest_Range:
-		timerange := req.GetRange()
-		start = timerange.StartTime.AsTime()
-		endTime := timerange.EndTime.AsTime()
-		end = &endTime
-	case *auditv1.GetEventsRequest_Since:
-		if err := req.GetSince().CheckValid(); err != nil {
-			return nil, fmt.Errorf("problem parsing duration: %w", err)
-		}
-
-		window := req.GetSince().AsDuration()
-		start = time.Now().Add(-window)
-	default:
-		return nil, errors.New("no time window requested")
+	start, end, err := timeWindow(req.GetRange(), req.GetSince())
+	if err != nil {
+		return nil, err
 	}
 
-	var page int
-	if req.PageToken != "" {
-		p, err := strconv.Atoi(req.PageToken)
-		if err != nil || p < 0 {
-			return nil, fmt.Errorf("invalid page token: %s", req.PageToken)
+	options := &audit.ReadOptions{Filter: req.Filter}
+	if page, err := strconv.ParseInt(req.PageToken, 10, 64); err == nil && page > 0 {
+		// n.b. page tokens used to be page numbers, which are still read as offsets so that clients paging through
+		// events while the gateway is upgraded don't fail.
+		limit := int64(req.Limit)
+		if limit == 0 {
+			limit = 1
+		}
+		options.Offset = page * limit
+	} else {
+		options.After, err = parsePageToken(req.PageToken)
+		if err != nil {
+			return nil, err
 		}
-		page = p
-	}
-
-	limit := int(req.Limit)
-	// if a request has no limit we return all found events.
-	if req.Limit == 0 {
-		// in the event there is no request limit set limit to be 1 for purposes
-		// of determining page offset later.
-		limit = 1
 	}
 
-	startIdx := page * limit
+	// if request limit is specified pass that value into the read options call.
 	// n.b. the user defined limit is increased by 1 to help determine if there is
 	// a subsequent page of information that should be denoted in the response
-	additionalEventsNumber := int64(limit + 1)
-	options := &audit.ReadOptions{Offset: int64(startIdx)}
-	// if request limit is specified pass that value into the read options call
 	if req.Limit != 0 {
-		options.Limit = additionalEventsNumber
+		options.Limit = int64(req.Limit + 1)
 	}
 	events, err := m.client.ReadEvents(ctx, start, end, options)
 	if err != nil {
 		return nil, err
 	}
 
-	// This logic is only used for purposes of pagination and should only be triggered if:
-	// 1. there are more than 0 events
-	// 2. if the request has a limit specified
-	// 3. if the number of events is equal to the request limit + 1 meaning there are
-	//    additional events to request on a subsequent page.
-	if len(events) > 0 && req.Limit != 0 && len(events) == int(additionalEventsNumber) {
-		resp.NextPageToken = strconv.FormatInt(int64(page+1), 10)
-		events = events[:len(events)-1]
+	// There are additional events to request on a subsequent page if more events than the limit were read. The
+	// subsequent page starts after the last event of this page.
+	if req.Limit != 0 && len(events) > int(req.Limit) {
+		events = events[:req.Limit]
+		resp.NextPageToken = pageToken(events[len(events)-1])
 	}
 
 	resp.Events = events
@@ -119,6 +103,58 @@ func (m *mod) GetEvents(ctx context.Context, req *auditv1.GetEventsRequest) (*au
 	return resp, nil
 }
 
+// Returns the start and the optional end of the time window of a request. Time windows without an end last until now.
+func timeWindow(timerange *auditv1.TimeRange, since *durationpb.Duration) (time.Time, *time.Time, error) {
+	switch {
+	case timerange != nil:
+		if timerange.EndTime == nil {
+			return timerange.StartTime.AsTime(), nil, nil
+		}
+		endTime := timerange.EndTime.AsTime()
+		return timerange.StartTime.AsTime(), &endTime, nil
+	case since != nil:
+		if err := since.CheckValid(); err != nil {
+			return time.Time{}, nil, fmt.Errorf("problem parsing duration: %w", err)
+		}
+		return time.Now().Add(-since.AsDuration()), nil, nil
+	default:
+		return time.Time{}, nil, errors.New("no time window requested")
+	}
+}
+
+// Page tokens encode the cursor of the last event of the previous page.
+func pageToken(event *auditv1.Event) string {
+	cursor := storage.CursorOf(event)
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%d", cursor.OccurredAt.UnixNano(), cursor.ID)))
+}
+
+func parsePageToken(token string) (*storage.Cursor, error) {
+	// n.b. "0" was the token of the first page when page tokens were page numbers.
+	if token == "" || token == "0" {
+		return nil, nil
+	}
+
+	invalid := fmt.Errorf("invalid page token: %s", token)
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, invalid
+	}
+
+	occurredAt, id, ok := strings.Cut(string(b), ":")
+	if !ok {
+		return nil, invalid
+	}
+	nanos, err := strconv.ParseInt(occurredAt, 10, 64)
+	if err != nil {
+		return nil, invalid
+	}
+	cursor := &storage.Cursor{OccurredAt: time.Unix(0, nanos).UTC()}
+	if cursor.ID, err = strconv.ParseInt(id, 10, 64); err != nil {
+		return nil, invalid
+	}
+	return cursor, nil
+}
+
 func (m *mod) GetEvent(ctx context.Context, req *auditv1.GetEventRequest) (*auditv1.GetEventResponse, error) {
 	event, err := m.client.ReadEvent(ctx, req.EventId)
 	if err != nil {
@@ -130,3 +166,17 @@ func (m *mod) GetEvent(ctx context.Context, req *auditv1.GetEventRequest) (*audi
 	}
 	return resp, nil
 }
+
+func (m *mod) VerifyAuditChain(ctx context.Context, req *auditv1.VerifyAuditChainRequest) (*auditv1.VerifyAuditChainResponse, error) {
+	verifier, ok := m.client.(audit.ChainVerifier)
+	if !ok {
+		return nil, status.Error(codes.Unimplemented, "the audit service does not support verifying the hash chain of events")
+	}
+
+	// The chain is verified up to the current time if the timerange has no end.
+	end := time.Now()
+	if req.Range.EndTime != nil {
+		end = req.Range.EndTime.AsTime()
+	}
+	return verifier.VerifyChain(ctx, req.Range.StartTime.AsTime(), end)
+}
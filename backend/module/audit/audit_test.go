@@ -8,11 +8,14 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	"github.com/lyft/clutch/backend/mock/service/auditmock"
+	"github.com/lyft/clutch/backend/service/audit"
 )
 
 func TestGetEvents(t *testing.T) {
@@ -21,7 +24,7 @@ func TestGetEvents(t *testing.T) {
 		eventCount         int
 		req                *auditv1.GetEventsRequest
 		expectedEventCount int
-		expectedNextToken  string
+		expectNextPage     bool
 		expectedErr        error
 	}{
 		{
@@ -41,7 +44,6 @@ func TestGetEvents(t *testing.T) {
 				},
 			},
 			expectedEventCount: 11,
-			expectedNextToken:  "",
 		},
 		{
 			id:         "with time window in future",
@@ -65,7 +67,6 @@ func TestGetEvents(t *testing.T) {
 				},
 			},
 			expectedEventCount: 11,
-			expectedNextToken:  "",
 		},
 		{
 			id:         "with time since a microsecond ago",
@@ -110,7 +111,6 @@ func TestGetEvents(t *testing.T) {
 				PageToken: "0",
 			},
 			expectedEventCount: 11,
-			expectedNextToken:  "",
 		},
 		{
 			id:         "with page token and limit",
@@ -123,7 +123,7 @@ func TestGetEvents(t *testing.T) {
 				Limit:     5,
 			},
 			expectedEventCount: 5,
-			expectedNextToken:  "1",
+			expectNextPage:     true,
 		},
 		{
 			id:         "with page token and no next page",
@@ -135,7 +135,6 @@ func TestGetEvents(t *testing.T) {
 				PageToken: "0",
 			},
 			expectedEventCount: 10,
-			expectedNextToken:  "",
 		},
 		{
 			id:         "with too large of page token",
@@ -148,6 +147,30 @@ func TestGetEvents(t *testing.T) {
 			},
 			expectedEventCount: 0,
 		},
+		{
+			id:         "with page number token and limit",
+			eventCount: 11,
+			req: &auditv1.GetEventsRequest{
+				Window: &auditv1.GetEventsRequest_Since{
+					Since: durationpb.New(1 * time.Hour),
+				},
+				PageToken: "1",
+				Limit:     5,
+			},
+			expectedEventCount: 5,
+			expectNextPage:     true,
+		},
+		{
+			id:         "with invalid page token",
+			eventCount: 1,
+			req: &auditv1.GetEventsRequest{
+				Window: &auditv1.GetEventsRequest_Since{
+					Since: durationpb.New(1 * time.Hour),
+				},
+				PageToken: "not-a-token",
+			},
+			expectedErr: errors.New("invalid page token: not-a-token"),
+		},
 	}
 
 	for _, test := range testCases {
@@ -170,12 +193,64 @@ func TestGetEvents(t *testing.T) {
 				assert.Equal(t, err, test.expectedErr)
 			} else {
 				assert.Equal(t, test.expectedEventCount, len(resp.Events))
-				assert.Equal(t, test.expectedNextToken, resp.NextPageToken)
+				assert.Equal(t, test.expectNextPage, resp.NextPageToken != "")
 			}
 		})
 	}
 }
 
+func TestGetEventsPages(t *testing.T) {
+	m := &mod{
+		client: auditmock.New(),
+	}
+	for i := 0; i < 11; i++ {
+		_, err := m.client.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
+		assert.NoError(t, err)
+	}
+
+	req := &auditv1.GetEventsRequest{
+		Window: &auditv1.GetEventsRequest_Since{Since: durationpb.New(1 * time.Hour)},
+		Limit:  5,
+	}
+
+	var ids []int64
+	for pages := 1; ; pages++ {
+		resp, err := m.GetEvents(context.Background(), req)
+		assert.NoError(t, err)
+		for _, e := range resp.Events {
+			ids = append(ids, e.Id)
+		}
+
+		// Events written between pages are read on a later page instead of shifting the pages.
+		if pages == 1 {
+			_, err := m.client.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
+			assert.NoError(t, err)
+		}
+
+		if resp.NextPageToken == "" {
+			assert.Equal(t, 3, pages)
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	assert.Equal(t, []int64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, ids)
+}
+
+func TestPageToken(t *testing.T) {
+	event := &auditv1.Event{Id: 42, OccurredAt: timestamppb.New(time.Date(2021, 5, 1, 12, 30, 0, 123456000, time.UTC))}
+
+	cursor, err := parsePageToken(pageToken(event))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), cursor.ID)
+	assert.True(t, event.OccurredAt.AsTime().Equal(cursor.OccurredAt))
+
+	for _, token := range []string{"", "0"} {
+		cursor, err := parsePageToken(token)
+		assert.NoError(t, err)
+		assert.Nil(t, cursor)
+	}
+}
+
 func TestGetEvent(t *testing.T) {
 	testCases := []struct {
 		req         *auditv1.GetEventRequest
@@ -217,3 +292,56 @@ func TestGetEvent(t *testing.T) {
 		})
 	}
 }
+
+type chainVerifier struct {
+	audit.Auditor
+
+	start, end time.Time
+}
+
+func (v *chainVerifier) VerifyChain(ctx context.Context, start time.Time, end time.Time) (*auditv1.VerifyAuditChainResponse, error) {
+	v.start, v.end = start, end
+	return &auditv1.VerifyAuditChainResponse{VerifiedEvents: 1}, nil
+}
+
+func TestVerifyAuditChain(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	req := &auditv1.VerifyAuditChainRequest{Range: &auditv1.TimeRange{StartTime: timestamppb.New(start)}}
+
+	// Auditors that can't verify chains return an error.
+	m := &mod{client: auditmock.New()}
+	_, err := m.VerifyAuditChain(context.Background(), req)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// Timeranges without an end are verified up to the current time.
+	verifier := &chainVerifier{Auditor: auditmock.New()}
+	m = &mod{client: verifier}
+	resp, err := m.VerifyAuditChain(context.Background(), req)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, resp.VerifiedEvents)
+	assert.True(t, verifier.start.Equal(start))
+	assert.WithinDuration(t, time.Now(), verifier.end, time.Minute)
+}
+
+func TestTimeWindow(t *testing.T) {
+	start := time.Now().Add(-time.Hour)
+	end := time.Now()
+
+	from, to, err := timeWindow(&auditv1.TimeRange{StartTime: timestamppb.New(start), EndTime: timestamppb.New(end)}, nil)
+	assert.NoError(t, err)
+	assert.True(t, start.Equal(from))
+	assert.True(t, end.Equal(*to))
+
+	// Time ranges without an end last until now, like windows since a duration.
+	from, to, err = timeWindow(&auditv1.TimeRange{StartTime: timestamppb.New(start)}, nil)
+	assert.NoError(t, err)
+	assert.True(t, start.Equal(from))
+	assert.Nil(t, to)
+
+	_, to, err = timeWindow(nil, durationpb.New(time.Hour))
+	assert.NoError(t, err)
+	assert.Nil(t, to)
+
+	_, _, err = timeWindow(nil, nil)
+	assert.Error(t, err)
+}
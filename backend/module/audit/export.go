@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	"github.com/lyft/clutch/backend/service/audit"
+	"github.com/lyft/clutch/backend/service/audit/storage"
+)
+
+// The number of events read and sent at a time, which bounds the memory used by an export.
+const exportBatchSize = 500
+
+var csvHeader = []string{
+	"id", "occurred_at", "username", "service_name", "method_name", "action_type", "status_code", "status_message", "resources",
+}
+
+func (m *mod) ExportEvents(req *auditv1.ExportEventsRequest, stream auditv1.AuditAPI_ExportEventsServer) error {
+	start, end, err := timeWindow(req.GetRange(), req.GetSince())
+	if err != nil {
+		return err
+	}
+	// The end of the export is fixed so that events written during the export don't prolong it.
+	if end == nil {
+		now := time.Now()
+		end = &now
+	}
+
+	var contentType string
+	var write func(*bytes.Buffer, *auditv1.Event) error
+	buf := &bytes.Buffer{}
+	switch req.Format {
+	case auditv1.ExportEventsRequest_NDJSON:
+		contentType, write = "application/x-ndjson", writeNDJSON
+	case auditv1.ExportEventsRequest_CSV:
+		contentType, write = "text/csv", writeCSV
+		if err := writeCSVRecord(buf, csvHeader); err != nil {
+			return err
+		}
+	default:
+		return errors.New("unknown export format")
+	}
+
+	options := &audit.ReadOptions{Limit: exportBatchSize, Filter: req.Filter}
+	for first := true; ; first = false {
+		events, err := m.client.ReadEvents(stream.Context(), start, end, options)
+		if err != nil {
+			return err
+		}
+
+		for _, event := range events {
+			if err := write(buf, event); err != nil {
+				return err
+			}
+		}
+
+		// The first chunk is sent even if it's empty, since it determines the content type of the response.
+		if buf.Len() > 0 || first {
+			if err := stream.Send(&httpbody.HttpBody{ContentType: contentType, Data: buf.Bytes()}); err != nil {
+				return err
+			}
+			buf = &bytes.Buffer{}
+		}
+
+		if len(events) < exportBatchSize {
+			return nil
+		}
+		options.After = storage.CursorOf(events[len(events)-1])
+	}
+}
+
+func writeNDJSON(buf *bytes.Buffer, event *auditv1.Event) error {
+	b, err := protojson.Marshal(event)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	buf.WriteByte('\n')
+	return nil
+}
+
+// Resources are written as "<type_url>:<id>", separated by ";".
+func writeCSV(buf *bytes.Buffer, event *auditv1.Event) error {
+	req := event.GetEvent()
+
+	resources := make([]string, len(req.GetResources()))
+	for i, r := range req.GetResources() {
+		resources[i] = r.TypeUrl + ":" + r.Id
+	}
+
+	return writeCSVRecord(buf, []string{
+		strconv.FormatInt(event.Id, 10),
+		event.OccurredAt.AsTime().Format(time.RFC3339Nano),
+		req.GetUsername(),
+		req.GetServiceName(),
+		req.GetMethodName(),
+		req.GetType().String(),
+		strconv.FormatInt(int64(req.GetStatus().GetCode()), 10),
+		req.GetStatus().GetMessage(),
+		strings.Join(resources, ";"),
+	})
+}
+
+func writeCSVRecord(buf *bytes.Buffer, record []string) error {
+	w := csv.NewWriter(buf)
+	if err := w.Write(record); err != nil {
+		return err
+	}
+	w.Flush()
+	return w.Error()
+}
@@ -0,0 +1,116 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	"github.com/lyft/clutch/backend/mock/service/auditmock"
+)
+
+type exportStream struct {
+	grpc.ServerStream
+
+	chunks []*httpbody.HttpBody
+}
+
+func (s *exportStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *exportStream) Send(chunk *httpbody.HttpBody) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func (s *exportStream) data() []byte {
+	var data []byte
+	for _, c := range s.chunks {
+		data = append(data, c.Data...)
+	}
+	return data
+}
+
+func TestExportEvents(t *testing.T) {
+	m := &mod{
+		client: auditmock.New(),
+	}
+	// More events than are read at a time.
+	for i := 0; i < exportBatchSize+1; i++ {
+		_, err := m.client.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{
+			Username:    "alice",
+			ServiceName: "clutch.k8s.v1.K8sAPI",
+			MethodName:  "DeletePod",
+			Resources:   []*auditv1.Resource{{TypeUrl: "clutch.k8s.v1.Pod", Id: "cluster/default/web, 1"}},
+		})
+		assert.NoError(t, err)
+	}
+	_, err := m.client.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{Username: "bob"})
+	assert.NoError(t, err)
+
+	req := &auditv1.ExportEventsRequest{
+		Window: &auditv1.ExportEventsRequest_Since{Since: durationpb.New(time.Hour)},
+		Filter: &auditv1.EventFilter{Username: "alice"},
+		Format: auditv1.ExportEventsRequest_NDJSON,
+	}
+
+	stream := &exportStream{}
+	assert.NoError(t, m.ExportEvents(req, stream))
+	assert.Len(t, stream.chunks, 2)
+	assert.Equal(t, "application/x-ndjson", stream.chunks[0].ContentType)
+
+	lines := strings.Split(strings.TrimSuffix(string(stream.data()), "\n"), "\n")
+	assert.Len(t, lines, exportBatchSize+1)
+	for _, line := range lines {
+		var event struct {
+			Event struct {
+				Username string `json:"username"`
+			} `json:"event"`
+		}
+		assert.NoError(t, json.Unmarshal([]byte(line), &event))
+		assert.Equal(t, "alice", event.Event.Username)
+	}
+
+	req.Format = auditv1.ExportEventsRequest_CSV
+	stream = &exportStream{}
+	assert.NoError(t, m.ExportEvents(req, stream))
+	assert.Equal(t, "text/csv", stream.chunks[0].ContentType)
+
+	records, err := csv.NewReader(bytes.NewReader(stream.data())).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, exportBatchSize+2)
+	assert.Equal(t, csvHeader, records[0])
+	assert.Equal(t, []string{"1", "alice", "clutch.k8s.v1.K8sAPI", "DeletePod", "UNSPECIFIED", "0", "", "clutch.k8s.v1.Pod:cluster/default/web, 1"},
+		append([]string{records[2][0]}, records[2][2:]...))
+
+	// Time ranges without an end are exported until now.
+	req.Window = &auditv1.ExportEventsRequest_Range{Range: &auditv1.TimeRange{
+		StartTime: timestamppb.New(time.Now().Add(-time.Hour)),
+	}}
+	stream = &exportStream{}
+	assert.NoError(t, m.ExportEvents(req, stream))
+	records, err = csv.NewReader(bytes.NewReader(stream.data())).ReadAll()
+	assert.NoError(t, err)
+	assert.Len(t, records, exportBatchSize+2)
+
+	// Exports of empty time ranges send an empty chunk, or just the header row for CSV.
+	req.Window = &auditv1.ExportEventsRequest_Range{Range: &auditv1.TimeRange{
+		StartTime: timestamppb.New(time.Now().Add(time.Hour)),
+		EndTime:   timestamppb.New(time.Now().Add(2 * time.Hour)),
+	}}
+	stream = &exportStream{}
+	assert.NoError(t, m.ExportEvents(req, stream))
+	assert.Len(t, stream.chunks, 1)
+	assert.Equal(t, strings.Join(csvHeader, ",")+"\n", string(stream.data()))
+}
@@ -12,6 +12,7 @@ import (
 	"go.uber.org/zap"
 
 	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+	elbv2v1 "github.com/lyft/clutch/backend/api/aws/elbv2/v1"
 	"github.com/lyft/clutch/backend/module"
 	"github.com/lyft/clutch/backend/service"
 	"github.com/lyft/clutch/backend/service/aws"
@@ -33,17 +34,24 @@ func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
 	}
 
 	mod := &mod{
-		ec2: newEC2API(c),
+		ec2:   newEC2API(c),
+		elbv2: newELBV2API(c),
 	}
 
 	return mod, nil
 }
 
 type mod struct {
-	ec2 ec2v1.EC2APIServer
+	ec2   ec2v1.EC2APIServer
+	elbv2 elbv2v1.ELBV2APIServer
 }
 
 func (m *mod) Register(r module.Registrar) error {
 	ec2v1.RegisterEC2APIServer(r.GRPCServer(), m.ec2)
-	return r.RegisterJSONGateway(ec2v1.RegisterEC2APIHandler)
+	if err := r.RegisterJSONGateway(ec2v1.RegisterEC2APIHandler); err != nil {
+		return err
+	}
+
+	elbv2v1.RegisterELBV2APIServer(r.GRPCServer(), m.elbv2)
+	return r.RegisterJSONGateway(elbv2v1.RegisterELBV2APIHandler)
 }
@@ -9,6 +9,7 @@ import (
 	"go.uber.org/zap/zaptest"
 
 	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+	elbv2v1 "github.com/lyft/clutch/backend/api/aws/elbv2/v1"
 	"github.com/lyft/clutch/backend/mock/service/awsmock"
 	"github.com/lyft/clutch/backend/module/moduletest"
 	"github.com/lyft/clutch/backend/service"
@@ -26,6 +27,7 @@ func TestModule(t *testing.T) {
 	r := moduletest.NewRegisterChecker()
 	assert.NoError(t, m.Register(r))
 	assert.NoError(t, r.HasAPI("clutch.aws.ec2.v1.EC2API"))
+	assert.NoError(t, r.HasAPI("clutch.aws.elbv2.v1.ELBV2API"))
 	assert.True(t, r.JSONRegistered())
 }
 
@@ -35,4 +37,71 @@ func TestEC2APIGetInstance(t *testing.T) {
 	resp, err := api.GetInstance(context.Background(), &ec2v1.GetInstanceRequest{})
 	assert.NoError(t, err)
 	assert.NotNil(t, resp)
+	assert.Empty(t, resp.Instance.TargetHealth)
+
+	resp, err = api.GetInstance(context.Background(), &ec2v1.GetInstanceRequest{InstanceId: "i-123", IncludeTargetHealth: true})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Instance.TargetHealth)
+}
+
+func TestEC2APIScheduledActions(t *testing.T) {
+	c := awsmock.New()
+	api := newEC2API(c)
+
+	listResp, err := api.ListScheduledActions(context.Background(), &ec2v1.ListScheduledActionsRequest{Name: "asg-one"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, listResp.ScheduledActions)
+
+	createResp, err := api.CreateScheduledAction(context.Background(), &ec2v1.CreateScheduledActionRequest{
+		Name:            "asg-one",
+		ScheduledAction: &ec2v1.ScheduledAction{Name: "foo", Recurrence: "0 13 * * *"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "asg-one", createResp.ScheduledAction.AutoscalingGroupName)
+
+	deleteResp, err := api.DeleteScheduledAction(context.Background(), &ec2v1.DeleteScheduledActionRequest{Name: "foo", AutoscalingGroupName: "asg-one"})
+	assert.NoError(t, err)
+	assert.NotNil(t, deleteResp)
+}
+
+func TestEC2APIWarmPool(t *testing.T) {
+	c := awsmock.New()
+	api := newEC2API(c)
+
+	describeResp, err := api.DescribeWarmPool(context.Background(), &ec2v1.DescribeWarmPoolRequest{Name: "asg-one"})
+	assert.NoError(t, err)
+	assert.NotNil(t, describeResp.WarmPool)
+
+	updateResp, err := api.UpdateWarmPool(context.Background(), &ec2v1.UpdateWarmPoolRequest{Name: "asg-one", WarmPool: &ec2v1.WarmPool{MinSize: 1}})
+	assert.NoError(t, err)
+	assert.NotNil(t, updateResp)
+}
+
+func TestELBV2APIDescribeLoadBalancers(t *testing.T) {
+	c := awsmock.New()
+	api := newELBV2API(c)
+	resp, err := api.DescribeLoadBalancers(context.Background(), &elbv2v1.DescribeLoadBalancersRequest{Names: []string{"foo"}})
+	assert.NoError(t, err)
+	assert.Len(t, resp.LoadBalancers, 1)
+	assert.Equal(t, "foo", resp.LoadBalancers[0].Name)
+}
+
+func TestELBV2APIDescribeTargetHealth(t *testing.T) {
+	c := awsmock.New()
+	api := newELBV2API(c)
+	resp, err := api.DescribeTargetHealth(context.Background(), &elbv2v1.DescribeTargetHealthRequest{TargetGroupName: "foo"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.TargetHealth)
+}
+
+func TestELBV2APIRegisterTargets(t *testing.T) {
+	c := awsmock.New()
+	api := newELBV2API(c)
+	resp, err := api.RegisterTargets(context.Background(), &elbv2v1.RegisterTargetsRequest{Targets: []*elbv2v1.Target{{Id: "i-123"}}})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	dresp, err := api.DeregisterTargets(context.Background(), &elbv2v1.DeregisterTargetsRequest{Targets: []*elbv2v1.Target{{Id: "i-123"}}})
+	assert.NoError(t, err)
+	assert.NotNil(t, dresp)
 }
@@ -41,6 +41,12 @@ func (a *ec2API) GetInstance(ctx context.Context, req *ec2v1.GetInstanceRequest)
 		return nil, err
 	}
 
+	if req.IncludeTargetHealth {
+		if err := a.client.DescribeInstancesTargetHealth(ctx, req.Account, req.Region, instances); err != nil {
+			return nil, err
+		}
+	}
+
 	return &ec2v1.GetInstanceResponse{Instance: instances[0]}, nil
 }
 
@@ -52,3 +58,48 @@ func (a *ec2API) RebootInstance(ctx context.Context, req *ec2v1.RebootInstanceRe
 
 	return &ec2v1.RebootInstanceResponse{}, nil
 }
+
+func (a *ec2API) ListScheduledActions(ctx context.Context, req *ec2v1.ListScheduledActionsRequest) (*ec2v1.ListScheduledActionsResponse, error) {
+	actions, err := a.client.DescribeAutoscalingScheduledActions(ctx, req.Account, req.Region, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ec2v1.ListScheduledActionsResponse{ScheduledActions: actions}, nil
+}
+
+func (a *ec2API) CreateScheduledAction(ctx context.Context, req *ec2v1.CreateScheduledActionRequest) (*ec2v1.CreateScheduledActionResponse, error) {
+	action, err := a.client.CreateAutoscalingScheduledAction(ctx, req.Account, req.Region, req.Name, req.ScheduledAction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ec2v1.CreateScheduledActionResponse{ScheduledAction: action}, nil
+}
+
+func (a *ec2API) DeleteScheduledAction(ctx context.Context, req *ec2v1.DeleteScheduledActionRequest) (*ec2v1.DeleteScheduledActionResponse, error) {
+	err := a.client.DeleteAutoscalingScheduledAction(ctx, req.Account, req.Region, req.AutoscalingGroupName, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ec2v1.DeleteScheduledActionResponse{}, nil
+}
+
+func (a *ec2API) DescribeWarmPool(ctx context.Context, req *ec2v1.DescribeWarmPoolRequest) (*ec2v1.DescribeWarmPoolResponse, error) {
+	warmPool, err := a.client.DescribeAutoscalingWarmPool(ctx, req.Account, req.Region, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ec2v1.DescribeWarmPoolResponse{WarmPool: warmPool}, nil
+}
+
+func (a *ec2API) UpdateWarmPool(ctx context.Context, req *ec2v1.UpdateWarmPoolRequest) (*ec2v1.UpdateWarmPoolResponse, error) {
+	err := a.client.UpdateAutoscalingWarmPool(ctx, req.Account, req.Region, req.Name, req.WarmPool)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ec2v1.UpdateWarmPoolResponse{}, nil
+}
@@ -0,0 +1,72 @@
+package aws
+
+import (
+	"context"
+
+	elbv2v1 "github.com/lyft/clutch/backend/api/aws/elbv2/v1"
+	"github.com/lyft/clutch/backend/service/aws"
+)
+
+func newELBV2API(c aws.Client) elbv2v1.ELBV2APIServer {
+	return &elbv2API{
+		client: c,
+	}
+}
+
+type elbv2API struct {
+	client aws.Client
+}
+
+func (a *elbv2API) DescribeLoadBalancers(ctx context.Context, req *elbv2v1.DescribeLoadBalancersRequest) (*elbv2v1.DescribeLoadBalancersResponse, error) {
+	lbs, err := a.client.DescribeLoadBalancers(ctx, req.Account, req.Region, req.Names)
+	if err != nil {
+		return nil, err
+	}
+
+	return &elbv2v1.DescribeLoadBalancersResponse{LoadBalancers: lbs}, nil
+}
+
+func (a *elbv2API) DescribeListeners(ctx context.Context, req *elbv2v1.DescribeListenersRequest) (*elbv2v1.DescribeListenersResponse, error) {
+	listeners, err := a.client.DescribeListeners(ctx, req.Account, req.Region, req.LoadBalancerName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &elbv2v1.DescribeListenersResponse{Listeners: listeners}, nil
+}
+
+func (a *elbv2API) DescribeTargetGroups(ctx context.Context, req *elbv2v1.DescribeTargetGroupsRequest) (*elbv2v1.DescribeTargetGroupsResponse, error) {
+	tgs, err := a.client.DescribeTargetGroups(ctx, req.Account, req.Region, req.Names)
+	if err != nil {
+		return nil, err
+	}
+
+	return &elbv2v1.DescribeTargetGroupsResponse{TargetGroups: tgs}, nil
+}
+
+func (a *elbv2API) DescribeTargetHealth(ctx context.Context, req *elbv2v1.DescribeTargetHealthRequest) (*elbv2v1.DescribeTargetHealthResponse, error) {
+	health, err := a.client.DescribeTargetHealth(ctx, req.Account, req.Region, req.TargetGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &elbv2v1.DescribeTargetHealthResponse{TargetHealth: health}, nil
+}
+
+func (a *elbv2API) RegisterTargets(ctx context.Context, req *elbv2v1.RegisterTargetsRequest) (*elbv2v1.RegisterTargetsResponse, error) {
+	err := a.client.RegisterTargets(ctx, req.Account, req.Region, req.TargetGroupName, req.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &elbv2v1.RegisterTargetsResponse{}, nil
+}
+
+func (a *elbv2API) DeregisterTargets(ctx context.Context, req *elbv2v1.DeregisterTargetsRequest) (*elbv2v1.DeregisterTargetsResponse, error) {
+	err := a.client.DeregisterTargets(ctx, req.Account, req.Region, req.TargetGroupName, req.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &elbv2v1.DeregisterTargetsResponse{}, nil
+}
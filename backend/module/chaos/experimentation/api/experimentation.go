@@ -12,10 +12,14 @@ import (
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	experimentation "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	configv1 "github.com/lyft/clutch/backend/api/config/module/chaos/experimentation/api/v1"
 	"github.com/lyft/clutch/backend/module"
 	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/audit"
 	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
 )
 
@@ -23,20 +27,47 @@ const (
 	Name = "clutch.module.chaos.experimentation.api"
 )
 
+var (
+	errSchedulesUnsupported = status.Error(codes.Unimplemented, "the experiment store does not support schedules")
+	errReportsUnsupported   = status.Error(codes.Unimplemented, "the experiment store does not support run reports")
+	errTemplatesUnsupported = status.Error(codes.Unimplemented, "the experiment store does not support templates")
+)
+
 // Service contains all dependencies for the API service.
 type Service struct {
-	storer                      experimentstore.Storer
-	logger                      *zap.SugaredLogger
-	createExperimentStat        tally.Counter
-	createOrGetExperimentStats  tally.Scope
-	cancelExperimentRunStat     tally.Counter
-	getExperimentsStat          tally.Counter
-	getListViewStat             tally.Counter
-	getExperimentRunDetailsStat tally.Counter
+	storer                          experimentstore.Storer
+	scheduleStorer                  experimentstore.ScheduleStorer
+	templateStorer                  experimentstore.TemplateStorer
+	runReporter                     experimentstore.RunReporter
+	reporter                        *reporter
+	logger                          *zap.SugaredLogger
+	createExperimentStat            tally.Counter
+	createOrGetExperimentStats      tally.Scope
+	cancelExperimentRunStat         tally.Counter
+	getExperimentsStat              tally.Counter
+	getListViewStat                 tally.Counter
+	getExperimentRunDetailsStat     tally.Counter
+	getExperimentRunReportStat      tally.Counter
+	createExperimentScheduleStat    tally.Counter
+	getExperimentSchedulesStat      tally.Counter
+	setExperimentSchedulePausedStat tally.Counter
+	deleteExperimentScheduleStat    tally.Counter
+	createExperimentTemplateStat    tally.Counter
+	getExperimentTemplatesStat      tally.Counter
+	getExperimentTemplateStat       tally.Counter
+	deleteExperimentTemplateStat    tally.Counter
+	createFromTemplateStat          tally.Counter
 }
 
 // New instantiates a Service object.
-func New(_ *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, error) {
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, error) {
+	config := &configv1.Config{}
+	if cfg != nil {
+		if err := cfg.UnmarshalTo(config); err != nil {
+			return nil, err
+		}
+	}
+
 	store, ok := service.Registry[experimentstore.Name]
 	if !ok {
 		return nil, errors.New("could not find experiment store service")
@@ -47,15 +78,45 @@ func New(_ *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, erro
 		return nil, errors.New("could not find valid experiment store")
 	}
 
+	// Schedules and templates are optional, as not every store supports them.
+	scheduleStore, _ := store.(experimentstore.ScheduleStorer)
+	templateStore, _ := store.(experimentstore.TemplateStorer)
+	runReporter, _ := store.(experimentstore.RunReporter)
+
+	// Audit events are added to run reports if the audit service is configured.
+	var auditor audit.Auditor
+	if svc, ok := service.Registry[audit.Name]; ok {
+		auditor, _ = svc.(audit.Auditor)
+	}
+
+	runReports, err := newReporter(config.ReportMetrics, auditor, logger.Sugar())
+	if err != nil {
+		return nil, err
+	}
+
 	return &Service{
-		storer:                      experimentStore,
-		logger:                      logger.Sugar(),
-		createExperimentStat:        scope.Counter("create_experiment"),
-		createOrGetExperimentStats:  scope.SubScope("create_or_get_experiment"),
-		cancelExperimentRunStat:     scope.Counter("cancel_experiment_run"),
-		getExperimentsStat:          scope.Counter("get_experiments"),
-		getListViewStat:             scope.Counter("get_list_view"),
-		getExperimentRunDetailsStat: scope.Counter("get_experiment_run_config_pair_details"),
+		storer:                          experimentStore,
+		scheduleStorer:                  scheduleStore,
+		templateStorer:                  templateStore,
+		runReporter:                     runReporter,
+		reporter:                        runReports,
+		logger:                          logger.Sugar(),
+		createExperimentStat:            scope.Counter("create_experiment"),
+		createOrGetExperimentStats:      scope.SubScope("create_or_get_experiment"),
+		cancelExperimentRunStat:         scope.Counter("cancel_experiment_run"),
+		getExperimentsStat:              scope.Counter("get_experiments"),
+		getListViewStat:                 scope.Counter("get_list_view"),
+		getExperimentRunDetailsStat:     scope.Counter("get_experiment_run_config_pair_details"),
+		getExperimentRunReportStat:      scope.Counter("get_experiment_run_report"),
+		createExperimentScheduleStat:    scope.Counter("create_experiment_schedule"),
+		getExperimentSchedulesStat:      scope.Counter("get_experiment_schedules"),
+		setExperimentSchedulePausedStat: scope.Counter("set_experiment_schedule_paused"),
+		deleteExperimentScheduleStat:    scope.Counter("delete_experiment_schedule"),
+		createExperimentTemplateStat:    scope.Counter("create_experiment_template"),
+		getExperimentTemplatesStat:      scope.Counter("get_experiment_templates"),
+		getExperimentTemplateStat:       scope.Counter("get_experiment_template"),
+		deleteExperimentTemplateStat:    scope.Counter("delete_experiment_template"),
+		createFromTemplateStat:          scope.Counter("create_experiment_from_template"),
 	}, nil
 }
 
@@ -169,3 +230,202 @@ func (s *Service) GetExperimentRunDetails(ctx context.Context, request *experime
 
 	return &experimentation.GetExperimentRunDetailsResponse{RunDetails: runDetails}, nil
 }
+
+// GetExperimentRunReport assembles the report of a run from the store, the audit service and the metrics provider.
+func (s *Service) GetExperimentRunReport(ctx context.Context, req *experimentation.GetExperimentRunReportRequest) (*experimentation.GetExperimentRunReportResponse, error) {
+	s.getExperimentRunReportStat.Inc(1)
+	if s.runReporter == nil {
+		return nil, errReportsUnsupported
+	}
+
+	now := time.Now()
+	runReport, err := s.runReporter.GetExperimentRunReport(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	report := s.reporter.complete(ctx, runReport, now)
+	export, err := exportReport(report, req.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	return &experimentation.GetExperimentRunReportResponse{Report: report, Export: export}, nil
+}
+
+// CreateExperimentSchedule adds a schedule whose runs are created by the scheduler service.
+func (s *Service) CreateExperimentSchedule(ctx context.Context, req *experimentation.CreateExperimentScheduleRequest) (*experimentation.CreateExperimentScheduleResponse, error) {
+	s.createExperimentScheduleStat.Inc(1)
+	if s.scheduleStorer == nil {
+		return nil, errSchedulesUnsupported
+	}
+
+	schedule, err := experimentstore.NewExperimentSchedule(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.scheduleStorer.CreateExperimentSchedule(ctx, schedule)
+	if err != nil {
+		return nil, err
+	}
+
+	return &experimentation.CreateExperimentScheduleResponse{Schedule: created.Proto(time.Now())}, nil
+}
+
+func (s *Service) GetExperimentSchedules(ctx context.Context, _ *experimentation.GetExperimentSchedulesRequest) (*experimentation.GetExperimentSchedulesResponse, error) {
+	s.getExperimentSchedulesStat.Inc(1)
+	if s.scheduleStorer == nil {
+		return nil, errSchedulesUnsupported
+	}
+
+	schedules, err := s.scheduleStorer.GetExperimentSchedules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	ret := make([]*experimentation.ExperimentSchedule, len(schedules))
+	for i, schedule := range schedules {
+		ret[i] = schedule.Proto(now)
+	}
+
+	return &experimentation.GetExperimentSchedulesResponse{Schedules: ret}, nil
+}
+
+// SetExperimentSchedulePaused pauses or resumes a schedule. Occurrences while paused are skipped.
+func (s *Service) SetExperimentSchedulePaused(ctx context.Context, req *experimentation.SetExperimentSchedulePausedRequest) (*experimentation.SetExperimentSchedulePausedResponse, error) {
+	s.setExperimentSchedulePausedStat.Inc(1)
+	if s.scheduleStorer == nil {
+		return nil, errSchedulesUnsupported
+	}
+
+	schedule, err := s.scheduleStorer.SetExperimentSchedulePaused(ctx, req.Id, req.Paused)
+	if err != nil {
+		return nil, err
+	}
+
+	return &experimentation.SetExperimentSchedulePausedResponse{Schedule: schedule.Proto(time.Now())}, nil
+}
+
+// DeleteExperimentSchedule deletes a schedule. Runs that were already created by it are not affected.
+func (s *Service) DeleteExperimentSchedule(ctx context.Context, req *experimentation.DeleteExperimentScheduleRequest) (*experimentation.DeleteExperimentScheduleResponse, error) {
+	s.deleteExperimentScheduleStat.Inc(1)
+	if s.scheduleStorer == nil {
+		return nil, errSchedulesUnsupported
+	}
+
+	if err := s.scheduleStorer.DeleteExperimentSchedule(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &experimentation.DeleteExperimentScheduleResponse{}, nil
+}
+
+// CreateExperimentTemplate saves an experiment config with parameters that runs can be created from.
+func (s *Service) CreateExperimentTemplate(ctx context.Context, req *experimentation.CreateExperimentTemplateRequest) (*experimentation.CreateExperimentTemplateResponse, error) {
+	s.createExperimentTemplateStat.Inc(1)
+	if s.templateStorer == nil {
+		return nil, errTemplatesUnsupported
+	}
+
+	template, err := experimentstore.NewExperimentTemplate(req)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := s.templateStorer.CreateExperimentTemplate(ctx, template)
+	if err != nil {
+		return nil, err
+	}
+
+	return &experimentation.CreateExperimentTemplateResponse{Template: created.Proto()}, nil
+}
+
+func (s *Service) GetExperimentTemplates(ctx context.Context, _ *experimentation.GetExperimentTemplatesRequest) (*experimentation.GetExperimentTemplatesResponse, error) {
+	s.getExperimentTemplatesStat.Inc(1)
+	if s.templateStorer == nil {
+		return nil, errTemplatesUnsupported
+	}
+
+	templates, err := s.templateStorer.GetExperimentTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*experimentation.ExperimentTemplate, len(templates))
+	for i, template := range templates {
+		ret[i] = template.Proto()
+	}
+
+	return &experimentation.GetExperimentTemplatesResponse{Templates: ret}, nil
+}
+
+func (s *Service) GetExperimentTemplate(ctx context.Context, req *experimentation.GetExperimentTemplateRequest) (*experimentation.GetExperimentTemplateResponse, error) {
+	s.getExperimentTemplateStat.Inc(1)
+	if s.templateStorer == nil {
+		return nil, errTemplatesUnsupported
+	}
+
+	template, err := s.templateStorer.GetExperimentTemplate(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &experimentation.GetExperimentTemplateResponse{Template: template.Proto()}, nil
+}
+
+// DeleteExperimentTemplate deletes a template. Runs that were already created from it are not affected.
+func (s *Service) DeleteExperimentTemplate(ctx context.Context, req *experimentation.DeleteExperimentTemplateRequest) (*experimentation.DeleteExperimentTemplateResponse, error) {
+	s.deleteExperimentTemplateStat.Inc(1)
+	if s.templateStorer == nil {
+		return nil, errTemplatesUnsupported
+	}
+
+	if err := s.templateStorer.DeleteExperimentTemplate(ctx, req.Id); err != nil {
+		return nil, err
+	}
+
+	return &experimentation.DeleteExperimentTemplateResponse{}, nil
+}
+
+// CreateExperimentFromTemplate renders the config of a template with the given parameter values and creates an
+// experiment with it, the same way as CreateExperiment.
+func (s *Service) CreateExperimentFromTemplate(ctx context.Context, req *experimentation.CreateExperimentFromTemplateRequest) (*experimentation.CreateExperimentFromTemplateResponse, error) {
+	s.createFromTemplateStat.Inc(1)
+	if s.templateStorer == nil {
+		return nil, errTemplatesUnsupported
+	}
+
+	template, err := s.templateStorer.GetExperimentTemplate(ctx, req.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := template.Render(req.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	es, err := experimentstore.NewExperimentSpecification(&experimentation.CreateExperimentData{
+		RunId:     req.RunId,
+		Config:    config,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := s.storer.CreateExperiment(ctx, es)
+	if err != nil {
+		return nil, err
+	}
+
+	ep, err := e.Proto(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &experimentation.CreateExperimentFromTemplateResponse{Experiment: ep}, nil
+}
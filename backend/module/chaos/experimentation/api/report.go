@@ -0,0 +1,323 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	experimentation "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	configv1 "github.com/lyft/clutch/backend/api/config/module/chaos/experimentation/api/v1"
+	"github.com/lyft/clutch/backend/service/audit"
+	"github.com/lyft/clutch/backend/service/audit/storage"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/metrics"
+)
+
+const (
+	defaultMetricQueryTimeout = 10 * time.Second
+
+	// The audit events of a run are read in pages, up to a maximum number of events since the run was created.
+	auditPageSize        = 500
+	maxAuditEventsRead   = 10000
+	experimentsAPIPrefix = "clutch.chaos.experimentation.v1."
+	experimentsAPIName   = experimentsAPIPrefix + "ExperimentsAPI"
+)
+
+type reportMetric struct {
+	name  string
+	query *template.Template
+}
+
+// The fields available to the query templates of report metrics.
+type reportMetricQueryData struct {
+	RunID   string
+	Cluster string
+	Window  string
+}
+
+// Adds the audit events and metric summaries of a run to the report of the store.
+type reporter struct {
+	// Nil if the audit service is not configured.
+	auditor audit.Auditor
+
+	// Nil if no metrics are configured.
+	metricsClient *metrics.Client
+	metrics       []*reportMetric
+	timeout       time.Duration
+
+	logger *zap.SugaredLogger
+}
+
+func newReporter(config *configv1.ReportMetrics, auditor audit.Auditor, logger *zap.SugaredLogger) (*reporter, error) {
+	r := &reporter{auditor: auditor, logger: logger}
+	if config == nil {
+		return r, nil
+	}
+
+	for _, m := range config.Metrics {
+		query, err := template.New(m.Name).Option("missingkey=error").Parse(m.Query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query template of report metric '%s': %w", m.Name, err)
+		}
+		r.metrics = append(r.metrics, &reportMetric{name: m.Name, query: query})
+	}
+
+	r.metricsClient = metrics.NewClient(config.Endpoint)
+	r.timeout = defaultMetricQueryTimeout
+	if config.Timeout != nil {
+		r.timeout = config.Timeout.AsDuration()
+	}
+	return r, nil
+}
+
+// Completes the report of the store. Audit events and metrics that can't be read are logged and left out, as the rest
+// of the report is still useful.
+func (r *reporter) complete(ctx context.Context, runReport *experimentstore.RunReport, now time.Time) *experimentation.ExperimentRunReport {
+	report := runReport.Report
+
+	if r.auditor != nil {
+		events, truncated, err := r.auditEvents(ctx, runReport.Run.Id, runReport.Run.CreationTime, now)
+		if err != nil {
+			r.logger.Warnw("failed to read audit events of experiment run", "runId", runReport.Run.Id, "err", err)
+		}
+		report.Timeline = append(report.Timeline, events...)
+		report.AuditEventsTruncated = truncated
+		sort.SliceStable(report.Timeline, func(i, j int) bool {
+			return report.Timeline[i].Time.AsTime().Before(report.Timeline[j].Time.AsTime())
+		})
+	}
+
+	if r.metricsClient != nil && runReport.Target != nil {
+		report.MetricSummaries = r.metricSummaries(ctx, runReport.Run, runReport.Target, now)
+	}
+
+	report.GenerationTime = timestamppb.New(now)
+	return report
+}
+
+// Returns the timeline events of the requests that changed the run, i.e. ignoring reads, and whether events were left
+// out because more events concern the run than are read.
+func (r *reporter) auditEvents(ctx context.Context, runId string, start time.Time, now time.Time) ([]*experimentation.TimelineEvent, bool, error) {
+	// The filter matches runs whose IDs start with the ID of the run as well, which are skipped while reading.
+	options := &audit.ReadOptions{
+		Limit:  auditPageSize,
+		Filter: &auditv1.EventFilter{ServiceName: experimentsAPIName, ResourceIdPrefix: runId},
+	}
+
+	var ret []*experimentation.TimelineEvent
+	for read := 0; ; {
+		events, err := r.auditor.ReadEvents(ctx, start, &now, options)
+		if err != nil {
+			return ret, false, err
+		}
+
+		for _, e := range events {
+			if read == maxAuditEventsRead {
+				return ret, true, nil
+			}
+			read++
+
+			req := e.GetEvent()
+			if req == nil || req.Type == apiv1.ActionType_READ || !concernsRun(req, runId) {
+				continue
+			}
+
+			description := req.MethodName
+			if req.Status.GetCode() != 0 {
+				description = fmt.Sprintf("%s failed: %s", req.MethodName, req.Status.GetMessage())
+			}
+			ret = append(ret, &experimentation.TimelineEvent{
+				Time:        e.OccurredAt,
+				Type:        experimentation.TimelineEvent_TYPE_AUDIT,
+				Description: description,
+				Username:    req.Username,
+			})
+		}
+
+		if len(events) < auditPageSize {
+			return ret, false, nil
+		}
+		options.After = storage.CursorOf(events[len(events)-1])
+	}
+}
+
+// Requests concern a run if the run is one of their resources, e.g. the run they cancelled or created.
+func concernsRun(req *auditv1.RequestEvent, runId string) bool {
+	for _, r := range req.Resources {
+		if r.Id == runId && strings.HasPrefix(r.TypeUrl, experimentsAPIPrefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Summarizes every metric for every targeted cluster over periods as long as the run before, during and after the run.
+func (r *reporter) metricSummaries(ctx context.Context, run *experimentstore.ExperimentRun, target *experimentstore.ExperimentTarget, now time.Time) []*experimentation.MetricSummary {
+	if run.StartTime.After(now) {
+		return nil
+	}
+
+	end := now
+	if run.EndTime != nil && run.EndTime.Before(end) {
+		end = *run.EndTime
+	}
+	if run.CancellationTime != nil && run.CancellationTime.Before(end) {
+		end = *run.CancellationTime
+	}
+	duration := end.Sub(run.StartTime)
+	if duration < time.Second {
+		return nil
+	}
+	ended := end.Before(now)
+
+	var clusters []string
+	for _, c := range []string{target.UpstreamCluster, target.DownstreamCluster} {
+		if c != "" && (len(clusters) == 0 || clusters[0] != c) {
+			clusters = append(clusters, c)
+		}
+	}
+
+	var ret []*experimentation.MetricSummary
+	for _, m := range r.metrics {
+		for _, cluster := range clusters {
+			var b bytes.Buffer
+			data := &reportMetricQueryData{
+				RunID:   run.Id,
+				Cluster: cluster,
+				Window:  fmt.Sprintf("%ds", int64(duration.Seconds())),
+			}
+			if err := m.query.Execute(&b, data); err != nil {
+				r.logger.Warnw("failed to render query of report metric", "metric", m.name, "err", err)
+				continue
+			}
+			query := b.String()
+
+			summary := &experimentation.MetricSummary{Name: m.name, Cluster: cluster}
+			summary.Before = r.evaluate(ctx, m.name, query, run.StartTime)
+			if ended {
+				summary.During = r.evaluate(ctx, m.name, query, end)
+			}
+			if after := end.Add(duration); !after.After(now) {
+				summary.After = r.evaluate(ctx, m.name, query, after)
+			}
+			ret = append(ret, summary)
+		}
+	}
+	return ret
+}
+
+// Returns the value of the first series of the query at the given time, or nil if it has none.
+func (r *reporter) evaluate(ctx context.Context, name, query string, at time.Time) *wrapperspb.DoubleValue {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	samples, err := r.metricsClient.Query(ctx, query, at)
+	if err != nil {
+		r.logger.Warnw("failed to query report metric", "metric", name, "err", err)
+		return nil
+	}
+	if len(samples) == 0 {
+		return nil
+	}
+	return wrapperspb.Double(samples[0].Value)
+}
+
+func exportReport(report *experimentation.ExperimentRunReport, format experimentation.GetExperimentRunReportRequest_Format) (string, error) {
+	switch format {
+	case experimentation.GetExperimentRunReportRequest_FORMAT_UNSPECIFIED:
+		return "", nil
+	case experimentation.GetExperimentRunReportRequest_FORMAT_JSON:
+		b, err := protojson.Marshal(report)
+		if err != nil {
+			return "", err
+		}
+		// The whitespace of protojson is deliberately unstable, so the export is indented separately.
+		var indented bytes.Buffer
+		if err := json.Indent(&indented, b, "", "  "); err != nil {
+			return "", err
+		}
+		return indented.String(), nil
+	case experimentation.GetExperimentRunReportRequest_FORMAT_MARKDOWN:
+		return reportMarkdown(report), nil
+	default:
+		return "", fmt.Errorf("unknown report format %v", format)
+	}
+}
+
+func reportMarkdown(report *experimentation.ExperimentRunReport) string {
+	var b strings.Builder
+	details := report.GetDetails()
+
+	fmt.Fprintf(&b, "# Experiment Run %s\n\n", details.GetRunId())
+	fmt.Fprintf(&b, "Generated at %s.\n\n", formatTimestamp(report.GetGenerationTime()))
+
+	b.WriteString("## Details\n\n| Property | Value |\n| --- | --- |\n")
+	for _, p := range details.GetProperties().GetItems() {
+		fmt.Fprintf(&b, "| %s | %s |\n", markdownCell(p.Label), markdownCell(propertyValue(p)))
+	}
+
+	b.WriteString("\n## Timeline\n\n| Time | Event | User |\n| --- | --- | --- |\n")
+	for _, e := range report.GetTimeline() {
+		fmt.Fprintf(&b, "| %s | %s | %s |\n", formatTimestamp(e.Time), markdownCell(e.Description), markdownCell(e.Username))
+	}
+	if report.GetAuditEventsTruncated() {
+		b.WriteString("\nSome audit events of the run are left out, as more audit events concern the run than a report includes.\n")
+	}
+
+	if len(report.GetMetricSummaries()) > 0 {
+		b.WriteString("\n## Metrics\n\n| Metric | Cluster | Before | During | After |\n| --- | --- | --- | --- | --- |\n")
+		for _, m := range report.GetMetricSummaries() {
+			fmt.Fprintf(&b, "| %s | %s | %s | %s | %s |\n", markdownCell(m.Name), markdownCell(m.Cluster),
+				formatMetricValue(m.Before), formatMetricValue(m.During), formatMetricValue(m.After))
+		}
+	}
+
+	return b.String()
+}
+
+func propertyValue(p *experimentation.Property) string {
+	switch v := p.Value.(type) {
+	case *experimentation.Property_DateValue:
+		return formatTimestamp(v.DateValue)
+	case *experimentation.Property_StringValue:
+		return v.StringValue
+	case *experimentation.Property_IntValue:
+		return strconv.FormatInt(v.IntValue, 10)
+	case *experimentation.Property_UrlValue:
+		return v.UrlValue
+	default:
+		return ""
+	}
+}
+
+func formatTimestamp(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+	return t.AsTime().UTC().Format(time.RFC3339)
+}
+
+func formatMetricValue(v *wrapperspb.DoubleValue) string {
+	if v == nil {
+		return "-"
+	}
+	return strconv.FormatFloat(v.Value, 'g', 4, 64)
+}
+
+// Pipes and line breaks would break the table.
+func markdownCell(s string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(s)
+}
@@ -0,0 +1,199 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	experimentation "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	configv1 "github.com/lyft/clutch/backend/api/config/module/chaos/experimentation/api/v1"
+	"github.com/lyft/clutch/backend/mock/prometheusmock"
+	"github.com/lyft/clutch/backend/service/audit"
+	"github.com/lyft/clutch/backend/service/audit/storage"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+)
+
+type fakeAuditor struct {
+	audit.Auditor
+
+	events []*auditv1.Event
+}
+
+func (f *fakeAuditor) ReadEvents(_ context.Context, _ time.Time, _ *time.Time, options *audit.ReadOptions) ([]*auditv1.Event, error) {
+	var ret []*auditv1.Event
+	for _, e := range f.events {
+		if options.After.Precedes(e) && storage.MatchesFilter(e, options.Filter) && int64(len(ret)) < options.Limit {
+			ret = append(ret, e)
+		}
+	}
+	return ret, nil
+}
+
+func auditEvent(id int64, at time.Time, method string, actionType apiv1.ActionType, resources []*auditv1.Resource) *auditv1.Event {
+	return &auditv1.Event{
+		Id:         id,
+		OccurredAt: timestamppb.New(at),
+		EventType: &auditv1.Event_Event{Event: &auditv1.RequestEvent{
+			Username:    "user@example.com",
+			ServiceName: "clutch.chaos.experimentation.v1.ExperimentsAPI",
+			MethodName:  method,
+			Type:        actionType,
+			Status:      &status.Status{},
+			Resources:   resources,
+		}},
+	}
+}
+
+func newTestRunReport(now time.Time) *experimentstore.RunReport {
+	end := now.Add(-10 * time.Minute)
+	run := &experimentstore.ExperimentRun{
+		Id:           "1",
+		CreationTime: now.Add(-31 * time.Minute),
+		StartTime:    now.Add(-30 * time.Minute),
+		EndTime:      &end,
+	}
+
+	return &experimentstore.RunReport{
+		Run: run,
+		Report: &experimentation.ExperimentRunReport{
+			Details: &experimentation.ExperimentRunDetails{
+				RunId: "1",
+				Properties: &experimentation.PropertiesList{Items: []*experimentation.Property{
+					{Id: "type", Label: "Type", Value: &experimentation.Property_StringValue{StringValue: "HTTP|gRPC"}},
+				}},
+			},
+			Timeline: run.Timeline(now),
+		},
+		Target: &experimentstore.ExperimentTarget{UpstreamCluster: "users", DownstreamCluster: "api", FaultPercentage: 10},
+	}
+}
+
+func TestReportAuditEvents(t *testing.T) {
+	now := time.Now()
+
+	createdResource := func(id string) []*auditv1.Resource {
+		return []*auditv1.Resource{{TypeUrl: "clutch.chaos.experimentation.v1.CreateExperimentResponse", Id: id}}
+	}
+	cancelResource := []*auditv1.Resource{{TypeUrl: "clutch.chaos.experimentation.v1.CancelExperimentRunRequest", Id: "1"}}
+	detailsResource := []*auditv1.Resource{{TypeUrl: "clutch.chaos.experimentation.v1.GetExperimentRunDetailsRequest", Id: "1"}}
+
+	auditor := &fakeAuditor{events: []*auditv1.Event{
+		auditEvent(1, now.Add(-31*time.Minute), "CreateExperiment", apiv1.ActionType_CREATE, createdResource("1")),
+		auditEvent(2, now.Add(-31*time.Minute), "CreateExperiment", apiv1.ActionType_CREATE, createdResource("2")),
+		auditEvent(3, now.Add(-31*time.Minute), "CreateExperiment", apiv1.ActionType_CREATE, createdResource("12")),
+		auditEvent(4, now.Add(-20*time.Minute), "GetExperimentRunDetails", apiv1.ActionType_READ, detailsResource),
+		auditEvent(5, now.Add(-5*time.Minute), "CancelExperimentRun", apiv1.ActionType_DELETE, cancelResource),
+	}}
+
+	r, err := newReporter(nil, auditor, zaptest.NewLogger(t).Sugar())
+	assert.NoError(t, err)
+
+	report := r.complete(context.Background(), newTestRunReport(now), now)
+
+	var descriptions []string
+	for _, e := range report.Timeline {
+		descriptions = append(descriptions, e.Description)
+	}
+	assert.Equal(t, []string{"Run created", "CreateExperiment", "Run started", "Run ended", "CancelExperimentRun"}, descriptions)
+	assert.Equal(t, "user@example.com", report.Timeline[4].Username)
+	assert.False(t, report.AuditEventsTruncated)
+	assert.Empty(t, report.MetricSummaries)
+	assert.Equal(t, now.Unix(), report.GenerationTime.AsTime().Unix())
+}
+
+func TestReportAuditEventsTruncated(t *testing.T) {
+	now := time.Now()
+
+	auditor := &fakeAuditor{}
+	resources := []*auditv1.Resource{{TypeUrl: "clutch.chaos.experimentation.v1.CancelExperimentRunRequest", Id: "1"}}
+	for i := 0; i <= maxAuditEventsRead; i++ {
+		at := now.Add(-20 * time.Minute).Add(time.Duration(i) * time.Millisecond)
+		auditor.events = append(auditor.events, auditEvent(int64(i), at, "CancelExperimentRun", apiv1.ActionType_DELETE, resources))
+	}
+
+	r, err := newReporter(nil, auditor, zaptest.NewLogger(t).Sugar())
+	assert.NoError(t, err)
+
+	report := r.complete(context.Background(), newTestRunReport(now), now)
+	assert.True(t, report.AuditEventsTruncated)
+	assert.Len(t, report.Timeline, 3+maxAuditEventsRead)
+}
+
+func TestReportMetricSummaries(t *testing.T) {
+	prometheus := prometheusmock.NewServer()
+	defer prometheus.Close()
+	prometheus.SetResult(`success_rate{cluster="users"}[1200s]`, prometheusmock.Sample{Value: 0.99})
+
+	r, err := newReporter(&configv1.ReportMetrics{
+		Endpoint: prometheus.URL,
+		Metrics: []*configv1.ReportMetrics_Metric{
+			{Name: "success rate", Query: `success_rate{cluster="{{.Cluster}}"}[{{.Window}}]`},
+		},
+	}, nil, zaptest.NewLogger(t).Sugar())
+	assert.NoError(t, err)
+
+	now := time.Now()
+	report := r.complete(context.Background(), newTestRunReport(now), now)
+
+	assert.Len(t, report.MetricSummaries, 2)
+	users := report.MetricSummaries[0]
+	assert.Equal(t, "users", users.Cluster)
+	assert.Equal(t, 0.99, users.Before.GetValue())
+	assert.Equal(t, 0.99, users.During.GetValue())
+	// The period after the run is not over yet.
+	assert.Nil(t, users.After)
+
+	api := report.MetricSummaries[1]
+	assert.Equal(t, "api", api.Cluster)
+	assert.Nil(t, api.Before)
+	assert.Nil(t, api.During)
+}
+
+func TestExportReport(t *testing.T) {
+	now := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+	report := newTestRunReport(now).Report
+	report.GenerationTime = timestamppb.New(now)
+	report.MetricSummaries = []*experimentation.MetricSummary{{Name: "success rate", Cluster: "users"}}
+
+	export, err := exportReport(report, experimentation.GetExperimentRunReportRequest_FORMAT_UNSPECIFIED)
+	assert.NoError(t, err)
+	assert.Empty(t, export)
+
+	export, err = exportReport(report, experimentation.GetExperimentRunReportRequest_FORMAT_JSON)
+	assert.NoError(t, err)
+	assert.Contains(t, export, `"runId": "1"`)
+
+	export, err = exportReport(report, experimentation.GetExperimentRunReportRequest_FORMAT_MARKDOWN)
+	assert.NoError(t, err)
+	assert.Equal(t, `# Experiment Run 1
+
+Generated at 2021-01-01T12:00:00Z.
+
+## Details
+
+| Property | Value |
+| --- | --- |
+| Type | HTTP\|gRPC |
+
+## Timeline
+
+| Time | Event | User |
+| --- | --- | --- |
+| 2021-01-01T11:29:00Z | Run created |  |
+| 2021-01-01T11:30:00Z | Run started |  |
+| 2021-01-01T11:50:00Z | Run ended |  |
+
+## Metrics
+
+| Metric | Cluster | Before | During | After |
+| --- | --- | --- | --- | --- |
+| success rate | users | - | - | - |
+`, export)
+}
@@ -0,0 +1,155 @@
+package xds
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	gcpDiscoveryV3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	gcpRuntimeServiceV3 "github.com/envoyproxy/go-control-plane/envoy/service/runtime/v3"
+	gcpCacheV3 "github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	gcpResourceV3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	gcpStreamV3 "github.com/envoyproxy/go-control-plane/pkg/server/stream/v3"
+	gcpServerV3 "github.com/envoyproxy/go-control-plane/pkg/server/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// Delta responses of the snapshot cache carry neither the TTLs of resources nor heartbeats, so faults served over delta
+// streams wouldn't expire if Envoys lost the connection to the control plane. deltaStream sets the TTLs of the
+// resources of the snapshot of the node in responses, and sends heartbeats for the TTL'd resources the node has like
+// the snapshot cache does for state of the world streams.
+type deltaStream struct {
+	gcpStreamV3.DeltaStream
+
+	cache gcpCacheV3.SnapshotCache
+
+	mu      sync.Mutex
+	node    string
+	typeUrl string
+	// The TTL'd resources the node has by name, without their body as they're sent as heartbeats.
+	heartbeats     map[string]*gcpDiscoveryV3.Resource
+	heartbeatCount int64
+}
+
+func newDeltaStream(stream gcpStreamV3.DeltaStream, cache gcpCacheV3.SnapshotCache) *deltaStream {
+	return &deltaStream{
+		DeltaStream: stream,
+		cache:       cache,
+		heartbeats:  make(map[string]*gcpDiscoveryV3.Resource),
+	}
+}
+
+// Serves a delta stream with the given server, sending heartbeats at the given interval.
+func serveDeltaStream(server gcpServerV3.Server, cache gcpCacheV3.SnapshotCache, heartbeatInterval time.Duration, stream gcpStreamV3.DeltaStream, typeUrl string) error {
+	s := newDeltaStream(stream, cache)
+
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+	if heartbeatInterval > 0 {
+		go s.heartbeat(ctx, heartbeatInterval)
+	}
+
+	return server.DeltaStreamHandler(s, typeUrl)
+}
+
+// The node is only set on the first request of a stream.
+func (s *deltaStream) Recv() (*gcpDiscoveryV3.DeltaDiscoveryRequest, error) {
+	req, err := s.DeltaStream.Recv()
+	if err == nil && req.GetNode() != nil {
+		s.mu.Lock()
+		s.node = ClusterHashV3{}.ID(req.GetNode())
+		s.mu.Unlock()
+	}
+	return req, err
+}
+
+func (s *deltaStream) Send(resp *gcpDiscoveryV3.DeltaDiscoveryResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ttls := s.resourceTtls(resp.TypeUrl)
+	s.typeUrl = resp.TypeUrl
+	for _, r := range resp.Resources {
+		if ttl, ok := ttls[r.Name]; ok {
+			r.Ttl = durationpb.New(ttl)
+			s.heartbeats[r.Name] = &gcpDiscoveryV3.Resource{Name: r.Name, Version: r.Version, Ttl: r.Ttl}
+		} else {
+			delete(s.heartbeats, r.Name)
+		}
+	}
+	for _, name := range resp.RemovedResources {
+		delete(s.heartbeats, name)
+	}
+
+	return s.DeltaStream.Send(resp)
+}
+
+// Returns the TTLs of the resources of the given type that have one in the snapshot of the node.
+func (s *deltaStream) resourceTtls(typeUrl string) map[string]time.Duration {
+	snapshot, err := s.cache.GetSnapshot(s.node)
+	if err != nil {
+		return nil
+	}
+
+	ttls := make(map[string]time.Duration)
+	for name, r := range snapshot.GetResourcesAndTTL(typeUrl) {
+		if r.TTL != nil && *r.TTL > 0 {
+			ttls[name] = *r.TTL
+		}
+	}
+	return ttls
+}
+
+func (s *deltaStream) heartbeat(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := s.sendHeartbeats(); err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Heartbeats are resources without a body, which refresh the TTL of the resource the node has with the same version.
+func (s *deltaStream) sendHeartbeats() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.heartbeats) == 0 {
+		return nil
+	}
+
+	resources := make([]*gcpDiscoveryV3.Resource, 0, len(s.heartbeats))
+	for _, r := range s.heartbeats {
+		resources = append(resources, r)
+	}
+	sort.Slice(resources, func(i, j int) bool { return resources[i].Name < resources[j].Name })
+
+	// n.b. the nonces of the server are numbers, so heartbeat nonces can't collide with them.
+	s.heartbeatCount++
+	return s.DeltaStream.Send(&gcpDiscoveryV3.DeltaDiscoveryResponse{
+		TypeUrl:   s.typeUrl,
+		Resources: resources,
+		Nonce:     fmt.Sprintf("heartbeat-%d", s.heartbeatCount),
+	})
+}
+
+// Serves delta RTDS streams with TTLs and heartbeats.
+type rtdsServer struct {
+	gcpServerV3.Server
+
+	cache             gcpCacheV3.SnapshotCache
+	heartbeatInterval time.Duration
+}
+
+func (s *rtdsServer) DeltaRuntime(stream gcpRuntimeServiceV3.RuntimeDiscoveryService_DeltaRuntimeServer) error {
+	return serveDeltaStream(s.Server, s.cache, s.heartbeatInterval, stream, gcpResourceV3.RuntimeType)
+}
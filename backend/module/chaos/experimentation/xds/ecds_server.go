@@ -2,7 +2,7 @@ package xds
 
 import (
 	"context"
-	"errors"
+	"time"
 
 	discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	extensionconfigservice "github.com/envoyproxy/go-control-plane/envoy/service/extension/v3"
@@ -19,11 +19,16 @@ type ECDSServer interface {
 
 type ecdsServer struct {
 	server gcpServerV3.Server
+
+	cache             cache.SnapshotCache
+	heartbeatInterval time.Duration
 }
 
-func NewECDSServer(ctx context.Context, config cache.Cache, callbacks gcpServerV3.Callbacks) ECDSServer {
+func NewECDSServer(ctx context.Context, config cache.SnapshotCache, callbacks gcpServerV3.Callbacks, heartbeatInterval time.Duration) ECDSServer {
 	return &ecdsServer{
-		server: gcpServerV3.NewServer(ctx, config, callbacks),
+		server:            gcpServerV3.NewServer(ctx, config, callbacks),
+		cache:             config,
+		heartbeatInterval: heartbeatInterval,
 	}
 }
 
@@ -31,8 +36,8 @@ func (e ecdsServer) StreamExtensionConfigs(stream extensionconfigservice.Extensi
 	return e.server.StreamHandler(stream, resource.ExtensionConfigType)
 }
 
-func (e ecdsServer) DeltaExtensionConfigs(extensionconfigservice.ExtensionConfigDiscoveryService_DeltaExtensionConfigsServer) error {
-	return errors.New("not implemented")
+func (e ecdsServer) DeltaExtensionConfigs(stream extensionconfigservice.ExtensionConfigDiscoveryService_DeltaExtensionConfigsServer) error {
+	return serveDeltaStream(e.server, e.cache, e.heartbeatInterval, stream, resource.ExtensionConfigType)
 }
 
 func (e ecdsServer) FetchExtensionConfigs(ctx context.Context, req *discovery.DiscoveryRequest) (*discovery.DiscoveryResponse, error) {
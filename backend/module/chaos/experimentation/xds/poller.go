@@ -39,12 +39,26 @@ type Poller struct {
 	ecdsDefaultResourceGenerationFailureCount tally.Counter
 	setCacheSnapshotSuccessCount              tally.Counter
 	setCacheSnapshotFailureCount              tally.Counter
+	changeTriggeredRefreshCount               tally.Counter
 	activeFaultsGauge                         tally.Gauge
 
 	logger *zap.SugaredLogger
 }
 
 func (p *Poller) Start(ctx context.Context) {
+	// If the store notifies of created and cancelled experiments the cache is also refreshed right away, so faults
+	// start and stop without waiting for the refresh interval. The interval still picks up experiments starting or
+	// ending at their scheduled time.
+	var changes <-chan struct{}
+	if n, ok := p.storer.(experimentstore.Notifier); ok {
+		c, err := n.Subscribe(ctx)
+		if err != nil {
+			p.logger.Warnw("Unable to subscribe to experiment changes, relying on the refresh interval", "error", err)
+		} else {
+			changes = c
+		}
+	}
+
 	ticker := time.NewTicker(p.cacheRefreshInterval)
 	go func() {
 		for {
@@ -54,6 +68,16 @@ func (p *Poller) Start(ctx context.Context) {
 			case <-ticker.C:
 				p.logger.Info("Refreshing xDS cache")
 				p.refreshCache(ctx)
+			case _, ok := <-changes:
+				if !ok {
+					p.logger.Warn("Experiment change subscription ended, relying on the refresh interval")
+					// Receiving from a nil channel blocks forever.
+					changes = nil
+					continue
+				}
+				p.logger.Info("Refreshing xDS cache after experiment change")
+				p.changeTriggeredRefreshCount.Inc(1)
+				p.refreshCache(ctx)
 			}
 		}
 	}()
@@ -303,6 +303,75 @@ func TestECDSResourcesRefresh(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestRefreshOnExperimentChange(t *testing.T) {
+	rtdsConfig := RTDSConfig{
+		layerName: "test_layer",
+	}
+	ecdsConfig := ECDSConfig{
+		ecdsResourceMap: &SafeEcdsResourceMap{},
+		enabledClusters: make(map[string]struct{}),
+	}
+
+	config := &wrapperspb.StringValue{}
+	rtdsGeneratorsByTypeUrl := map[string]RTDSResourceGenerator{
+		TypeUrl(config): &MockRTDSResourceGenerator{
+			resource: &RTDSResource{
+				Cluster:          "foo",
+				RuntimeKeyValues: []*RuntimeKeyValue{{Key: "foo1", Value: 1}},
+			},
+		},
+	}
+
+	scope := tally.NewTestScope("", nil)
+	l, err := zap.NewDevelopment()
+	assert.NoError(t, err)
+
+	s := experimentstoremock.SimpleStorer{}
+	cache := gcpCacheV3.NewSnapshotCache(false, gcpCacheV3.IDHash{}, nil)
+	p := Poller{
+		storer: &s,
+		cache:  cache,
+		// Long enough for the test to only pass if changes trigger a refresh.
+		cacheRefreshInterval:                      time.Hour,
+		resourceTtl:                               time.Second,
+		rtdsConfig:                                &rtdsConfig,
+		ecdsConfig:                                &ecdsConfig,
+		rtdsGeneratorsByTypeUrl:                   rtdsGeneratorsByTypeUrl,
+		ecdsGeneratorsByTypeUrl:                   make(map[string]ECDSResourceGenerator),
+		rtdsResourceGenerationFailureCount:        scope.Counter("c1"),
+		ecdsResourceGenerationFailureCount:        scope.Counter("c2"),
+		ecdsDefaultResourceGenerationFailureCount: scope.Counter("c3"),
+		setCacheSnapshotSuccessCount:              scope.Counter("c4"),
+		setCacheSnapshotFailureCount:              scope.Counter("c5"),
+		changeTriggeredRefreshCount:               scope.Counter("change_triggered_refresh"),
+		activeFaultsGauge:                         scope.Gauge("active_faults"),
+		logger:                                    l.Sugar(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p.Start(ctx)
+
+	now := time.Now()
+	a, err := anypb.New(config)
+	assert.NoError(t, err)
+	es, err := experimentstore.NewExperimentSpecification(
+		&experimentation.CreateExperimentData{
+			EndTime: timestamppb.New(now.Add(time.Hour)),
+			Config:  a,
+		}, now)
+	assert.NoError(t, err)
+	e, err := s.CreateExperiment(context.Background(), es)
+	assert.NoError(t, err)
+
+	awaitGaugeEquals(t, scope, "active_faults+", 1)
+
+	assert.NoError(t, s.CancelExperimentRun(context.Background(), e.Run.Id, "done"))
+	awaitGaugeEquals(t, scope, "active_faults+", 0)
+
+	assert.GreaterOrEqual(t, scope.Snapshot().Counters()["change_triggered_refresh+"].Value(), int64(2))
+}
+
 func TestComputeVersionReturnValue(t *testing.T) {
 	rtdsLayerName := "TestRtdsLayer"
 
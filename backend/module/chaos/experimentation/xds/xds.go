@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"sync/atomic"
+	"time"
 
 	gcpCoreV3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	gcpDiscoveryV3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
@@ -41,6 +42,8 @@ type Server struct {
 
 	ecdsConfig *ECDSConfig
 
+	heartbeatInterval time.Duration
+
 	scope  tally.Scope
 	logger *zap.SugaredLogger
 }
@@ -107,6 +110,7 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, er
 		ecdsDefaultResourceGenerationFailureCount: scope.Counter("ecds_default_resource_generation_failure"),
 		setCacheSnapshotSuccessCount:              scope.Counter("set_snapshot_success"),
 		setCacheSnapshotFailureCount:              scope.Counter("set_snapshot_failure"),
+		changeTriggeredRefreshCount:               scope.Counter("change_triggered_refresh"),
 		activeFaultsGauge:                         scope.Gauge("active_faults"),
 
 		logger: logger.Sugar(),
@@ -118,6 +122,8 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, er
 		ecdsConfig: ecdsConfig,
 		scope:      scope,
 
+		heartbeatInterval: config.HeartbeatInterval.AsDuration(),
+
 		logger: logger.Sugar(),
 	}, nil
 }
@@ -141,11 +147,15 @@ func (s *Server) Register(r module.Registrar) error {
 	ctx := context.Background()
 	s.poller.Start(ctx)
 	// RTDS V3 Server
-	rtdsServer := gcpServerV3.NewServer(s.ctx, s.poller.cache, &rtdsCallbacks{callbacksBase{s.newScopedStats("rtds"),
-		s.logger, 0}})
+	rtdsServer := &rtdsServer{
+		Server: gcpServerV3.NewServer(s.ctx, s.poller.cache, &rtdsCallbacks{callbacksBase{s.newScopedStats("rtds"),
+			s.logger, 0}}),
+		cache:             s.poller.cache,
+		heartbeatInterval: s.heartbeatInterval,
+	}
 	gcpRuntimeServiceV3.RegisterRuntimeDiscoveryServiceServer(r.GRPCServer(), rtdsServer)
 
-	ecdsServer := NewECDSServer(s.ctx, s.poller.cache, &ecdsCallbacks{callbacksBase{s.newScopedStats("ecds"), s.logger, 0}, s.ecdsConfig.ecdsResourceMap})
+	ecdsServer := NewECDSServer(s.ctx, s.poller.cache, &ecdsCallbacks{callbacksBase{s.newScopedStats("ecds"), s.logger, 0}, s.ecdsConfig.ecdsResourceMap}, s.heartbeatInterval)
 	gcpExtencionServiceV3.RegisterExtensionConfigDiscoveryServiceServer(r.GRPCServer(), ecdsServer)
 	return nil
 }
@@ -178,21 +188,29 @@ func (c *callbacksBase) onStreamResponse(_ int64, _ string, _ string) {
 	c.serverStats.totalResourcesServed.Inc(1)
 }
 
-// Unimplemented Delta Callbacks
-func (c *callbacksBase) OnDeltaStreamOpen(ctx context.Context, i int64, s string) error {
-	panic("delta callbacks not implemented, use 'GRPC' api_type")
+// Delta Callbacks
+// Delta responses only contain the resources that changed. Faults are removed by pushing the default resources when the
+// experiment ends, and expire like over state of the world streams if the control plane becomes unreachable, see
+// deltaStream.
+func (c *callbacksBase) OnDeltaStreamOpen(ctx context.Context, streamID int64, typeURL string) error {
+	c.logger.Debugw("Delta onStreamOpen", "streamID", streamID, "typeURL", typeURL)
+	return c.onStreamOpen(ctx)
 }
 
-func (c *callbacksBase) OnStreamDeltaRequest(i int64, request *gcpDiscoveryV3.DeltaDiscoveryRequest) error {
-	panic("delta callbacks not implemented, use 'GRPC' api_type")
+func (c *callbacksBase) OnStreamDeltaRequest(streamID int64, req *gcpDiscoveryV3.DeltaDiscoveryRequest) error {
+	c.logger.Debugw("Delta OnStreamRequest", "streamID", streamID, "cluster", req.Node.GetCluster(), "typeURL", req.TypeUrl)
+	c.onStreamRequest(streamID, req.Node.GetCluster(), req.ErrorDetail)
+	return nil
 }
 
-func (c *callbacksBase) OnStreamDeltaResponse(i int64, request *gcpDiscoveryV3.DeltaDiscoveryRequest, response *gcpDiscoveryV3.DeltaDiscoveryResponse) {
-	panic("delta callbacks not implemented, use 'GRPC' api_type")
+func (c *callbacksBase) OnStreamDeltaResponse(streamID int64, req *gcpDiscoveryV3.DeltaDiscoveryRequest, resp *gcpDiscoveryV3.DeltaDiscoveryResponse) {
+	c.logger.Debugw("Delta OnStreamResponse", "streamID", streamID, "cluster", req.Node.GetCluster(), "version", resp.SystemVersionInfo)
+	c.onStreamResponse(streamID, req.Node.GetCluster(), resp.SystemVersionInfo)
 }
 
 func (c *callbacksBase) OnDeltaStreamClosed(streamID int64, node *gcpCoreV3.Node) {
-	panic("delta callbacks not implemented, use 'GRPC' api_type")
+	c.logger.Debugw("Delta onStreamClosed", "streamID", streamID, "cluster", node.GetCluster())
+	c.onStreamClosed(streamID)
 }
 
 // RTDS Callbacks
@@ -261,6 +279,18 @@ func (c *ecdsCallbacks) OnStreamRequest(streamID int64, req *gcpDiscoveryV3.Disc
 	return nil
 }
 
+// Delta requests only carry the resources subscribed to since the previous request, along with the resources the client
+// already has when it reconnects.
+func (c *ecdsCallbacks) OnStreamDeltaRequest(streamID int64, req *gcpDiscoveryV3.DeltaDiscoveryRequest) error {
+	resourceNames := append([]string{}, req.ResourceNamesSubscribe...)
+	for name := range req.InitialResourceVersions {
+		resourceNames = append(resourceNames, name)
+	}
+	c.safeECDSResources.setResourcesForCluster(req.Node.GetCluster(), resourceNames)
+
+	return c.callbacksBase.OnStreamDeltaRequest(streamID, req)
+}
+
 func (c *ecdsCallbacks) OnStreamResponse(ctx context.Context, streamID int64, request *gcpDiscoveryV3.DiscoveryRequest, response *gcpDiscoveryV3.DiscoveryResponse) {
 	c.logger.Debugw("ECDS OnStreamResponse", "streamID", streamID, "cluster", request.Node.Cluster, "version", request.VersionInfo)
 	c.onStreamResponse(streamID, request.Node.Cluster, request.VersionInfo)
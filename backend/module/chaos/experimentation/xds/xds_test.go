@@ -8,12 +8,15 @@ import (
 
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	gcpDiscoveryV3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	gcpExtensionServiceV3 "github.com/envoyproxy/go-control-plane/envoy/service/extension/v3"
 	gcpRuntimeServiceV3 "github.com/envoyproxy/go-control-plane/envoy/service/runtime/v3"
+	gcpResourceV3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/uber-go/tally/v4"
 	rpc_status "google.golang.org/genproto/googleapis/rpc/status"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	xdsv1 "github.com/lyft/clutch/backend/api/config/module/chaos/experimentation/xds/v1"
 	"github.com/lyft/clutch/backend/module/chaos/experimentation/xds/internal/xdstest"
@@ -134,6 +137,193 @@ func TestResourceTTL(t *testing.T) {
 	assert.NoError(t, noFaultStream.sendV3RequestWithoutResponse(r.VersionInfo, r.Nonce))
 }
 
+// Verifies that faults are pushed to delta RTDS streams as soon as experiments are created and cancelled.
+func TestDeltaRuntime(t *testing.T) {
+	RTDSGeneratorsByTypeUrl[TypeUrl(&wrapperspb.UInt32Value{})] = &MockRTDSResourceGenerator{
+		resource: &RTDSResource{
+			Cluster:          "delta-cluster",
+			RuntimeKeyValues: []*RuntimeKeyValue{{Key: "foo", Value: 1}},
+		},
+	}
+
+	xdsConfig := &xdsv1.Config{
+		RtdsLayerName:     "rtds",
+		HeartbeatInterval: durationpb.New(time.Second),
+		// Long enough for the test to only pass if changes are pushed.
+		CacheRefreshInterval: durationpb.New(time.Hour),
+	}
+
+	testServer, err := xdstest.NewTestModuleServer(New, false, xdsConfig)
+	assert.NoError(t, err)
+	defer testServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := testServer.ClientConn()
+	assert.NoError(t, err)
+	s, err := gcpRuntimeServiceV3.NewRuntimeDiscoveryServiceClient(conn).DeltaRuntime(ctx)
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, s.CloseSend()) }()
+
+	err = s.Send(&gcpDiscoveryV3.DeltaDiscoveryRequest{
+		Node:                   &envoy_config_core_v3.Node{Cluster: "delta-cluster"},
+		TypeUrl:                gcpResourceV3.RuntimeType,
+		ResourceNamesSubscribe: []string{"rtds"},
+	})
+	assert.NoError(t, err)
+
+	a, err := anypb.New(&wrapperspb.UInt32Value{})
+	assert.NoError(t, err)
+	now := time.Now()
+	e, err := testServer.Storer.CreateExperiment(context.Background(), &experimentstore.ExperimentSpecification{StartTime: now, Config: a})
+	assert.NoError(t, err)
+
+	r, err := awaitDeltaResponse(s.Recv)
+	assert.NoError(t, err)
+	assert.Len(t, r.Resources, 1)
+	runtime := &gcpRuntimeServiceV3.Runtime{}
+	assert.NoError(t, r.Resources[0].Resource.UnmarshalTo(runtime))
+	assert.Equal(t, "rtds", runtime.Name)
+	assert.Equal(t, 1.0, runtime.Layer.Fields["foo"].GetNumberValue())
+
+	assert.NoError(t, s.Send(&gcpDiscoveryV3.DeltaDiscoveryRequest{TypeUrl: gcpResourceV3.RuntimeType, ResponseNonce: r.Nonce}))
+	assert.NoError(t, testServer.Storer.CancelExperimentRun(context.Background(), e.Run.Id, "done"))
+
+	r, err = awaitDeltaResponse(s.Recv)
+	assert.NoError(t, err)
+	assert.Len(t, r.Resources, 1)
+	runtime = &gcpRuntimeServiceV3.Runtime{}
+	assert.NoError(t, r.Resources[0].Resource.UnmarshalTo(runtime))
+	assert.Empty(t, runtime.Layer.Fields)
+}
+
+// Verifies that resources are TTL'd and heartbeated over delta streams as they are over state of the world streams.
+func TestDeltaResourceTTL(t *testing.T) {
+	RTDSGeneratorsByTypeUrl[TypeUrl(&wrapperspb.Int32Value{})] = &MockRTDSResourceGenerator{
+		resource: &RTDSResource{
+			Cluster:          "delta-ttl-cluster",
+			RuntimeKeyValues: []*RuntimeKeyValue{{Key: "foo", Value: 1}},
+		},
+	}
+	defer delete(RTDSGeneratorsByTypeUrl, TypeUrl(&wrapperspb.Int32Value{}))
+
+	testServer, err := xdstest.NewTestModuleServer(New, true, &xdsv1.Config{RtdsLayerName: "rtds"})
+	assert.NoError(t, err)
+	defer testServer.Stop()
+
+	a, err := anypb.New(&wrapperspb.Int32Value{})
+	assert.NoError(t, err)
+	_, err = testServer.Storer.CreateExperiment(context.Background(), &experimentstore.ExperimentSpecification{StartTime: time.Now(), Config: a})
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := testServer.ClientConn()
+	assert.NoError(t, err)
+	s, err := gcpRuntimeServiceV3.NewRuntimeDiscoveryServiceClient(conn).DeltaRuntime(ctx)
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, s.CloseSend()) }()
+
+	err = s.Send(&gcpDiscoveryV3.DeltaDiscoveryRequest{
+		Node:                   &envoy_config_core_v3.Node{Cluster: "delta-ttl-cluster"},
+		TypeUrl:                gcpResourceV3.RuntimeType,
+		ResourceNamesSubscribe: []string{"rtds"},
+	})
+	assert.NoError(t, err)
+
+	// The fault is TTL'd.
+	r, err := awaitDeltaResponse(s.Recv)
+	assert.NoError(t, err)
+	assert.Len(t, r.Resources, 1)
+	assert.NotNil(t, r.Resources[0].Resource)
+	assert.Equal(t, int64(2), r.Resources[0].Ttl.GetSeconds())
+	version := r.Resources[0].Version
+
+	assert.NoError(t, s.Send(&gcpDiscoveryV3.DeltaDiscoveryRequest{TypeUrl: gcpResourceV3.RuntimeType, ResponseNonce: r.Nonce}))
+
+	// Heartbeats refresh the TTL of the fault without its body.
+	r, err = awaitDeltaResponse(s.Recv)
+	assert.NoError(t, err)
+	assert.Len(t, r.Resources, 1)
+	assert.Equal(t, "rtds", r.Resources[0].Name)
+	assert.Equal(t, version, r.Resources[0].Version)
+	assert.Equal(t, int64(2), r.Resources[0].Ttl.GetSeconds())
+	assert.Nil(t, r.Resources[0].Resource)
+}
+
+func TestDeltaExtensionConfigs(t *testing.T) {
+	filterConfig, err := anypb.New(&wrapperspb.StringValue{Value: "fault"})
+	assert.NoError(t, err)
+	ECDSGeneratorsByTypeUrl[TypeUrl(&wrapperspb.UInt64Value{})] = &MockECDSResourceGenerator{
+		resource: &ECDSResource{
+			Cluster:         "ecds-cluster",
+			ExtensionConfig: &envoy_config_core_v3.TypedExtensionConfig{Name: "filter", TypedConfig: filterConfig},
+		},
+	}
+	defer delete(ECDSGeneratorsByTypeUrl, TypeUrl(&wrapperspb.UInt64Value{}))
+
+	xdsConfig := &xdsv1.Config{
+		RtdsLayerName:        "rtds",
+		HeartbeatInterval:    durationpb.New(time.Second),
+		CacheRefreshInterval: durationpb.New(time.Hour),
+		EcdsAllowList:        &xdsv1.Config_ECDSAllowList{EnabledClusters: []string{"ecds-cluster"}},
+	}
+
+	testServer, err := xdstest.NewTestModuleServer(New, false, xdsConfig)
+	assert.NoError(t, err)
+	defer testServer.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	conn, err := testServer.ClientConn()
+	assert.NoError(t, err)
+	s, err := gcpExtensionServiceV3.NewExtensionConfigDiscoveryServiceClient(conn).DeltaExtensionConfigs(ctx)
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, s.CloseSend()) }()
+
+	err = s.Send(&gcpDiscoveryV3.DeltaDiscoveryRequest{
+		Node:                   &envoy_config_core_v3.Node{Cluster: "ecds-cluster"},
+		TypeUrl:                gcpResourceV3.ExtensionConfigType,
+		ResourceNamesSubscribe: []string{"filter"},
+	})
+	assert.NoError(t, err)
+
+	a, err := anypb.New(&wrapperspb.UInt64Value{})
+	assert.NoError(t, err)
+	_, err = testServer.Storer.CreateExperiment(context.Background(), &experimentstore.ExperimentSpecification{StartTime: time.Now(), Config: a})
+	assert.NoError(t, err)
+
+	r, err := awaitDeltaResponse(s.Recv)
+	assert.NoError(t, err)
+	assert.Len(t, r.Resources, 1)
+	assert.Equal(t, "filter", r.Resources[0].Name)
+	extensionConfig := &envoy_config_core_v3.TypedExtensionConfig{}
+	assert.NoError(t, r.Resources[0].Resource.UnmarshalTo(extensionConfig))
+	assert.Equal(t, "filter", extensionConfig.Name)
+}
+
+func awaitDeltaResponse(recv func() (*gcpDiscoveryV3.DeltaDiscoveryResponse, error)) (*gcpDiscoveryV3.DeltaDiscoveryResponse, error) {
+	type result struct {
+		response *gcpDiscoveryV3.DeltaDiscoveryResponse
+		err      error
+	}
+	ch := make(chan result, 1)
+	go func() {
+		r, err := recv()
+		ch <- result{r, err}
+	}()
+
+	select {
+	case r := <-ch:
+		return r.response, r.err
+	case <-time.After(5 * time.Second):
+		return nil, errors.New("timed out waiting for a delta response")
+	}
+}
+
 func awaitCounterEquals(t *testing.T, scope tally.TestScope, counter string, value int64) {
 	t.Helper()
 
@@ -0,0 +1,299 @@
+package k8sexperimentation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+
+	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	k8sexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/k8sexperimentation/v1"
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+	k8sservice "github.com/lyft/clutch/backend/service/k8s"
+)
+
+const (
+	configTypeUrl = "type.googleapis.com/clutch.chaos.k8sexperimentation.v1.PodFaultConfig"
+
+	injectorLockId = "chaos:experimentation:k8sexperimentation"
+
+	stressContainerPrefix = "clutch-stress-"
+)
+
+var invalidContainerNameChars = regexp.MustCompile(`[^a-z0-9-]`)
+
+// Implemented by stores that can hold an advisory lock shared by all gateway instances.
+type locker interface {
+	AttemptLock(ctx context.Context, lockID uint32) (bool, error)
+	ReleaseLock(ctx context.Context, lockID uint32) (bool, error)
+}
+
+// The store of the injector, which shares the injection state of runs between gateway instances.
+type injectorStore interface {
+	experimentstore.Storer
+	experimentstore.InjectionStateStorer
+}
+
+// Injector injects the faults of running Kubernetes experiments. Faults are only injected for runs that are running at
+// the time of a poll, so cancelled or terminated runs stop killing pods at the latest after one poll interval.
+type Injector struct {
+	store       injectorStore
+	lock        locker
+	k8s         k8sservice.Service
+	interval    time.Duration
+	stressImage string
+
+	log *zap.SugaredLogger
+
+	podsKilled        tally.Counter
+	podsStressed      tally.Counter
+	injectionFailures tally.Counter
+}
+
+func NewInjector(store injectorStore, k8s k8sservice.Service, interval time.Duration, stressImage string, logger *zap.Logger, scope tally.Scope) *Injector {
+	lock, _ := store.(locker)
+
+	return &Injector{
+		store:             store,
+		lock:              lock,
+		k8s:               k8s,
+		interval:          interval,
+		stressImage:       stressImage,
+		log:               logger.Sugar(),
+		podsKilled:        scope.Counter("pods_killed"),
+		podsStressed:      scope.Counter("pods_stressed"),
+		injectionFailures: scope.Counter("injection_failures"),
+	}
+}
+
+// Run injects faults at every poll interval until the context is done. If the store supports it, gateway instances
+// share an advisory lock so that only one of them injects faults at a time.
+func (i *Injector) Run(ctx context.Context) {
+	lockId := experimentstore.ConvertLockToUint32(injectorLockId)
+	ticker := time.NewTicker(i.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			tickCtx, cancel := context.WithTimeout(ctx, i.interval)
+
+			locked := true
+			if i.lock != nil {
+				var err error
+				locked, err = i.lock.AttemptLock(tickCtx, lockId)
+				if err != nil {
+					i.log.Errorw("failed to acquire the injector lock", "err", err)
+				}
+			}
+
+			if locked {
+				i.Inject(tickCtx, time.Now())
+
+				if i.lock != nil {
+					if _, err := i.lock.ReleaseLock(tickCtx, lockId); err != nil {
+						i.log.Errorw("failed to release the injector lock", "err", err)
+					}
+				}
+			}
+
+			cancel()
+		}
+	}
+}
+
+// Inject injects the faults of all running experiments that are due at the given time. Pods are killed when a run is
+// first seen and then whenever the kill interval of the run has passed, while stress is added once per run. The time
+// of the last kill and the stressed pods are kept in the store, so that they carry over to the next gateway instance
+// holding the lock.
+func (i *Injector) Inject(ctx context.Context, now time.Time) {
+	experiments, err := i.store.GetExperiments(ctx, configTypeUrl, experimentationv1.GetExperimentsRequest_STATUS_RUNNING)
+	if err != nil {
+		i.log.Errorw("failed to retrieve experiments from experiment store", "err", err)
+		return
+	}
+
+	for _, e := range experiments {
+		config, ok := e.Config.Message.(*k8sexperimentationv1.PodFaultConfig)
+		if !ok {
+			continue
+		}
+
+		if err := i.injectRun(ctx, e.Run, config, now); err != nil {
+			i.injectionFailures.Inc(1)
+			i.log.Errorw("failed to inject fault of experiment", "experimentRunId", e.Run.Id, "err", err)
+		}
+	}
+}
+
+func (i *Injector) injectRun(ctx context.Context, run *experimentstore.ExperimentRun, config *k8sexperimentationv1.PodFaultConfig, now time.Time) error {
+	state, err := i.store.GetInjectionState(ctx, run.Id)
+	if err != nil {
+		return err
+	}
+
+	switch config.GetFault().(type) {
+	case *k8sexperimentationv1.PodFaultConfig_PodKillFault:
+		fault := config.GetPodKillFault()
+		if state != nil && state.LastInjectionTime != nil && now.Sub(*state.LastInjectionTime) < fault.GetInterval().AsDuration() {
+			return nil
+		}
+		return i.killPods(ctx, run, config.GetTargeting(), fault, now)
+	case *k8sexperimentationv1.PodFaultConfig_StressFault:
+		if state != nil && state.LastInjectionTime != nil {
+			return nil
+		}
+		return i.stressPods(ctx, run, state, config.GetTargeting(), config.GetStressFault(), now)
+	default:
+		return fmt.Errorf("unexpected fault type %v", config.GetFault())
+	}
+}
+
+func (i *Injector) killPods(ctx context.Context, run *experimentstore.ExperimentRun, targeting *k8sexperimentationv1.PodTargeting, fault *k8sexperimentationv1.PodKillFault, now time.Time) error {
+	pods, err := i.selectPods(ctx, targeting, fault.GetPercentage())
+	if err != nil {
+		return err
+	}
+
+	// The kill is recorded before pods are deleted, so that failures to delete some of them don't cause more pods to be
+	// deleted before the interval has passed.
+	if err := i.store.SetInjectionState(ctx, run.Id, &experimentstore.InjectionState{LastInjectionTime: &now, Targets: pods}); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, pod := range pods {
+		if err := i.k8s.DeletePod(ctx, targeting.GetClientset(), targeting.GetCluster(), targeting.GetNamespace(), pod); err != nil {
+			errs = append(errs, fmt.Errorf("failed to delete pod '%s': %w", pod, err))
+			continue
+		}
+		i.podsKilled.Inc(1)
+	}
+	return errors.Join(errs...)
+}
+
+// Pods are selected once per run and stored before they're stressed, so that failed attempts are retried on the same
+// pods rather than stressing more pods than the percentage of the fault.
+func (i *Injector) stressPods(ctx context.Context, run *experimentstore.ExperimentRun, state *experimentstore.InjectionState, targeting *k8sexperimentationv1.PodTargeting, fault *k8sexperimentationv1.StressFault, now time.Time) error {
+	container, err := i.stressContainer(run, fault, now)
+	if err != nil {
+		return err
+	}
+
+	if state == nil {
+		pods, err := i.selectPods(ctx, targeting, fault.GetPercentage())
+		if err != nil {
+			return err
+		}
+
+		state = &experimentstore.InjectionState{Targets: pods}
+		if err := i.store.SetInjectionState(ctx, run.Id, state); err != nil {
+			return err
+		}
+	}
+
+	var errs []error
+	for _, pod := range state.Targets {
+		err := i.k8s.AddEphemeralContainer(ctx, targeting.GetClientset(), targeting.GetCluster(), targeting.GetNamespace(), pod, container)
+		if status.Code(err) == codes.AlreadyExists || k8serrors.IsNotFound(err) {
+			// The pod was already stressed by this run, or it is gone.
+			continue
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to add stress container to pod '%s': %w", pod, err))
+			continue
+		}
+		i.podsStressed.Inc(1)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	state.LastInjectionTime = &now
+	return i.store.SetInjectionState(ctx, run.Id, state)
+}
+
+func (i *Injector) stressContainer(run *experimentstore.ExperimentRun, fault *k8sexperimentationv1.StressFault, now time.Time) (*corev1.EphemeralContainer, error) {
+	if fault.GetCpuWorkers() == 0 && fault.GetMemoryWorkers() == 0 {
+		return nil, errors.New("stress fault has neither CPU nor memory workers")
+	}
+
+	duration := fault.GetDuration().AsDuration()
+	if fault.GetDuration() == nil {
+		if run.EndTime == nil {
+			return nil, errors.New("stress fault without a duration requires the experiment to have an end time")
+		}
+		duration = run.EndTime.Sub(now)
+	}
+	seconds := int64(duration.Round(time.Second) / time.Second)
+	if seconds < 1 {
+		return nil, errors.New("stress fault has no remaining duration")
+	}
+
+	args := []string{"--timeout", fmt.Sprintf("%ds", seconds)}
+	if fault.GetCpuWorkers() > 0 {
+		load := fault.GetCpuLoad()
+		if load == 0 {
+			load = 100
+		}
+		args = append(args, "--cpu", fmt.Sprint(fault.GetCpuWorkers()), "--cpu-load", fmt.Sprint(load))
+	}
+	if fault.GetMemoryWorkers() > 0 {
+		args = append(args, "--vm", fmt.Sprint(fault.GetMemoryWorkers()))
+		if fault.GetMemoryMbPerWorker() > 0 {
+			args = append(args, "--vm-bytes", fmt.Sprintf("%dM", fault.GetMemoryMbPerWorker()))
+		}
+	}
+
+	return &corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{
+			Name:    stressContainerName(run.Id),
+			Image:   i.stressImage,
+			Command: []string{"stress-ng"},
+			Args:    args,
+		},
+	}, nil
+}
+
+// Returns the names of a random selection of the given percentage of the running pods matching the targeting, rounded
+// up to at least one pod.
+func (i *Injector) selectPods(ctx context.Context, targeting *k8sexperimentationv1.PodTargeting, percentage uint32) ([]string, error) {
+	pods, err := i.k8s.ListPods(ctx, targeting.GetClientset(), targeting.GetCluster(), targeting.GetNamespace(),
+		&k8sapiv1.ListOptions{Labels: targeting.GetLabelSelector()})
+	if err != nil {
+		return nil, err
+	}
+
+	var running []string
+	for _, pod := range pods {
+		if pod.State == k8sapiv1.Pod_RUNNING {
+			running = append(running, pod.Name)
+		}
+	}
+
+	count := (len(running)*int(percentage) + 99) / 100
+	rand.Shuffle(len(running), func(a, b int) { running[a], running[b] = running[b], running[a] })
+	return running[:count], nil
+}
+
+// Container names must be DNS labels, while run IDs are arbitrary strings.
+func stressContainerName(runId string) string {
+	name := stressContainerPrefix + invalidContainerNameChars.ReplaceAllString(strings.ToLower(runId), "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.TrimRight(name, "-")
+}
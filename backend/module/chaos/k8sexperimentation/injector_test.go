@@ -0,0 +1,222 @@
+package k8sexperimentation
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	corev1 "k8s.io/api/core/v1"
+
+	k8sexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/k8sexperimentation/v1"
+	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	"github.com/lyft/clutch/backend/mock/service/chaos/experimentation/experimentstoremock"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+	k8sservice "github.com/lyft/clutch/backend/service/k8s"
+)
+
+type fakeK8s struct {
+	k8sservice.Service
+
+	pods       []*k8sapiv1.Pod
+	deleted    []string
+	containers map[string][]*corev1.EphemeralContainer
+
+	sync.Mutex
+}
+
+func (f *fakeK8s) ListPods(_ context.Context, _, _, _ string, _ *k8sapiv1.ListOptions) ([]*k8sapiv1.Pod, error) {
+	return f.pods, nil
+}
+
+func (f *fakeK8s) DeletePod(_ context.Context, _, _, _, name string) error {
+	f.Lock()
+	defer f.Unlock()
+	f.deleted = append(f.deleted, name)
+	return nil
+}
+
+func (f *fakeK8s) AddEphemeralContainer(_ context.Context, _, _, _, name string, container *corev1.EphemeralContainer) error {
+	f.Lock()
+	defer f.Unlock()
+	for _, c := range f.containers[name] {
+		if c.Name == container.Name {
+			return status.Error(codes.AlreadyExists, "exists")
+		}
+	}
+	f.containers[name] = append(f.containers[name], container)
+	return nil
+}
+
+func newFakeK8s() *fakeK8s {
+	return &fakeK8s{
+		pods: []*k8sapiv1.Pod{
+			{Name: "pod-1", State: k8sapiv1.Pod_RUNNING},
+			{Name: "pod-2", State: k8sapiv1.Pod_RUNNING},
+			{Name: "pod-3", State: k8sapiv1.Pod_RUNNING},
+			{Name: "pod-4", State: k8sapiv1.Pod_PENDING},
+		},
+		containers: map[string][]*corev1.EphemeralContainer{},
+	}
+}
+
+func createExperiment(t *testing.T, store *experimentstoremock.SimpleStorer, config *k8sexperimentationv1.PodFaultConfig, start time.Time, end *time.Time) *experimentstore.Experiment {
+	a, err := anypb.New(config)
+	assert.NoError(t, err)
+
+	e, err := store.CreateExperiment(context.Background(), &experimentstore.ExperimentSpecification{
+		StartTime: start,
+		EndTime:   end,
+		Config:    a,
+	})
+	assert.NoError(t, err)
+	return e
+}
+
+func targeting() *k8sexperimentationv1.PodTargeting {
+	return &k8sexperimentationv1.PodTargeting{
+		Cluster:       "cluster",
+		Namespace:     "namespace",
+		LabelSelector: map[string]string{"app": "api"},
+	}
+}
+
+func TestInjectPodKill(t *testing.T) {
+	store := &experimentstoremock.SimpleStorer{}
+	k8s := newFakeK8s()
+	injector := NewInjector(store, k8s, time.Second, defaultStressImage, zaptest.NewLogger(t), tally.NoopScope)
+
+	now := time.Now()
+	e := createExperiment(t, store, &k8sexperimentationv1.PodFaultConfig{
+		Targeting: targeting(),
+		Fault: &k8sexperimentationv1.PodFaultConfig_PodKillFault{PodKillFault: &k8sexperimentationv1.PodKillFault{
+			Percentage: 50,
+			Interval:   durationpb.New(time.Minute),
+		}},
+	}, now, nil)
+
+	// Half of the three running pods is rounded up.
+	injector.Inject(context.Background(), now)
+	assert.Len(t, k8s.deleted, 2)
+	assert.NotContains(t, k8s.deleted, "pod-4")
+
+	// No kills until the interval passed.
+	injector.Inject(context.Background(), now.Add(30*time.Second))
+	assert.Len(t, k8s.deleted, 2)
+
+	injector.Inject(context.Background(), now.Add(time.Minute))
+	assert.Len(t, k8s.deleted, 4)
+
+	// Cancelled runs stop killing pods.
+	assert.NoError(t, store.CancelExperimentRun(context.Background(), e.Run.Id, "reason"))
+	injector.Inject(context.Background(), now.Add(2*time.Minute))
+	assert.Len(t, k8s.deleted, 4)
+}
+
+func TestInjectStress(t *testing.T) {
+	store := &experimentstoremock.SimpleStorer{}
+	k8s := newFakeK8s()
+	injector := NewInjector(store, k8s, time.Second, defaultStressImage, zaptest.NewLogger(t), tally.NoopScope)
+
+	now := time.Now()
+	end := now.Add(5 * time.Minute)
+	e := createExperiment(t, store, &k8sexperimentationv1.PodFaultConfig{
+		Targeting: targeting(),
+		Fault: &k8sexperimentationv1.PodFaultConfig_StressFault{StressFault: &k8sexperimentationv1.StressFault{
+			Percentage:        100,
+			CpuWorkers:        2,
+			MemoryWorkers:     1,
+			MemoryMbPerWorker: 256,
+		}},
+	}, now, &end)
+
+	injector.Inject(context.Background(), now)
+	injector.Inject(context.Background(), now.Add(time.Minute))
+
+	assert.Len(t, k8s.containers, 3)
+	for _, containers := range k8s.containers {
+		assert.Len(t, containers, 1)
+		assert.Equal(t, stressContainerName(e.Run.Id), containers[0].Name)
+		assert.Equal(t, defaultStressImage, containers[0].Image)
+		assert.Equal(t, []string{"--timeout", "300s", "--cpu", "2", "--cpu-load", "100", "--vm", "1", "--vm-bytes", "256M"}, containers[0].Args)
+	}
+}
+
+func TestInjectStressWithoutDuration(t *testing.T) {
+	store := &experimentstoremock.SimpleStorer{}
+	k8s := newFakeK8s()
+	injector := NewInjector(store, k8s, time.Second, defaultStressImage, zaptest.NewLogger(t), tally.NoopScope)
+
+	now := time.Now()
+	e := createExperiment(t, store, &k8sexperimentationv1.PodFaultConfig{
+		Targeting: targeting(),
+		Fault: &k8sexperimentationv1.PodFaultConfig_StressFault{StressFault: &k8sexperimentationv1.StressFault{
+			Percentage: 100,
+			CpuWorkers: 1,
+		}},
+	}, now, nil)
+
+	injector.Inject(context.Background(), now)
+	assert.Empty(t, k8s.containers)
+	state, err := store.GetInjectionState(context.Background(), e.Run.Id)
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+}
+
+// Gateway instances take turns holding the lock, so the injection state must carry over between injectors.
+func TestInjectorsShareState(t *testing.T) {
+	store := &experimentstoremock.SimpleStorer{}
+	k8s := newFakeK8s()
+	first := NewInjector(store, k8s, time.Second, defaultStressImage, zaptest.NewLogger(t), tally.NoopScope)
+	second := NewInjector(store, k8s, time.Second, defaultStressImage, zaptest.NewLogger(t), tally.NoopScope)
+
+	now := time.Now()
+	end := now.Add(5 * time.Minute)
+	createExperiment(t, store, &k8sexperimentationv1.PodFaultConfig{
+		Targeting: targeting(),
+		Fault: &k8sexperimentationv1.PodFaultConfig_PodKillFault{PodKillFault: &k8sexperimentationv1.PodKillFault{
+			Percentage: 30,
+			Interval:   durationpb.New(time.Minute),
+		}},
+	}, now, nil)
+	stress := createExperiment(t, store, &k8sexperimentationv1.PodFaultConfig{
+		Targeting: targeting(),
+		Fault: &k8sexperimentationv1.PodFaultConfig_StressFault{StressFault: &k8sexperimentationv1.StressFault{
+			Percentage: 30,
+			CpuWorkers: 1,
+		}},
+	}, now, &end)
+
+	first.Inject(context.Background(), now)
+	assert.Len(t, k8s.deleted, 1)
+	assert.Len(t, k8s.containers, 1)
+
+	// The other injector neither kills pods before the interval passed nor stresses more pods.
+	for i := 0; i < 10; i++ {
+		second.Inject(context.Background(), now.Add(30*time.Second))
+	}
+	assert.Len(t, k8s.deleted, 1)
+	assert.Len(t, k8s.containers, 1)
+
+	second.Inject(context.Background(), now.Add(time.Minute))
+	assert.Len(t, k8s.deleted, 2)
+
+	state, err := store.GetInjectionState(context.Background(), stress.Run.Id)
+	assert.NoError(t, err)
+	assert.Len(t, state.Targets, 1)
+	assert.Contains(t, k8s.containers, state.Targets[0])
+}
+
+func TestStressContainerName(t *testing.T) {
+	assert.Equal(t, "clutch-stress-1", stressContainerName("1"))
+	assert.Equal(t, "clutch-stress-my-run-id", stressContainerName("My_Run.ID"))
+	assert.Len(t, stressContainerName(strings.Repeat("a", 100)), 63)
+}
@@ -0,0 +1,202 @@
+package k8sexperimentation
+
+// <!-- START clutchdoc -->
+// description: Chaos Experimentation Framework - Supports Kubernetes pod kill and stress experiments.
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	k8sexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/k8sexperimentation/v1"
+	configv1 "github.com/lyft/clutch/backend/api/config/module/chaos/k8sexperimentation/v1"
+	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+	k8sservice "github.com/lyft/clutch/backend/service/k8s"
+)
+
+const (
+	Name = "clutch.module.chaos.k8sexperimentation"
+
+	defaultPollInterval = 10 * time.Second
+	defaultStressImage  = "ghcr.io/colinianking/stress-ng"
+)
+
+type Service struct {
+	storer   experimentstore.Storer
+	injector *Injector
+}
+
+// New instantiates a Service object.
+func New(untypedConfig *any.Any, logger *zap.Logger, scope tally.Scope) (module.Module, error) {
+	config := &configv1.Config{}
+	if untypedConfig != nil {
+		if err := untypedConfig.UnmarshalTo(config); err != nil {
+			return nil, err
+		}
+	}
+
+	store, ok := service.Registry[experimentstore.Name]
+	if !ok {
+		return nil, errors.New("could not find experiment store service")
+	}
+
+	storer, ok := store.(injectorStore)
+	if !ok {
+		return nil, errors.New("service was not the correct type")
+	}
+
+	k8sClient, ok := service.Registry[k8sservice.Name]
+	if !ok {
+		return nil, errors.New("could not find k8s service")
+	}
+
+	k8s, ok := k8sClient.(k8sservice.Service)
+	if !ok {
+		return nil, errors.New("service was not the correct type")
+	}
+
+	pollInterval := defaultPollInterval
+	if config.PollInterval != nil {
+		pollInterval = config.PollInterval.AsDuration()
+	}
+
+	stressImage := defaultStressImage
+	if config.StressImage != "" {
+		stressImage = config.StressImage
+	}
+
+	return &Service{
+		storer:   storer,
+		injector: NewInjector(storer, k8s, pollInterval, stressImage, logger, scope),
+	}, nil
+}
+
+func (s *Service) Register(r module.Registrar) error {
+	transformation := experimentstore.Transformation{
+		ConfigTypeUrl:    configTypeUrl,
+		RunTransform:     s.transform,
+		TargetTransform:  s.target,
+		ConfigValidation: s.validate,
+	}
+	if err := s.storer.RegisterTransformation(transformation); err != nil {
+		return err
+	}
+
+	go s.injector.Run(context.Background())
+	return nil
+}
+
+func (s *Service) transform(_ *experimentstore.ExperimentRun, config *experimentstore.ExperimentConfig) ([]*experimentationv1.Property, error) {
+	var experimentConfig = k8sexperimentationv1.PodFaultConfig{}
+	if err := config.Config.UnmarshalTo(&experimentConfig); err != nil {
+		return []*experimentationv1.Property{}, err
+	}
+
+	faultsDescription, err := experimentConfigToFaultString(&experimentConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	targeting := experimentConfig.GetTargeting()
+	return []*experimentationv1.Property{
+		{
+			Id:    "type",
+			Label: "Type",
+			Value: &experimentationv1.Property_StringValue{StringValue: "Kubernetes"},
+		},
+		{
+			Id:    "target",
+			Label: "Target",
+			Value: &experimentationv1.Property_StringValue{
+				StringValue: fmt.Sprintf("%s/%s %s", targeting.GetCluster(), targeting.GetNamespace(), labelSelectorString(targeting.GetLabelSelector())),
+			},
+		},
+		{
+			Id:    "fault_types",
+			Label: "Fault Types",
+			Value: &experimentationv1.Property_StringValue{StringValue: faultsDescription},
+		},
+	}, nil
+}
+
+// Stress faults that can't be injected would otherwise fail on every poll of the injector.
+func (s *Service) validate(config *experimentstore.ExperimentConfig, endTime *time.Time) error {
+	var experimentConfig = k8sexperimentationv1.PodFaultConfig{}
+	if err := config.Config.UnmarshalTo(&experimentConfig); err != nil {
+		return err
+	}
+
+	fault := experimentConfig.GetStressFault()
+	if fault == nil {
+		return nil
+	}
+	if fault.GetCpuWorkers() == 0 && fault.GetMemoryWorkers() == 0 {
+		return status.Error(codes.InvalidArgument, "stress fault must have CPU or memory workers")
+	}
+	if fault.GetDuration() == nil && endTime == nil {
+		return status.Error(codes.InvalidArgument, "stress fault without a duration requires the experiment to have an end time")
+	}
+	return nil
+}
+
+// The Kubernetes cluster of the pods is the upstream cluster of the target, and the target has no downstream cluster.
+// Guardrails don't distinguish Kubernetes clusters from Envoy clusters, so denied clusters, tiers and the limit of
+// experiments per upstream cluster apply to the Kubernetes cluster by name.
+func (s *Service) target(config *experimentstore.ExperimentConfig) (*experimentstore.ExperimentTarget, error) {
+	var experimentConfig = k8sexperimentationv1.PodFaultConfig{}
+	if err := config.Config.UnmarshalTo(&experimentConfig); err != nil {
+		return nil, err
+	}
+
+	var percentage uint32
+	switch experimentConfig.GetFault().(type) {
+	case *k8sexperimentationv1.PodFaultConfig_PodKillFault:
+		percentage = experimentConfig.GetPodKillFault().GetPercentage()
+	case *k8sexperimentationv1.PodFaultConfig_StressFault:
+		percentage = experimentConfig.GetStressFault().GetPercentage()
+	default:
+		return nil, fmt.Errorf("unexpected fault type %v", experimentConfig.GetFault())
+	}
+
+	return &experimentstore.ExperimentTarget{
+		UpstreamCluster: experimentConfig.GetTargeting().GetCluster(),
+		FaultPercentage: float64(percentage),
+	}, nil
+}
+
+func experimentConfigToFaultString(experiment *k8sexperimentationv1.PodFaultConfig) (string, error) {
+	if experiment == nil {
+		return "", errors.New("experiment is nil")
+	}
+
+	switch experiment.GetFault().(type) {
+	case *k8sexperimentationv1.PodFaultConfig_PodKillFault:
+		return "Pod Kill", nil
+	case *k8sexperimentationv1.PodFaultConfig_StressFault:
+		return "Stress", nil
+	default:
+		return "", fmt.Errorf("unexpected fault type %v", experiment.GetFault())
+	}
+}
+
+func labelSelectorString(labels map[string]string) string {
+	selectors := make([]string, 0, len(labels))
+	for k, v := range labels {
+		selectors = append(selectors, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(selectors)
+	return strings.Join(selectors, ",")
+}
@@ -0,0 +1,62 @@
+package k8sexperimentation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	k8sexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/k8sexperimentation/v1"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+)
+
+func TestValidate(t *testing.T) {
+	end := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		fault   *k8sexperimentationv1.StressFault
+		endTime *time.Time
+		code    codes.Code
+	}{
+		{fault: &k8sexperimentationv1.StressFault{Percentage: 10, CpuWorkers: 1}, endTime: &end, code: codes.OK},
+		{fault: &k8sexperimentationv1.StressFault{Percentage: 10, MemoryWorkers: 1, Duration: durationpb.New(time.Minute)}, code: codes.OK},
+		{fault: &k8sexperimentationv1.StressFault{Percentage: 10}, endTime: &end, code: codes.InvalidArgument},
+		{fault: &k8sexperimentationv1.StressFault{Percentage: 10, CpuWorkers: 1}, code: codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		a, err := anypb.New(&k8sexperimentationv1.PodFaultConfig{
+			Targeting: targeting(),
+			Fault:     &k8sexperimentationv1.PodFaultConfig_StressFault{StressFault: tt.fault},
+		})
+		assert.NoError(t, err)
+
+		err = (&Service{}).validate(&experimentstore.ExperimentConfig{Id: "1", Config: a}, tt.endTime)
+		assert.Equal(t, tt.code, status.Code(err))
+	}
+
+	// Pod kills don't depend on the end time of the experiment.
+	a, err := anypb.New(&k8sexperimentationv1.PodFaultConfig{
+		Targeting: targeting(),
+		Fault:     &k8sexperimentationv1.PodFaultConfig_PodKillFault{PodKillFault: &k8sexperimentationv1.PodKillFault{Percentage: 10}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, (&Service{}).validate(&experimentstore.ExperimentConfig{Id: "1", Config: a}, nil))
+}
+
+// Guardrails apply to the Kubernetes cluster of the pods as the upstream cluster of the experiment.
+func TestTarget(t *testing.T) {
+	a, err := anypb.New(&k8sexperimentationv1.PodFaultConfig{
+		Targeting: targeting(),
+		Fault:     &k8sexperimentationv1.PodFaultConfig_PodKillFault{PodKillFault: &k8sexperimentationv1.PodKillFault{Percentage: 25}},
+	})
+	assert.NoError(t, err)
+
+	target, err := (&Service{}).target(&experimentstore.ExperimentConfig{Id: "1", Config: a})
+	assert.NoError(t, err)
+	assert.Equal(t, &experimentstore.ExperimentTarget{UpstreamCluster: "cluster", FaultPercentage: 25}, target)
+}
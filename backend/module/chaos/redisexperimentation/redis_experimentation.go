@@ -7,6 +7,7 @@ package redisexperimentation
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
@@ -57,7 +58,11 @@ func New(untypedConfig *any.Any, logger *zap.Logger, scope tally.Scope) (module.
 }
 
 func (s *Service) Register(r module.Registrar) error {
-	transformation := experimentstore.Transformation{ConfigTypeUrl: "type.googleapis.com/clutch.chaos.redisexperimentation.v1.FaultConfig", RunTransform: s.transform}
+	transformation := experimentstore.Transformation{
+		ConfigTypeUrl:   "type.googleapis.com/clutch.chaos.redisexperimentation.v1.FaultConfig",
+		RunTransform:    s.transform,
+		TargetTransform: s.target,
+	}
 	return s.storer.RegisterTransformation(transformation)
 }
 
@@ -76,7 +81,7 @@ func (s *Service) transform(_ *experimentstore.ExperimentRun, config *experiment
 	downstream = experimentConfig.GetFaultTargeting().GetDownstreamCluster().GetName()
 	upstream = experimentConfig.GetFaultTargeting().GetUpstreamCluster().GetName()
 
-	return []*experimentationv1.Property{
+	properties := []*experimentationv1.Property{
 		{
 			Id:    "type",
 			Label: "Type",
@@ -92,6 +97,39 @@ func (s *Service) transform(_ *experimentstore.ExperimentRun, config *experiment
 			Label: "Fault Types",
 			Value: &experimentationv1.Property_StringValue{StringValue: faultsDescription},
 		},
+	}
+
+	if commands := experimentConfig.GetFaultTargeting().GetCommands(); len(commands) > 0 {
+		properties = append(properties, &experimentationv1.Property{
+			Id:    "commands",
+			Label: "Commands",
+			Value: &experimentationv1.Property_StringValue{StringValue: strings.Join(commands, ", ")},
+		})
+	}
+
+	return properties, nil
+}
+
+func (s *Service) target(config *experimentstore.ExperimentConfig) (*experimentstore.ExperimentTarget, error) {
+	var experimentConfig = redisexperimentationv1.FaultConfig{}
+	if err := config.Config.UnmarshalTo(&experimentConfig); err != nil {
+		return nil, err
+	}
+
+	var percentage uint32
+	switch experimentConfig.GetFault().(type) {
+	case *redisexperimentationv1.FaultConfig_ErrorFault:
+		percentage = experimentConfig.GetErrorFault().GetPercentage().GetPercentage()
+	case *redisexperimentationv1.FaultConfig_LatencyFault:
+		percentage = experimentConfig.GetLatencyFault().GetPercentage().GetPercentage()
+	default:
+		return nil, fmt.Errorf("unexpected fault type %v", experimentConfig.GetFault())
+	}
+
+	return &experimentstore.ExperimentTarget{
+		UpstreamCluster:   experimentConfig.GetFaultTargeting().GetUpstreamCluster().GetName(),
+		DownstreamCluster: experimentConfig.GetFaultTargeting().GetDownstreamCluster().GetName(),
+		FaultPercentage:   float64(percentage),
 	}, nil
 }
 
@@ -104,6 +142,9 @@ func experimentConfigToFaultString(experiment *redisexperimentationv1.FaultConfi
 	case *redisexperimentationv1.FaultConfig_ErrorFault:
 		return "Error", nil
 	case *redisexperimentationv1.FaultConfig_LatencyFault:
+		if d := experiment.GetLatencyFault().GetLatencyDuration(); d != nil {
+			return fmt.Sprintf("Delay (%dms)", d.GetFixedDurationMs()), nil
+		}
 		return "Delay", nil
 	default:
 		return "", fmt.Errorf("unexpected fault type %v", experiment.GetFault())
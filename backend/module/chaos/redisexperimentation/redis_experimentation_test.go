@@ -0,0 +1,37 @@
+package redisexperimentation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	redisexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/redisexperimentation/v1"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+)
+
+func TestTransform(t *testing.T) {
+	a, err := anypb.New(&redisexperimentationv1.FaultConfig{
+		FaultTargeting: &redisexperimentationv1.FaultTargeting{
+			UpstreamCluster:   &redisexperimentationv1.SingleCluster{Name: "redis"},
+			DownstreamCluster: &redisexperimentationv1.SingleCluster{Name: "foo"},
+			Commands:          []string{"get", "set"},
+		},
+		Fault: &redisexperimentationv1.FaultConfig_LatencyFault{LatencyFault: &redisexperimentationv1.LatencyFault{
+			Percentage:      &redisexperimentationv1.FaultPercentage{Percentage: 10},
+			LatencyDuration: &redisexperimentationv1.FaultLatencyDuration{FixedDurationMs: 100},
+		}},
+	})
+	assert.NoError(t, err)
+
+	properties, err := (&Service{}).transform(nil, &experimentstore.ExperimentConfig{Id: "1", Config: a})
+	assert.NoError(t, err)
+
+	values := make(map[string]string, len(properties))
+	for _, p := range properties {
+		values[p.Id] = p.GetValue().(*experimentationv1.Property_StringValue).StringValue
+	}
+	assert.Equal(t, "Delay (100ms)", values["fault_types"])
+	assert.Equal(t, "get, set", values["commands"])
+}
@@ -2,6 +2,14 @@ package xds
 
 import (
 	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gcpCoreV3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	gcpRedisProxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/redis_proxy/v3"
+	gcpType "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	redisexperimentation "github.com/lyft/clutch/backend/api/chaos/redisexperimentation/v1"
 	"github.com/lyft/clutch/backend/module/chaos/experimentation/xds"
@@ -9,8 +17,8 @@ import (
 )
 
 const (
-	redisErrorPercentage   = `%s.%s.error.error_percent`
-	redisLatencyPercentage = `%s.%s.delay.fixed_delay_percent`
+	redisErrorPercentage   = `%s.%s.error%s.error_percent`
+	redisLatencyPercentage = `%s.%s.delay%s.fixed_delay_percent`
 )
 
 type RTDSFaultsGenerator struct {
@@ -24,35 +32,99 @@ func (g RTDSFaultsGenerator) GenerateResource(experiment *experimentstore.Experi
 	}
 
 	downstreamCluster := redisFaultConfig.GetFaultTargeting().GetDownstreamCluster().GetName()
-	upstreamCluster := redisFaultConfig.GetFaultTargeting().GetUpstreamCluster().GetName()
 
-	percentageKey, percentageValue, err := g.createRedisRuntimeKeys(upstreamCluster, redisFaultConfig)
+	faults, err := RedisFaults(g.FaultRuntimePrefix, redisFaultConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	return xds.NewRTDSResource(downstreamCluster, []*xds.RuntimeKeyValue{
-		{
-			Key:   percentageKey,
-			Value: percentageValue,
-		},
-	})
+	var percentage uint32
+	switch redisFaultConfig.GetFault().(type) {
+	case *redisexperimentation.FaultConfig_ErrorFault:
+		percentage = redisFaultConfig.GetErrorFault().GetPercentage().GetPercentage()
+	case *redisexperimentation.FaultConfig_LatencyFault:
+		percentage = redisFaultConfig.GetLatencyFault().GetPercentage().GetPercentage()
+	}
+
+	runtimeKeyValues := make([]*xds.RuntimeKeyValue, len(faults))
+	for i, f := range faults {
+		runtimeKeyValues[i] = &xds.RuntimeKeyValue{
+			Key:   f.FaultEnabled.RuntimeKey,
+			Value: percentage,
+		}
+	}
+
+	return xds.NewRTDSResource(downstreamCluster, runtimeKeyValues)
 }
 
-func (g RTDSFaultsGenerator) createRedisRuntimeKeys(upstreamCluster string, redisFaultConfig *redisexperimentation.FaultConfig) (string, uint32, error) {
-	var percentageKey string
-	var percentageValue uint32
+// RedisFaults returns the faults that the redis proxy filter of the downstream cluster must have for the experiment to
+// have an effect. The faults are disabled by default and enabled by the runtime keys generated for the experiment.
+//
+// The runtime keys identify the upstream cluster, the fault type, the delay and the command of a fault, e.g.
+// "<prefix>.<upstream>.delay.100ms.get.fixed_delay_percent", so that a filter can configure a fault for every
+// delay and command that experiments are expected to use. The delay and command are left out of the key if the
+// experiment doesn't specify them.
+func RedisFaults(runtimePrefix string, redisFaultConfig *redisexperimentation.FaultConfig) ([]*gcpRedisProxy.RedisProxy_RedisFault, error) {
+	upstreamCluster := redisFaultConfig.GetFaultTargeting().GetUpstreamCluster().GetName()
+
+	var faultType gcpRedisProxy.RedisProxy_RedisFault_RedisFaultType
+	var keyFormat, keyInfix string
+	var delay *durationpb.Duration
 
 	switch redisFaultConfig.GetFault().(type) {
 	case *redisexperimentation.FaultConfig_ErrorFault:
-		percentageValue = redisFaultConfig.GetErrorFault().GetPercentage().GetPercentage()
-		percentageKey = fmt.Sprintf(redisErrorPercentage, g.FaultRuntimePrefix, upstreamCluster)
+		faultType = gcpRedisProxy.RedisProxy_RedisFault_ERROR
+		keyFormat = redisErrorPercentage
 	case *redisexperimentation.FaultConfig_LatencyFault:
-		percentageValue = redisFaultConfig.GetLatencyFault().GetPercentage().GetPercentage()
-		percentageKey = fmt.Sprintf(redisLatencyPercentage, g.FaultRuntimePrefix, upstreamCluster)
+		faultType = gcpRedisProxy.RedisProxy_RedisFault_DELAY
+		keyFormat = redisLatencyPercentage
+		if d := redisFaultConfig.GetLatencyFault().GetLatencyDuration(); d != nil {
+			delay = durationpb.New(time.Duration(d.GetFixedDurationMs()) * time.Millisecond)
+			keyInfix = fmt.Sprintf(".%dms", d.GetFixedDurationMs())
+		}
 	default:
-		return "", 0, fmt.Errorf("unknown fault type %v", redisFaultConfig)
+		return nil, fmt.Errorf("unknown fault type %v", redisFaultConfig)
+	}
+
+	newFault := func(infix string, commands []string) *gcpRedisProxy.RedisProxy_RedisFault {
+		return &gcpRedisProxy.RedisProxy_RedisFault{
+			FaultType: faultType,
+			FaultEnabled: &gcpCoreV3.RuntimeFractionalPercent{
+				DefaultValue: &gcpType.FractionalPercent{
+					Numerator:   0,
+					Denominator: gcpType.FractionalPercent_HUNDRED,
+				},
+				RuntimeKey: fmt.Sprintf(keyFormat, runtimePrefix, upstreamCluster, infix),
+			},
+			Delay:    delay,
+			Commands: commands,
+		}
+	}
+
+	commands := normalizedCommands(redisFaultConfig.GetFaultTargeting().GetCommands())
+	if len(commands) == 0 {
+		return []*gcpRedisProxy.RedisProxy_RedisFault{newFault(keyInfix, nil)}, nil
 	}
 
-	return percentageKey, percentageValue, nil
+	// Every command gets its own fault and runtime key, so that filters can configure faults per command.
+	faults := make([]*gcpRedisProxy.RedisProxy_RedisFault, len(commands))
+	for i, c := range commands {
+		faults[i] = newFault(keyInfix+"."+c, []string{c})
+	}
+	return faults, nil
+}
+
+// The redis proxy matches lowercase command names.
+func normalizedCommands(commands []string) []string {
+	seen := make(map[string]bool, len(commands))
+	var ret []string
+	for _, c := range commands {
+		c = strings.ToLower(c)
+		if !seen[c] {
+			seen[c] = true
+			ret = append(ret, c)
+		}
+	}
+	sort.Strings(ret)
+	return ret
 }
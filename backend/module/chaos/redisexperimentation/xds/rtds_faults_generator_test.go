@@ -3,7 +3,9 @@ package xds
 import (
 	"fmt"
 	"testing"
+	"time"
 
+	gcpRedisProxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/redis_proxy/v3"
 	"github.com/stretchr/testify/assert"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -50,6 +52,47 @@ func TestRTDSFaultsGeneration(t *testing.T) {
 	}
 }
 
+func TestRTDSFaultsGenerationWithDurationAndCommands(t *testing.T) {
+	e := createExperiment(t, "foo", "bar", faultTypeLatency, 10)
+	config := e.Config.Message.(*redisexperimentation.FaultConfig)
+	config.GetLatencyFault().LatencyDuration = &redisexperimentation.FaultLatencyDuration{FixedDurationMs: 100}
+	config.FaultTargeting.Commands = []string{"SET", "get", "GET"}
+
+	g := RTDSFaultsGenerator{FaultRuntimePrefix: "pre"}
+	r, err := g.GenerateResource(e)
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", r.Cluster)
+	assert.Equal(t, []*xds.RuntimeKeyValue{
+		{Key: "pre.bar.delay.100ms.get.fixed_delay_percent", Value: 10},
+		{Key: "pre.bar.delay.100ms.set.fixed_delay_percent", Value: 10},
+	}, r.RuntimeKeyValues)
+}
+
+func TestRedisFaults(t *testing.T) {
+	e := createExperiment(t, "foo", "bar", faultTypeLatency, 10)
+	config := e.Config.Message.(*redisexperimentation.FaultConfig)
+	config.GetLatencyFault().LatencyDuration = &redisexperimentation.FaultLatencyDuration{FixedDurationMs: 1500}
+	config.FaultTargeting.Commands = []string{"get"}
+
+	faults, err := RedisFaults("pre", config)
+	assert.NoError(t, err)
+	assert.Len(t, faults, 1)
+	assert.Equal(t, gcpRedisProxy.RedisProxy_RedisFault_DELAY, faults[0].FaultType)
+	assert.Equal(t, "pre.bar.delay.1500ms.get.fixed_delay_percent", faults[0].FaultEnabled.RuntimeKey)
+	assert.Equal(t, uint32(0), faults[0].FaultEnabled.DefaultValue.Numerator)
+	assert.Equal(t, 1500*time.Millisecond, faults[0].Delay.AsDuration())
+	assert.Equal(t, []string{"get"}, faults[0].Commands)
+
+	e = createExperiment(t, "foo", "bar", faultTypeError, 10)
+	faults, err = RedisFaults("pre", e.Config.Message.(*redisexperimentation.FaultConfig))
+	assert.NoError(t, err)
+	assert.Len(t, faults, 1)
+	assert.Equal(t, gcpRedisProxy.RedisProxy_RedisFault_ERROR, faults[0].FaultType)
+	assert.Equal(t, "pre.bar.error.error_percent", faults[0].FaultEnabled.RuntimeKey)
+	assert.Nil(t, faults[0].Delay)
+	assert.Empty(t, faults[0].Commands)
+}
+
 const (
 	faultTypeError   = `error`
 	faultTypeLatency = `latency`
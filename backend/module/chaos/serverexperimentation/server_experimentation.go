@@ -7,10 +7,14 @@ package serverexperimentation
 import (
 	"errors"
 	"fmt"
+	"time"
 
+	gcpType "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
 	serverexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/serverexperimentation/v1"
@@ -28,6 +32,9 @@ const (
 
 type Service struct {
 	storer experimentstore.Storer
+
+	// The clusters whose faults are delivered over ECDS instead of RTDS.
+	ecdsEnabledClusters map[string]struct{}
 }
 
 // New instantiates a Service object.
@@ -58,16 +65,47 @@ func New(untypedConfig *any.Any, logger *zap.Logger, scope tally.Scope) (module.
 	}
 	xds.ECDSGeneratorsByTypeUrl[xds.TypeUrl(&serverexperimentationv1.HTTPFaultConfig{})] = g
 
+	ecdsEnabledClusters := make(map[string]struct{})
+	for _, cluster := range config.EcdsEnabledClusters {
+		ecdsEnabledClusters[cluster] = struct{}{}
+	}
+
 	return &Service{
-		storer: storer,
+		storer:              storer,
+		ecdsEnabledClusters: ecdsEnabledClusters,
 	}, nil
 }
 
 func (s *Service) Register(r module.Registrar) error {
-	transformation := experimentstore.Transformation{ConfigTypeUrl: "type.googleapis.com/clutch.chaos.serverexperimentation.v1.HTTPFaultConfig", RunTransform: s.transform}
+	transformation := experimentstore.Transformation{
+		ConfigTypeUrl:    "type.googleapis.com/clutch.chaos.serverexperimentation.v1.HTTPFaultConfig",
+		RunTransform:     s.transform,
+		TargetTransform:  s.target,
+		ConfigValidation: s.validate,
+	}
 	return s.storer.RegisterTransformation(transformation)
 }
 
+func (s *Service) validate(config *experimentstore.ExperimentConfig, _ *time.Time) error {
+	var experimentConfig = serverexperimentationv1.HTTPFaultConfig{}
+	if err := config.Config.UnmarshalTo(&experimentConfig); err != nil {
+		return err
+	}
+
+	if experimentConfig.GetResponseRateLimitFault() == nil {
+		return nil
+	}
+
+	cluster, err := serverexperimentationxds.GetEnforcingCluster(&experimentConfig)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if _, ok := s.ecdsEnabledClusters[cluster]; !ok {
+		return status.Errorf(codes.InvalidArgument, "response rate limit faults are only supported for ECDS enabled clusters, faults of cluster '%s' are delivered over RTDS", cluster)
+	}
+	return nil
+}
+
 func (s *Service) transform(_ *experimentstore.ExperimentRun, config *experimentstore.ExperimentConfig) ([]*experimentationv1.Property, error) {
 	var experimentConfig = serverexperimentationv1.HTTPFaultConfig{}
 	if err := config.Config.UnmarshalTo(&experimentConfig); err != nil {
@@ -79,44 +117,9 @@ func (s *Service) transform(_ *experimentstore.ExperimentRun, config *experiment
 		return nil, err
 	}
 
-	var downstream, upstream string
-	switch experimentConfig.GetFaultTargeting().GetEnforcer().(type) {
-	case *serverexperimentationv1.FaultTargeting_DownstreamEnforcing:
-		downstreamEnforcing := experimentConfig.GetFaultTargeting().GetDownstreamEnforcing()
-
-		switch downstreamEnforcing.GetDownstreamType().(type) {
-		case *serverexperimentationv1.DownstreamEnforcing_DownstreamCluster:
-			downstream = downstreamEnforcing.GetDownstreamCluster().GetName()
-		default:
-			return nil, fmt.Errorf("unknown downstream type of downstream enforcing %v", downstreamEnforcing.GetDownstreamType())
-		}
-
-		switch downstreamEnforcing.GetUpstreamType().(type) {
-		case *serverexperimentationv1.DownstreamEnforcing_UpstreamCluster:
-			upstream = downstreamEnforcing.GetUpstreamCluster().GetName()
-		default:
-			return nil, fmt.Errorf("unknown upstream type of downstream enforcing %v", downstreamEnforcing.GetUpstreamType())
-		}
-
-	case *serverexperimentationv1.FaultTargeting_UpstreamEnforcing:
-		upstreamEnforcing := experimentConfig.GetFaultTargeting().GetUpstreamEnforcing()
-
-		switch upstreamEnforcing.GetDownstreamType().(type) {
-		case *serverexperimentationv1.UpstreamEnforcing_DownstreamCluster:
-			downstream = upstreamEnforcing.GetDownstreamCluster().GetName()
-		default:
-			return nil, fmt.Errorf("unknown downstream type of upstream enforcing %v", upstreamEnforcing.GetDownstreamType())
-		}
-
-		switch upstreamEnforcing.GetUpstreamType().(type) {
-		case *serverexperimentationv1.UpstreamEnforcing_UpstreamCluster:
-			upstream = upstreamEnforcing.GetUpstreamCluster().GetName()
-		default:
-			return nil, fmt.Errorf("unknown upstream type of upstream enforcing %v", upstreamEnforcing.GetUpstreamType())
-		}
-
-	default:
-		return nil, fmt.Errorf("unknown enforcer %v", experimentConfig.GetFaultTargeting())
+	upstream, downstream, err := serverexperimentationxds.GetClusterPair(&experimentConfig)
+	if err != nil {
+		return nil, err
 	}
 
 	return []*experimentationv1.Property{
@@ -138,6 +141,41 @@ func (s *Service) transform(_ *experimentstore.ExperimentRun, config *experiment
 	}, nil
 }
 
+func (s *Service) target(config *experimentstore.ExperimentConfig) (*experimentstore.ExperimentTarget, error) {
+	var experimentConfig = serverexperimentationv1.HTTPFaultConfig{}
+	if err := config.Config.UnmarshalTo(&experimentConfig); err != nil {
+		return nil, err
+	}
+
+	upstream, downstream, err := serverexperimentationxds.GetClusterPair(&experimentConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	percentage, err := serverexperimentationxds.GetHTTPFaultPercentage(&experimentConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &experimentstore.ExperimentTarget{
+		UpstreamCluster:   upstream,
+		DownstreamCluster: downstream,
+		FaultPercentage:   faultPercentage(percentage),
+	}, nil
+}
+
+// Converts the fault percentage to a percentage out of 100, regardless of its denominator.
+func faultPercentage(p *gcpType.FractionalPercent) float64 {
+	switch p.GetDenominator() {
+	case gcpType.FractionalPercent_TEN_THOUSAND:
+		return float64(p.GetNumerator()) / 100
+	case gcpType.FractionalPercent_MILLION:
+		return float64(p.GetNumerator()) / 10000
+	default:
+		return float64(p.GetNumerator())
+	}
+}
+
 func experimentConfigToString(experiment *serverexperimentationv1.HTTPFaultConfig) (string, error) {
 	if experiment == nil {
 		return "", errors.New("experiment is nil")
@@ -148,6 +186,10 @@ func experimentConfigToString(experiment *serverexperimentationv1.HTTPFaultConfi
 		return "Abort", nil
 	case *serverexperimentationv1.HTTPFaultConfig_LatencyFault:
 		return "Latency", nil
+	case *serverexperimentationv1.HTTPFaultConfig_GrpcAbortFault:
+		return "gRPC Abort", nil
+	case *serverexperimentationv1.HTTPFaultConfig_ResponseRateLimitFault:
+		return "Response Rate Limit", nil
 	default:
 		return "", fmt.Errorf("unexpected fault type %v", experiment.GetFault())
 	}
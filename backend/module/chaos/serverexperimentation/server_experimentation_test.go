@@ -0,0 +1,115 @@
+package serverexperimentation
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	serverexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/serverexperimentation/v1"
+	configv1 "github.com/lyft/clutch/backend/api/config/module/chaos/serverexperimentation/v1"
+	"github.com/lyft/clutch/backend/mock/service/chaos/experimentation/experimentstoremock"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+)
+
+func TestValidate(t *testing.T) {
+	service.Registry[experimentstore.Name] = &experimentstoremock.MockStorer{}
+
+	// The gateway is configured on its own, without the xDS module that serves the faults.
+	cfg, err := anypb.New(&configv1.Config{
+		IngressFaultRuntimePrefix: "fault.http",
+		EgressFaultRuntimePrefix:  "fault.http.egress",
+		EcdsEnabledClusters:       []string{"ecds"},
+	})
+	assert.NoError(t, err)
+	m, err := New(cfg, zap.NewNop(), tally.NoopScope)
+	assert.NoError(t, err)
+	s := m.(*Service)
+
+	tests := []struct {
+		cluster string
+		fault   *serverexperimentationv1.HTTPFaultConfig
+		code    codes.Code
+	}{
+		{
+			cluster: "rtds",
+			fault: &serverexperimentationv1.HTTPFaultConfig{
+				Fault: &serverexperimentationv1.HTTPFaultConfig_AbortFault{AbortFault: &serverexperimentationv1.AbortFault{
+					Percentage:  &serverexperimentationv1.FaultPercentage{Percentage: 10},
+					AbortStatus: &serverexperimentationv1.FaultAbortStatus{HttpStatusCode: 503},
+				}},
+			},
+			code: codes.OK,
+		},
+		{
+			cluster: "rtds",
+			fault: &serverexperimentationv1.HTTPFaultConfig{
+				Fault: &serverexperimentationv1.HTTPFaultConfig_ResponseRateLimitFault{ResponseRateLimitFault: &serverexperimentationv1.ResponseRateLimitFault{
+					Percentage: &serverexperimentationv1.FaultPercentage{Percentage: 10},
+					RateLimit:  &serverexperimentationv1.FaultRateLimit{FixedLimitKbps: 100},
+				}},
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			cluster: "ecds",
+			fault: &serverexperimentationv1.HTTPFaultConfig{
+				Fault: &serverexperimentationv1.HTTPFaultConfig_ResponseRateLimitFault{ResponseRateLimitFault: &serverexperimentationv1.ResponseRateLimitFault{
+					Percentage: &serverexperimentationv1.FaultPercentage{Percentage: 10},
+					RateLimit:  &serverexperimentationv1.FaultRateLimit{FixedLimitKbps: 100},
+				}},
+			},
+			code: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		tt.fault.FaultTargeting = &serverexperimentationv1.FaultTargeting{
+			Enforcer: &serverexperimentationv1.FaultTargeting_UpstreamEnforcing{
+				UpstreamEnforcing: &serverexperimentationv1.UpstreamEnforcing{
+					UpstreamType:   &serverexperimentationv1.UpstreamEnforcing_UpstreamCluster{UpstreamCluster: &serverexperimentationv1.SingleCluster{Name: tt.cluster}},
+					DownstreamType: &serverexperimentationv1.UpstreamEnforcing_DownstreamCluster{DownstreamCluster: &serverexperimentationv1.SingleCluster{Name: "downstream"}},
+				},
+			},
+		}
+		a, err := anypb.New(tt.fault)
+		assert.NoError(t, err)
+
+		err = s.validate(&experimentstore.ExperimentConfig{Id: "1", Config: a}, nil)
+		assert.Equal(t, tt.code, status.Code(err))
+	}
+}
+
+func TestTarget(t *testing.T) {
+	a, err := anypb.New(&serverexperimentationv1.HTTPFaultConfig{
+		FaultTargeting: &serverexperimentationv1.FaultTargeting{
+			Enforcer: &serverexperimentationv1.FaultTargeting_DownstreamEnforcing{
+				DownstreamEnforcing: &serverexperimentationv1.DownstreamEnforcing{
+					DownstreamType: &serverexperimentationv1.DownstreamEnforcing_DownstreamCluster{DownstreamCluster: &serverexperimentationv1.SingleCluster{Name: "downstream"}},
+					UpstreamType:   &serverexperimentationv1.DownstreamEnforcing_UpstreamCluster{UpstreamCluster: &serverexperimentationv1.SingleCluster{Name: "upstream"}},
+				},
+			},
+		},
+		Fault: &serverexperimentationv1.HTTPFaultConfig_GrpcAbortFault{GrpcAbortFault: &serverexperimentationv1.GrpcAbortFault{
+			Percentage: &serverexperimentationv1.FaultPercentage{
+				Percentage:  2500,
+				Denominator: serverexperimentationv1.FaultPercentage_DENOMINATOR_TEN_THOUSAND,
+			},
+			AbortStatus: &serverexperimentationv1.FaultGrpcAbortStatus{GrpcStatusCode: 14},
+		}},
+	})
+	assert.NoError(t, err)
+
+	target, err := (&Service{}).target(&experimentstore.ExperimentConfig{Id: "1", Config: a})
+	assert.NoError(t, err)
+	assert.Equal(t, &experimentstore.ExperimentTarget{
+		UpstreamCluster:   "upstream",
+		DownstreamCluster: "downstream",
+		FaultPercentage:   25,
+	}, target)
+}
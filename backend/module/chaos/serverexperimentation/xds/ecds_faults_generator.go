@@ -24,10 +24,12 @@ const (
 	faultFilterConfigNameForIngressFault = `envoy.extension_config`
 	faultFilterTypeURL                   = `type.googleapis.com/envoy.extensions.filters.http.fault.v3.HTTPFault`
 
-	delayPercentRuntime    = `ecds_runtime_override_do_not_use.http.delay.percentage`
-	delayDurationRuntime   = `ecds_runtime_override_do_not_use.http.delay.fixed_duration_ms`
-	abortHttpStatusRuntime = `ecds_runtime_override_do_not_use.http.abort.http_status`
-	abortPercentRuntime    = `ecds_runtime_override_do_not_use.http.abort.abort_percent`
+	delayPercentRuntime             = `ecds_runtime_override_do_not_use.http.delay.percentage`
+	delayDurationRuntime            = `ecds_runtime_override_do_not_use.http.delay.fixed_duration_ms`
+	abortHttpStatusRuntime          = `ecds_runtime_override_do_not_use.http.abort.http_status`
+	abortGrpcStatusRuntime          = `ecds_runtime_override_do_not_use.http.abort.grpc_status`
+	abortPercentRuntime             = `ecds_runtime_override_do_not_use.http.abort.abort_percent`
+	responseRateLimitPercentRuntime = `ecds_runtime_override_do_not_use.http.rate_limit.response_percent`
 )
 
 // Default abort and delay fault configs
@@ -62,10 +64,12 @@ func NewECDSFaultsGenerator() (*ECDSFaultsGenerator, error) {
 		Delay: DefaultDelayFaultConfig,
 		Abort: DefaultAbortFaultConfig,
 		// override runtimes so that default runtime is not used.
-		DelayPercentRuntime:    delayPercentRuntime,
-		DelayDurationRuntime:   delayDurationRuntime,
-		AbortHttpStatusRuntime: abortHttpStatusRuntime,
-		AbortPercentRuntime:    abortPercentRuntime,
+		DelayPercentRuntime:             delayPercentRuntime,
+		DelayDurationRuntime:            delayDurationRuntime,
+		AbortHttpStatusRuntime:          abortHttpStatusRuntime,
+		AbortGrpcStatusRuntime:          abortGrpcStatusRuntime,
+		AbortPercentRuntime:             abortPercentRuntime,
+		ResponseRateLimitPercentRuntime: responseRateLimitPercentRuntime,
 	}
 	marshaledFilter, err := proto.Marshal(faultFilter)
 	if err != nil {
@@ -92,7 +96,7 @@ func (g ECDSFaultsGenerator) GenerateResource(experiment *experimentstore.Experi
 		return nil, err
 	}
 
-	egressFault, abortFault, delayFault, err := g.createAbortDelayConfig(httpFaultConfig)
+	egressFault, abortFault, delayFault, rateLimitFault, err := g.createFaultConfig(httpFaultConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -108,14 +112,17 @@ func (g ECDSFaultsGenerator) GenerateResource(experiment *experimentstore.Experi
 	}
 
 	faultFilter := &gcpFilterFault.HTTPFault{
-		Delay: delay,
-		Abort: abort,
+		Delay:             delay,
+		Abort:             abort,
+		ResponseRateLimit: rateLimitFault,
 
 		// override runtimes so that default runtime is not used.
-		DelayPercentRuntime:    delayPercentRuntime,
-		DelayDurationRuntime:   delayDurationRuntime,
-		AbortHttpStatusRuntime: abortHttpStatusRuntime,
-		AbortPercentRuntime:    abortPercentRuntime,
+		DelayPercentRuntime:             delayPercentRuntime,
+		DelayDurationRuntime:            delayDurationRuntime,
+		AbortHttpStatusRuntime:          abortHttpStatusRuntime,
+		AbortGrpcStatusRuntime:          abortGrpcStatusRuntime,
+		AbortPercentRuntime:             abortPercentRuntime,
+		ResponseRateLimitPercentRuntime: responseRateLimitPercentRuntime,
 	}
 
 	var faultFilterName string
@@ -166,30 +173,31 @@ func (g *ECDSFaultsGenerator) GenerateDefaultResource(cluster string, resourceNa
 	return xds.NewECDSResource(cluster, config)
 }
 
-func (g ECDSFaultsGenerator) createAbortDelayConfig(httpFaultConfig *serverexperimentation.HTTPFaultConfig) (bool, *gcpFilterFault.FaultAbort, *gcpFilterCommon.FaultDelay, error) {
+func (g ECDSFaultsGenerator) createFaultConfig(httpFaultConfig *serverexperimentation.HTTPFaultConfig) (bool, *gcpFilterFault.FaultAbort, *gcpFilterCommon.FaultDelay, *gcpFilterCommon.FaultRateLimit, error) {
 	var isEgressFault bool
 	var abort *gcpFilterFault.FaultAbort
 	var delay *gcpFilterCommon.FaultDelay
+	var rateLimit *gcpFilterCommon.FaultRateLimit
+
+	switch httpFaultConfig.GetFaultTargeting().GetEnforcer().(type) {
+	case *serverexperimentation.FaultTargeting_DownstreamEnforcing:
+		// Egress Fault
+		isEgressFault = true
+
+	case *serverexperimentation.FaultTargeting_UpstreamEnforcing:
+		// Internal Fault
+		isEgressFault = false
+
+	default:
+		return false, nil, nil, nil, fmt.Errorf("unknown enforcer %v", httpFaultConfig)
+	}
 
 	percentage, err := GetHTTPFaultPercentage(httpFaultConfig)
 	if err != nil {
-		return false, nil, nil, err
+		return false, nil, nil, nil, err
 	}
 	switch httpFaultConfig.GetFault().(type) {
 	case *serverexperimentation.HTTPFaultConfig_AbortFault:
-		switch httpFaultConfig.GetFaultTargeting().GetEnforcer().(type) {
-		case *serverexperimentation.FaultTargeting_DownstreamEnforcing:
-			// Abort Egress Fault
-			isEgressFault = true
-
-		case *serverexperimentation.FaultTargeting_UpstreamEnforcing:
-			// Abort Internal Fault
-			isEgressFault = false
-
-		default:
-			return false, nil, nil, fmt.Errorf("unknown enforcer %v", httpFaultConfig)
-		}
-
 		abort = &gcpFilterFault.FaultAbort{
 			ErrorType: &gcpFilterFault.FaultAbort_HttpStatus{
 				HttpStatus: httpFaultConfig.GetAbortFault().GetAbortStatus().GetHttpStatusCode(),
@@ -197,19 +205,6 @@ func (g ECDSFaultsGenerator) createAbortDelayConfig(httpFaultConfig *serverexper
 			Percentage: percentage,
 		}
 	case *serverexperimentation.HTTPFaultConfig_LatencyFault:
-		switch httpFaultConfig.GetFaultTargeting().GetEnforcer().(type) {
-		case *serverexperimentation.FaultTargeting_DownstreamEnforcing:
-			// Latency Egress Fault
-			isEgressFault = true
-
-		case *serverexperimentation.FaultTargeting_UpstreamEnforcing:
-			// Latency Internal Fault for all downstream services
-			isEgressFault = false
-
-		default:
-			return false, nil, nil, fmt.Errorf("unknown enforcer %v", httpFaultConfig)
-		}
-
 		delay = &gcpFilterCommon.FaultDelay{
 			FaultDelaySecifier: &gcpFilterCommon.FaultDelay_FixedDelay{
 				FixedDelay: &duration.Duration{
@@ -218,9 +213,25 @@ func (g ECDSFaultsGenerator) createAbortDelayConfig(httpFaultConfig *serverexper
 			},
 			Percentage: percentage,
 		}
+	case *serverexperimentation.HTTPFaultConfig_GrpcAbortFault:
+		abort = &gcpFilterFault.FaultAbort{
+			ErrorType: &gcpFilterFault.FaultAbort_GrpcStatus{
+				GrpcStatus: httpFaultConfig.GetGrpcAbortFault().GetAbortStatus().GetGrpcStatusCode(),
+			},
+			Percentage: percentage,
+		}
+	case *serverexperimentation.HTTPFaultConfig_ResponseRateLimitFault:
+		rateLimit = &gcpFilterCommon.FaultRateLimit{
+			LimitType: &gcpFilterCommon.FaultRateLimit_FixedLimit_{
+				FixedLimit: &gcpFilterCommon.FaultRateLimit_FixedLimit{
+					LimitKbps: httpFaultConfig.GetResponseRateLimitFault().GetRateLimit().GetFixedLimitKbps(),
+				},
+			},
+			Percentage: percentage,
+		}
 	default:
-		return false, nil, nil, fmt.Errorf("unknown fault type %v", httpFaultConfig)
+		return false, nil, nil, nil, fmt.Errorf("unknown fault type %v", httpFaultConfig)
 	}
 
-	return isEgressFault, abort, delay, nil
+	return isEgressFault, abort, delay, rateLimit, nil
 }
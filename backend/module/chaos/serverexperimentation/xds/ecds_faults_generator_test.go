@@ -24,6 +24,7 @@ func TestECDSFaultsGeneration(t *testing.T) {
 		expectedResourceName             string
 		expectedAbort                    *gcpFilterFault.FaultAbort
 		expectedDelay                    *gcpFilterCommon.FaultDelay
+		expectedRateLimit                *gcpFilterCommon.FaultRateLimit
 		expectedHeadersDownstreamCluster string
 	}{
 		{
@@ -96,6 +97,37 @@ func TestECDSFaultsGeneration(t *testing.T) {
 				},
 			},
 		},
+		{
+			// gRPC Abort - Service B -> Service A (Internal)
+			experiment:           createExperiment(t, "serviceA", "serviceB", 20, 100, 14, faultUpstreamServiceTypeInternal, faultTypeGrpcAbort),
+			expectedCluster:      "serviceA",
+			expectedResourceName: "envoy.extension_config",
+			expectedAbort: &gcpFilterFault.FaultAbort{
+				ErrorType: &gcpFilterFault.FaultAbort_GrpcStatus{
+					GrpcStatus: 14,
+				},
+				Percentage: &gcpType.FractionalPercent{
+					Numerator:   20,
+					Denominator: gcpType.FractionalPercent_HUNDRED,
+				},
+			},
+			expectedHeadersDownstreamCluster: "serviceB",
+		},
+		{
+			// Response Rate Limit - Service A -> Service X (External)
+			experiment:           createExperiment(t, "serviceX", "serviceA", 50, 100, 64, faultUpstreamServiceTypeExternal, faultTypeResponseRateLimit),
+			expectedCluster:      "serviceA",
+			expectedResourceName: "envoy.egress.extension_config.serviceX",
+			expectedRateLimit: &gcpFilterCommon.FaultRateLimit{
+				LimitType: &gcpFilterCommon.FaultRateLimit_FixedLimit_{
+					FixedLimit: &gcpFilterCommon.FaultRateLimit_FixedLimit{LimitKbps: 64},
+				},
+				Percentage: &gcpType.FractionalPercent{
+					Numerator:   50,
+					Denominator: gcpType.FractionalPercent_HUNDRED,
+				},
+			},
+		},
 	}
 
 	for idx, tt := range tests {
@@ -121,6 +153,7 @@ func TestECDSFaultsGeneration(t *testing.T) {
 			if tt.expectedDelay != nil {
 				assert.Equal(t, tt.expectedDelay, faultFilter.Delay)
 			}
+			assert.Equal(t, tt.expectedRateLimit, faultFilter.ResponseRateLimit)
 
 			if tt.expectedHeadersDownstreamCluster != "" {
 				expectedHeaders := []*gcpRoute.HeaderMatcher{
@@ -189,6 +222,8 @@ const (
 	faultUpstreamServiceTypeExternal = "external"
 	faultTypeAbort                   = "abort"
 	faultTypeLatency                 = "latency"
+	faultTypeGrpcAbort               = "grpcAbort"
+	faultTypeResponseRateLimit       = "responseRateLimit"
 )
 
 func createExperiment(t *testing.T, upstreamCluster string, downstreamCluster string, faultNumerator uint32, faultDenominator uint32, faultValue uint32, faultInjectorEnforcing string, faultType string) *experimentstore.Experiment {
@@ -227,6 +262,20 @@ func createExperiment(t *testing.T, upstreamCluster string, downstreamCluster st
 				LatencyDuration: &serverexperimentation.FaultLatencyDuration{FixedDurationMs: faultValue},
 			},
 		}
+	case faultTypeGrpcAbort:
+		httpConfig.Fault = &serverexperimentation.HTTPFaultConfig_GrpcAbortFault{
+			GrpcAbortFault: &serverexperimentation.GrpcAbortFault{
+				Percentage:  &serverexperimentation.FaultPercentage{Percentage: faultNumerator, Denominator: denom},
+				AbortStatus: &serverexperimentation.FaultGrpcAbortStatus{GrpcStatusCode: faultValue},
+			},
+		}
+	case faultTypeResponseRateLimit:
+		httpConfig.Fault = &serverexperimentation.HTTPFaultConfig_ResponseRateLimitFault{
+			ResponseRateLimitFault: &serverexperimentation.ResponseRateLimitFault{
+				Percentage: &serverexperimentation.FaultPercentage{Percentage: faultNumerator, Denominator: denom},
+				RateLimit:  &serverexperimentation.FaultRateLimit{FixedLimitKbps: uint64(faultValue)},
+			},
+		}
 	}
 
 	switch faultInjectorEnforcing {
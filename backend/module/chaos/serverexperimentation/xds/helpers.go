@@ -97,6 +97,10 @@ func GetHTTPFaultPercentage(httpFaultConfig *serverexperimentation.HTTPFaultConf
 		expPercentage = httpFaultConfig.GetAbortFault().GetPercentage()
 	case *serverexperimentation.HTTPFaultConfig_LatencyFault:
 		expPercentage = httpFaultConfig.GetLatencyFault().GetPercentage()
+	case *serverexperimentation.HTTPFaultConfig_GrpcAbortFault:
+		expPercentage = httpFaultConfig.GetGrpcAbortFault().GetPercentage()
+	case *serverexperimentation.HTTPFaultConfig_ResponseRateLimitFault:
+		expPercentage = httpFaultConfig.GetResponseRateLimitFault().GetPercentage()
 	default:
 		return nil, fmt.Errorf("unknown enforcer %v", httpFaultConfig)
 	}
@@ -10,24 +10,17 @@ import (
 
 const (
 	// INGRESS FAULT
-	// a given downstream service to a given upstream service faults
-	latencyPercentageWithDownstream = `%s.%s.delay.fixed_delay_percent`
-	latencyDurationWithDownstream   = `%s.%s.delay.fixed_duration_ms`
-	httpPercentageWithDownstream    = `%s.%s.abort.abort_percent`
-	httpStatusWithDownstream        = `%s.%s.abort.http_status`
-
-	// all downstream service to a given upstream faults
-	latencyPercentageWithoutDownstream = `%s.delay.fixed_delay_percent`
-	latencyDurationWithoutDownstream   = `%s.delay.fixed_duration_ms`
-	httpPercentageWithoutDownstream    = `%s.abort.abort_percent`
-	httpStatusWithoutDownstream        = `%s.abort.http_status`
-
+	// a given downstream service to a given upstream service faults: <prefix>.<downstream>.<fault key>
+	// all downstream service to a given upstream faults: <prefix>.<fault key>
+	//
 	// EGRESS FAULT
-	// a given downstream service to a given external upstream faults
-	latencyPercentageForEgress = `%s.%s.delay.fixed_delay_percent`
-	latencyDurationForEgress   = `%s.%s.delay.fixed_duration_ms`
-	httpPercentageForEgress    = `%s.%s.abort.abort_percent`
-	httpStatusForEgress        = `%s.%s.abort.http_status`
+	// a given downstream service to a given external upstream faults: <prefix>.<upstream>.<fault key>
+	latencyPercentage        = `delay.fixed_delay_percent`
+	latencyDuration          = `delay.fixed_duration_ms`
+	abortPercentage          = `abort.abort_percent`
+	abortHttpStatus          = `abort.http_status`
+	abortGrpcStatus          = `abort.grpc_status`
+	responseRateLimitPercent = `rate_limit.response_percent`
 )
 
 type RTDSFaultsGenerator struct {
@@ -53,18 +46,15 @@ func (g RTDSFaultsGenerator) GenerateResource(experiment *experimentstore.Experi
 		return nil, err
 	}
 
-	percentageKey, percentageValue, faultKey, faultValue, err := g.createRuntimeKeys(upstreamCluster, downstreamCluster, httpFaultConfig)
+	runtimeKeyValues, err := g.createRuntimeKeys(upstreamCluster, downstreamCluster, httpFaultConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	return xds.NewRTDSResource(cluster, []*xds.RuntimeKeyValue{
-		{Key: percentageKey, Value: percentageValue},
-		{Key: faultKey, Value: faultValue},
-	})
+	return xds.NewRTDSResource(cluster, runtimeKeyValues)
 }
 
-func (g RTDSFaultsGenerator) createRuntimeKeys(upstreamCluster string, downstreamCluster string, httpFaultConfig *serverexperimentationv1.HTTPFaultConfig) (string, uint32, string, uint32, error) {
+func (g RTDSFaultsGenerator) createRuntimeKeys(upstreamCluster string, downstreamCluster string, httpFaultConfig *serverexperimentationv1.HTTPFaultConfig) ([]*xds.RuntimeKeyValue, error) {
 	var percentageKey string
 	var percentageValue uint32
 	var faultKey string
@@ -73,59 +63,60 @@ func (g RTDSFaultsGenerator) createRuntimeKeys(upstreamCluster string, downstrea
 	switch httpFaultConfig.GetFault().(type) {
 	case *serverexperimentationv1.HTTPFaultConfig_AbortFault:
 		abort := httpFaultConfig.GetAbortFault()
+		percentageKey = abortPercentage
 		percentageValue = abort.GetPercentage().GetPercentage()
+		faultKey = abortHttpStatus
 		faultValue = abort.GetAbortStatus().GetHttpStatusCode()
 
-		switch httpFaultConfig.GetFaultTargeting().GetEnforcer().(type) {
-		case *serverexperimentationv1.FaultTargeting_DownstreamEnforcing:
-			// Abort Egress Fault
-			percentageKey = fmt.Sprintf(httpPercentageForEgress, g.EgressFaultRuntimePrefix, upstreamCluster)
-			faultKey = fmt.Sprintf(httpStatusForEgress, g.EgressFaultRuntimePrefix, upstreamCluster)
-
-		case *serverexperimentationv1.FaultTargeting_UpstreamEnforcing:
-			// Abort Internal Fault for all downstream services
-			if downstreamCluster == "" {
-				percentageKey = fmt.Sprintf(httpPercentageWithoutDownstream, g.IngressFaultRuntimePrefix)
-				faultKey = fmt.Sprintf(httpStatusWithoutDownstream, g.IngressFaultRuntimePrefix)
-			} else {
-				// Abort Internal Fault for a given downstream services
-				percentageKey = fmt.Sprintf(httpPercentageWithDownstream, g.IngressFaultRuntimePrefix, downstreamCluster)
-				faultKey = fmt.Sprintf(httpStatusWithDownstream, g.IngressFaultRuntimePrefix, downstreamCluster)
-			}
-
-		default:
-			return "", 0, "", 0, fmt.Errorf("unknown enforcer %v", httpFaultConfig)
-		}
-
 	case *serverexperimentationv1.HTTPFaultConfig_LatencyFault:
 		latency := httpFaultConfig.GetLatencyFault()
+		percentageKey = latencyPercentage
 		percentageValue = latency.GetPercentage().GetPercentage()
+		faultKey = latencyDuration
 		faultValue = latency.GetLatencyDuration().GetFixedDurationMs()
 
-		switch httpFaultConfig.GetFaultTargeting().GetEnforcer().(type) {
-		case *serverexperimentationv1.FaultTargeting_DownstreamEnforcing:
-			// Latency Egress Fault
-			percentageKey = fmt.Sprintf(latencyPercentageForEgress, g.EgressFaultRuntimePrefix, upstreamCluster)
-			faultKey = fmt.Sprintf(latencyDurationForEgress, g.EgressFaultRuntimePrefix, upstreamCluster)
-
-		case *serverexperimentationv1.FaultTargeting_UpstreamEnforcing:
-			// Latency Internal Fault for all downstream services
-			if downstreamCluster == "" {
-				percentageKey = fmt.Sprintf(latencyPercentageWithoutDownstream, g.IngressFaultRuntimePrefix)
-				faultKey = fmt.Sprintf(latencyDurationWithoutDownstream, g.IngressFaultRuntimePrefix)
-			} else {
-				// Latency Internal Fault for a given downstream services
-				percentageKey = fmt.Sprintf(latencyPercentageWithDownstream, g.IngressFaultRuntimePrefix, downstreamCluster)
-				faultKey = fmt.Sprintf(latencyDurationWithDownstream, g.IngressFaultRuntimePrefix, downstreamCluster)
-			}
-
-		default:
-			return "", 0, "", 0, fmt.Errorf("unknown enforcer %v", httpFaultConfig)
+	case *serverexperimentationv1.HTTPFaultConfig_GrpcAbortFault:
+		abort := httpFaultConfig.GetGrpcAbortFault()
+		percentageKey = abortPercentage
+		percentageValue = abort.GetPercentage().GetPercentage()
+		faultKey = abortGrpcStatus
+		faultValue = abort.GetAbortStatus().GetGrpcStatusCode()
+
+	case *serverexperimentationv1.HTTPFaultConfig_ResponseRateLimitFault:
+		// The bandwidth limit can't be overridden by runtime, it is taken from the fault filter configuration of the
+		// listener. Use ECDS to apply the limit of the experiment, experiments of clusters served over RTDS are rejected
+		// at creation.
+		percentageKey = responseRateLimitPercent
+		percentageValue = httpFaultConfig.GetResponseRateLimitFault().GetPercentage().GetPercentage()
+
+	default:
+		return nil, fmt.Errorf("unknown fault type %v", httpFaultConfig)
+	}
+
+	var prefix string
+	switch httpFaultConfig.GetFaultTargeting().GetEnforcer().(type) {
+	case *serverexperimentationv1.FaultTargeting_DownstreamEnforcing:
+		// Egress Fault
+		prefix = fmt.Sprintf("%s.%s", g.EgressFaultRuntimePrefix, upstreamCluster)
+
+	case *serverexperimentationv1.FaultTargeting_UpstreamEnforcing:
+		if downstreamCluster == "" {
+			// Internal Fault for all downstream services
+			prefix = g.IngressFaultRuntimePrefix
+		} else {
+			// Internal Fault for a given downstream services
+			prefix = fmt.Sprintf("%s.%s", g.IngressFaultRuntimePrefix, downstreamCluster)
 		}
 
 	default:
-		return "", 0, "", 0, fmt.Errorf("unknown fault type %v", httpFaultConfig)
+		return nil, fmt.Errorf("unknown enforcer %v", httpFaultConfig)
 	}
 
-	return percentageKey, percentageValue, faultKey, faultValue, nil
+	runtimeKeyValues := []*xds.RuntimeKeyValue{
+		{Key: fmt.Sprintf("%s.%s", prefix, percentageKey), Value: percentageValue},
+	}
+	if faultKey != "" {
+		runtimeKeyValues = append(runtimeKeyValues, &xds.RuntimeKeyValue{Key: fmt.Sprintf("%s.%s", prefix, faultKey), Value: faultValue})
+	}
+	return runtimeKeyValues, nil
 }
@@ -58,6 +58,25 @@ func TestRTDSFaultsGeneration(t *testing.T) {
 				{Key: "egressfoo.serviceY.delay.fixed_duration_ms", Value: 200},
 			},
 		},
+		{
+			// gRPC Abort - Service B -> Service A (Internal)
+			experiment:              createExperiment(t, "serviceA", "serviceB", 20, 100, 14, faultUpstreamServiceTypeInternal, faultTypeGrpcAbort),
+			ingressRuntimeKeyPrefix: "ingressfoo",
+			expectedCluster:         "serviceA",
+			expectedRuntimeKeyValues: []*xds.RuntimeKeyValue{
+				{Key: "ingressfoo.serviceB.abort.abort_percent", Value: 20},
+				{Key: "ingressfoo.serviceB.abort.grpc_status", Value: 14},
+			},
+		},
+		{
+			// Response Rate Limit - Service A -> Service X (External)
+			experiment:             createExperiment(t, "serviceX", "serviceA", 50, 100, 64, faultUpstreamServiceTypeExternal, faultTypeResponseRateLimit),
+			egressRuntimeKeyPrefix: "egressfoo",
+			expectedCluster:        "serviceA",
+			expectedRuntimeKeyValues: []*xds.RuntimeKeyValue{
+				{Key: "egressfoo.serviceX.rate_limit.response_percent", Value: 50},
+			},
+		},
 	}
 
 	containsKeyValue := func(keyValues []*xds.RuntimeKeyValue, keyValue *xds.RuntimeKeyValue) bool {
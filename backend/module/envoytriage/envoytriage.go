@@ -7,17 +7,28 @@ package envoytriage
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	"github.com/lyft/clutch/backend/gateway/meta"
 	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/resolver"
+	k8sresolver "github.com/lyft/clutch/backend/resolver/k8s"
 	"github.com/lyft/clutch/backend/service"
 	"github.com/lyft/clutch/backend/service/envoyadmin"
 )
 
+var typeURLPod = meta.TypeURL((*k8sv1.Pod)(nil))
+
 const (
 	Name = "clutch.module.envoytriage"
 )
@@ -72,3 +83,187 @@ func (a *api) Read(ctx context.Context, request *envoytriagev1.ReadRequest) (*en
 
 	return resp, nil
 }
+
+func (a *api) BatchRead(ctx context.Context, request *envoytriagev1.BatchReadRequest) (*envoytriagev1.BatchReadResponse, error) {
+	addresses := append([]*envoytriagev1.Address{}, request.Addresses...)
+
+	var failures []*envoytriagev1.BatchReadResponse_Failure
+	podsByAddress := make(map[string]string)
+	if len(request.Pods) > 0 {
+		podAddresses, podFailures, err := a.resolvePods(ctx, request.Pods, request.PodAdminPort, int(request.MaxConcurrency))
+		if err != nil {
+			return nil, err
+		}
+		for idx, address := range podAddresses {
+			if address == nil {
+				continue
+			}
+			podsByAddress[podKey(address.Pod)] = request.Pods[idx]
+			addresses = append(addresses, address)
+		}
+		failures = append(failures, podFailures...)
+	}
+
+	opts := envoyadmin.BatchOptions{MaxConcurrency: int(request.MaxConcurrency)}
+	if request.PerHostTimeout != nil {
+		opts.PerHostTimeout = request.PerHostTimeout.AsDuration()
+	}
+	results, readFailures := a.client.BatchGet(ctx, addresses, request.Include, opts)
+	for _, f := range readFailures {
+		if f.Address.GetPod() != nil {
+			f.Pod = podsByAddress[podKey(f.Address.Pod)]
+		}
+	}
+	failures = append(failures, readFailures...)
+
+	var certExpiryWindow time.Duration
+	if request.CertExpiryWindow != nil {
+		certExpiryWindow = request.CertExpiryWindow.AsDuration()
+	}
+
+	return &envoytriagev1.BatchReadResponse{
+		Results:   results,
+		Failures:  failures,
+		Aggregate: envoyadmin.Aggregate(results, certExpiryWindow),
+	}, nil
+}
+
+// Locates pods with the Kubernetes resolver and returns the address of the admin interface of each pod, in the order
+// of the queries. Pods that cannot be located have a nil address and are returned as failures.
+func (a *api) resolvePods(ctx context.Context, pods []string, port uint32, concurrency int) ([]*envoytriagev1.Address, []*envoytriagev1.BatchReadResponse_Failure, error) {
+	res, ok := resolver.Registry[k8sresolver.Name]
+	if !ok {
+		return nil, nil, status.Errorf(codes.FailedPrecondition, "the '%s' resolver is required to read pods", k8sresolver.Name)
+	}
+
+	addresses := make([]*envoytriagev1.Address, len(pods))
+	errs := make([]error, len(pods))
+
+	var g errgroup.Group
+	if concurrency > 0 {
+		g.SetLimit(concurrency)
+	}
+	for idx, pod := range pods {
+		idx, pod := idx, pod
+		g.Go(func() error {
+			addresses[idx], errs[idx] = podAddress(ctx, res, pod, port)
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var failures []*envoytriagev1.BatchReadResponse_Failure
+	for idx, pod := range pods {
+		if errs[idx] != nil {
+			addresses[idx] = nil
+			failures = append(failures, &envoytriagev1.BatchReadResponse_Failure{Pod: pod, Error: errs[idx].Error()})
+		}
+	}
+	return addresses, failures, nil
+}
+
+func podAddress(ctx context.Context, res resolver.Resolver, query string, port uint32) (*envoytriagev1.Address, error) {
+	results, err := res.Search(ctx, typeURLPod, query, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(results.Messages) == 0 {
+		return nil, fmt.Errorf("pod '%s' not found", query)
+	}
+
+	pod, ok := results.Messages[0].(*k8sv1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("resolver returned unexpected type '%T'", results.Messages[0])
+	}
+	if pod.PodIp == "" {
+		return nil, fmt.Errorf("pod '%s' does not have an IP", pod.Name)
+	}
+
+	// Pods are reached through the API server, since their network may not be routable from Clutch.
+	return &envoytriagev1.Address{
+		Pod: &envoytriagev1.Address_Pod{
+			Cluster:   pod.Cluster,
+			Namespace: pod.Namespace,
+			Name:      pod.Name,
+		},
+		Port: port,
+	}, nil
+}
+
+// Pod IPs may collide across clusters, so resolved pods are identified by their cluster, namespace and name.
+func podKey(pod *envoytriagev1.Address_Pod) string {
+	return fmt.Sprintf("%s/%s/%s/%s", pod.Clientset, pod.Cluster, pod.Namespace, pod.Name)
+}
+
+func (a *api) SampleStats(ctx context.Context, request *envoytriagev1.SampleStatsRequest) (*envoytriagev1.SampleStatsResponse, error) {
+	sample, err := a.client.SampleStats(ctx, request.Address, envoyadmin.StatsSampleOptions{
+		Interval: request.Interval.AsDuration(),
+		Samples:  int(request.Samples),
+		Filter:   request.Filter,
+		TopN:     int(request.TopN),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &envoytriagev1.SampleStatsResponse{Sample: sample}, nil
+}
+
+func (a *api) CompareConfig(ctx context.Context, request *envoytriagev1.CompareConfigRequest) (*envoytriagev1.CompareConfigResponse, error) {
+	diffs, err := a.client.CompareConfig(ctx, request.Base, request.Target)
+	if err != nil {
+		return nil, err
+	}
+	return &envoytriagev1.CompareConfigResponse{Diffs: diffs}, nil
+}
+
+func (a *api) CreateConfigSnapshot(ctx context.Context, request *envoytriagev1.CreateConfigSnapshotRequest) (*envoytriagev1.CreateConfigSnapshotResponse, error) {
+	snapshot, err := a.client.CreateConfigSnapshot(ctx, request.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &envoytriagev1.CreateConfigSnapshotResponse{Snapshot: snapshot}, nil
+}
+
+func (a *api) ListConfigSnapshots(ctx context.Context, request *envoytriagev1.ListConfigSnapshotsRequest) (*envoytriagev1.ListConfigSnapshotsResponse, error) {
+	snapshots, err := a.client.ListConfigSnapshots(ctx, request.Address)
+	if err != nil {
+		return nil, err
+	}
+	return &envoytriagev1.ListConfigSnapshotsResponse{Snapshots: snapshots}, nil
+}
+
+func (a *api) ModifyRuntime(ctx context.Context, request *envoytriagev1.ModifyRuntimeRequest) (*envoytriagev1.ModifyRuntimeResponse, error) {
+	if err := a.client.ModifyRuntime(ctx, request.Address, request.Overrides); err != nil {
+		return nil, err
+	}
+	return &envoytriagev1.ModifyRuntimeResponse{}, nil
+}
+
+func (a *api) SetLogLevel(ctx context.Context, request *envoytriagev1.SetLogLevelRequest) (*envoytriagev1.SetLogLevelResponse, error) {
+	loggers, err := a.client.SetLogLevel(ctx, request.Address, request.Logger, request.Level)
+	if err != nil {
+		return nil, err
+	}
+	return &envoytriagev1.SetLogLevelResponse{Loggers: loggers}, nil
+}
+
+func (a *api) FailHealthcheck(ctx context.Context, request *envoytriagev1.FailHealthcheckRequest) (*envoytriagev1.FailHealthcheckResponse, error) {
+	if err := a.client.FailHealthcheck(ctx, request.Address); err != nil {
+		return nil, err
+	}
+	return &envoytriagev1.FailHealthcheckResponse{}, nil
+}
+
+func (a *api) PassHealthcheck(ctx context.Context, request *envoytriagev1.PassHealthcheckRequest) (*envoytriagev1.PassHealthcheckResponse, error) {
+	if err := a.client.PassHealthcheck(ctx, request.Address); err != nil {
+		return nil, err
+	}
+	return &envoytriagev1.PassHealthcheckResponse{}, nil
+}
+
+func (a *api) DrainListeners(ctx context.Context, request *envoytriagev1.DrainListenersRequest) (*envoytriagev1.DrainListenersResponse, error) {
+	if err := a.client.DrainListeners(ctx, request.Address, request.InboundOnly, request.Graceful); err != nil {
+		return nil, err
+	}
+	return &envoytriagev1.DrainListenersResponse{}, nil
+}
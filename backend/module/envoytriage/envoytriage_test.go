@@ -0,0 +1,126 @@
+package envoytriage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/proto"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+	k8sv1 "github.com/lyft/clutch/backend/api/k8s/v1"
+	resolverv1 "github.com/lyft/clutch/backend/api/resolver/v1"
+	"github.com/lyft/clutch/backend/mock/service/envoyadminmock"
+	"github.com/lyft/clutch/backend/module/moduletest"
+	"github.com/lyft/clutch/backend/resolver"
+	k8sresolver "github.com/lyft/clutch/backend/resolver/k8s"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/envoyadmin"
+	k8sservice "github.com/lyft/clutch/backend/service/k8s"
+)
+
+func newTestAPI(t *testing.T) envoytriagev1.EnvoyTriageAPIServer {
+	svc, err := envoyadminmock.NewAsService(nil, nil, nil)
+	assert.NoError(t, err)
+	return newAPI(svc.(envoyadmin.Client))
+}
+
+func TestModule(t *testing.T) {
+	svc, err := envoyadminmock.NewAsService(nil, nil, nil)
+	assert.NoError(t, err)
+	service.Registry["clutch.service.envoyadmin"] = svc
+
+	m, err := New(nil, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+
+	r := moduletest.NewRegisterChecker()
+	assert.NoError(t, m.Register(r))
+	assert.NoError(t, r.HasAPI("clutch.envoytriage.v1.EnvoyTriageAPI"))
+	assert.True(t, r.JSONRegistered())
+}
+
+type fakePodResolver struct {
+	resolver.Resolver
+
+	pods map[string]*k8sv1.Pod
+}
+
+func (r *fakePodResolver) Search(ctx context.Context, typeURL, query string, limit uint32) (*resolver.Results, error) {
+	pod, ok := r.pods[query]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &resolver.Results{Messages: []proto.Message{pod}}, nil
+}
+
+func (r *fakePodResolver) Schemas() resolver.TypeURLToSchemasMap {
+	return resolver.TypeURLToSchemasMap{typeURLPod: []*resolverv1.Schema{}}
+}
+
+type fakePodProxy struct {
+	k8sservice.Service
+
+	proxied []string
+}
+
+func (k *fakePodProxy) PodProxy(_ context.Context, _, cluster, namespace, name string, port uint32) (*http.Client, string, error) {
+	if name == "unreachable" {
+		return nil, "", errors.New("unreachable")
+	}
+	k.proxied = append(k.proxied, fmt.Sprintf("%s/%s/%s:%d", cluster, namespace, name, port))
+	return envoyadminmock.NewHTTPClient(), "http://" + name, nil
+}
+
+func TestBatchRead(t *testing.T) {
+	resolver.Registry[k8sresolver.Name] = &fakePodResolver{
+		pods: map[string]*k8sv1.Pod{
+			"ns/pod-1":         {Cluster: "a", Namespace: "ns", Name: "pod-1", PodIp: "10.0.0.2"},
+			"ns/pending":       {Cluster: "a", Namespace: "ns", Name: "pending"},
+			"b/ns/unreachable": {Cluster: "b", Namespace: "ns", Name: "unreachable", PodIp: "10.0.0.2"},
+		},
+	}
+	defer delete(resolver.Registry, k8sresolver.Name)
+
+	k8s := &fakePodProxy{}
+	service.Registry[k8sservice.Name] = k8s
+	defer delete(service.Registry, k8sservice.Name)
+
+	api := newTestAPI(t)
+	resp, err := api.BatchRead(context.Background(), &envoytriagev1.BatchReadRequest{
+		Addresses: []*envoytriagev1.Address{{Host: "10.0.0.1"}},
+		Pods:      []string{"ns/pod-1", "ns/pending", "ns/missing", "b/ns/unreachable"},
+		Include:   &envoytriagev1.ReadOperation_Include{Clusters: true, ConfigDump: true},
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, resp.Results, 2)
+	assert.Equal(t, "10.0.0.1", resp.Results[0].Address.Host)
+	assert.Equal(t, "pod-1", resp.Results[1].Address.Pod.Name)
+	assert.Equal(t, uint32(9999), resp.Results[1].Address.Port)
+	// Pods are read through the API server rather than on their IP.
+	assert.Equal(t, []string{"a/ns/pod-1:9999"}, k8s.proxied)
+
+	// Failures of pods are attributed to the pod, even if another pod has the same IP in a different cluster.
+	assert.Len(t, resp.Failures, 3)
+	assert.Equal(t, "ns/pending", resp.Failures[0].Pod)
+	assert.Equal(t, "ns/missing", resp.Failures[1].Pod)
+	assert.Equal(t, "b/ns/unreachable", resp.Failures[2].Pod)
+
+	assert.NotEmpty(t, resp.Aggregate.UpstreamHealth)
+	for _, upstream := range resp.Aggregate.UpstreamHealth {
+		assert.Equal(t, uint32(2), upstream.ReportingHosts)
+	}
+	assert.Equal(t, uint32(2), resp.Aggregate.ConfigDrift.MajorityHosts)
+	assert.Empty(t, resp.Aggregate.ConfigDrift.DifferingHosts)
+}
+
+func TestBatchReadPodsWithoutResolver(t *testing.T) {
+	api := newTestAPI(t)
+	_, err := api.BatchRead(context.Background(), &envoytriagev1.BatchReadRequest{Pods: []string{"ns/pod-1"}})
+	assert.Error(t, err)
+}
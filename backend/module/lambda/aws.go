@@ -0,0 +1,45 @@
+package lambda
+
+import (
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+
+	lambdav1 "github.com/lyft/clutch/backend/api/aws/lambda/v1"
+	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/aws"
+)
+
+const (
+	Name = "clutch.module.lambda"
+)
+
+func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
+	awsClient, ok := service.Registry["clutch.service.aws"]
+	if !ok {
+		return nil, errors.New("could not find service")
+	}
+
+	c, ok := awsClient.(aws.Client)
+	if !ok {
+		return nil, errors.New("service was not the correct type")
+	}
+
+	mod := &mod{
+		lambda: newLambdaAPI(c),
+	}
+
+	return mod, nil
+}
+
+type mod struct {
+	lambda lambdav1.LambdaAPIServer
+}
+
+func (m *mod) Register(r module.Registrar) error {
+	lambdav1.RegisterLambdaAPIServer(r.GRPCServer(), m.lambda)
+	return r.RegisterJSONGateway(lambdav1.RegisterLambdaAPIHandler)
+}
@@ -0,0 +1,59 @@
+package lambda
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+
+	lambdav1 "github.com/lyft/clutch/backend/api/aws/lambda/v1"
+	"github.com/lyft/clutch/backend/mock/service/awsmock"
+	"github.com/lyft/clutch/backend/module/moduletest"
+	"github.com/lyft/clutch/backend/service"
+)
+
+func TestModule(t *testing.T) {
+	service.Registry["clutch.service.aws"] = awsmock.New()
+
+	log := zaptest.NewLogger(t)
+	scope := tally.NewTestScope("", nil)
+
+	m, err := New(nil, log, scope)
+	assert.NoError(t, err)
+
+	r := moduletest.NewRegisterChecker()
+	assert.NoError(t, m.Register(r))
+	assert.NoError(t, r.HasAPI("clutch.aws.lambda.v1.LambdaAPI"))
+	assert.True(t, r.JSONRegistered())
+}
+
+func TestLambdaAPIDescribeFunction(t *testing.T) {
+	c := awsmock.New()
+	api := newLambdaAPI(c)
+	resp, err := api.DescribeFunction(context.Background(), &lambdav1.DescribeFunctionRequest{Name: "foo", Region: "us-east-1", Account: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", resp.Function.Name)
+	assert.NotEmpty(t, resp.Function.EnvironmentVariableKeys)
+}
+
+func TestLambdaAPIGetInvocationSummary(t *testing.T) {
+	c := awsmock.New()
+	api := newLambdaAPI(c)
+	resp, err := api.GetInvocationSummary(context.Background(), &lambdav1.GetInvocationSummaryRequest{Name: "foo"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Summary.Datapoints)
+}
+
+func TestLambdaAPIFunctionConcurrency(t *testing.T) {
+	c := awsmock.New()
+	api := newLambdaAPI(c)
+	resp, err := api.PutFunctionConcurrency(context.Background(), &lambdav1.PutFunctionConcurrencyRequest{Name: "foo", ReservedConcurrentExecutions: 0})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	dresp, err := api.DeleteFunctionConcurrency(context.Background(), &lambdav1.DeleteFunctionConcurrencyRequest{Name: "foo"})
+	assert.NoError(t, err)
+	assert.NotNil(t, dresp)
+}
@@ -0,0 +1,54 @@
+package lambda
+
+import (
+	"context"
+
+	lambdav1 "github.com/lyft/clutch/backend/api/aws/lambda/v1"
+	"github.com/lyft/clutch/backend/service/aws"
+)
+
+func newLambdaAPI(c aws.Client) lambdav1.LambdaAPIServer {
+	return &lambdaAPI{
+		client: c,
+	}
+}
+
+type lambdaAPI struct {
+	client aws.Client
+}
+
+func (a *lambdaAPI) DescribeFunction(ctx context.Context, req *lambdav1.DescribeFunctionRequest) (*lambdav1.DescribeFunctionResponse, error) {
+	function, err := a.client.DescribeLambdaFunction(ctx, req.Account, req.Region, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lambdav1.DescribeFunctionResponse{Function: function}, nil
+}
+
+func (a *lambdaAPI) GetInvocationSummary(ctx context.Context, req *lambdav1.GetInvocationSummaryRequest) (*lambdav1.GetInvocationSummaryResponse, error) {
+	summary, err := a.client.GetLambdaInvocationSummary(ctx, req.Account, req.Region, req.Name, req.Window.AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &lambdav1.GetInvocationSummaryResponse{Summary: summary}, nil
+}
+
+func (a *lambdaAPI) PutFunctionConcurrency(ctx context.Context, req *lambdav1.PutFunctionConcurrencyRequest) (*lambdav1.PutFunctionConcurrencyResponse, error) {
+	err := a.client.PutLambdaFunctionConcurrency(ctx, req.Account, req.Region, req.Name, req.ReservedConcurrentExecutions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lambdav1.PutFunctionConcurrencyResponse{}, nil
+}
+
+func (a *lambdaAPI) DeleteFunctionConcurrency(ctx context.Context, req *lambdav1.DeleteFunctionConcurrencyRequest) (*lambdav1.DeleteFunctionConcurrencyResponse, error) {
+	err := a.client.DeleteLambdaFunctionConcurrency(ctx, req.Account, req.Region, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lambdav1.DeleteFunctionConcurrencyResponse{}, nil
+}
@@ -0,0 +1,45 @@
+package rds
+
+import (
+	"errors"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+
+	rdsv1 "github.com/lyft/clutch/backend/api/aws/rds/v1"
+	"github.com/lyft/clutch/backend/module"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/aws"
+)
+
+const (
+	Name = "clutch.module.rds"
+)
+
+func New(*any.Any, *zap.Logger, tally.Scope) (module.Module, error) {
+	awsClient, ok := service.Registry["clutch.service.aws"]
+	if !ok {
+		return nil, errors.New("could not find service")
+	}
+
+	c, ok := awsClient.(aws.Client)
+	if !ok {
+		return nil, errors.New("service was not the correct type")
+	}
+
+	mod := &mod{
+		rds: newRDSAPI(c),
+	}
+
+	return mod, nil
+}
+
+type mod struct {
+	rds rdsv1.RDSAPIServer
+}
+
+func (m *mod) Register(r module.Registrar) error {
+	rdsv1.RegisterRDSAPIServer(r.GRPCServer(), m.rds)
+	return r.RegisterJSONGateway(rdsv1.RegisterRDSAPIHandler)
+}
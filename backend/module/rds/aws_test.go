@@ -0,0 +1,72 @@
+package rds
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+
+	rdsv1 "github.com/lyft/clutch/backend/api/aws/rds/v1"
+	"github.com/lyft/clutch/backend/mock/service/awsmock"
+	"github.com/lyft/clutch/backend/module/moduletest"
+	"github.com/lyft/clutch/backend/service"
+)
+
+func TestModule(t *testing.T) {
+	service.Registry["clutch.service.aws"] = awsmock.New()
+
+	log := zaptest.NewLogger(t)
+	scope := tally.NewTestScope("", nil)
+
+	m, err := New(nil, log, scope)
+	assert.NoError(t, err)
+
+	r := moduletest.NewRegisterChecker()
+	assert.NoError(t, m.Register(r))
+	assert.NoError(t, r.HasAPI("clutch.aws.rds.v1.RDSAPI"))
+	assert.True(t, r.JSONRegistered())
+}
+
+func TestRDSAPIDescribeDBInstance(t *testing.T) {
+	c := awsmock.New()
+	api := newRDSAPI(c)
+	resp, err := api.DescribeDBInstance(context.Background(), &rdsv1.DescribeDBInstanceRequest{Identifier: "foo", Region: "us-east-1", Account: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", resp.Instance.Identifier)
+	assert.NotEmpty(t, resp.Instance.PendingMaintenanceActions)
+}
+
+func TestRDSAPIDescribeDBCluster(t *testing.T) {
+	c := awsmock.New()
+	api := newRDSAPI(c)
+	resp, err := api.DescribeDBCluster(context.Background(), &rdsv1.DescribeDBClusterRequest{Identifier: "foo", Region: "us-east-1", Account: "default"})
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", resp.Cluster.Identifier)
+	assert.Len(t, resp.Cluster.Members, 2)
+}
+
+func TestRDSAPIRebootAndFailover(t *testing.T) {
+	c := awsmock.New()
+	api := newRDSAPI(c)
+	resp, err := api.RebootDBInstance(context.Background(), &rdsv1.RebootDBInstanceRequest{Identifier: "foo", ForceFailover: true})
+	assert.NoError(t, err)
+	assert.NotNil(t, resp)
+
+	fresp, err := api.FailoverDBCluster(context.Background(), &rdsv1.FailoverDBClusterRequest{Identifier: "foo"})
+	assert.NoError(t, err)
+	assert.NotNil(t, fresp)
+}
+
+func TestRDSAPIDescribeEvents(t *testing.T) {
+	c := awsmock.New()
+	api := newRDSAPI(c)
+	resp, err := api.DescribeEvents(context.Background(), &rdsv1.DescribeEventsRequest{
+		SourceIdentifier: "foo",
+		SourceType:       rdsv1.Event_DB_INSTANCE,
+	})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, resp.Events)
+	assert.Equal(t, "foo", resp.Events[0].SourceIdentifier)
+}
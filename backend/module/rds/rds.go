@@ -0,0 +1,63 @@
+package rds
+
+import (
+	"context"
+
+	rdsv1 "github.com/lyft/clutch/backend/api/aws/rds/v1"
+	"github.com/lyft/clutch/backend/service/aws"
+)
+
+func newRDSAPI(c aws.Client) rdsv1.RDSAPIServer {
+	return &rdsAPI{
+		client: c,
+	}
+}
+
+type rdsAPI struct {
+	client aws.Client
+}
+
+func (a *rdsAPI) DescribeDBInstance(ctx context.Context, req *rdsv1.DescribeDBInstanceRequest) (*rdsv1.DescribeDBInstanceResponse, error) {
+	instance, err := a.client.DescribeDBInstance(ctx, req.Account, req.Region, req.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdsv1.DescribeDBInstanceResponse{Instance: instance}, nil
+}
+
+func (a *rdsAPI) DescribeDBCluster(ctx context.Context, req *rdsv1.DescribeDBClusterRequest) (*rdsv1.DescribeDBClusterResponse, error) {
+	cluster, err := a.client.DescribeDBCluster(ctx, req.Account, req.Region, req.Identifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdsv1.DescribeDBClusterResponse{Cluster: cluster}, nil
+}
+
+func (a *rdsAPI) RebootDBInstance(ctx context.Context, req *rdsv1.RebootDBInstanceRequest) (*rdsv1.RebootDBInstanceResponse, error) {
+	err := a.client.RebootDBInstance(ctx, req.Account, req.Region, req.Identifier, req.ForceFailover)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdsv1.RebootDBInstanceResponse{}, nil
+}
+
+func (a *rdsAPI) FailoverDBCluster(ctx context.Context, req *rdsv1.FailoverDBClusterRequest) (*rdsv1.FailoverDBClusterResponse, error) {
+	err := a.client.FailoverDBCluster(ctx, req.Account, req.Region, req.Identifier, req.TargetDbInstanceIdentifier)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdsv1.FailoverDBClusterResponse{}, nil
+}
+
+func (a *rdsAPI) DescribeEvents(ctx context.Context, req *rdsv1.DescribeEventsRequest) (*rdsv1.DescribeEventsResponse, error) {
+	events, err := a.client.DescribeRDSEvents(ctx, req.Account, req.Region, req.SourceIdentifier, req.SourceType, req.Duration.AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdsv1.DescribeEventsResponse{Events: events}, nil
+}
@@ -19,6 +19,8 @@ import (
 	ec2v1api "github.com/lyft/clutch/backend/api/aws/ec2/v1"
 	iamv1api "github.com/lyft/clutch/backend/api/aws/iam/v1"
 	kinesisv1api "github.com/lyft/clutch/backend/api/aws/kinesis/v1"
+	lambdav1api "github.com/lyft/clutch/backend/api/aws/lambda/v1"
+	rdsv1api "github.com/lyft/clutch/backend/api/aws/rds/v1"
 	s3v1api "github.com/lyft/clutch/backend/api/aws/s3/v1"
 	awsv1resolver "github.com/lyft/clutch/backend/api/resolver/aws/v1"
 	resolverv1 "github.com/lyft/clutch/backend/api/resolver/v1"
@@ -39,6 +41,9 @@ var typeURLDynamodbTable = meta.TypeURL((*dynamodbv1api.Table)(nil))
 var typeURLS3Bucket = meta.TypeURL((*s3v1api.Bucket)(nil))
 var typeURLS3AccessPoint = meta.TypeURL((*s3v1api.AccessPoint)(nil))
 var typeURLIAMRole = meta.TypeURL((*iamv1api.Role)(nil))
+var typeURLRDSInstance = meta.TypeURL((*rdsv1api.DBInstance)(nil))
+var typeURLRDSCluster = meta.TypeURL((*rdsv1api.DBCluster)(nil))
+var typeURLLambdaFunction = meta.TypeURL((*lambdav1api.Function)(nil))
 
 var typeSchemas = resolver.TypeURLToSchemaMessagesMap{
 	typeURLInstance: {
@@ -62,6 +67,15 @@ var typeSchemas = resolver.TypeURLToSchemaMessagesMap{
 	typeURLIAMRole: {
 		(*awsv1resolver.IAMRoleName)(nil),
 	},
+	typeURLRDSInstance: {
+		(*awsv1resolver.RDSInstanceIdentifier)(nil),
+	},
+	typeURLRDSCluster: {
+		(*awsv1resolver.RDSClusterIdentifier)(nil),
+	},
+	typeURLLambdaFunction: {
+		(*awsv1resolver.LambdaFunctionName)(nil),
+	},
 }
 
 func makeRegionOptions(regions []string) []*resolverv1.Option {
@@ -179,6 +193,15 @@ func (r *res) Resolve(ctx context.Context, wantTypeURL string, input proto.Messa
 	case typeURLIAMRole:
 		return r.resolveIAMRoleForInput(ctx, input)
 
+	case typeURLRDSInstance:
+		return r.resolveRDSInstanceForInput(ctx, input)
+
+	case typeURLRDSCluster:
+		return r.resolveRDSClusterForInput(ctx, input)
+
+	case typeURLLambdaFunction:
+		return r.resolveLambdaFunctionForInput(ctx, input)
+
 	default:
 		return nil, status.Errorf(codes.Internal, "resolver for '%s' not implemented", wantTypeURL)
 	}
@@ -269,6 +292,42 @@ func (r *res) Search(ctx context.Context, typeURL, query string, limit uint32) (
 
 		return r.iamRoleResults(ctx, resolver.OptionAll, resolver.OptionAll, query, limit)
 
+	case typeURLRDSInstance:
+		patternValues, ok, err := meta.ExtractPatternValuesFromString((*rdsv1api.DBInstance)(nil), query)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return r.rdsInstanceResults(ctx, patternValues["account"], patternValues["region"], patternValues["identifier"], limit)
+		}
+
+		return r.rdsInstanceResults(ctx, resolver.OptionAll, resolver.OptionAll, query, limit)
+
+	case typeURLRDSCluster:
+		patternValues, ok, err := meta.ExtractPatternValuesFromString((*rdsv1api.DBCluster)(nil), query)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return r.rdsClusterResults(ctx, patternValues["account"], patternValues["region"], patternValues["identifier"], limit)
+		}
+
+		return r.rdsClusterResults(ctx, resolver.OptionAll, resolver.OptionAll, query, limit)
+
+	case typeURLLambdaFunction:
+		patternValues, ok, err := meta.ExtractPatternValuesFromString((*lambdav1api.Function)(nil), query)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			return r.lambdaFunctionResults(ctx, patternValues["account"], patternValues["region"], patternValues["name"], limit)
+		}
+
+		return r.lambdaFunctionResults(ctx, resolver.OptionAll, resolver.OptionAll, query, limit)
+
 	default:
 		return nil, status.Errorf(codes.Internal, "resolver search for '%s' not implemented", typeURL)
 	}
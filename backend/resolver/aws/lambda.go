@@ -0,0 +1,44 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	awsv1 "github.com/lyft/clutch/backend/api/resolver/aws/v1"
+	"github.com/lyft/clutch/backend/resolver"
+)
+
+func (r *res) resolveLambdaFunctionForInput(ctx context.Context, input proto.Message) (*resolver.Results, error) {
+	switch i := input.(type) {
+	case *awsv1.LambdaFunctionName:
+		return r.lambdaFunctionResults(ctx, i.Account, i.Region, i.Name, 1)
+	default:
+		return nil, status.Errorf(codes.Internal, "unrecognized input type '%T'", i)
+	}
+}
+
+func (r *res) lambdaFunctionResults(ctx context.Context, account, region, name string, limit uint32) (*resolver.Results, error) {
+	ctx, handler := resolver.NewFanoutHandler(ctx)
+
+	allAccountRegions := r.determineAccountAndRegionsForOption(account, region)
+	for account := range allAccountRegions {
+		for _, region := range allAccountRegions[account] {
+			handler.Add(1)
+			go func(account, region string) {
+				defer handler.Done()
+				function, err := r.client.DescribeLambdaFunction(ctx, account, region, name)
+				select {
+				case handler.Channel() <- resolver.NewSingleFanoutResult(function, err):
+					return
+				case <-handler.Cancelled():
+					return
+				}
+			}(account, region)
+		}
+	}
+
+	return handler.Results(limit)
+}
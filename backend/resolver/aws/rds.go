@@ -0,0 +1,76 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	awsv1 "github.com/lyft/clutch/backend/api/resolver/aws/v1"
+	"github.com/lyft/clutch/backend/resolver"
+)
+
+func (r *res) resolveRDSInstanceForInput(ctx context.Context, input proto.Message) (*resolver.Results, error) {
+	switch i := input.(type) {
+	case *awsv1.RDSInstanceIdentifier:
+		return r.rdsInstanceResults(ctx, i.Account, i.Region, i.Identifier, 1)
+	default:
+		return nil, status.Errorf(codes.Internal, "unrecognized input type '%T'", i)
+	}
+}
+
+func (r *res) rdsInstanceResults(ctx context.Context, account, region, identifier string, limit uint32) (*resolver.Results, error) {
+	ctx, handler := resolver.NewFanoutHandler(ctx)
+
+	allAccountRegions := r.determineAccountAndRegionsForOption(account, region)
+	for account := range allAccountRegions {
+		for _, region := range allAccountRegions[account] {
+			handler.Add(1)
+			go func(account, region string) {
+				defer handler.Done()
+				instance, err := r.client.DescribeDBInstance(ctx, account, region, identifier)
+				select {
+				case handler.Channel() <- resolver.NewSingleFanoutResult(instance, err):
+					return
+				case <-handler.Cancelled():
+					return
+				}
+			}(account, region)
+		}
+	}
+
+	return handler.Results(limit)
+}
+
+func (r *res) resolveRDSClusterForInput(ctx context.Context, input proto.Message) (*resolver.Results, error) {
+	switch i := input.(type) {
+	case *awsv1.RDSClusterIdentifier:
+		return r.rdsClusterResults(ctx, i.Account, i.Region, i.Identifier, 1)
+	default:
+		return nil, status.Errorf(codes.Internal, "unrecognized input type '%T'", i)
+	}
+}
+
+func (r *res) rdsClusterResults(ctx context.Context, account, region, identifier string, limit uint32) (*resolver.Results, error) {
+	ctx, handler := resolver.NewFanoutHandler(ctx)
+
+	allAccountRegions := r.determineAccountAndRegionsForOption(account, region)
+	for account := range allAccountRegions {
+		for _, region := range allAccountRegions[account] {
+			handler.Add(1)
+			go func(account, region string) {
+				defer handler.Done()
+				cluster, err := r.client.DescribeDBCluster(ctx, account, region, identifier)
+				select {
+				case handler.Channel() <- resolver.NewSingleFanoutResult(cluster, err):
+					return
+				case <-handler.Cancelled():
+					return
+				}
+			}(account, region)
+		}
+	}
+
+	return handler.Results(limit)
+}
@@ -17,6 +17,8 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
 
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
@@ -32,6 +34,8 @@ import (
 const (
 	Name                 = "clutch.service.audit"
 	auditEventSinkLockId = "audit:eventsink"
+
+	defaultMaxDeliveryAttempts = 10
 )
 
 func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
@@ -58,7 +62,19 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 			EmitUnpopulated: true,
 		},
 
-		filter: config.Filter,
+		filter:              config.Filter,
+		maxDeliveryAttempts: defaultMaxDeliveryAttempts,
+	}
+	if config.MaxDeliveryAttempts > 0 {
+		c.maxDeliveryAttempts = int(config.MaxDeliveryAttempts)
+	}
+
+	if config.HashChain {
+		chainer, ok := storageProvider.(storage.HashChainer)
+		if !ok {
+			return nil, errors.New("hash chaining is not supported by the audit storage provider")
+		}
+		c.chainer = chainer
 	}
 
 	for _, sinkName := range config.Sinks {
@@ -110,6 +126,10 @@ type client struct {
 
 	storage storage.Storage
 	filter  *auditconfigv1.Filter
+	// Set if hash chaining of stored events is enabled.
+	chainer storage.HashChainer
+
+	maxDeliveryAttempts int
 
 	marshaler *protojson.MarshalOptions
 	sinks     []registeredSink
@@ -143,6 +163,8 @@ func (c *client) ReadEvents(ctx context.Context, start time.Time, end *time.Time
 		o = &storage.ReadOptions{
 			Offset: options.Offset,
 			Limit:  options.Limit,
+			Filter: options.Filter,
+			After:  options.After,
 		}
 	}
 	return c.storage.ReadEvents(ctx, start, end, o)
@@ -152,6 +174,13 @@ func (c *client) ReadEvent(ctx context.Context, id int64) (*auditv1.Event, error
 	return c.storage.ReadEvent(ctx, id)
 }
 
+func (c *client) VerifyChain(ctx context.Context, start time.Time, end time.Time) (*auditv1.VerifyAuditChainResponse, error) {
+	if c.chainer == nil {
+		return nil, status.Error(codes.FailedPrecondition, "hash chaining of audit events is not enabled")
+	}
+	return c.chainer.VerifyChain(ctx, start, end)
+}
+
 func (c *client) readAndFanout(ctx context.Context) {
 	// TODO(maybe): Backpressure on continued failure.
 
@@ -167,25 +196,110 @@ func (c *client) readAndFanout(ctx context.Context) {
 	c.sinkWriterScope.Counter("events_total").Inc(int64(numEvents))
 
 	flushTimer := c.sinkWriterScope.Timer("unsent_events_write_all").Start()
-	for _, event := range events {
-		lag := time.Since(event.OccurredAt.AsTime())
+	deliveries := make([]*storage.Delivery, len(events))
+	byID := make(map[int64]*storage.Delivery, len(events))
+	for i, unsent := range events {
+		lag := time.Since(unsent.Event.OccurredAt.AsTime())
 		c.sinkWriterScope.Timer("event_lag").Record(lag)
 
-		for _, s := range c.sinks {
-			if err := s.Write(event); err == nil {
-				c.sinkWriterScope.Counter("event_write.success")
+		deliveries[i] = &storage.Delivery{ID: unsent.Event.Id, SentSinks: append([]string(nil), unsent.SentSinks...)}
+		byID[unsent.Event.Id] = deliveries[i]
+	}
+
+	// Events are only written to the sinks that haven't written them yet, so that sinks that are down don't cause
+	// events to be written to the other sinks again.
+	for _, s := range c.sinks {
+		var pending []*auditv1.Event
+		for _, unsent := range events {
+			if !contains(byID[unsent.Event.Id].SentSinks, s.name) {
+				pending = append(pending, unsent.Event)
+			}
+		}
+
+		for _, batch := range batches(s, pending) {
+			if err := s.write(ctx, batch); err == nil {
+				c.sinkWriterScope.Counter("event_write.success").Inc(int64(len(batch)))
+				for _, event := range batch {
+					d := byID[event.Id]
+					d.SentSinks = append(d.SentSinks, s.name)
+				}
 			} else {
-				c.sinkWriterScope.Counter("event_write.fail")
-				c.logger.Error(
-					"error writing audit event to sink",
-					zap.String("sink", s.name),
-					log.ProtoField("event", event),
-					zap.Error(err),
-				)
+				c.sinkWriterScope.Counter("event_write.fail").Inc(int64(len(batch)))
+				for _, event := range batch {
+					byID[event.Id].Failed = true
+					c.logger.Error(
+						"error writing audit event to sink",
+						zap.String("sink", s.name),
+						log.ProtoField("event", event),
+						zap.Error(err),
+					)
+				}
 			}
 		}
 	}
 	flushTimer.Stop()
+
+	// Events that failed too many times are given up on, so that they don't hold up newer events.
+	for i, d := range deliveries {
+		d.Done = !d.Failed || events[i].FailedAttempts+1 >= c.maxDeliveryAttempts
+		if d.Failed && d.Done {
+			c.sinkWriterScope.Counter("events_dropped").Inc(1)
+			c.logger.Error(
+				"giving up on writing audit event to sinks",
+				zap.Strings("sent_sinks", d.SentSinks),
+				log.ProtoField("event", events[i].Event),
+			)
+		}
+	}
+
+	if err := c.storage.UpdateDeliveries(ctx, deliveries); err != nil {
+		c.sinkWriterScope.Counter("events_mark_sent_error").Inc(1)
+		c.logger.Error("error recording audit event deliveries", zap.Error(err))
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *client) chainEvents(ctx context.Context) {
+	n, err := c.chainer.ChainEvents(ctx)
+	if err != nil {
+		c.scope.Counter("chain_events_error").Inc(1)
+		c.logger.Error("error chaining audit events", zap.Error(err))
+		return
+	}
+	c.scope.Counter("events_chained").Inc(int64(n))
+}
+
+// Splits events into the batches that a sink writes at once.
+func batches(s registeredSink, events []*auditv1.Event) [][]*auditv1.Event {
+	size := 1
+	if bs, ok := s.Sink.(auditsink.BatchSink); ok && bs.BatchSize() > 1 {
+		size = bs.BatchSize()
+	}
+
+	var ret [][]*auditv1.Event
+	for len(events) > size {
+		ret = append(ret, events[:size])
+		events = events[size:]
+	}
+	if len(events) > 0 {
+		ret = append(ret, events)
+	}
+	return ret
+}
+
+func (s registeredSink) write(ctx context.Context, batch []*auditv1.Event) error {
+	if bs, ok := s.Sink.(auditsink.BatchSink); ok {
+		return bs.WriteBatch(ctx, batch)
+	}
+	return s.Write(batch[0])
 }
 
 // This should be called via `go` in order to avoid blocking main exectuion.
@@ -215,6 +329,9 @@ func (c *client) poll() {
 			c.scope.Counter("lock_acquired").Inc(1)
 
 			c.readAndFanout(ctx)
+			if c.chainer != nil {
+				c.chainEvents(ctx)
+			}
 
 			_, err := c.storage.ReleaseLock(ctx, lockID)
 			if err != nil {
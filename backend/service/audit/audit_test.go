@@ -2,6 +2,7 @@ package audit
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	auditconfigv1 "github.com/lyft/clutch/backend/api/config/service/audit/v1"
+	"github.com/lyft/clutch/backend/service/audit/storage/local"
 )
 
 func TestNew(t *testing.T) {
@@ -225,3 +227,81 @@ func TestConvertLockToUint32(t *testing.T) {
 		assert.Equal(t, tt.expect, id)
 	}
 }
+
+type sinkMock struct {
+	batchSize int
+	fail      bool
+
+	writes [][]int64
+}
+
+func (s *sinkMock) Write(event *auditv1.Event) error {
+	return s.WriteBatch(context.Background(), []*auditv1.Event{event})
+}
+
+func (s *sinkMock) BatchSize() int {
+	return s.batchSize
+}
+
+func (s *sinkMock) WriteBatch(_ context.Context, events []*auditv1.Event) error {
+	var ids []int64
+	for _, e := range events {
+		ids = append(ids, e.Id)
+	}
+	s.writes = append(s.writes, ids)
+	if s.fail {
+		return errors.New("failed")
+	}
+	return nil
+}
+
+func TestReadAndFanout(t *testing.T) {
+	cfg := &auditconfigv1.Config{
+		StorageProvider: &auditconfigv1.Config_InMemory{InMemory: true},
+	}
+	log := zaptest.NewLogger(t)
+	scope := tally.NewTestScope("", nil)
+	storage, err := local.New(cfg, log, scope)
+	assert.NoError(t, err)
+
+	single := &sinkMock{}
+	batched := &sinkMock{batchSize: 2, fail: true}
+	c := &client{
+		logger:              log,
+		scope:               scope,
+		sinkWriterScope:     scope,
+		storage:             storage,
+		sinks:               []registeredSink{{Sink: single, name: "single"}, {Sink: batched, name: "batched"}},
+		maxDeliveryAttempts: 2,
+	}
+	assert.NoError(t, writeRequestEvents(c, "fanout", 3))
+
+	c.readAndFanout(context.Background())
+	assert.Equal(t, [][]int64{{0}, {1}, {2}}, single.writes)
+	assert.Equal(t, [][]int64{{0, 1}, {2}}, batched.writes)
+
+	// Events that failed to be written are written again, but only to the sinks that failed to write them.
+	batched.fail = false
+	c.readAndFanout(context.Background())
+	assert.Len(t, single.writes, 3)
+	assert.Equal(t, [][]int64{{0, 1}, {2}, {0, 1}, {2}}, batched.writes)
+
+	unsent, err := storage.UnsentEvents(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, unsent)
+
+	// Events are given up on after failing the maximum number of attempts, so that they don't hold up newer events.
+	batched.fail = true
+	assert.NoError(t, writeRequestEvents(c, "fanout", 1))
+	c.readAndFanout(context.Background())
+	unsent, err = storage.UnsentEvents(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, unsent, 1)
+
+	c.readAndFanout(context.Background())
+	assert.Len(t, single.writes, 4)
+	assert.Len(t, batched.writes, 6)
+	unsent, err = storage.UnsentEvents(context.Background())
+	assert.NoError(t, err)
+	assert.Empty(t, unsent)
+}
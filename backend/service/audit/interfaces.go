@@ -6,6 +6,7 @@ import (
 	"time"
 
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	"github.com/lyft/clutch/backend/service/audit/storage"
 )
 
 var ErrFailedFilters = errors.New("event did not pass auditor's filters")
@@ -13,6 +14,10 @@ var ErrFailedFilters = errors.New("event did not pass auditor's filters")
 type ReadOptions struct {
 	Offset int64
 	Limit  int64
+	// Only events matching the filter are read, if it's set.
+	Filter *auditv1.EventFilter
+	// Only events after the cursor are read, if it's set.
+	After *storage.Cursor
 }
 
 // Required functions to save/share events processed by Clutch.
@@ -24,10 +29,16 @@ type Auditor interface {
 	WriteRequestEvent(ctx context.Context, req *auditv1.RequestEvent) (int64, error)
 	UpdateRequestEvent(ctx context.Context, id int64, update *auditv1.RequestEvent) error
 
-	// Used for services and modules to read past events within a timerange.
+	// Used for services and modules to read past events within a timerange, ordered by time of occurrence and ID.
 	// If end is nil, should search until the current time.
 	ReadEvents(ctx context.Context, start time.Time, end *time.Time, options *ReadOptions) ([]*auditv1.Event, error)
 
 	// Used for services and modules to read a specific event.
 	ReadEvent(ctx context.Context, id int64) (*auditv1.Event, error)
 }
+
+// Optionally implemented by auditors that can verify the hash chain of stored events.
+type ChainVerifier interface {
+	// Verifies the hash chain of the events that occurred within a timerange, reporting the first broken link.
+	VerifyChain(ctx context.Context, start time.Time, end time.Time) (*auditv1.VerifyAuditChainResponse, error)
+}
@@ -26,35 +26,97 @@ type client struct {
 	logger *zap.Logger
 	scope  tally.Scope
 
-	events []*auditv1.Event
+	// Events are only kept in memory until they are done being delivered to the sinks.
+	events     []*auditv1.Event
+	deliveries map[int64]*storage.UnsentEvent
+	nextID     int64
 }
 
 func New(cfg *auditconfigv1.Config, logger *zap.Logger, scope tally.Scope) (storage.Storage, error) {
 	c := &client{
-		logger: logger,
-		scope:  scope,
-		events: make([]*auditv1.Event, 0),
+		logger:     logger,
+		scope:      scope,
+		events:     make([]*auditv1.Event, 0),
+		deliveries: make(map[int64]*storage.UnsentEvent),
 	}
 
 	return c, nil
 }
 
-func (c *client) UnsentEvents(ctx context.Context) ([]*auditv1.Event, error) {
+func (c *client) UnsentEvents(ctx context.Context) ([]*storage.UnsentEvent, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	unsent := make([]*storage.UnsentEvent, 0, len(c.events))
+	for _, event := range c.events {
+		d := c.delivery(event)
+		unsent = append(unsent, &storage.UnsentEvent{
+			Event:          event,
+			SentSinks:      append([]string(nil), d.SentSinks...),
+			FailedAttempts: d.FailedAttempts,
+		})
+	}
+
+	return unsent, nil
+}
+
+func (c *client) UpdateDeliveries(ctx context.Context, deliveries []*storage.Delivery) error {
 	c.Lock()
 	defer c.Unlock()
 
-	unsent := make([]*auditv1.Event, 0, len(c.events))
-	unsent = append(unsent, c.events...)
-	c.events = make([]*auditv1.Event, 0)
+	done := make(map[int64]bool, len(deliveries))
+	for _, d := range deliveries {
+		if d.Done {
+			done[d.ID] = true
+			delete(c.deliveries, d.ID)
+			continue
+		}
 
-	return unsent, nil
+		delivery := c.deliveries[d.ID]
+		if delivery == nil {
+			delivery = &storage.UnsentEvent{}
+			c.deliveries[d.ID] = delivery
+		}
+		delivery.SentSinks = append([]string(nil), d.SentSinks...)
+		if d.Failed {
+			delivery.FailedAttempts++
+		}
+	}
+
+	events := make([]*auditv1.Event, 0, len(c.events))
+	for _, event := range c.events {
+		if !done[event.Id] {
+			events = append(events, event)
+		}
+	}
+	c.events = events
+	return nil
+}
+
+// Must be called with the lock held.
+func (c *client) delivery(event *auditv1.Event) *storage.UnsentEvent {
+	if d, ok := c.deliveries[event.Id]; ok {
+		return d
+	}
+	return &storage.UnsentEvent{}
+}
+
+// Must be called with the lock held.
+func (c *client) find(id int64) *auditv1.Event {
+	for _, event := range c.events {
+		if event.Id == id {
+			return event
+		}
+	}
+	return nil
 }
 
 func (c *client) WriteRequestEvent(ctx context.Context, req *auditv1.RequestEvent) (int64, error) {
 	c.Lock()
 	defer c.Unlock()
 
-	i := int64(len(c.events))
+	i := c.nextID
+	c.nextID++
 	c.events = append(c.events,
 		&auditv1.Event{
 			Id:         i,
@@ -65,19 +127,20 @@ func (c *client) WriteRequestEvent(ctx context.Context, req *auditv1.RequestEven
 }
 
 func (c *client) UpdateRequestEvent(ctx context.Context, id int64, update *auditv1.RequestEvent) error {
-	c.RLock()
-	defer c.RUnlock()
+	c.Lock()
+	defer c.Unlock()
 
-	if id >= int64(len(c.events)) || id < 0 {
+	e := c.find(id)
+	if e == nil {
 		return fmt.Errorf("cannot update event because cannot find by id: %d", id)
 	}
 
-	event := c.events[id].GetEvent()
+	event := e.GetEvent()
 	proto.Merge(proto.MessageV1(event), proto.MessageV1(update))
 	return nil
 }
 
-func (c *client) eventsInRange(ctx context.Context, start time.Time, end *time.Time) []*auditv1.Event {
+func (c *client) eventsInRange(ctx context.Context, start time.Time, end *time.Time, filter *auditv1.EventFilter, after *storage.Cursor) []*auditv1.Event {
 	c.RLock()
 	defer c.RUnlock()
 
@@ -91,7 +154,7 @@ func (c *client) eventsInRange(ctx context.Context, start time.Time, end *time.T
 	events := make([]*auditv1.Event, 0, len(c.events))
 	for _, value := range c.events {
 		t := value.OccurredAt.AsTime()
-		if start.Before(t) && stop.After(t) {
+		if start.Before(t) && stop.After(t) && storage.MatchesFilter(value, filter) && after.Precedes(value) {
 			events = append(events, value)
 		}
 	}
@@ -100,7 +163,14 @@ func (c *client) eventsInRange(ctx context.Context, start time.Time, end *time.T
 
 // Does a full scan through and copies those with a timestamp that fits the bill.
 func (c *client) ReadEvents(ctx context.Context, start time.Time, end *time.Time, options *storage.ReadOptions) ([]*auditv1.Event, error) {
-	events := c.eventsInRange(ctx, start, end)
+	var filter *auditv1.EventFilter
+	var after *storage.Cursor
+	if options != nil {
+		filter = options.Filter
+		after = options.After
+	}
+
+	events := c.eventsInRange(ctx, start, end, filter, after)
 	if options != nil {
 		if options.Offset > int64(len(events)) {
 			return []*auditv1.Event{}, nil
@@ -125,11 +195,12 @@ func (c *client) ReadEvent(ctx context.Context, id int64) (*auditv1.Event, error
 	c.RLock()
 	defer c.RUnlock()
 
-	if id >= int64(len(c.events)) || id < 0 {
+	event := c.find(id)
+	if event == nil {
 		return nil, fmt.Errorf("cannot find event by id: %d", id)
 	}
 
-	return c.events[id], nil
+	return event, nil
 }
 
 func (c *client) AttemptLock(ctx context.Context, lockID uint32) (bool, error) {
@@ -22,18 +22,18 @@ func TestLocalstorage(t *testing.T) {
 	log := zaptest.NewLogger(t)
 	scope := tally.NewTestScope("", nil)
 
-	storage, err := New(cfg, log, scope)
+	s, err := New(cfg, log, scope)
 	assert.Nil(t, err)
 
 	// No unsent events.
-	unsent, err := storage.UnsentEvents(context.Background())
+	unsent, err := s.UnsentEvents(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, 0, len(unsent))
 
 	// Assert that later elements will have a larger ID.
-	first, err := storage.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
+	first, err := s.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
 	assert.NoError(t, err)
-	second, err := storage.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
+	second, err := s.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
 	assert.NoError(t, err)
 	assert.Greater(t, second, first)
 
@@ -41,30 +41,46 @@ func TestLocalstorage(t *testing.T) {
 	diff := &auditv1.RequestEvent{
 		Username: "foobar",
 	}
-	err = storage.UpdateRequestEvent(context.Background(), first, diff)
+	err = s.UpdateRequestEvent(context.Background(), first, diff)
 	assert.NoError(t, err)
 
-	events, err := storage.ReadEvents(context.Background(), time.Time{}, nil, nil)
+	events, err := s.ReadEvents(context.Background(), time.Time{}, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, 2, len(events))
 	assert.Equal(t, diff, events[0].GetEvent())
 
-	event, err := storage.ReadEvent(context.Background(), 0)
+	event, err := s.ReadEvent(context.Background(), 0)
 	assert.NoError(t, err)
 	assert.Equal(t, diff, event.GetEvent())
 
 	// Assert the expected events are unsent.
-	unsent, err = storage.UnsentEvents(context.Background())
+	unsent, err = s.UnsentEvents(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, 2, len(unsent))
 
-	// Assert storage is cleared after flushing.
-	unsent, err = storage.UnsentEvents(context.Background())
+	// Assert events remain unsent until they're done being delivered, keeping track of the sinks that wrote them.
+	assert.NoError(t, s.UpdateDeliveries(context.Background(), []*storage.Delivery{
+		{ID: first, SentSinks: []string{"logger"}, Failed: true},
+		{ID: second, SentSinks: []string{"logger", "webhook"}, Done: true},
+	}))
+	unsent, err = s.UnsentEvents(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(unsent))
+	assert.Equal(t, first, unsent[0].Event.Id)
+	assert.Equal(t, []string{"logger"}, unsent[0].SentSinks)
+	assert.Equal(t, 1, unsent[0].FailedAttempts)
+
+	// Assert events are removed from memory once they're done, even while others are still unsent.
+	_, err = s.ReadEvent(context.Background(), second)
+	assert.Error(t, err)
+
+	assert.NoError(t, s.UpdateDeliveries(context.Background(), []*storage.Delivery{{ID: first, Done: true}}))
+	unsent, err = s.UnsentEvents(context.Background())
 	assert.NoError(t, err)
 	assert.Equal(t, 0, len(unsent))
 
 	// Assert updating will return an error.
-	err = storage.UpdateRequestEvent(context.Background(), first, &auditv1.RequestEvent{})
+	err = s.UpdateRequestEvent(context.Background(), first, &auditv1.RequestEvent{})
 	assert.Equal(t, errors.New("cannot update event because cannot find by id: 0"), err)
 }
 
@@ -117,6 +133,13 @@ func TestReadEvents(t *testing.T) {
 			},
 			expectedCount: 2,
 		},
+		{
+			id: "returns events matching the filter",
+			options: &storage.ReadOptions{
+				Filter: &auditv1.EventFilter{Username: "alice"},
+			},
+			expectedCount: 1,
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.id, func(t *testing.T) {
@@ -129,9 +152,9 @@ func TestReadEvents(t *testing.T) {
 			storage, err := New(cfg, log, scope)
 			assert.Nil(t, err)
 
-			_, err = storage.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
+			_, err = storage.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{Username: "alice"})
 			assert.NoError(t, err)
-			_, err = storage.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
+			_, err = storage.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{Username: "bob"})
 			assert.NoError(t, err)
 			time.Sleep(100 * time.Millisecond)
 
@@ -182,3 +205,35 @@ func TestReadEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestReadEventsAfterCursor(t *testing.T) {
+	cfg := &auditconfigv1.Config{
+		StorageProvider: &auditconfigv1.Config_InMemory{InMemory: true},
+	}
+	s, err := New(cfg, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err = s.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
+		assert.NoError(t, err)
+	}
+	time.Sleep(100 * time.Millisecond)
+
+	start := time.Now().Add(-5 * time.Minute)
+	first, err := s.ReadEvents(context.Background(), start, nil, &storage.ReadOptions{Limit: 2})
+	assert.NoError(t, err)
+	assert.Len(t, first, 2)
+
+	// Events written after the first page was read neither shift the next page nor reappear in it.
+	_, err = s.WriteRequestEvent(context.Background(), &auditv1.RequestEvent{})
+	assert.NoError(t, err)
+
+	next, err := s.ReadEvents(context.Background(), start, nil, &storage.ReadOptions{
+		Limit: 2,
+		After: storage.CursorOf(first[1]),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, next, 2)
+	assert.Equal(t, int64(2), next[0].Id)
+	assert.Equal(t, int64(3), next[1].Id)
+}
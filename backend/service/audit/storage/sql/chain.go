@@ -0,0 +1,229 @@
+package sql
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/proto"
+
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	"github.com/lyft/clutch/backend/service/audit/storage"
+)
+
+var _ storage.HashChainer = (*client)(nil)
+
+const (
+	// Events are chained once their response is recorded. Events whose response is never recorded, e.g. because the
+	// gateway stopped while handling the request, are chained after this delay so that they don't hold up the chain.
+	incompleteEventChainDelay = time.Hour
+
+	// The number of events chained or verified at a time.
+	chainBatchSize = 500
+)
+
+// A link of the chain, i.e. an event along with its position in the chain and its stored hash.
+type chainLink struct {
+	event *auditv1.Event
+	index int64
+	hash  []byte
+}
+
+// Returns the hash of an event chained after an event with the given hash. Events are hashed using their
+// deterministic proto encoding, which is the same regardless of how the event is stored.
+func chainHash(previous []byte, event *auditv1.Event) ([]byte, error) {
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	h := sha256.New()
+	h.Write(previous)
+	h.Write(b)
+	return h.Sum(nil), nil
+}
+
+func (c *client) ChainEvents(ctx context.Context) (int, error) {
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	// Rolling back is a no-op once the transaction is committed.
+	defer tx.Rollback()
+
+	var index int64
+	var previous []byte
+	const lastLinkStatement = `
+		SELECT chain_index, hash FROM audit_events
+		WHERE chain_index IS NOT NULL
+		ORDER BY chain_index DESC
+		LIMIT 1
+	`
+	err = tx.QueryRowContext(ctx, lastLinkStatement).Scan(&index, &previous)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return 0, err
+	}
+
+	// The rows are locked so that their responses can't be recorded while they're being chained.
+	const unchainedEventsStatement = `
+		SELECT id, occurred_at, details FROM audit_events
+		WHERE chain_index IS NULL AND (completed OR occurred_at < $1::timestamp)
+		ORDER BY id
+		LIMIT $2
+		FOR UPDATE
+	`
+	rows, err := tx.QueryContext(ctx, unchainedEventsStatement, time.Now().Add(-incompleteEventChainDelay), chainBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	var events []*auditv1.Event
+	for rows.Next() {
+		event, err := c.scanEvent(rows)
+		if err != nil {
+			rows.Close()
+			return 0, err
+		}
+		events = append(events, event)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	const chainEventStatement = `UPDATE audit_events SET chain_index = $2, hash = $3 WHERE id = $1`
+	for _, event := range events {
+		hash, err := chainHash(previous, event)
+		if err != nil {
+			return 0, err
+		}
+
+		index++
+		if _, err := tx.ExecContext(ctx, chainEventStatement, event.Id, index, hash); err != nil {
+			return 0, err
+		}
+		previous = hash
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+func (c *client) VerifyChain(ctx context.Context, start time.Time, end time.Time) (*auditv1.VerifyAuditChainResponse, error) {
+	resp := &auditv1.VerifyAuditChainResponse{}
+
+	var first, last sql.NullInt64
+	const chainRangeStatement = `
+		SELECT COUNT(*) FILTER (WHERE chain_index IS NULL), MIN(chain_index), MAX(chain_index) FROM audit_events
+		WHERE occurred_at BETWEEN $1::timestamp AND $2::timestamp
+	`
+	err := c.db.QueryRowContext(ctx, chainRangeStatement, start, end).Scan(&resp.UnchainedEvents, &first, &last)
+	if err != nil {
+		return nil, err
+	}
+	if !first.Valid {
+		return resp, nil
+	}
+
+	// The first link is verified using the hash of the link before it, which may be outside of the timerange.
+	var previous []byte
+	previousMissing := false
+	if first.Int64 > 1 {
+		const hashStatement = `SELECT hash FROM audit_events WHERE chain_index = $1`
+		err := c.db.QueryRowContext(ctx, hashStatement, first.Int64-1).Scan(&previous)
+		if errors.Is(err, sql.ErrNoRows) {
+			previousMissing = true
+		} else if err != nil {
+			return nil, err
+		}
+	}
+
+	// Links are walked by their position, rather than by the time of their events, so that events deleted from
+	// within the timerange are detected as gaps in the chain.
+	next := first.Int64
+	for next <= last.Int64 {
+		links, err := c.chainLinks(ctx, next, last.Int64)
+		if err != nil {
+			return nil, err
+		}
+		if len(links) == 0 {
+			return nil, fmt.Errorf("cannot find chain links from index %d", next)
+		}
+
+		for _, link := range links {
+			reason := ""
+			switch {
+			case link.index != next:
+				reason = missingLinks(next, link.index-1)
+			case previousMissing:
+				reason = missingLinks(next-1, next-1)
+			}
+
+			if reason == "" {
+				hash, err := chainHash(previous, link.event)
+				if err != nil {
+					return nil, err
+				}
+				if !bytes.Equal(hash, link.hash) {
+					reason = "the stored hash doesn't match the event and the hash of the link before it"
+				}
+			}
+
+			if reason != "" {
+				resp.FirstBrokenLink = &auditv1.BrokenChainLink{
+					EventId:    link.event.Id,
+					ChainIndex: link.index,
+					OccurredAt: link.event.OccurredAt,
+					Reason:     reason,
+				}
+				return resp, nil
+			}
+
+			resp.VerifiedEvents++
+			previous = link.hash
+			next++
+		}
+	}
+
+	return resp, nil
+}
+
+func missingLinks(from int64, to int64) string {
+	if from == to {
+		return fmt.Sprintf("link %d of the chain is missing", from)
+	}
+	return fmt.Sprintf("links %d to %d of the chain are missing", from, to)
+}
+
+// Returns the links of the chain from one index to another, in order, up to the batch size.
+func (c *client) chainLinks(ctx context.Context, from int64, to int64) ([]*chainLink, error) {
+	const chainLinksStatement = `
+		SELECT id, occurred_at, details, chain_index, hash FROM audit_events
+		WHERE chain_index BETWEEN $1 AND $2
+		ORDER BY chain_index
+		LIMIT $3
+	`
+	rows, err := c.db.QueryContext(ctx, chainLinksStatement, from, to, chainBatchSize)
+	if err != nil {
+		c.logger.Error("error querying db", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var links []*chainLink
+	for rows.Next() {
+		link := &chainLink{}
+		link.event, err = c.scanEvent(rows, &link.index, &link.hash)
+		if err != nil {
+			return nil, err
+		}
+		links = append(links, link)
+	}
+	return links, rows.Err()
+}
@@ -0,0 +1,109 @@
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/lyft/clutch/backend/mock/service/dbmock"
+)
+
+// Matches any hash, recording it.
+type hashArg struct {
+	hash []byte
+}
+
+func (a *hashArg) Match(v driver.Value) bool {
+	a.hash, _ = v.([]byte)
+	return a.hash != nil
+}
+
+func TestHashChain(t *testing.T) {
+	dbm := dbmock.NewMockDB()
+	c := &client{db: dbm.DB(), logger: zaptest.NewLogger(t)}
+
+	occurred := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	details := []string{`{"user_name": "alice", "method_name": "DeletePod"}`, `{"user_name": "bob", "method_name": "ResizeHPA"}`}
+	eventColumns := []string{"id", "occurred_at", "details"}
+	linkColumns := []string{"id", "occurred_at", "details", "chain_index", "hash"}
+
+	// Events are chained after the last link of the chain.
+	first, second := &hashArg{}, &hashArg{}
+	dbm.Mock.ExpectBegin()
+	dbm.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT chain_index, hash FROM audit_events`)).
+		WillReturnRows(sqlmock.NewRows([]string{"chain_index", "hash"}).AddRow(6, []byte("previous")))
+	dbm.Mock.ExpectQuery(regexp.QuoteMeta(`WHERE chain_index IS NULL AND (completed OR occurred_at < $1::timestamp)`)).
+		WithArgs(sqlmock.AnyArg(), chainBatchSize).
+		WillReturnRows(sqlmock.NewRows(eventColumns).AddRow(10, occurred, details[0]).AddRow(11, occurred, details[1]))
+	dbm.Mock.ExpectExec(regexp.QuoteMeta(`UPDATE audit_events SET chain_index = $2, hash = $3 WHERE id = $1`)).
+		WithArgs(10, 7, first).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbm.Mock.ExpectExec(regexp.QuoteMeta(`UPDATE audit_events SET chain_index = $2, hash = $3 WHERE id = $1`)).
+		WithArgs(11, 8, second).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbm.Mock.ExpectCommit()
+
+	n, err := c.ChainEvents(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+	assert.NotEqual(t, first.hash, second.hash)
+
+	start, end := occurred.Add(-time.Minute), occurred.Add(time.Minute)
+	expectVerify := func(links *sqlmock.Rows) {
+		dbm.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FILTER (WHERE chain_index IS NULL), MIN(chain_index), MAX(chain_index)`)).
+			WithArgs(start, end).
+			WillReturnRows(sqlmock.NewRows([]string{"count", "min", "max"}).AddRow(1, 7, 8))
+		dbm.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT hash FROM audit_events WHERE chain_index = $1`)).
+			WithArgs(6).
+			WillReturnRows(sqlmock.NewRows([]string{"hash"}).AddRow([]byte("previous")))
+		dbm.Mock.ExpectQuery(regexp.QuoteMeta(`WHERE chain_index BETWEEN $1 AND $2`)).
+			WithArgs(7, 8, chainBatchSize).
+			WillReturnRows(links)
+	}
+
+	// The chain verifies if the events are unchanged.
+	expectVerify(sqlmock.NewRows(linkColumns).
+		AddRow(10, occurred, details[0], 7, first.hash).
+		AddRow(11, occurred, details[1], 8, second.hash))
+	resp, err := c.VerifyChain(context.Background(), start, end)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 2, resp.VerifiedEvents)
+	assert.EqualValues(t, 1, resp.UnchainedEvents)
+	assert.Nil(t, resp.FirstBrokenLink)
+
+	// Edited events break the chain.
+	expectVerify(sqlmock.NewRows(linkColumns).
+		AddRow(10, occurred, details[0], 7, first.hash).
+		AddRow(11, occurred, `{"user_name": "mallory", "method_name": "ResizeHPA"}`, 8, second.hash))
+	resp, err = c.VerifyChain(context.Background(), start, end)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, resp.VerifiedEvents)
+	assert.EqualValues(t, 11, resp.FirstBrokenLink.EventId)
+	assert.EqualValues(t, 8, resp.FirstBrokenLink.ChainIndex)
+
+	// Deleted events leave a gap in the chain.
+	expectVerify(sqlmock.NewRows(linkColumns).
+		AddRow(11, occurred, details[1], 8, second.hash))
+	resp, err = c.VerifyChain(context.Background(), start, end)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, resp.VerifiedEvents)
+	assert.EqualValues(t, 11, resp.FirstBrokenLink.EventId)
+	assert.Equal(t, "link 7 of the chain is missing", resp.FirstBrokenLink.Reason)
+
+	// Nothing is verified if no events in the timerange are chained.
+	dbm.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT COUNT(*) FILTER (WHERE chain_index IS NULL), MIN(chain_index), MAX(chain_index)`)).
+		WithArgs(start, end).
+		WillReturnRows(sqlmock.NewRows([]string{"count", "min", "max"}).AddRow(3, nil, nil))
+	resp, err = c.VerifyChain(context.Background(), start, end)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, resp.VerifiedEvents)
+	assert.EqualValues(t, 3, resp.UnchainedEvents)
+
+	dbm.MustMeetExpectations()
+}
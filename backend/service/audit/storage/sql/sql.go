@@ -6,8 +6,10 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
@@ -103,12 +105,14 @@ func (c *client) UpdateRequestEvent(ctx context.Context, id int64, update *audit
 		return err
 	}
 
+	// Events can't be updated once they're chained, since that would break the chain.
 	const updateEventStatement = `
 		UPDATE audit_events
-		SET details = details || $2::jsonb
-		WHERE id = $1
+		SET details = details || $2::jsonb, completed = TRUE
+		WHERE id = $1 AND chain_index IS NULL
     `
-	if _, err = c.db.ExecContext(ctx, updateEventStatement, id, blob); err != nil {
+	result, err := c.db.ExecContext(ctx, updateEventStatement, id, blob)
+	if err != nil {
 		c.logger.Warn(
 			"error updating audit row",
 			zap.Int64("row_id", id),
@@ -118,50 +122,165 @@ func (c *client) UpdateRequestEvent(ctx context.Context, id int64, update *audit
 		return err
 	}
 
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		c.logger.Warn(
+			"audit row was not updated because it doesn't exist or is already chained",
+			zap.Int64("row_id", id),
+			log.ProtoField("event", update),
+		)
+	}
+
 	return nil
 }
 
-func (c *client) UnsentEvents(ctx context.Context) ([]*auditv1.Event, error) {
+func (c *client) UnsentEvents(ctx context.Context) ([]*storage.UnsentEvent, error) {
 	const unsentEventsQuery = `
-		UPDATE audit_events
-		SET sent = TRUE
-		WHERE id IN (SELECT id FROM audit_events WHERE sent = FALSE LIMIT 100)
-		RETURNING id, occurred_at, details;
+		SELECT id, occurred_at, details, sent_sinks, failed_deliveries FROM audit_events
+		WHERE sent = FALSE
+		ORDER BY id
+		LIMIT 100
 	`
 
-	return c.query(ctx, unsentEventsQuery)
+	rows, err := c.db.QueryContext(ctx, unsentEventsQuery)
+	if err != nil {
+		c.logger.Error("error querying db", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*storage.UnsentEvent
+	for rows.Next() {
+		unsent := &storage.UnsentEvent{}
+		unsent.Event, err = c.scanEvent(rows, pq.Array(&unsent.SentSinks), &unsent.FailedAttempts)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, unsent)
+	}
+
+	return events, rows.Err()
 }
 
-func (c *client) ReadEvents(ctx context.Context, start time.Time, end *time.Time, options *storage.ReadOptions) ([]*auditv1.Event, error) {
-	readEventsRangeStatement := `
-		SELECT id, occurred_at, details FROM audit_events
-		WHERE occurred_at BETWEEN $1::timestamp AND $2::timestamp
-		ORDER BY id
+func (c *client) UpdateDeliveries(ctx context.Context, deliveries []*storage.Delivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	// Rolling back is a no-op once the transaction is committed.
+	defer tx.Rollback()
+
+	const updateDeliveryStatement = `
+		UPDATE audit_events
+		SET sent_sinks = $2, failed_deliveries = failed_deliveries + $3, sent = $4
+		WHERE id = $1
 	`
+	for _, d := range deliveries {
+		failed := 0
+		if d.Failed {
+			failed = 1
+		}
+		sinks := d.SentSinks
+		if sinks == nil {
+			sinks = []string{}
+		}
+		if _, err := tx.ExecContext(ctx, updateDeliveryStatement, d.ID, pq.Array(sinks), failed, d.Done); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
 
+func (c *client) ReadEvents(ctx context.Context, start time.Time, end *time.Time, options *storage.ReadOptions) ([]*auditv1.Event, error) {
 	endTime := time.Now()
 	if end != nil {
 		endTime = *end
 	}
 
 	args := []interface{}{start, endTime}
+	conditions := []string{`occurred_at BETWEEN $1::timestamp AND $2::timestamp`}
+	if options != nil && options.Filter != nil {
+		conditions, args = filterConditions(options.Filter, conditions, args)
+	}
+	if options != nil && options.After != nil {
+		// Keyset pagination, which uses the index on (occurred_at, id) instead of scanning the skipped events.
+		args = append(args, options.After.OccurredAt, options.After.ID)
+		conditions = append(conditions, fmt.Sprintf(`(occurred_at, id) > ($%d, $%d)`, len(args)-1, len(args)))
+	}
+
+	readEventsRangeStatement := fmt.Sprintf(`
+		SELECT id, occurred_at, details FROM audit_events
+		WHERE %s
+		ORDER BY occurred_at, id
+	`, strings.Join(conditions, " AND "))
+
 	if options != nil {
-		switch {
-		case options.Limit != 0 && options.Offset != 0:
-			readEventsRangeStatement = fmt.Sprintf(`%s LIMIT $3 OFFSET $4`, readEventsRangeStatement)
-			args = append(args, options.Limit, options.Offset)
-		case options.Limit != 0:
-			readEventsRangeStatement = fmt.Sprintf(`%s LIMIT $3`, readEventsRangeStatement)
+		if options.Limit != 0 {
 			args = append(args, options.Limit)
-		case options.Offset != 0:
-			readEventsRangeStatement = fmt.Sprintf(`%s OFFSET $4`, readEventsRangeStatement)
+			readEventsRangeStatement = fmt.Sprintf(`%s LIMIT $%d`, readEventsRangeStatement, len(args))
+		}
+		if options.Offset != 0 {
 			args = append(args, options.Offset)
+			readEventsRangeStatement = fmt.Sprintf(`%s OFFSET $%d`, readEventsRangeStatement, len(args))
 		}
 	}
 
 	return c.query(ctx, readEventsRangeStatement, args...)
 }
 
+// Appends the conditions of the filters that are set to the WHERE clause of a query, along with their arguments.
+// Usernames, services and methods are compared with the expressions of the indexes on them, resource types are matched
+// by containment to use the GIN indexes on the resources.
+func filterConditions(filter *auditv1.EventFilter, conditions []string, args []interface{}) ([]string, []interface{}) {
+	add := func(condition string, arg interface{}) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, len(args)))
+	}
+
+	if filter.Username != "" {
+		add(`details->>'user_name' = $%d`, filter.Username)
+	}
+	if filter.ServiceName != "" {
+		add(`details->>'service_name' = $%d`, filter.ServiceName)
+	}
+	if filter.MethodName != "" {
+		add(`details->>'method_name' = $%d`, filter.MethodName)
+	}
+	if filter.ActionType != apiv1.ActionType_UNSPECIFIED {
+		add(`details->>'type' = $%d`, filter.ActionType.String())
+	}
+	if filter.StatusCode != nil {
+		// The status of events is only recorded once their response is.
+		add(`completed AND (details->'status'->>'code')::int = $%d`, filter.StatusCode.Value)
+	}
+
+	if filter.ResourceTypeUrl != "" {
+		types, _ := json.Marshal([]*resource{{TypeUrl: filter.ResourceTypeUrl}})
+		add(`(details->'request_resources' @> $%[1]d::jsonb OR details->'response_resources' @> $%[1]d::jsonb)`, string(types))
+	}
+	if filter.ResourceTypeUrl != "" || filter.ResourceIdPrefix != "" {
+		typeUrl, idPrefix := len(args)+1, len(args)+2
+		args = append(args, filter.ResourceTypeUrl, likePrefix(filter.ResourceIdPrefix))
+		conditions = append(conditions, fmt.Sprintf(`EXISTS (
+			SELECT 1 FROM jsonb_array_elements(
+				COALESCE(details->'request_resources', '[]'::jsonb) || COALESCE(details->'response_resources', '[]'::jsonb)
+			) AS r
+			WHERE ($%[1]d = '' OR r->>'type_url' = $%[1]d) AND COALESCE(r->>'id', '') LIKE $%[2]d
+		)`, typeUrl, idPrefix))
+	}
+
+	return conditions, args
+}
+
+// Returns a LIKE pattern matching strings with the given prefix.
+func likePrefix(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix) + "%"
+}
+
 func (c *client) ReadEvent(ctx context.Context, id int64) (*auditv1.Event, error) {
 	const readEventsRangeStatement = `
 		SELECT id, occurred_at, details FROM audit_events
@@ -188,38 +307,46 @@ func (c *client) query(ctx context.Context, query string, args ...interface{}) (
 
 	var events []*auditv1.Event
 	for rows.Next() {
-		row := &event{
-			Details: &eventDetails{},
-		}
-		var blob []byte
-		if err := rows.Scan(&row.Id, &row.OccurredAt, &blob); err != nil {
-			c.logger.Error("error scanning db results", zap.Error(err))
+		proto, err := c.scanEvent(rows)
+		if err != nil {
 			return nil, err
 		}
+		events = append(events, proto)
+	}
 
-		if err := json.Unmarshal(blob, row.Details); err != nil {
-			c.logger.Error("unmarshallable blob in db result", zap.Error(err))
-			return nil, err
-		}
+	return events, nil
+}
 
-		occurred := timestamppb.New(row.OccurredAt)
-		if err := occurred.CheckValid(); err != nil {
-			c.logger.Error("error in parsing db result's timestamp", zap.Error(err))
-			return nil, err
-		}
+// Scans a row of the id, occurred_at and details columns of an event, followed by any other columns.
+func (c *client) scanEvent(rows *sql.Rows, columns ...interface{}) (*auditv1.Event, error) {
+	row := &event{
+		Details: &eventDetails{},
+	}
+	var blob []byte
+	if err := rows.Scan(append([]interface{}{&row.Id, &row.OccurredAt, &blob}, columns...)...); err != nil {
+		c.logger.Error("error scanning db results", zap.Error(err))
+		return nil, err
+	}
 
-		proto := &auditv1.Event{
-			// n.b. this is a safe casting since BIGSERIAL can only reach the max value for signed int64.
-			Id:         int64(row.Id), //nolint
-			OccurredAt: occurred,
-			EventType: &auditv1.Event_Event{
-				Event: requestEventProto(c.logger, row),
-			},
-		}
-		events = append(events, proto)
+	if err := json.Unmarshal(blob, row.Details); err != nil {
+		c.logger.Error("unmarshallable blob in db result", zap.Error(err))
+		return nil, err
 	}
 
-	return events, nil
+	occurred := timestamppb.New(row.OccurredAt)
+	if err := occurred.CheckValid(); err != nil {
+		c.logger.Error("error in parsing db result's timestamp", zap.Error(err))
+		return nil, err
+	}
+
+	return &auditv1.Event{
+		// n.b. this is a safe casting since BIGSERIAL can only reach the max value for signed int64.
+		Id:         int64(row.Id), //nolint
+		OccurredAt: occurred,
+		EventType: &auditv1.Event_Event{
+			Event: requestEventProto(c.logger, row),
+		},
+	}, nil
 }
 
 // We create our own connection to use for acquiring the advisory lock.
@@ -1,15 +1,25 @@
 package sql
 
 import (
+	"context"
 	"encoding/json"
+	"regexp"
 	"testing"
+	"time"
 
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
 	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
 	k8sapiv1 "github.com/lyft/clutch/backend/api/k8s/v1"
 	"github.com/lyft/clutch/backend/mock/service/dbmock"
+	"github.com/lyft/clutch/backend/service/audit/storage"
 )
 
 func TestConvertAPIBody(t *testing.T) {
@@ -77,3 +87,89 @@ func TestGetAdvisoryConn(t *testing.T) {
 	assert.NotNil(t, conn)
 	assert.NotNil(t, c.advisoryLockConn)
 }
+
+func TestReadEventsWithFilter(t *testing.T) {
+	dbm := dbmock.NewMockDB()
+	c := &client{db: dbm.DB(), logger: zaptest.NewLogger(t)}
+
+	start := time.Now().Add(-time.Hour)
+	end := time.Now()
+	columns := []string{"id", "occurred_at", "details"}
+
+	dbm.Mock.ExpectQuery(regexp.QuoteMeta(`WHERE occurred_at BETWEEN $1::timestamp AND $2::timestamp AND `+
+		`details->>'user_name' = $3 AND details->>'type' = $4 AND completed AND (details->'status'->>'code')::int = $5 AND `+
+		`(details->'request_resources' @> $6::jsonb OR details->'response_resources' @> $6::jsonb) AND EXISTS (`)).
+		WithArgs(start, end, "alice", "UPDATE", int32(0), `[{"type_url":"clutch.k8s.v1.Pod"}]`, "clutch.k8s.v1.Pod", `cluster-a/default/web\_%`, int64(10), int64(20)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(1, end, `{"user_name": "alice", "type": "UPDATE", "status": {"code": 0}}`))
+
+	events, err := c.ReadEvents(context.Background(), start, &end, &storage.ReadOptions{
+		Limit:  10,
+		Offset: 20,
+		Filter: &auditv1.EventFilter{
+			Username:         "alice",
+			ActionType:       apiv1.ActionType_UPDATE,
+			StatusCode:       wrapperspb.Int32(0),
+			ResourceTypeUrl:  "clutch.k8s.v1.Pod",
+			ResourceIdPrefix: "cluster-a/default/web_",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, "alice", events[0].GetEvent().Username)
+
+	// Offsets without a limit use the next placeholder.
+	dbm.Mock.ExpectQuery(regexp.QuoteMeta(`ORDER BY occurred_at, id`)+`\s+OFFSET \$3`).
+		WithArgs(start, end, int64(5)).
+		WillReturnRows(sqlmock.NewRows(columns))
+
+	_, err = c.ReadEvents(context.Background(), start, &end, &storage.ReadOptions{Offset: 5})
+	assert.NoError(t, err)
+
+	// Cursors are compared with the keys of the rows.
+	cursor := &storage.Cursor{OccurredAt: start.Add(time.Minute), ID: 42}
+	dbm.Mock.ExpectQuery(regexp.QuoteMeta(`AND (occurred_at, id) > ($3, $4)`)+`\s+`+regexp.QuoteMeta(`ORDER BY occurred_at, id`)+`\s+LIMIT \$5`).
+		WithArgs(start, end, cursor.OccurredAt, int64(42), int64(100)).
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(43, end, `{"user_name": "bob"}`))
+
+	events, err = c.ReadEvents(context.Background(), start, &end, &storage.ReadOptions{Limit: 100, After: cursor})
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, int64(43), events[0].Id)
+
+	dbm.MustMeetExpectations()
+}
+
+func TestUnsentEvents(t *testing.T) {
+	dbm := dbmock.NewMockDB()
+	c := &client{db: dbm.DB(), logger: zaptest.NewLogger(t)}
+
+	// Reading unsent events doesn't mark them as sent.
+	dbm.Mock.ExpectQuery(regexp.QuoteMeta(`SELECT id, occurred_at, details, sent_sinks, failed_deliveries FROM audit_events`) + `\s+WHERE sent = FALSE`).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "occurred_at", "details", "sent_sinks", "failed_deliveries"}).
+			AddRow(1, time.Now(), `{}`, `{logger}`, 2))
+
+	events, err := c.UnsentEvents(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, events, 1)
+	assert.Equal(t, []string{"logger"}, events[0].SentSinks)
+	assert.Equal(t, 2, events[0].FailedAttempts)
+
+	const updateDeliveryStatement = `SET sent_sinks = $2, failed_deliveries = failed_deliveries + $3, sent = $4`
+	dbm.Mock.ExpectBegin()
+	dbm.Mock.ExpectExec(regexp.QuoteMeta(updateDeliveryStatement)).
+		WithArgs(1, pq.Array([]string{"logger"}), 1, false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbm.Mock.ExpectExec(regexp.QuoteMeta(updateDeliveryStatement)).
+		WithArgs(2, pq.Array([]string{"logger", "webhook"}), 0, true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	dbm.Mock.ExpectCommit()
+	assert.NoError(t, c.UpdateDeliveries(context.Background(), []*storage.Delivery{
+		{ID: 1, SentSinks: []string{"logger"}, Failed: true},
+		{ID: 2, SentSinks: []string{"logger", "webhook"}, Done: true},
+	}))
+
+	// Nothing is updated without deliveries.
+	assert.NoError(t, c.UpdateDeliveries(context.Background(), nil))
+
+	dbm.MustMeetExpectations()
+}
@@ -2,6 +2,7 @@ package storage
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
@@ -10,17 +11,67 @@ import (
 type ReadOptions struct {
 	Offset int64
 	Limit  int64
+	// Only events matching the filter are read, if it's set.
+	Filter *auditv1.EventFilter
+	// Only events after the cursor are read, if it's set. Unlike offsets, cursors don't skip or repeat events when
+	// events are written between reads.
+	After *Cursor
+}
+
+// Cursor is the position of an event in the order in which events are read, i.e. by time of occurrence and then ID.
+type Cursor struct {
+	OccurredAt time.Time
+	ID         int64
+}
+
+// CursorOf returns the position of an event.
+func CursorOf(event *auditv1.Event) *Cursor {
+	return &Cursor{OccurredAt: event.OccurredAt.AsTime(), ID: event.Id}
+}
+
+// Precedes returns whether an event comes after the cursor. Storage that can't compare cursors while reading events
+// can use it to skip events in memory.
+func (c *Cursor) Precedes(event *auditv1.Event) bool {
+	if c == nil {
+		return true
+	}
+
+	t := event.OccurredAt.AsTime()
+	return t.After(c.OccurredAt) || (t.Equal(c.OccurredAt) && event.Id > c.ID)
+}
+
+// UnsentEvent is an event that still has to be written to some of the sinks.
+type UnsentEvent struct {
+	Event *auditv1.Event
+	// The names of the sinks the event was already written to.
+	SentSinks []string
+	// The number of deliveries of the event that failed for at least one sink.
+	FailedAttempts int
+}
+
+// Delivery is the outcome of writing an unsent event to the sinks it had not been written to.
+type Delivery struct {
+	ID int64
+	// The names of the sinks the event was written to, including by previous deliveries.
+	SentSinks []string
+	// Whether writing the event to any of the sinks failed.
+	Failed bool
+	// Whether the event is done being delivered, either because it was written to every sink or because it ran out
+	// of attempts.
+	Done bool
 }
 
 type Storage interface {
-	// Used to get un-sent events.
-	UnsentEvents(ctx context.Context) ([]*auditv1.Event, error)
+	// Used to get un-sent events, oldest first. Events remain un-sent until a delivery is done, so that events that
+	// failed to be written to a sink are returned again.
+	UnsentEvents(ctx context.Context) ([]*UnsentEvent, error)
+	UpdateDeliveries(ctx context.Context, deliveries []*Delivery) error
 
 	// Calls used by middleware to persist events during requests.
 	WriteRequestEvent(ctx context.Context, req *auditv1.RequestEvent) (int64, error)
 	UpdateRequestEvent(ctx context.Context, id int64, update *auditv1.RequestEvent) error
 
-	// Used for services and modules to read past events within a timerange.
+	// Used for services and modules to read past events within a timerange, ordered by time of occurrence and ID.
 	// If end is nil, should search until the current time.
 	ReadEvents(ctx context.Context, start time.Time, end *time.Time, options *ReadOptions) ([]*auditv1.Event, error)
 
@@ -30,3 +81,50 @@ type Storage interface {
 	AttemptLock(ctx context.Context, lockID uint32) (bool, error)
 	ReleaseLock(ctx context.Context, lockID uint32) (bool, error)
 }
+
+// HashChainer is implemented by storage that can chain the hashes of events, to make edits and deletions of stored
+// events evident. Each event is hashed along with the hash of the event before it in the chain.
+type HashChainer interface {
+	// Adds the events that are complete to the chain, returning the number of events that were added.
+	ChainEvents(ctx context.Context) (int, error)
+
+	// Verifies the links of the chain of the events that occurred within a timerange, up to the first broken link.
+	VerifyChain(ctx context.Context, start time.Time, end time.Time) (*auditv1.VerifyAuditChainResponse, error)
+}
+
+// MatchesFilter returns whether an event matches every filter that is set. Storage that can't filter events while
+// reading them can use it to filter events in memory.
+func MatchesFilter(event *auditv1.Event, filter *auditv1.EventFilter) bool {
+	if filter == nil {
+		return true
+	}
+
+	req := event.GetEvent()
+	if req == nil {
+		return false
+	}
+
+	switch {
+	case filter.Username != "" && req.Username != filter.Username:
+		return false
+	case filter.ServiceName != "" && req.ServiceName != filter.ServiceName:
+		return false
+	case filter.MethodName != "" && req.MethodName != filter.MethodName:
+		return false
+	case filter.ActionType != 0 && req.Type != filter.ActionType:
+		return false
+	case filter.StatusCode != nil && (req.Status == nil || req.Status.Code != filter.StatusCode.Value):
+		// Events that are still in flight have no status yet.
+		return false
+	}
+
+	if filter.ResourceTypeUrl == "" && filter.ResourceIdPrefix == "" {
+		return true
+	}
+	for _, r := range req.Resources {
+		if (filter.ResourceTypeUrl == "" || r.TypeUrl == filter.ResourceTypeUrl) && strings.HasPrefix(r.Id, filter.ResourceIdPrefix) {
+			return true
+		}
+	}
+	return false
+}
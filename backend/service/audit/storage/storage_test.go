@@ -0,0 +1,83 @@
+package storage
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	apiv1 "github.com/lyft/clutch/backend/api/api/v1"
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+)
+
+func TestMatchesFilter(t *testing.T) {
+	event := &auditv1.Event{EventType: &auditv1.Event_Event{Event: &auditv1.RequestEvent{
+		Username:    "alice",
+		ServiceName: "clutch.k8s.v1.K8sAPI",
+		MethodName:  "DeletePod",
+		Type:        apiv1.ActionType_DELETE,
+		Status:      &status.Status{Code: 7},
+		Resources:   []*auditv1.Resource{{TypeUrl: "clutch.k8s.v1.Pod", Id: "cluster-a/default/web-1"}},
+	}}}
+
+	tests := []struct {
+		id      string
+		filter  *auditv1.EventFilter
+		matches bool
+	}{
+		{id: "no filter", filter: nil, matches: true},
+		{id: "empty filter", filter: &auditv1.EventFilter{}, matches: true},
+		{id: "username", filter: &auditv1.EventFilter{Username: "alice"}, matches: true},
+		{id: "other username", filter: &auditv1.EventFilter{Username: "bob"}, matches: false},
+		{id: "service and method", filter: &auditv1.EventFilter{ServiceName: "clutch.k8s.v1.K8sAPI", MethodName: "DeletePod"}, matches: true},
+		{id: "other method", filter: &auditv1.EventFilter{ServiceName: "clutch.k8s.v1.K8sAPI", MethodName: "ListPods"}, matches: false},
+		{id: "action type", filter: &auditv1.EventFilter{ActionType: apiv1.ActionType_DELETE}, matches: true},
+		{id: "other action type", filter: &auditv1.EventFilter{ActionType: apiv1.ActionType_READ}, matches: false},
+		{id: "status code", filter: &auditv1.EventFilter{StatusCode: wrapperspb.Int32(7)}, matches: true},
+		{id: "successful status code", filter: &auditv1.EventFilter{StatusCode: wrapperspb.Int32(0)}, matches: false},
+		{id: "resource id prefix", filter: &auditv1.EventFilter{ResourceTypeUrl: "clutch.k8s.v1.Pod", ResourceIdPrefix: "cluster-a/"}, matches: true},
+		{id: "resource id prefix of any type", filter: &auditv1.EventFilter{ResourceIdPrefix: "cluster-a/"}, matches: true},
+		{id: "other resource id prefix", filter: &auditv1.EventFilter{ResourceTypeUrl: "clutch.k8s.v1.Pod", ResourceIdPrefix: "cluster-b/"}, matches: false},
+		{id: "other resource type", filter: &auditv1.EventFilter{ResourceTypeUrl: "clutch.k8s.v1.Deployment"}, matches: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.matches, MatchesFilter(event, tt.filter))
+		})
+	}
+}
+
+func TestMatchesFilterInFlight(t *testing.T) {
+	event := &auditv1.Event{EventType: &auditv1.Event_Event{Event: &auditv1.RequestEvent{Username: "alice"}}}
+
+	assert.True(t, MatchesFilter(event, &auditv1.EventFilter{Username: "alice"}))
+	assert.False(t, MatchesFilter(event, &auditv1.EventFilter{StatusCode: wrapperspb.Int32(0)}))
+
+	event.GetEvent().Status = &status.Status{}
+	assert.True(t, MatchesFilter(event, &auditv1.EventFilter{StatusCode: wrapperspb.Int32(0)}))
+}
+
+func TestCursorPrecedes(t *testing.T) {
+	now := time.Now().UTC()
+	cursor := &Cursor{OccurredAt: now, ID: 5}
+
+	event := func(occurredAt time.Time, id int64) *auditv1.Event {
+		return &auditv1.Event{OccurredAt: timestamppb.New(occurredAt), Id: id}
+	}
+
+	assert.True(t, cursor.Precedes(event(now.Add(time.Second), 1)))
+	assert.True(t, cursor.Precedes(event(now, 6)))
+	assert.False(t, cursor.Precedes(event(now, 5)))
+	assert.False(t, cursor.Precedes(event(now.Add(-time.Second), 9)))
+
+	// Every event comes after a nil cursor.
+	var none *Cursor
+	assert.True(t, none.Precedes(event(now, 0)))
+	assert.Equal(t, cursor, CursorOf(event(now, 5)))
+}
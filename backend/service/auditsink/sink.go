@@ -1,17 +1,37 @@
 package auditsink
 
 import (
+	"context"
+
 	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
 	configv1 "github.com/lyft/clutch/backend/api/config/service/audit/v1"
 )
 
 // Required functions to register successfully with the configured
 // Auditor in order to process audit events.
+//
+// Events that a sink fails to write are written to that sink again later, up to
+// the configured number of attempts. Sinks may be given the same event more than
+// once, e.g. if the auditor fails to record that an event was written.
 type Sink interface {
 	// Write an event out to whatever this sinks into.
 	Write(event *auditv1.Event) error
 }
 
+// BatchSink is implemented by sinks that can write several events at once,
+// e.g. to reduce the number of requests to a remote system.
+type BatchSink interface {
+	Sink
+
+	// The maximum number of events to write at once. Events are written one
+	// at a time if it's not greater than 1.
+	BatchSize() int
+	// Write a batch of events, giving up once the context is done. The batch
+	// fails as a whole if there's an error. The auditor writes single events
+	// with it too, so that writes are bounded by the context.
+	WriteBatch(ctx context.Context, events []*auditv1.Event) error
+}
+
 // Returns true if the filter matched the event, false if not.
 // Because of how it interprets the denylist flag, auditors or sinks
 // should check if auditsink.Filter(...) to see if the event should be passed
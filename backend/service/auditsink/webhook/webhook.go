@@ -0,0 +1,198 @@
+package webhook
+
+// <!-- START clutchdoc -->
+// description: POSTs events as JSON to a configured HTTP endpoint, optionally in batches and signed with HMAC.
+// <!-- END clutchdoc -->
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	auditconfigv1 "github.com/lyft/clutch/backend/api/config/service/audit/v1"
+	configv1 "github.com/lyft/clutch/backend/api/config/service/auditsink/webhook/v1"
+	"github.com/lyft/clutch/backend/retry"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/auditsink"
+)
+
+const (
+	Name = "clutch.service.auditsink.webhook"
+
+	// The header of the HMAC-SHA256 signature of the request body, if a signing secret is configured.
+	SignatureHeader = "X-Clutch-Signature"
+
+	defaultTimeout     = 5 * time.Second
+	defaultMaxAttempts = 3
+)
+
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
+	config := &configv1.WebhookConfig{}
+	if err := cfg.UnmarshalTo(config); err != nil {
+		return nil, err
+	}
+
+	timeout := defaultTimeout
+	if config.Timeout != nil {
+		timeout = config.Timeout.AsDuration()
+	}
+
+	maxAttempts := uint(defaultMaxAttempts)
+	if config.MaxAttempts > 0 {
+		maxAttempts = uint(config.MaxAttempts)
+	}
+
+	s := &svc{
+		logger: logger,
+		scope:  scope,
+
+		filter:      config.Filter,
+		url:         config.Url,
+		headers:     config.Headers,
+		secret:      []byte(config.SigningSecret),
+		batchSize:   int(config.BatchSize),
+		maxAttempts: maxAttempts,
+		backoff:     retry.ExponentialBackoff,
+
+		client: &http.Client{Timeout: timeout},
+		marshaler: &protojson.MarshalOptions{
+			// Use field names from the .proto rather than JSON camel case names.
+			UseProtoNames: true,
+			// Render zero values (useful for successful status).
+			EmitUnpopulated: true,
+		},
+	}
+	return s, nil
+}
+
+type svc struct {
+	logger *zap.Logger
+	scope  tally.Scope
+
+	filter      *auditconfigv1.Filter
+	url         string
+	headers     map[string]string
+	secret      []byte
+	batchSize   int
+	maxAttempts uint
+	backoff     retry.BackoffStrategy
+
+	client    *http.Client
+	marshaler *protojson.MarshalOptions
+}
+
+func (s *svc) Write(event *auditv1.Event) error {
+	return s.write(context.Background(), event)
+}
+
+func (s *svc) write(ctx context.Context, event *auditv1.Event) error {
+	if !auditsink.Filter(s.filter, event) {
+		return nil
+	}
+
+	body, err := s.marshaler.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return s.post(ctx, body)
+}
+
+func (s *svc) BatchSize() int {
+	return s.batchSize
+}
+
+// Batches are POSTed as a JSON array of the events that pass the filter, unless batching is disabled.
+func (s *svc) WriteBatch(ctx context.Context, events []*auditv1.Event) error {
+	if s.batchSize <= 1 {
+		for _, event := range events {
+			if err := s.write(ctx, event); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	body := &bytes.Buffer{}
+	body.WriteByte('[')
+	count := 0
+	for _, event := range events {
+		if !auditsink.Filter(s.filter, event) {
+			continue
+		}
+
+		b, err := s.marshaler.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			body.WriteByte(',')
+		}
+		body.Write(b)
+		count++
+	}
+	body.WriteByte(']')
+
+	if count == 0 {
+		return nil
+	}
+	return s.post(ctx, body.Bytes())
+}
+
+func (s *svc) post(ctx context.Context, body []byte) error {
+	return retry.Do(
+		ctx,
+		s.logger,
+		s.scope,
+		func() error { return s.send(ctx, body) },
+		retry.Retries(s.maxAttempts),
+		retry.Backoff(s.backoff),
+	)
+}
+
+func (s *svc) send(ctx context.Context, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	if len(s.secret) > 0 {
+		req.Header.Set(SignatureHeader, "sha256="+Sign(s.secret, body))
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// Drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the hex encoded HMAC-SHA256 of a payload, which receivers can compare to the signature header to
+// verify that the payload was sent by Clutch.
+func Sign(secret, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
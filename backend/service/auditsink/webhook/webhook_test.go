@@ -0,0 +1,156 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	auditv1 "github.com/lyft/clutch/backend/api/audit/v1"
+	auditconfigv1 "github.com/lyft/clutch/backend/api/config/service/audit/v1"
+	configv1 "github.com/lyft/clutch/backend/api/config/service/auditsink/webhook/v1"
+	"github.com/lyft/clutch/backend/retry"
+	"github.com/lyft/clutch/backend/service/auditsink"
+)
+
+func newTestSink(t *testing.T, config *configv1.WebhookConfig) *svc {
+	cfg, err := anypb.New(config)
+	assert.NoError(t, err)
+
+	s, err := New(cfg, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+	assert.NoError(t, err)
+
+	sink := s.(*svc)
+	sink.backoff = retry.DefaultBackoff
+	return sink
+}
+
+func testEvent(id int64, method string) *auditv1.Event {
+	return &auditv1.Event{
+		Id:        id,
+		EventType: &auditv1.Event_Event{Event: &auditv1.RequestEvent{Username: "alice", MethodName: method}},
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	log := zaptest.NewLogger(t)
+	scope := tally.NewTestScope("", nil)
+
+	_, err := New(nil, log, scope)
+	assert.Error(t, err)
+
+	cfg, _ := anypb.New(&configv1.WebhookConfig{Url: "https://siem.example.com", BatchSize: 10})
+	s, err := New(cfg, log, scope)
+	assert.NoError(t, err)
+
+	bs, ok := s.(auditsink.BatchSink)
+	assert.True(t, ok)
+	assert.Equal(t, 10, bs.BatchSize())
+	assert.Equal(t, uint(defaultMaxAttempts), s.(*svc).maxAttempts)
+	assert.Equal(t, defaultTimeout, s.(*svc).client.Timeout)
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	var body []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ = io.ReadAll(r.Body)
+		header = r.Header
+	}))
+	defer server.Close()
+
+	s := newTestSink(t, &configv1.WebhookConfig{
+		Url:           server.URL,
+		Headers:       map[string]string{"Authorization": "Bearer token"},
+		SigningSecret: "secret",
+	})
+	assert.NoError(t, s.Write(testEvent(1, "DeletePod")))
+
+	event := map[string]interface{}{}
+	assert.NoError(t, json.Unmarshal(body, &event))
+	assert.Equal(t, "1", event["id"])
+	assert.Equal(t, "alice", event["event"].(map[string]interface{})["username"])
+
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", header.Get("Authorization"))
+	assert.Equal(t, "sha256="+Sign([]byte("secret"), body), header.Get(SignatureHeader))
+}
+
+func TestWriteBatch(t *testing.T) {
+	t.Parallel()
+
+	var bodies [][]byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, body)
+		assert.Empty(t, r.Header.Get(SignatureHeader))
+	}))
+	defer server.Close()
+
+	s := newTestSink(t, &configv1.WebhookConfig{
+		Url:       server.URL,
+		BatchSize: 10,
+		Filter: &auditconfigv1.Filter{
+			Denylist: true,
+			Rules: []*auditconfigv1.EventFilter{
+				{Field: auditconfigv1.EventFilter_METHOD, Value: &auditconfigv1.EventFilter_Text{Text: "Healthcheck"}},
+			},
+		},
+	})
+	assert.NoError(t, s.WriteBatch(context.Background(), []*auditv1.Event{testEvent(1, "DeletePod"), testEvent(2, "Healthcheck"), testEvent(3, "ResizeHPA")}))
+
+	// Events that don't pass the filter aren't POSTed, and nothing is POSTed if none of them do.
+	assert.NoError(t, s.WriteBatch(context.Background(), []*auditv1.Event{testEvent(4, "Healthcheck")}))
+	assert.Len(t, bodies, 1)
+
+	var events []map[string]interface{}
+	assert.NoError(t, json.Unmarshal(bodies[0], &events))
+	assert.Len(t, events, 2)
+	assert.Equal(t, "1", events[0]["id"])
+	assert.Equal(t, "3", events[1]["id"])
+}
+
+func TestWriteRetries(t *testing.T) {
+	t.Parallel()
+
+	var attempts, failures int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		if atomic.AddInt32(&failures, -1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	}))
+	defer server.Close()
+
+	s := newTestSink(t, &configv1.WebhookConfig{Url: server.URL, MaxAttempts: 3})
+
+	// Succeeds once the endpoint recovers.
+	atomic.StoreInt32(&failures, 2)
+	assert.NoError(t, s.Write(testEvent(1, "DeletePod")))
+	assert.EqualValues(t, 3, atomic.LoadInt32(&attempts))
+
+	// Fails after the last attempt, so that the event is written again later.
+	atomic.StoreInt32(&attempts, 0)
+	atomic.StoreInt32(&failures, 3)
+	assert.Error(t, s.Write(testEvent(1, "DeletePod")))
+	assert.EqualValues(t, 3, atomic.LoadInt32(&attempts))
+
+	// Gives up once the auditor's context is done.
+	atomic.StoreInt32(&attempts, 0)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.ErrorIs(t, s.WriteBatch(ctx, []*auditv1.Event{testEvent(1, "DeletePod")}), context.Canceled)
+	assert.EqualValues(t, 0, atomic.LoadInt32(&attempts))
+}
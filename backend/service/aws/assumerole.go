@@ -0,0 +1,168 @@
+package aws
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+
+	awsv1 "github.com/lyft/clutch/backend/api/config/service/aws/v1"
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+const (
+	defaultRoleChainSessionDuration = 15 * time.Minute
+	defaultRoleChainRefreshWindow   = time.Minute
+
+	roleChainSessionName = "clutch"
+)
+
+var (
+	// https://docs.aws.amazon.com/STS/latest/APIReference/API_AssumeRole.html
+	invalidSessionNameChars = regexp.MustCompile(`[^\w+=,.@-]`)
+	// https://docs.aws.amazon.com/STS/latest/APIReference/API_Tag.html
+	invalidSessionTagValueChars = regexp.MustCompile(`[^\p{L}\p{Z}\p{N}_.:/=+\-@]`)
+)
+
+// roleChain assumes roles in target accounts through a hub role, which is assumed with Clutch's own credentials.
+type roleChain struct {
+	config *awsv1.AssumeRoleChain
+
+	sessionDuration time.Duration
+	refreshWindow   time.Duration
+
+	// Configuration and STS client authenticated as the hub role.
+	hubConfig aws.Config
+	hubSTS    stscreds.AssumeRoleAPIClient
+}
+
+func newRoleChain(cfg *awsv1.AssumeRoleChain, baseConfig aws.Config, baseSTS stscreds.AssumeRoleAPIClient) *roleChain {
+	rc := &roleChain{
+		config:          cfg,
+		sessionDuration: defaultRoleChainSessionDuration,
+		refreshWindow:   defaultRoleChainRefreshWindow,
+	}
+	if cfg.SessionDuration != nil {
+		rc.sessionDuration = cfg.SessionDuration.AsDuration()
+	}
+	if cfg.RefreshWindow != nil {
+		rc.refreshWindow = cfg.RefreshWindow.AsDuration()
+	}
+
+	hubProvider := stscreds.NewAssumeRoleProvider(baseSTS, cfg.HubRoleArn, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = roleChainSessionName
+		o.Duration = rc.sessionDuration
+	})
+
+	rc.hubConfig = baseConfig.Copy()
+	rc.hubConfig.Credentials = rc.newCredentialsCache(hubProvider)
+	rc.hubSTS = sts.NewFromConfig(rc.hubConfig)
+	return rc
+}
+
+func (rc *roleChain) newCredentialsCache(provider aws.CredentialsProvider) *aws.CredentialsCache {
+	return aws.NewCredentialsCache(provider, func(o *aws.CredentialsCacheOptions) {
+		o.ExpiryWindow = rc.refreshWindow
+	})
+}
+
+// Returns a credentials provider for the target role that is assumed from the hub role.
+func (rc *roleChain) providerForRole(roleARN string) aws.CredentialsProvider {
+	return &chainedRoleProvider{
+		chain:   rc,
+		roleARN: roleARN,
+		caches:  make(map[string]*userCredentialsCache),
+	}
+}
+
+// The username from the authn claims of the request, if usernames are tagged.
+func (rc *roleChain) usernameFromContext(ctx context.Context) string {
+	if rc.config.UsernameSessionTagKey == "" {
+		return ""
+	}
+
+	claims, err := authn.ClaimsFromContext(ctx)
+	if err != nil || claims.StandardClaims == nil || claims.Subject == authn.AnonymousSubject {
+		return ""
+	}
+	return claims.Subject
+}
+
+// chainedRoleProvider retrieves credentials for a target role. Since the session tags differ per user, credentials
+// are cached separately for each user and refreshed by the cache before they expire. The cache of a user is
+// evicted once it has not been used for a session duration, by then its credentials have expired anyway.
+type chainedRoleProvider struct {
+	chain   *roleChain
+	roleARN string
+
+	mu     sync.Mutex
+	caches map[string]*userCredentialsCache
+}
+
+type userCredentialsCache struct {
+	*aws.CredentialsCache
+	lastUsed time.Time
+}
+
+func (p *chainedRoleProvider) Retrieve(ctx context.Context) (aws.Credentials, error) {
+	return p.cacheForUser(p.chain.usernameFromContext(ctx)).Retrieve(ctx)
+}
+
+func (p *chainedRoleProvider) cacheForUser(username string) *aws.CredentialsCache {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	if cache, ok := p.caches[username]; ok {
+		cache.lastUsed = now
+		return cache.CredentialsCache
+	}
+
+	// Evict idle users before adding a new one, so the caches are bounded by the users active within a session
+	// duration.
+	for name, cache := range p.caches {
+		if now.Sub(cache.lastUsed) > p.chain.sessionDuration {
+			delete(p.caches, name)
+		}
+	}
+
+	cfg := p.chain.config
+	provider := stscreds.NewAssumeRoleProvider(p.chain.hubSTS, p.roleARN, func(o *stscreds.AssumeRoleOptions) {
+		o.RoleSessionName = roleChainSessionName
+		o.Duration = p.chain.sessionDuration
+		if cfg.ExternalId != "" {
+			o.ExternalID = aws.String(cfg.ExternalId)
+		}
+		if username != "" {
+			o.RoleSessionName = sessionNameForUsername(username)
+			o.Tags = []ststypes.Tag{
+				{Key: aws.String(cfg.UsernameSessionTagKey), Value: aws.String(sessionTagValue(username))},
+			}
+		}
+	})
+
+	cache := p.chain.newCredentialsCache(provider)
+	p.caches[username] = &userCredentialsCache{CredentialsCache: cache, lastUsed: now}
+	return cache
+}
+
+func sessionNameForUsername(username string) string {
+	name := invalidSessionNameChars.ReplaceAllString(roleChainSessionName+"-"+username, "_")
+	if len(name) > 64 {
+		name = name[:64]
+	}
+	return name
+}
+
+func sessionTagValue(value string) string {
+	value = invalidSessionTagValueChars.ReplaceAllString(value, "_")
+	if runes := []rune(value); len(runes) > 256 {
+		value = string(runes[:256])
+	}
+	return value
+}
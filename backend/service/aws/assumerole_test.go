@@ -0,0 +1,223 @@
+package aws
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	ststypes "github.com/aws/aws-sdk-go-v2/service/sts/types"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap/zaptest"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	awsv1 "github.com/lyft/clutch/backend/api/config/service/aws/v1"
+	"github.com/lyft/clutch/backend/service/authn"
+)
+
+const testTargetRoleARN = "arn:aws:iam::123456789012:role/clutch"
+
+type mockAssumeRoleSTS struct {
+	mu       sync.Mutex
+	inputs   []*sts.AssumeRoleInput
+	lifetime time.Duration
+}
+
+func (m *mockAssumeRoleSTS) AssumeRole(ctx context.Context, params *sts.AssumeRoleInput, optFns ...func(*sts.Options)) (*sts.AssumeRoleOutput, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.inputs = append(m.inputs, params)
+	return &sts.AssumeRoleOutput{
+		Credentials: &ststypes.Credentials{
+			AccessKeyId:     aws.String("AKID"),
+			SecretAccessKey: aws.String("SECRET"),
+			SessionToken:    aws.String("TOKEN"),
+			Expiration:      aws.Time(time.Now().Add(m.lifetime)),
+		},
+	}, nil
+}
+
+func newTestRoleChain(cfg *awsv1.AssumeRoleChain, m *mockAssumeRoleSTS) *roleChain {
+	return &roleChain{
+		config:          cfg,
+		sessionDuration: defaultRoleChainSessionDuration,
+		refreshWindow:   defaultRoleChainRefreshWindow,
+		hubSTS:          m,
+	}
+}
+
+func contextWithSubject(subject string) context.Context {
+	return authn.ContextWithClaims(context.Background(), &authn.Claims{StandardClaims: &jwt.StandardClaims{Subject: subject}})
+}
+
+func TestChainedRoleProviderSessionTags(t *testing.T) {
+	m := &mockAssumeRoleSTS{lifetime: time.Hour}
+	rc := newTestRoleChain(&awsv1.AssumeRoleChain{
+		HubRoleArn:            "arn:aws:iam::000000000000:role/hub",
+		ExternalId:            "external",
+		UsernameSessionTagKey: "clutch-username",
+	}, m)
+	p := rc.providerForRole(testTargetRoleARN)
+
+	creds, err := p.Retrieve(contextWithSubject("user@example.com"))
+	assert.NoError(t, err)
+	assert.Equal(t, "AKID", creds.AccessKeyID)
+
+	assert.Len(t, m.inputs, 1)
+	input := m.inputs[0]
+	assert.Equal(t, testTargetRoleARN, aws.ToString(input.RoleArn))
+	assert.Equal(t, "external", aws.ToString(input.ExternalId))
+	assert.Equal(t, int32(900), aws.ToInt32(input.DurationSeconds))
+	assert.Equal(t, "clutch-user@example.com", aws.ToString(input.RoleSessionName))
+	assert.Len(t, input.Tags, 1)
+	assert.Equal(t, "clutch-username", aws.ToString(input.Tags[0].Key))
+	assert.Equal(t, "user@example.com", aws.ToString(input.Tags[0].Value))
+
+	// Credentials for the same user are cached.
+	_, err = p.Retrieve(contextWithSubject("user@example.com"))
+	assert.NoError(t, err)
+	assert.Len(t, m.inputs, 1)
+
+	// Other users get their own session.
+	_, err = p.Retrieve(contextWithSubject("other@example.com"))
+	assert.NoError(t, err)
+	assert.Len(t, m.inputs, 2)
+	assert.Equal(t, "other@example.com", aws.ToString(m.inputs[1].Tags[0].Value))
+
+	// Requests without claims or with anonymous claims share an untagged session.
+	_, err = p.Retrieve(context.Background())
+	assert.NoError(t, err)
+	_, err = p.Retrieve(authn.ContextWithAnonymousClaims(context.Background()))
+	assert.NoError(t, err)
+	assert.Len(t, m.inputs, 3)
+	assert.Equal(t, roleChainSessionName, aws.ToString(m.inputs[2].RoleSessionName))
+	assert.Empty(t, m.inputs[2].Tags)
+}
+
+func TestChainedRoleProviderWithoutUsernameTag(t *testing.T) {
+	m := &mockAssumeRoleSTS{lifetime: time.Hour}
+	rc := newTestRoleChain(&awsv1.AssumeRoleChain{HubRoleArn: "arn:aws:iam::000000000000:role/hub"}, m)
+	p := rc.providerForRole(testTargetRoleARN)
+
+	_, err := p.Retrieve(contextWithSubject("user@example.com"))
+	assert.NoError(t, err)
+	_, err = p.Retrieve(contextWithSubject("other@example.com"))
+	assert.NoError(t, err)
+
+	assert.Len(t, m.inputs, 1)
+	assert.Nil(t, m.inputs[0].ExternalId)
+	assert.Empty(t, m.inputs[0].Tags)
+}
+
+func TestChainedRoleProviderRefresh(t *testing.T) {
+	// Credentials expiring within the refresh window are retrieved again.
+	m := &mockAssumeRoleSTS{lifetime: 30 * time.Second}
+	rc := newTestRoleChain(&awsv1.AssumeRoleChain{HubRoleArn: "arn:aws:iam::000000000000:role/hub"}, m)
+	p := rc.providerForRole(testTargetRoleARN)
+
+	_, err := p.Retrieve(context.Background())
+	assert.NoError(t, err)
+	_, err = p.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, m.inputs, 2)
+
+	m.lifetime = time.Hour
+	_, err = p.Retrieve(context.Background())
+	assert.NoError(t, err)
+	_, err = p.Retrieve(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, m.inputs, 3)
+}
+
+func TestChainedRoleProviderEvictsIdleUsers(t *testing.T) {
+	m := &mockAssumeRoleSTS{lifetime: time.Hour}
+	rc := newTestRoleChain(&awsv1.AssumeRoleChain{
+		HubRoleArn:            "arn:aws:iam::000000000000:role/hub",
+		UsernameSessionTagKey: "clutch-username",
+	}, m)
+	p := rc.providerForRole(testTargetRoleARN).(*chainedRoleProvider)
+
+	_, err := p.Retrieve(contextWithSubject("idle@example.com"))
+	assert.NoError(t, err)
+	_, err = p.Retrieve(contextWithSubject("active@example.com"))
+	assert.NoError(t, err)
+	assert.Len(t, p.caches, 2)
+
+	p.caches["idle@example.com"].lastUsed = time.Now().Add(-2 * rc.sessionDuration)
+	p.caches["active@example.com"].lastUsed = time.Now().Add(-rc.sessionDuration / 2)
+
+	// A new user evicts the caches that have been idle for longer than a session duration.
+	_, err = p.Retrieve(contextWithSubject("new@example.com"))
+	assert.NoError(t, err)
+	assert.Len(t, p.caches, 2)
+	assert.NotContains(t, p.caches, "idle@example.com")
+	assert.Contains(t, p.caches, "active@example.com")
+
+	// An evicted user assumes the role again.
+	_, err = p.Retrieve(contextWithSubject("idle@example.com"))
+	assert.NoError(t, err)
+	assert.Len(t, m.inputs, 4)
+}
+
+func TestSessionNameForUsername(t *testing.T) {
+	assert.Equal(t, "clutch-user@example.com", sessionNameForUsername("user@example.com"))
+	assert.Equal(t, "clutch-first_last", sessionNameForUsername("first last"))
+	assert.Len(t, sessionNameForUsername(strings.Repeat("a", 100)), 64)
+}
+
+func TestSessionTagValue(t *testing.T) {
+	assert.Equal(t, "user@example.com", sessionTagValue("user@example.com"))
+	assert.Equal(t, "first last_", sessionTagValue("first last!"))
+	assert.Equal(t, 256, len([]rune(sessionTagValue(strings.Repeat("ü", 300)))))
+}
+
+func TestNewWithRoleChain(t *testing.T) {
+	cfg, _ := anypb.New(&awsv1.Config{
+		Regions: []string{"us-east-1"},
+		AdditionalAccounts: []*awsv1.AWSAccount{
+			{
+				Alias:         "staging",
+				AccountNumber: "456",
+				IamRole:       "iam-staging",
+				Regions:       []string{"us-west-2"},
+			},
+		},
+		AssumeRoleChain: &awsv1.AssumeRoleChain{
+			HubRoleArn:      "arn:aws:iam::000000000000:role/hub",
+			SessionDuration: durationpb.New(time.Hour),
+			RefreshWindow:   durationpb.New(5 * time.Minute),
+		},
+	})
+	log := zaptest.NewLogger(t)
+	scope := tally.NewTestScope("", nil)
+	s, err := New(cfg, log, scope)
+	require.NoError(t, err)
+
+	c := s.(*client)
+	creds := c.accounts["staging"].clients["us-west-2"].regionCfg.Credentials
+	p, ok := creds.(*chainedRoleProvider)
+	assert.True(t, ok)
+	assert.Equal(t, "arn:aws:iam::456:role/iam-staging", p.roleARN)
+	assert.Equal(t, time.Hour, p.chain.sessionDuration)
+	assert.Equal(t, 5*time.Minute, p.chain.refreshWindow)
+}
+
+func TestNewOrganizationsDiscoveryRequiresTemplate(t *testing.T) {
+	for _, chain := range []*awsv1.AssumeRoleChain{nil, {HubRoleArn: "arn:aws:iam::000000000000:role/hub"}} {
+		cfg, _ := anypb.New(&awsv1.Config{
+			Regions:                []string{"us-east-1"},
+			AssumeRoleChain:        chain,
+			OrganizationsDiscovery: &awsv1.OrganizationsDiscovery{},
+		})
+		_, err := New(cfg, zaptest.NewLogger(t), tally.NewTestScope("", nil))
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "organizations_discovery")
+	}
+}
@@ -0,0 +1,242 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	astypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+)
+
+func (c *client) DescribeAutoscalingScheduledActions(ctx context.Context, account, region, groupName string) ([]*ec2v1.ScheduledAction, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &autoscaling.DescribeScheduledActionsInput{AutoScalingGroupName: aws.String(groupName)}
+
+	var ret []*ec2v1.ScheduledAction
+	paginator := autoscaling.NewDescribeScheduledActionsPaginator(cl.autoscaling, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range output.ScheduledUpdateGroupActions {
+			ret = append(ret, newProtoForScheduledAction(account, region, a))
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *client) CreateAutoscalingScheduledAction(ctx context.Context, account, region, groupName string, action *ec2v1.ScheduledAction) (*ec2v1.ScheduledAction, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	if action.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "a name is required for the scheduled action")
+	}
+	if action.Recurrence == "" && action.StartTime == nil {
+		return nil, status.Error(codes.InvalidArgument, "either a recurrence or a start time is required for the scheduled action")
+	}
+	if action.MinSize == nil && action.MaxSize == nil && action.DesiredCapacity == nil {
+		return nil, status.Error(codes.InvalidArgument, "at least one of min size, max size or desired capacity is required for the scheduled action")
+	}
+
+	input := &autoscaling.PutScheduledUpdateGroupActionInput{
+		AutoScalingGroupName: aws.String(groupName),
+		ScheduledActionName:  aws.String(action.Name),
+	}
+	if action.Recurrence != "" {
+		input.Recurrence = aws.String(action.Recurrence)
+	}
+	if action.StartTime != nil {
+		input.StartTime = aws.Time(action.StartTime.AsTime())
+	}
+	if action.EndTime != nil {
+		input.EndTime = aws.Time(action.EndTime.AsTime())
+	}
+	if action.TimeZone != "" {
+		input.TimeZone = aws.String(action.TimeZone)
+	}
+	if action.MinSize != nil {
+		input.MinSize = aws.Int32(action.MinSize.Value)
+	}
+	if action.MaxSize != nil {
+		input.MaxSize = aws.Int32(action.MaxSize.Value)
+	}
+	if action.DesiredCapacity != nil {
+		input.DesiredCapacity = aws.Int32(action.DesiredCapacity.Value)
+	}
+
+	if _, err := cl.autoscaling.PutScheduledUpdateGroupAction(ctx, input); err != nil {
+		return nil, err
+	}
+
+	return &ec2v1.ScheduledAction{
+		Name:                 action.Name,
+		AutoscalingGroupName: groupName,
+		Region:               region,
+		Account:              account,
+		Recurrence:           action.Recurrence,
+		StartTime:            action.StartTime,
+		EndTime:              action.EndTime,
+		TimeZone:             action.TimeZone,
+		MinSize:              action.MinSize,
+		MaxSize:              action.MaxSize,
+		DesiredCapacity:      action.DesiredCapacity,
+	}, nil
+}
+
+func (c *client) DeleteAutoscalingScheduledAction(ctx context.Context, account, region, groupName, actionName string) error {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return err
+	}
+
+	input := &autoscaling.DeleteScheduledActionInput{
+		AutoScalingGroupName: aws.String(groupName),
+		ScheduledActionName:  aws.String(actionName),
+	}
+	_, err = cl.autoscaling.DeleteScheduledAction(ctx, input)
+	return err
+}
+
+func (c *client) DescribeAutoscalingWarmPool(ctx context.Context, account, region, groupName string) (*ec2v1.WarmPool, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &autoscaling.DescribeWarmPoolInput{AutoScalingGroupName: aws.String(groupName)}
+
+	var ret *ec2v1.WarmPool
+	paginator := autoscaling.NewDescribeWarmPoolPaginator(cl.autoscaling, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		// The configuration is returned with every page, only the instances are paginated.
+		if output.WarmPoolConfiguration == nil {
+			return nil, nil
+		}
+		if ret == nil {
+			ret = newProtoForWarmPool(output.WarmPoolConfiguration)
+		}
+
+		for _, i := range output.Instances {
+			ret.Instances = append(ret.Instances, &ec2v1.WarmPool_Instance{
+				Id:             aws.ToString(i.InstanceId),
+				Zone:           aws.ToString(i.AvailabilityZone),
+				LifecycleState: string(i.LifecycleState),
+				Healthy:        aws.ToString(i.HealthStatus) == "HEALTHY",
+			})
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *client) UpdateAutoscalingWarmPool(ctx context.Context, account, region, groupName string, warmPool *ec2v1.WarmPool) error {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return err
+	}
+
+	if warmPool.MinSize < 0 {
+		return status.Error(codes.InvalidArgument, "the min size of the warm pool cannot be negative")
+	}
+
+	input := &autoscaling.PutWarmPoolInput{
+		AutoScalingGroupName: aws.String(groupName),
+		MinSize:              aws.Int32(warmPool.MinSize),
+		InstanceReusePolicy:  &astypes.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(warmPool.ReuseOnScaleIn)},
+	}
+	if warmPool.MaxGroupPreparedCapacity != nil {
+		input.MaxGroupPreparedCapacity = aws.Int32(warmPool.MaxGroupPreparedCapacity.Value)
+	}
+	switch warmPool.PoolState {
+	case ec2v1.WarmPool_UNSPECIFIED:
+	case ec2v1.WarmPool_UNKNOWN:
+		return status.Error(codes.InvalidArgument, "unknown warm pool state")
+	default:
+		input.PoolState = astypes.WarmPoolState(strcase.ToCamel(warmPool.PoolState.String()))
+	}
+
+	_, err = cl.autoscaling.PutWarmPool(ctx, input)
+	return err
+}
+
+func newProtoForScheduledAction(account, region string, a astypes.ScheduledUpdateGroupAction) *ec2v1.ScheduledAction {
+	ret := &ec2v1.ScheduledAction{
+		Name:                 aws.ToString(a.ScheduledActionName),
+		AutoscalingGroupName: aws.ToString(a.AutoScalingGroupName),
+		Region:               region,
+		Account:              account,
+		Arn:                  aws.ToString(a.ScheduledActionARN),
+		Recurrence:           aws.ToString(a.Recurrence),
+		TimeZone:             aws.ToString(a.TimeZone),
+	}
+
+	if a.StartTime != nil {
+		ret.StartTime = timestamppb.New(*a.StartTime)
+	}
+	if a.EndTime != nil {
+		ret.EndTime = timestamppb.New(*a.EndTime)
+	}
+	if a.MinSize != nil {
+		ret.MinSize = wrapperspb.Int32(*a.MinSize)
+	}
+	if a.MaxSize != nil {
+		ret.MaxSize = wrapperspb.Int32(*a.MaxSize)
+	}
+	if a.DesiredCapacity != nil {
+		ret.DesiredCapacity = wrapperspb.Int32(*a.DesiredCapacity)
+	}
+
+	return ret
+}
+
+func newProtoForWarmPool(cfg *astypes.WarmPoolConfiguration) *ec2v1.WarmPool {
+	ret := &ec2v1.WarmPool{
+		MinSize:       aws.ToInt32(cfg.MinSize),
+		PoolState:     protoForWarmPoolState(cfg.PoolState),
+		PendingDelete: cfg.Status == astypes.WarmPoolStatusPendingDelete,
+	}
+
+	// A max prepared capacity of -1 means the pool is sized off the max size of the group, same as leaving it unset.
+	if cfg.MaxGroupPreparedCapacity != nil && *cfg.MaxGroupPreparedCapacity >= 0 {
+		ret.MaxGroupPreparedCapacity = wrapperspb.Int32(*cfg.MaxGroupPreparedCapacity)
+	}
+	if cfg.InstanceReusePolicy != nil {
+		ret.ReuseOnScaleIn = aws.ToBool(cfg.InstanceReusePolicy.ReuseOnScaleIn)
+	}
+
+	return ret
+}
+
+func protoForWarmPoolState(state astypes.WarmPoolState) ec2v1.WarmPool_PoolState {
+	if state == "" {
+		return ec2v1.WarmPool_UNSPECIFIED
+	}
+
+	val, ok := ec2v1.WarmPool_PoolState_value[strcase.ToScreamingSnake(string(state))]
+	if !ok {
+		return ec2v1.WarmPool_UNKNOWN
+	}
+	return ec2v1.WarmPool_PoolState(val)
+}
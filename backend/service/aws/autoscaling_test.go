@@ -0,0 +1,243 @@
+package aws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	astypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+)
+
+type mockAutoscalingSchedules struct {
+	autoscalingClient
+
+	scheduledActions []astypes.ScheduledUpdateGroupAction
+	warmPool         *autoscaling.DescribeWarmPoolOutput
+
+	putScheduledActionInput    *autoscaling.PutScheduledUpdateGroupActionInput
+	deleteScheduledActionInput *autoscaling.DeleteScheduledActionInput
+	putWarmPoolInput           *autoscaling.PutWarmPoolInput
+}
+
+func (m *mockAutoscalingSchedules) DescribeScheduledActions(ctx context.Context, params *autoscaling.DescribeScheduledActionsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeScheduledActionsOutput, error) {
+	return &autoscaling.DescribeScheduledActionsOutput{ScheduledUpdateGroupActions: m.scheduledActions}, nil
+}
+
+func (m *mockAutoscalingSchedules) PutScheduledUpdateGroupAction(ctx context.Context, params *autoscaling.PutScheduledUpdateGroupActionInput, optFns ...func(*autoscaling.Options)) (*autoscaling.PutScheduledUpdateGroupActionOutput, error) {
+	m.putScheduledActionInput = params
+	return &autoscaling.PutScheduledUpdateGroupActionOutput{}, nil
+}
+
+func (m *mockAutoscalingSchedules) DeleteScheduledAction(ctx context.Context, params *autoscaling.DeleteScheduledActionInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DeleteScheduledActionOutput, error) {
+	m.deleteScheduledActionInput = params
+	return &autoscaling.DeleteScheduledActionOutput{}, nil
+}
+
+func (m *mockAutoscalingSchedules) DescribeWarmPool(ctx context.Context, params *autoscaling.DescribeWarmPoolInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeWarmPoolOutput, error) {
+	if m.warmPool == nil {
+		return &autoscaling.DescribeWarmPoolOutput{}, nil
+	}
+	return m.warmPool, nil
+}
+
+func (m *mockAutoscalingSchedules) PutWarmPool(ctx context.Context, params *autoscaling.PutWarmPoolInput, optFns ...func(*autoscaling.Options)) (*autoscaling.PutWarmPoolOutput, error) {
+	m.putWarmPoolInput = params
+	return &autoscaling.PutWarmPoolOutput{}, nil
+}
+
+func newTestAutoscalingClient(m *mockAutoscalingSchedules) *client {
+	return &client{
+		currentAccountAlias: "default",
+		accounts: map[string]*accountClients{
+			"default": {
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", autoscaling: m},
+				},
+			},
+		},
+	}
+}
+
+func TestDescribeAutoscalingScheduledActions(t *testing.T) {
+	start := time.Date(2026, 11, 27, 8, 0, 0, 0, time.UTC)
+	m := &mockAutoscalingSchedules{
+		scheduledActions: []astypes.ScheduledUpdateGroupAction{
+			{
+				ScheduledActionName:  aws.String("black-friday"),
+				AutoScalingGroupName: aws.String("asg-one"),
+				ScheduledActionARN:   aws.String("arn:aws:autoscaling:us-east-1:123456789012:scheduledUpdateGroupAction:1"),
+				StartTime:            &start,
+				MinSize:              aws.Int32(100),
+			},
+			{
+				ScheduledActionName:  aws.String("weekday"),
+				AutoScalingGroupName: aws.String("asg-one"),
+				Recurrence:           aws.String("0 13 * * 1-5"),
+				TimeZone:             aws.String("America/Los_Angeles"),
+				DesiredCapacity:      aws.Int32(0),
+			},
+		},
+	}
+	c := newTestAutoscalingClient(m)
+
+	actions, err := c.DescribeAutoscalingScheduledActions(context.Background(), "default", "us-east-1", "asg-one")
+	assert.NoError(t, err)
+	assert.Len(t, actions, 2)
+
+	assert.Equal(t, "black-friday", actions[0].Name)
+	assert.Equal(t, "asg-one", actions[0].AutoscalingGroupName)
+	assert.Equal(t, "default", actions[0].Account)
+	assert.Equal(t, "us-east-1", actions[0].Region)
+	assert.Equal(t, start, actions[0].StartTime.AsTime())
+	assert.Equal(t, int32(100), actions[0].MinSize.Value)
+	assert.Nil(t, actions[0].MaxSize)
+	assert.Nil(t, actions[0].DesiredCapacity)
+
+	assert.Equal(t, "0 13 * * 1-5", actions[1].Recurrence)
+	assert.Equal(t, "America/Los_Angeles", actions[1].TimeZone)
+	assert.Nil(t, actions[1].StartTime)
+	assert.Equal(t, int32(0), actions[1].DesiredCapacity.Value)
+}
+
+func TestCreateAutoscalingScheduledAction(t *testing.T) {
+	m := &mockAutoscalingSchedules{}
+	c := newTestAutoscalingClient(m)
+
+	start := time.Date(2026, 11, 27, 8, 0, 0, 0, time.UTC)
+	action, err := c.CreateAutoscalingScheduledAction(context.Background(), "default", "us-east-1", "asg-one", &ec2v1.ScheduledAction{
+		Name:                 "black-friday",
+		AutoscalingGroupName: "ignored",
+		StartTime:            timestamppb.New(start),
+		MinSize:              wrapperspb.Int32(100),
+		DesiredCapacity:      wrapperspb.Int32(150),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "asg-one", action.AutoscalingGroupName)
+	assert.Equal(t, "default", action.Account)
+
+	input := m.putScheduledActionInput
+	assert.Equal(t, "asg-one", aws.ToString(input.AutoScalingGroupName))
+	assert.Equal(t, "black-friday", aws.ToString(input.ScheduledActionName))
+	assert.Equal(t, start, aws.ToTime(input.StartTime))
+	assert.Nil(t, input.Recurrence)
+	assert.Nil(t, input.EndTime)
+	assert.Equal(t, int32(100), aws.ToInt32(input.MinSize))
+	assert.Nil(t, input.MaxSize)
+	assert.Equal(t, int32(150), aws.ToInt32(input.DesiredCapacity))
+}
+
+func TestCreateAutoscalingScheduledActionInvalid(t *testing.T) {
+	tests := []struct {
+		id     string
+		action *ec2v1.ScheduledAction
+	}{
+		{
+			id:     "missing name",
+			action: &ec2v1.ScheduledAction{Recurrence: "0 13 * * *", MinSize: wrapperspb.Int32(1)},
+		},
+		{
+			id:     "missing schedule",
+			action: &ec2v1.ScheduledAction{Name: "foo", MinSize: wrapperspb.Int32(1)},
+		},
+		{
+			id:     "missing sizes",
+			action: &ec2v1.ScheduledAction{Name: "foo", Recurrence: "0 13 * * *"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			m := &mockAutoscalingSchedules{}
+			c := newTestAutoscalingClient(m)
+
+			_, err := c.CreateAutoscalingScheduledAction(context.Background(), "default", "us-east-1", "asg-one", tt.action)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Nil(t, m.putScheduledActionInput)
+		})
+	}
+}
+
+func TestDeleteAutoscalingScheduledAction(t *testing.T) {
+	m := &mockAutoscalingSchedules{}
+	c := newTestAutoscalingClient(m)
+
+	err := c.DeleteAutoscalingScheduledAction(context.Background(), "default", "us-east-1", "asg-one", "black-friday")
+	assert.NoError(t, err)
+	assert.Equal(t, "asg-one", aws.ToString(m.deleteScheduledActionInput.AutoScalingGroupName))
+	assert.Equal(t, "black-friday", aws.ToString(m.deleteScheduledActionInput.ScheduledActionName))
+
+	err = c.DeleteAutoscalingScheduledAction(context.Background(), "default", "us-west-2", "asg-one", "black-friday")
+	assert.Error(t, err)
+}
+
+func TestDescribeAutoscalingWarmPool(t *testing.T) {
+	m := &mockAutoscalingSchedules{}
+	c := newTestAutoscalingClient(m)
+
+	warmPool, err := c.DescribeAutoscalingWarmPool(context.Background(), "default", "us-east-1", "asg-one")
+	assert.NoError(t, err)
+	assert.Nil(t, warmPool)
+
+	m.warmPool = &autoscaling.DescribeWarmPoolOutput{
+		WarmPoolConfiguration: &astypes.WarmPoolConfiguration{
+			MinSize:                  aws.Int32(5),
+			MaxGroupPreparedCapacity: aws.Int32(-1),
+			PoolState:                astypes.WarmPoolStateHibernated,
+			Status:                   astypes.WarmPoolStatusPendingDelete,
+			InstanceReusePolicy:      &astypes.InstanceReusePolicy{ReuseOnScaleIn: aws.Bool(true)},
+		},
+		Instances: []astypes.Instance{
+			{
+				InstanceId:       aws.String("i-123"),
+				AvailabilityZone: aws.String("us-east-1a"),
+				LifecycleState:   astypes.LifecycleStateWarmedHibernated,
+				HealthStatus:     aws.String("HEALTHY"),
+			},
+		},
+	}
+
+	warmPool, err = c.DescribeAutoscalingWarmPool(context.Background(), "default", "us-east-1", "asg-one")
+	assert.NoError(t, err)
+	assert.Equal(t, int32(5), warmPool.MinSize)
+	assert.Nil(t, warmPool.MaxGroupPreparedCapacity)
+	assert.Equal(t, ec2v1.WarmPool_HIBERNATED, warmPool.PoolState)
+	assert.True(t, warmPool.PendingDelete)
+	assert.True(t, warmPool.ReuseOnScaleIn)
+	assert.Len(t, warmPool.Instances, 1)
+	assert.Equal(t, "Warmed:Hibernated", warmPool.Instances[0].LifecycleState)
+	assert.True(t, warmPool.Instances[0].Healthy)
+}
+
+func TestUpdateAutoscalingWarmPool(t *testing.T) {
+	m := &mockAutoscalingSchedules{}
+	c := newTestAutoscalingClient(m)
+
+	err := c.UpdateAutoscalingWarmPool(context.Background(), "default", "us-east-1", "asg-one", &ec2v1.WarmPool{
+		MinSize:                  10,
+		MaxGroupPreparedCapacity: wrapperspb.Int32(50),
+		PoolState:                ec2v1.WarmPool_STOPPED,
+	})
+	assert.NoError(t, err)
+
+	input := m.putWarmPoolInput
+	assert.Equal(t, "asg-one", aws.ToString(input.AutoScalingGroupName))
+	assert.Equal(t, int32(10), aws.ToInt32(input.MinSize))
+	assert.Equal(t, int32(50), aws.ToInt32(input.MaxGroupPreparedCapacity))
+	assert.Equal(t, astypes.WarmPoolStateStopped, input.PoolState)
+	assert.False(t, aws.ToBool(input.InstanceReusePolicy.ReuseOnScaleIn))
+
+	err = c.UpdateAutoscalingWarmPool(context.Background(), "default", "us-east-1", "asg-one", &ec2v1.WarmPool{PoolState: ec2v1.WarmPool_UNKNOWN})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
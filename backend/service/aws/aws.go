@@ -11,6 +11,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
@@ -18,11 +19,16 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
 	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
 	astypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	dynamodb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
@@ -36,8 +42,11 @@ import (
 
 	dynamodbv1 "github.com/lyft/clutch/backend/api/aws/dynamodb/v1"
 	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+	elbv2v1 "github.com/lyft/clutch/backend/api/aws/elbv2/v1"
 	iamv1 "github.com/lyft/clutch/backend/api/aws/iam/v1"
 	kinesisv1 "github.com/lyft/clutch/backend/api/aws/kinesis/v1"
+	lambdav1 "github.com/lyft/clutch/backend/api/aws/lambda/v1"
+	rdsv1 "github.com/lyft/clutch/backend/api/aws/rds/v1"
 	s3v1 "github.com/lyft/clutch/backend/api/aws/s3/v1"
 	awsv1 "github.com/lyft/clutch/backend/api/config/service/aws/v1"
 	topologyv1 "github.com/lyft/clutch/backend/api/topology/v1"
@@ -103,34 +112,68 @@ func New(cfg *anypb.Any, logger *zap.Logger, scope tally.Scope) (service.Service
 		c.createRegionalClients(c.currentAccountAlias, region, ac.Regions, ds, regionCfg)
 	}
 
-	if err := c.configureAdditionalAccountClient(ac.AdditionalAccounts, ds, awsHTTPClient, awsClientCommonOptions); err != nil {
+	var chain *roleChain
+	if ac.AssumeRoleChain != nil {
+		primary := c.accounts[c.currentAccountAlias].clients[c.accounts[c.currentAccountAlias].regions[0]]
+		chain = newRoleChain(ac.AssumeRoleChain, *primary.regionCfg, primary.sts)
+	}
+
+	if err := c.configureAdditionalAccountClient(ac.AdditionalAccounts, ds, awsHTTPClient, awsClientCommonOptions, chain); err != nil {
 		return nil, err
 	}
 
+	if ac.OrganizationsDiscovery != nil {
+		if chain == nil || ac.AssumeRoleChain.TargetRoleArnTemplate == "" {
+			return nil, errors.New("AWS config field [organizations_discovery] requires [assume_role_chain] with a [target_role_arn_template]")
+		}
+
+		regions := ac.OrganizationsDiscovery.Regions
+		if len(regions) == 0 {
+			regions = ac.Regions
+		}
+		if err := c.configureOrganizationAccountClients(context.TODO(), organizations.NewFromConfig(chain.hubConfig), ac.OrganizationsDiscovery, regions, chain, ds, awsClientCommonOptions); err != nil {
+			return nil, err
+		}
+	}
+
 	return c, nil
 }
 
-func (c *client) configureAdditionalAccountClient(accounts []*awsv1.AWSAccount, ds *awsv1.ScalingLimits, awsHTTPClient *http.Client, awsClientOptions []func(*config.LoadOptions) error) error {
+func (c *client) configureAdditionalAccountClient(accounts []*awsv1.AWSAccount, ds *awsv1.ScalingLimits, awsHTTPClient *http.Client, awsClientOptions []func(*config.LoadOptions) error, chain *roleChain) error {
 	for _, account := range accounts {
 		accountRoleARN := fmt.Sprintf("arn:aws:iam::%s:role/%s", account.AccountNumber, account.IamRole)
-		// For doing STS calls it does not matter which region client we are using, as they are not bounded by region
-		// we choose just the first region client
-		stsClient := c.accounts[c.currentAccountAlias].clients[c.accounts[c.currentAccountAlias].regions[0]].sts
-		assumeRoleProvider := stscreds.NewAssumeRoleProvider(stsClient, accountRoleARN)
-		credsCache := aws.NewCredentialsCache(assumeRoleProvider)
-
-		for _, region := range account.Regions {
-			regionCfg, err := config.LoadDefaultConfig(context.TODO(),
-				append(awsClientOptions, config.WithRegion(region))...,
-			)
-			if err != nil {
-				return err
-			}
 
-			regionCfg.Credentials = credsCache
+		var creds aws.CredentialsProvider
+		if chain != nil {
+			creds = chain.providerForRole(accountRoleARN)
+		} else {
+			// For doing STS calls it does not matter which region client we are using, as they are not bounded by region
+			// we choose just the first region client
+			stsClient := c.accounts[c.currentAccountAlias].clients[c.accounts[c.currentAccountAlias].regions[0]].sts
+			assumeRoleProvider := stscreds.NewAssumeRoleProvider(stsClient, accountRoleARN)
+			creds = aws.NewCredentialsCache(assumeRoleProvider)
+		}
+
+		if err := c.configureAccountClients(account.Alias, account.Regions, ds, awsClientOptions, creds); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
-			c.createRegionalClients(account.Alias, region, account.Regions, ds, regionCfg)
+func (c *client) configureAccountClients(accountAlias string, regions []string, ds *awsv1.ScalingLimits, awsClientOptions []func(*config.LoadOptions) error, creds aws.CredentialsProvider) error {
+	for _, region := range regions {
+		regionCfg, err := config.LoadDefaultConfig(context.TODO(),
+			append(awsClientOptions, config.WithRegion(region))...,
+		)
+		if err != nil {
+			return err
 		}
+
+		regionCfg.Credentials = creds
+
+		c.createRegionalClients(accountAlias, region, regions, ds, regionCfg)
 	}
 
 	return nil
@@ -162,7 +205,11 @@ func (c *client) createRegionalClients(accountAlias, region string, regions []st
 		kinesis:     kinesis.NewFromConfig(regionCfg),
 		ec2:         ec2.NewFromConfig(regionCfg),
 		autoscaling: autoscaling.NewFromConfig(regionCfg),
+		elbv2:       elbv2.NewFromConfig(regionCfg),
 		dynamodb:    dynamodb.NewFromConfig(regionCfg),
+		rds:         rds.NewFromConfig(regionCfg),
+		lambda:      lambda.NewFromConfig(regionCfg),
+		cloudwatch:  cloudwatch.NewFromConfig(regionCfg),
 		sts:         sts.NewFromConfig(regionCfg),
 		iam:         iam.NewFromConfig(regionCfg),
 	}
@@ -170,11 +217,25 @@ func (c *client) createRegionalClients(accountAlias, region string, regions []st
 
 type Client interface {
 	DescribeInstances(ctx context.Context, account, region string, ids []string) ([]*ec2v1.Instance, error)
+	// DescribeInstancesTargetHealth populates the health of instances in the target groups of their autoscaling groups.
+	DescribeInstancesTargetHealth(ctx context.Context, account, region string, instances []*ec2v1.Instance) error
 	TerminateInstances(ctx context.Context, account, region string, ids []string) error
 	RebootInstances(ctx context.Context, account, region string, ids []string) error
 
 	DescribeAutoscalingGroups(ctx context.Context, account, region string, names []string) ([]*ec2v1.AutoscalingGroup, error)
 	ResizeAutoscalingGroup(ctx context.Context, account, region string, name string, size *ec2v1.AutoscalingGroupSize) error
+	DescribeAutoscalingScheduledActions(ctx context.Context, account, region, groupName string) ([]*ec2v1.ScheduledAction, error)
+	CreateAutoscalingScheduledAction(ctx context.Context, account, region, groupName string, action *ec2v1.ScheduledAction) (*ec2v1.ScheduledAction, error)
+	DeleteAutoscalingScheduledAction(ctx context.Context, account, region, groupName, actionName string) error
+	DescribeAutoscalingWarmPool(ctx context.Context, account, region, groupName string) (*ec2v1.WarmPool, error)
+	UpdateAutoscalingWarmPool(ctx context.Context, account, region, groupName string, warmPool *ec2v1.WarmPool) error
+
+	DescribeLoadBalancers(ctx context.Context, account, region string, names []string) ([]*elbv2v1.LoadBalancer, error)
+	DescribeListeners(ctx context.Context, account, region, loadBalancerName string) ([]*elbv2v1.Listener, error)
+	DescribeTargetGroups(ctx context.Context, account, region string, names []string) ([]*elbv2v1.TargetGroup, error)
+	DescribeTargetHealth(ctx context.Context, account, region, targetGroupName string) ([]*elbv2v1.TargetHealth, error)
+	RegisterTargets(ctx context.Context, account, region, targetGroupName string, targets []*elbv2v1.Target) error
+	DeregisterTargets(ctx context.Context, account, region, targetGroupName string, targets []*elbv2v1.Target) error
 
 	DescribeKinesisStream(ctx context.Context, account, region, streamName string) (*kinesisv1.Stream, error)
 	UpdateKinesisShardCount(ctx context.Context, account, region, streamName string, targetShardCount int32) error
@@ -190,6 +251,17 @@ type Client interface {
 	BatchGetItem(ctx context.Context, account, region string, params *dynamodb.BatchGetItemInput) (*dynamodb.BatchGetItemOutput, error)
 	DescribeContinuousBackups(ctx context.Context, account, region string, tableName string) (*dynamodbv1.ContinuousBackups, error)
 
+	DescribeDBInstance(ctx context.Context, account, region, identifier string) (*rdsv1.DBInstance, error)
+	DescribeDBCluster(ctx context.Context, account, region, identifier string) (*rdsv1.DBCluster, error)
+	RebootDBInstance(ctx context.Context, account, region, identifier string, forceFailover bool) error
+	FailoverDBCluster(ctx context.Context, account, region, identifier, targetInstanceIdentifier string) error
+	DescribeRDSEvents(ctx context.Context, account, region, sourceIdentifier string, sourceType rdsv1.Event_SourceType, duration time.Duration) ([]*rdsv1.Event, error)
+
+	DescribeLambdaFunction(ctx context.Context, account, region, name string) (*lambdav1.Function, error)
+	GetLambdaInvocationSummary(ctx context.Context, account, region, name string, window time.Duration) (*lambdav1.InvocationSummary, error)
+	PutLambdaFunctionConcurrency(ctx context.Context, account, region, name string, reservedConcurrentExecutions int32) error
+	DeleteLambdaFunctionConcurrency(ctx context.Context, account, region, name string) error
+
 	GetCallerIdentity(ctx context.Context, account, region string) (*sts.GetCallerIdentityOutput, error)
 
 	SimulateCustomPolicy(ctx context.Context, account, region string, customPolicySimulatorParams *iam.SimulateCustomPolicyInput) (*iam.SimulateCustomPolicyOutput, error)
@@ -211,11 +283,15 @@ type Client interface {
 // development of a feature that you add the calls to a service interface so they can be tested more easily.
 type DirectClient interface {
 	Autoscaling() *autoscaling.Client
+	CloudWatch() *cloudwatch.Client
 	Config() *aws.Config
 	DynamoDB() *dynamodb.Client
 	EC2() *ec2.Client
+	ElasticLoadBalancingV2() *elbv2.Client
 	IAM() *iam.Client
 	Kinesis() *kinesis.Client
+	Lambda() *lambda.Client
+	RDS() *rds.Client
 	S3() *s3.Client
 	STS() *sts.Client
 }
@@ -235,10 +311,14 @@ type regionalClient struct {
 	dynamodbCfg *awsv1.DynamodbConfig
 
 	autoscaling autoscalingClient
+	cloudwatch  cloudwatchClient
 	dynamodb    dynamodbClient
 	ec2         ec2Client
+	elbv2       elbv2Client
 	iam         iamClient
 	kinesis     kinesisClient
+	lambda      lambdaClient
+	rds         rdsClient
 	s3          s3Client
 	s3control   s3ControlClient
 	sts         stsClient
@@ -252,6 +332,10 @@ func (r *regionalClient) Autoscaling() *autoscaling.Client {
 	return r.autoscaling.(*autoscaling.Client)
 }
 
+func (r *regionalClient) CloudWatch() *cloudwatch.Client {
+	return r.cloudwatch.(*cloudwatch.Client)
+}
+
 func (r *regionalClient) DynamoDB() *dynamodb.Client {
 	return r.dynamodb.(*dynamodb.Client)
 }
@@ -260,6 +344,10 @@ func (r *regionalClient) EC2() *ec2.Client {
 	return r.ec2.(*ec2.Client)
 }
 
+func (r *regionalClient) ElasticLoadBalancingV2() *elbv2.Client {
+	return r.elbv2.(*elbv2.Client)
+}
+
 func (r *regionalClient) IAM() *iam.Client {
 	return r.iam.(*iam.Client)
 }
@@ -268,6 +356,14 @@ func (r *regionalClient) Kinesis() *kinesis.Client {
 	return r.kinesis.(*kinesis.Client)
 }
 
+func (r *regionalClient) Lambda() *lambda.Client {
+	return r.lambda.(*lambda.Client)
+}
+
+func (r *regionalClient) RDS() *rds.Client {
+	return r.rds.(*rds.Client)
+}
+
 func (r *regionalClient) S3() *s3.Client {
 	return r.s3.(*s3.Client)
 }
@@ -597,6 +597,8 @@ func (m *mockEC2) RebootInstances(ctx context.Context, params *ec2.RebootInstanc
 }
 
 type mockAutoscaling struct {
+	autoscalingClient
+
 	describeASGErr    error
 	describeASGOutput *autoscaling.DescribeAutoScalingGroupsOutput
 
@@ -13,6 +13,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/kinesis"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3control"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
@@ -59,6 +61,9 @@ func (c *client) processRegionTopologyObjects(ctx context.Context) {
 			go c.startTickerForCacheResource(ctx, time.Duration(time.Minute*30), account.alias, client, c.processAllS3Buckets)
 			go c.startTickerForCacheResource(ctx, time.Duration(time.Minute*30), account.alias, client, c.processAllS3AccessPoints)
 			go c.startTickerForCacheResource(ctx, time.Duration(time.Minute*30), account.alias, client, c.processAllIamRoles)
+			go c.startTickerForCacheResource(ctx, time.Duration(time.Minute*10), account.alias, client, c.processAllRDSInstances)
+			go c.startTickerForCacheResource(ctx, time.Duration(time.Minute*10), account.alias, client, c.processAllRDSClusters)
+			go c.startTickerForCacheResource(ctx, time.Duration(time.Minute*30), account.alias, client, c.processAllLambdaFunctions)
 		}
 	}
 }
@@ -399,3 +404,126 @@ func (c *client) processAllIamRoles(ctx context.Context, account string, client
 		}
 	}
 }
+
+func (c *client) processAllRDSInstances(ctx context.Context, account string, client *regionalClient) {
+	c.log.Info("starting to process rds instances for region", zap.String("region", client.region))
+	// 100 is the maximum amount of records per page allowed for this API
+	input := rds.DescribeDBInstancesInput{
+		MaxRecords: aws.Int32(100),
+	}
+
+	paginator := rds.NewDescribeDBInstancesPaginator(client.rds, &input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			c.log.Error("unable to get next rds instance page", zap.Error(err))
+			break
+		}
+
+		for _, instance := range output.DBInstances {
+			protoInstance := newProtoForDBInstance(account, client.region, instance)
+
+			instanceAny, err := anypb.New(protoInstance)
+			if err != nil {
+				c.log.Error("unable to marshal rds instance proto", zap.Error(err))
+				continue
+			}
+
+			patternId, err := meta.HydratedPatternForProto(protoInstance)
+			if err != nil {
+				c.log.Error("unable to get proto id from pattern", zap.Error(err))
+				continue
+			}
+
+			c.topologyObjectChan <- &topologyv1.UpdateCacheRequest{
+				Resource: &topologyv1.Resource{
+					Id: patternId,
+					Pb: instanceAny,
+				},
+				Action: topologyv1.UpdateCacheRequest_CREATE_OR_UPDATE,
+			}
+		}
+	}
+}
+
+func (c *client) processAllRDSClusters(ctx context.Context, account string, client *regionalClient) {
+	c.log.Info("starting to process rds clusters for region", zap.String("region", client.region))
+	// 100 is the maximum amount of records per page allowed for this API
+	input := rds.DescribeDBClustersInput{
+		MaxRecords: aws.Int32(100),
+	}
+
+	paginator := rds.NewDescribeDBClustersPaginator(client.rds, &input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			c.log.Error("unable to get next rds cluster page", zap.Error(err))
+			break
+		}
+
+		for _, cluster := range output.DBClusters {
+			protoCluster := newProtoForDBCluster(account, client.region, cluster)
+
+			clusterAny, err := anypb.New(protoCluster)
+			if err != nil {
+				c.log.Error("unable to marshal rds cluster proto", zap.Error(err))
+				continue
+			}
+
+			patternId, err := meta.HydratedPatternForProto(protoCluster)
+			if err != nil {
+				c.log.Error("unable to get proto id from pattern", zap.Error(err))
+				continue
+			}
+
+			c.topologyObjectChan <- &topologyv1.UpdateCacheRequest{
+				Resource: &topologyv1.Resource{
+					Id: patternId,
+					Pb: clusterAny,
+				},
+				Action: topologyv1.UpdateCacheRequest_CREATE_OR_UPDATE,
+			}
+		}
+	}
+}
+
+func (c *client) processAllLambdaFunctions(ctx context.Context, account string, client *regionalClient) {
+	c.log.Info("starting to process lambda functions for region", zap.String("region", client.region))
+	// 50 is the maximum amount of records per page allowed for this API
+	input := lambda.ListFunctionsInput{
+		MaxItems: aws.Int32(50),
+	}
+
+	paginator := lambda.NewListFunctionsPaginator(client.lambda, &input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			c.log.Error("unable to get next lambda function page", zap.Error(err))
+			break
+		}
+
+		for _, function := range output.Functions {
+			protoFunction := newProtoForLambdaFunction(account, client.region, &function)
+
+			functionAny, err := anypb.New(protoFunction)
+			if err != nil {
+				c.log.Error("unable to marshal lambda function proto", zap.Error(err))
+				continue
+			}
+
+			patternId, err := meta.HydratedPatternForProto(protoFunction)
+			if err != nil {
+				c.log.Error("unable to get proto id from pattern", zap.Error(err))
+				continue
+			}
+
+			c.topologyObjectChan <- &topologyv1.UpdateCacheRequest{
+				Resource: &topologyv1.Resource{
+					Id: patternId,
+					Pb: functionAny,
+				},
+				Action: topologyv1.UpdateCacheRequest_CREATE_OR_UPDATE,
+			}
+		}
+	}
+}
@@ -0,0 +1,465 @@
+package aws
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	astypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/iancoleman/strcase"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+	elbv2v1 "github.com/lyft/clutch/backend/api/aws/elbv2/v1"
+)
+
+const (
+	// The tag AWS places on instances launched by an autoscaling group.
+	autoscalingGroupNameTag = "aws:autoscaling:groupName"
+	// The maximum number of names and records of a DescribeAutoScalingGroups request.
+	maxAutoScalingGroupNames = 100
+)
+
+func (c *client) DescribeLoadBalancers(ctx context.Context, account, region string, names []string) ([]*elbv2v1.LoadBalancer, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &elbv2.DescribeLoadBalancersInput{}
+	if len(names) > 0 {
+		input.Names = names
+	}
+
+	var ret []*elbv2v1.LoadBalancer
+	paginator := elbv2.NewDescribeLoadBalancersPaginator(cl.elbv2, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, lb := range output.LoadBalancers {
+			ret = append(ret, newProtoForLoadBalancer(account, region, lb))
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *client) DescribeListeners(ctx context.Context, account, region, loadBalancerName string) ([]*elbv2v1.Listener, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	lbs, err := c.DescribeLoadBalancers(ctx, account, region, []string{loadBalancerName})
+	if err != nil {
+		return nil, err
+	}
+	if len(lbs) != 1 {
+		return nil, status.Errorf(codes.NotFound, "load balancer '%s' not found", loadBalancerName)
+	}
+
+	input := &elbv2.DescribeListenersInput{LoadBalancerArn: aws.String(lbs[0].Arn)}
+
+	var ret []*elbv2v1.Listener
+	paginator := elbv2.NewDescribeListenersPaginator(cl.elbv2, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, l := range output.Listeners {
+			ret = append(ret, newProtoForListener(l))
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *client) DescribeTargetGroups(ctx context.Context, account, region string, names []string) ([]*elbv2v1.TargetGroup, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &elbv2.DescribeTargetGroupsInput{}
+	if len(names) > 0 {
+		input.Names = names
+	}
+
+	return c.describeTargetGroups(ctx, cl, account, input)
+}
+
+func (c *client) describeTargetGroups(ctx context.Context, cl *regionalClient, account string, input *elbv2.DescribeTargetGroupsInput) ([]*elbv2v1.TargetGroup, error) {
+	var ret []*elbv2v1.TargetGroup
+	paginator := elbv2.NewDescribeTargetGroupsPaginator(cl.elbv2, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tg := range output.TargetGroups {
+			ret = append(ret, newProtoForTargetGroup(account, cl.region, tg))
+		}
+	}
+
+	return ret, nil
+}
+
+// Target group APIs only accept ARNs, while Clutch references target groups by name.
+func (c *client) getTargetGroupARN(ctx context.Context, cl *regionalClient, name string) (string, error) {
+	output, err := cl.elbv2.DescribeTargetGroups(ctx, &elbv2.DescribeTargetGroupsInput{Names: []string{name}})
+	if err != nil {
+		return "", err
+	}
+
+	if len(output.TargetGroups) != 1 {
+		return "", status.Errorf(codes.NotFound, "target group '%s' not found", name)
+	}
+	return aws.ToString(output.TargetGroups[0].TargetGroupArn), nil
+}
+
+func (c *client) DescribeTargetHealth(ctx context.Context, account, region, targetGroupName string) ([]*elbv2v1.TargetHealth, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	arn, err := c.getTargetGroupARN(ctx, cl, targetGroupName)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.describeTargetHealth(ctx, cl, targetGroupName, arn, nil)
+}
+
+func (c *client) describeTargetHealth(ctx context.Context, cl *regionalClient, targetGroupName, targetGroupARN string, targets []elbv2types.TargetDescription) ([]*elbv2v1.TargetHealth, error) {
+	input := &elbv2.DescribeTargetHealthInput{
+		TargetGroupArn: aws.String(targetGroupARN),
+		Targets:        targets,
+	}
+
+	output, err := cl.elbv2.DescribeTargetHealth(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]*elbv2v1.TargetHealth, len(output.TargetHealthDescriptions))
+	for idx, th := range output.TargetHealthDescriptions {
+		ret[idx] = newProtoForTargetHealth(targetGroupName, targetGroupARN, th)
+	}
+	return ret, nil
+}
+
+func (c *client) RegisterTargets(ctx context.Context, account, region, targetGroupName string, targets []*elbv2v1.Target) error {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return err
+	}
+
+	arn, err := c.getTargetGroupARN(ctx, cl, targetGroupName)
+	if err != nil {
+		return err
+	}
+
+	input := &elbv2.RegisterTargetsInput{
+		TargetGroupArn: aws.String(arn),
+		Targets:        targetDescriptionsFromProto(targets),
+	}
+
+	_, err = cl.elbv2.RegisterTargets(ctx, input)
+	return err
+}
+
+func (c *client) DeregisterTargets(ctx context.Context, account, region, targetGroupName string, targets []*elbv2v1.Target) error {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return err
+	}
+
+	arn, err := c.getTargetGroupARN(ctx, cl, targetGroupName)
+	if err != nil {
+		return err
+	}
+
+	input := &elbv2.DeregisterTargetsInput{
+		TargetGroupArn: aws.String(arn),
+		Targets:        targetDescriptionsFromProto(targets),
+	}
+
+	_, err = cl.elbv2.DeregisterTargets(ctx, input)
+	return err
+}
+
+func (c *client) DescribeInstancesTargetHealth(ctx context.Context, account, region string, instances []*ec2v1.Instance) error {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return err
+	}
+
+	c.linkInstancesToTargetGroups(ctx, cl, instances)
+	return nil
+}
+
+// Populate the target health of instances that belong to an autoscaling group with the health from each of the target
+// groups attached to the autoscaling group. This is best effort, failures are logged and the instances are left as is.
+func (c *client) linkInstancesToTargetGroups(ctx context.Context, cl *regionalClient, instances []*ec2v1.Instance) {
+	byGroup := make(map[string][]*ec2v1.Instance)
+	for _, i := range instances {
+		if name, ok := i.Tags[autoscalingGroupNameTag]; ok {
+			byGroup[name] = append(byGroup[name], i)
+		}
+	}
+	if len(byGroup) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(byGroup))
+	for name := range byGroup {
+		names = append(names, name)
+	}
+
+	var groups []astypes.AutoScalingGroup
+	for start := 0; start < len(names); start += maxAutoScalingGroupNames {
+		end := start + maxAutoScalingGroupNames
+		if end > len(names) {
+			end = len(names)
+		}
+
+		result, err := cl.autoscaling.DescribeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: names[start:end],
+			MaxRecords:            aws.Int32(maxAutoScalingGroupNames),
+		})
+		if err != nil {
+			c.log.Warn("unable to describe autoscaling groups for instances", zap.Error(err))
+			return
+		}
+		groups = append(groups, result.AutoScalingGroups...)
+	}
+
+	for _, group := range groups {
+		members := byGroup[aws.ToString(group.AutoScalingGroupName)]
+		if len(group.TargetGroupARNs) == 0 || len(members) == 0 {
+			continue
+		}
+
+		targets := make([]elbv2types.TargetDescription, len(members))
+		byID := make(map[string]*ec2v1.Instance, len(members))
+		for idx, i := range members {
+			targets[idx] = elbv2types.TargetDescription{Id: aws.String(i.InstanceId)}
+			byID[i.InstanceId] = i
+		}
+
+		for _, arn := range group.TargetGroupARNs {
+			health, err := c.describeTargetHealth(ctx, cl, targetGroupNameFromARN(arn), arn, targets)
+			if err != nil {
+				c.log.Warn("unable to describe target health for instances", zap.String("targetGroup", arn), zap.Error(err))
+				continue
+			}
+
+			for _, th := range health {
+				if i, ok := byID[th.Target.GetId()]; ok {
+					i.TargetHealth = append(i.TargetHealth, th)
+				}
+			}
+		}
+	}
+}
+
+// e.g. arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-targets/73e2d6bc24d8a067 -> my-targets
+func targetGroupNameFromARN(arn string) string {
+	parts := strings.Split(arn, "/")
+	if len(parts) < 2 {
+		return ""
+	}
+	return parts[1]
+}
+
+func targetDescriptionsFromProto(targets []*elbv2v1.Target) []elbv2types.TargetDescription {
+	ret := make([]elbv2types.TargetDescription, len(targets))
+	for idx, t := range targets {
+		ret[idx] = elbv2types.TargetDescription{Id: aws.String(t.Id)}
+		if t.Port != 0 {
+			ret[idx].Port = aws.Int32(t.Port)
+		}
+		if t.AvailabilityZone != "" {
+			ret[idx].AvailabilityZone = aws.String(t.AvailabilityZone)
+		}
+	}
+	return ret
+}
+
+func protoForLoadBalancerType(t elbv2types.LoadBalancerTypeEnum) elbv2v1.LoadBalancer_Type {
+	val, ok := elbv2v1.LoadBalancer_Type_value[strings.ToUpper(string(t))]
+	if !ok {
+		return elbv2v1.LoadBalancer_UNKNOWN
+	}
+	return elbv2v1.LoadBalancer_Type(val)
+}
+
+func protoForLoadBalancerScheme(scheme elbv2types.LoadBalancerSchemeEnum) elbv2v1.LoadBalancer_Scheme {
+	val, ok := elbv2v1.LoadBalancer_Scheme_value[strcase.ToScreamingSnake(string(scheme))]
+	if !ok {
+		return elbv2v1.LoadBalancer_SCHEME_UNKNOWN
+	}
+	return elbv2v1.LoadBalancer_Scheme(val)
+}
+
+func protoForLoadBalancerState(state *elbv2types.LoadBalancerState) elbv2v1.LoadBalancer_State {
+	if state == nil {
+		return elbv2v1.LoadBalancer_STATE_UNSPECIFIED
+	}
+	val, ok := elbv2v1.LoadBalancer_State_value[strings.ToUpper(string(state.Code))]
+	if !ok {
+		return elbv2v1.LoadBalancer_STATE_UNKNOWN
+	}
+	return elbv2v1.LoadBalancer_State(val)
+}
+
+func protoForTargetType(t elbv2types.TargetTypeEnum) elbv2v1.TargetGroup_TargetType {
+	val, ok := elbv2v1.TargetGroup_TargetType_value[strings.ToUpper(string(t))]
+	if !ok {
+		return elbv2v1.TargetGroup_UNKNOWN
+	}
+	return elbv2v1.TargetGroup_TargetType(val)
+}
+
+func protoForTargetHealthState(state elbv2types.TargetHealthStateEnum) elbv2v1.TargetHealth_State {
+	// Transform 'unhealthy.draining' to 'UNHEALTHY_DRAINING'.
+	val, ok := elbv2v1.TargetHealth_State_value[strings.ReplaceAll(strings.ToUpper(string(state)), ".", "_")]
+	if !ok {
+		return elbv2v1.TargetHealth_UNKNOWN
+	}
+	return elbv2v1.TargetHealth_State(val)
+}
+
+func protoForTargetHealthReason(reason elbv2types.TargetHealthReasonEnum) elbv2v1.TargetHealth_Reason {
+	if reason == "" {
+		return elbv2v1.TargetHealth_REASON_UNSPECIFIED
+	}
+
+	// Transform 'Target.ResponseCodeMismatch' to 'TARGET_RESPONSE_CODE_MISMATCH'.
+	val, ok := elbv2v1.TargetHealth_Reason_value[strcase.ToScreamingSnake(strings.ReplaceAll(string(reason), ".", ""))]
+	if !ok {
+		return elbv2v1.TargetHealth_REASON_UNKNOWN
+	}
+	return elbv2v1.TargetHealth_Reason(val)
+}
+
+func newProtoForLoadBalancer(account, region string, lb elbv2types.LoadBalancer) *elbv2v1.LoadBalancer {
+	ret := &elbv2v1.LoadBalancer{
+		Name:    aws.ToString(lb.LoadBalancerName),
+		Region:  region,
+		Account: account,
+		Arn:     aws.ToString(lb.LoadBalancerArn),
+		DnsName: aws.ToString(lb.DNSName),
+		Type:    protoForLoadBalancerType(lb.Type),
+		Scheme:  protoForLoadBalancerScheme(lb.Scheme),
+		State:   protoForLoadBalancerState(lb.State),
+		VpcId:   aws.ToString(lb.VpcId),
+	}
+
+	if lb.State != nil {
+		ret.StateReason = aws.ToString(lb.State.Reason)
+	}
+
+	ret.Zones = make([]string, len(lb.AvailabilityZones))
+	for idx, az := range lb.AvailabilityZones {
+		ret.Zones[idx] = aws.ToString(az.ZoneName)
+	}
+
+	return ret
+}
+
+func newProtoForListener(l elbv2types.Listener) *elbv2v1.Listener {
+	ret := &elbv2v1.Listener{
+		Arn:             aws.ToString(l.ListenerArn),
+		LoadBalancerArn: aws.ToString(l.LoadBalancerArn),
+		Port:            aws.ToInt32(l.Port),
+		Protocol:        string(l.Protocol),
+	}
+
+	for _, action := range l.DefaultActions {
+		if action.Type != elbv2types.ActionTypeEnumForward {
+			continue
+		}
+
+		// A forward action either has a single target group or a weighted list of target groups.
+		if action.TargetGroupArn != nil {
+			ret.DefaultTargetGroupArns = append(ret.DefaultTargetGroupArns, aws.ToString(action.TargetGroupArn))
+		} else if action.ForwardConfig != nil {
+			for _, tg := range action.ForwardConfig.TargetGroups {
+				ret.DefaultTargetGroupArns = append(ret.DefaultTargetGroupArns, aws.ToString(tg.TargetGroupArn))
+			}
+		}
+	}
+
+	return ret
+}
+
+func newProtoForTargetGroup(account, region string, tg elbv2types.TargetGroup) *elbv2v1.TargetGroup {
+	ret := &elbv2v1.TargetGroup{
+		Name:             aws.ToString(tg.TargetGroupName),
+		Region:           region,
+		Account:          account,
+		Arn:              aws.ToString(tg.TargetGroupArn),
+		Protocol:         string(tg.Protocol),
+		Port:             aws.ToInt32(tg.Port),
+		VpcId:            aws.ToString(tg.VpcId),
+		TargetType:       protoForTargetType(tg.TargetType),
+		LoadBalancerArns: tg.LoadBalancerArns,
+		HealthCheck: &elbv2v1.HealthCheck{
+			Enabled:                 aws.ToBool(tg.HealthCheckEnabled),
+			Protocol:                string(tg.HealthCheckProtocol),
+			Port:                    aws.ToString(tg.HealthCheckPort),
+			Path:                    aws.ToString(tg.HealthCheckPath),
+			IntervalSeconds:         aws.ToInt32(tg.HealthCheckIntervalSeconds),
+			TimeoutSeconds:          aws.ToInt32(tg.HealthCheckTimeoutSeconds),
+			HealthyThresholdCount:   aws.ToInt32(tg.HealthyThresholdCount),
+			UnhealthyThresholdCount: aws.ToInt32(tg.UnhealthyThresholdCount),
+		},
+	}
+
+	if tg.Matcher != nil {
+		if tg.Matcher.HttpCode != nil {
+			ret.HealthCheck.Matcher = aws.ToString(tg.Matcher.HttpCode)
+		} else {
+			ret.HealthCheck.Matcher = aws.ToString(tg.Matcher.GrpcCode)
+		}
+	}
+
+	return ret
+}
+
+func newProtoForTargetHealth(targetGroupName, targetGroupARN string, th elbv2types.TargetHealthDescription) *elbv2v1.TargetHealth {
+	ret := &elbv2v1.TargetHealth{
+		TargetGroupName: targetGroupName,
+		TargetGroupArn:  targetGroupARN,
+		HealthCheckPort: aws.ToString(th.HealthCheckPort),
+	}
+
+	if th.Target != nil {
+		ret.Target = &elbv2v1.Target{
+			Id:               aws.ToString(th.Target.Id),
+			Port:             aws.ToInt32(th.Target.Port),
+			AvailabilityZone: aws.ToString(th.Target.AvailabilityZone),
+		}
+	}
+
+	if th.TargetHealth != nil {
+		ret.State = protoForTargetHealthState(th.TargetHealth.State)
+		ret.Reason = protoForTargetHealthReason(th.TargetHealth.Reason)
+		ret.Description = aws.ToString(th.TargetHealth.Description)
+	}
+
+	return ret
+}
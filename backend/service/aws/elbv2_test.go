@@ -0,0 +1,419 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	astypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	elbv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbv2types "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	ec2v1 "github.com/lyft/clutch/backend/api/aws/ec2/v1"
+	elbv2v1 "github.com/lyft/clutch/backend/api/aws/elbv2/v1"
+)
+
+const testTargetGroupARN = "arn:aws:elasticloadbalancing:us-east-1:123456789012:targetgroup/my-targets/73e2d6bc24d8a067"
+
+var testTargetGroup = elbv2types.TargetGroup{
+	TargetGroupName:            aws.String("my-targets"),
+	TargetGroupArn:             aws.String(testTargetGroupARN),
+	Protocol:                   elbv2types.ProtocolEnumHttp,
+	Port:                       aws.Int32(80),
+	VpcId:                      aws.String("vpc-3ac0fb5f"),
+	TargetType:                 elbv2types.TargetTypeEnumInstance,
+	LoadBalancerArns:           []string{"arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"},
+	HealthCheckEnabled:         aws.Bool(true),
+	HealthCheckProtocol:        elbv2types.ProtocolEnumHttp,
+	HealthCheckPort:            aws.String("traffic-port"),
+	HealthCheckPath:            aws.String("/healthcheck"),
+	HealthCheckIntervalSeconds: aws.Int32(30),
+	HealthCheckTimeoutSeconds:  aws.Int32(5),
+	HealthyThresholdCount:      aws.Int32(5),
+	UnhealthyThresholdCount:    aws.Int32(2),
+	Matcher:                    &elbv2types.Matcher{HttpCode: aws.String("200")},
+}
+
+var testTargetHealthDescriptions = []elbv2types.TargetHealthDescription{
+	{
+		Target:          &elbv2types.TargetDescription{Id: aws.String("i-123456789abcdef0"), Port: aws.Int32(80)},
+		HealthCheckPort: aws.String("80"),
+		TargetHealth: &elbv2types.TargetHealth{
+			State:       elbv2types.TargetHealthStateEnumUnhealthy,
+			Reason:      elbv2types.TargetHealthReasonEnumResponseCodeMismatch,
+			Description: aws.String("Health checks failed with these codes: [503]"),
+		},
+	},
+}
+
+func TestDescribeLoadBalancers(t *testing.T) {
+	m := &mockELBV2{
+		loadBalancers: []elbv2types.LoadBalancer{
+			{
+				LoadBalancerName:  aws.String("my-lb"),
+				LoadBalancerArn:   aws.String("arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188"),
+				DNSName:           aws.String("my-lb-1234567890.us-east-1.elb.amazonaws.com"),
+				Type:              elbv2types.LoadBalancerTypeEnumApplication,
+				Scheme:            elbv2types.LoadBalancerSchemeEnumInternetFacing,
+				State:             &elbv2types.LoadBalancerState{Code: elbv2types.LoadBalancerStateEnumActiveImpaired, Reason: aws.String("impaired")},
+				VpcId:             aws.String("vpc-3ac0fb5f"),
+				AvailabilityZones: []elbv2types.AvailabilityZone{{ZoneName: aws.String("us-east-1a")}},
+			},
+		},
+	}
+	c := &client{
+		currentAccountAlias: "default",
+		accounts: map[string]*accountClients{
+			"default": {
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", elbv2: m},
+				},
+			},
+		},
+	}
+
+	results, err := c.DescribeLoadBalancers(context.Background(), "default", "us-east-1", []string{"my-lb"})
+	assert.NoError(t, err)
+	assert.Equal(t, []*elbv2v1.LoadBalancer{
+		{
+			Name:        "my-lb",
+			Region:      "us-east-1",
+			Account:     "default",
+			Arn:         "arn:aws:elasticloadbalancing:us-east-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188",
+			DnsName:     "my-lb-1234567890.us-east-1.elb.amazonaws.com",
+			Type:        elbv2v1.LoadBalancer_APPLICATION,
+			Scheme:      elbv2v1.LoadBalancer_INTERNET_FACING,
+			State:       elbv2v1.LoadBalancer_ACTIVE_IMPAIRED,
+			StateReason: "impaired",
+			VpcId:       "vpc-3ac0fb5f",
+			Zones:       []string{"us-east-1a"},
+		},
+	}, results)
+
+	m.err = errors.New("whoops")
+	_, err = c.DescribeLoadBalancers(context.Background(), "default", "us-east-1", nil)
+	assert.EqualError(t, err, "whoops")
+
+	_, err = c.DescribeLoadBalancers(context.Background(), "default", "us-north-5", nil)
+	assert.Error(t, err)
+}
+
+func TestDescribeListeners(t *testing.T) {
+	m := &mockELBV2{
+		loadBalancers: []elbv2types.LoadBalancer{{LoadBalancerName: aws.String("my-lb"), LoadBalancerArn: aws.String("lb-arn")}},
+		listeners: []elbv2types.Listener{
+			{
+				ListenerArn:     aws.String("listener-arn"),
+				LoadBalancerArn: aws.String("lb-arn"),
+				Port:            aws.Int32(443),
+				Protocol:        elbv2types.ProtocolEnumHttps,
+				DefaultActions: []elbv2types.Action{
+					{Type: elbv2types.ActionTypeEnumForward, TargetGroupArn: aws.String("tg-1")},
+					{Type: elbv2types.ActionTypeEnumForward, ForwardConfig: &elbv2types.ForwardActionConfig{
+						TargetGroups: []elbv2types.TargetGroupTuple{{TargetGroupArn: aws.String("tg-2")}, {TargetGroupArn: aws.String("tg-3")}},
+					}},
+					{Type: elbv2types.ActionTypeEnumFixedResponse},
+				},
+			},
+		},
+	}
+	c := &client{
+		currentAccountAlias: "default",
+		accounts: map[string]*accountClients{
+			"default": {
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", elbv2: m},
+				},
+			},
+		},
+	}
+
+	results, err := c.DescribeListeners(context.Background(), "default", "us-east-1", "my-lb")
+	assert.NoError(t, err)
+	assert.Equal(t, "lb-arn", aws.ToString(m.listenersInput.LoadBalancerArn))
+	assert.Equal(t, []*elbv2v1.Listener{
+		{
+			Arn:                    "listener-arn",
+			LoadBalancerArn:        "lb-arn",
+			Port:                   443,
+			Protocol:               "HTTPS",
+			DefaultTargetGroupArns: []string{"tg-1", "tg-2", "tg-3"},
+		},
+	}, results)
+
+	m.loadBalancers = nil
+	_, err = c.DescribeListeners(context.Background(), "default", "us-east-1", "my-lb")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestDescribeTargetGroups(t *testing.T) {
+	m := &mockELBV2{targetGroups: []elbv2types.TargetGroup{testTargetGroup}}
+	c := &client{
+		currentAccountAlias: "default",
+		accounts: map[string]*accountClients{
+			"default": {
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", elbv2: m},
+				},
+			},
+		},
+	}
+
+	results, err := c.DescribeTargetGroups(context.Background(), "default", "us-east-1", nil)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Equal(t, "my-targets", results[0].Name)
+	assert.Equal(t, testTargetGroupARN, results[0].Arn)
+	assert.Equal(t, elbv2v1.TargetGroup_INSTANCE, results[0].TargetType)
+	assert.Equal(t, "200", results[0].HealthCheck.Matcher)
+	assert.Equal(t, int32(2), results[0].HealthCheck.UnhealthyThresholdCount)
+}
+
+func TestDescribeTargetHealth(t *testing.T) {
+	m := &mockELBV2{
+		targetGroups: []elbv2types.TargetGroup{testTargetGroup},
+		targetHealth: testTargetHealthDescriptions,
+	}
+	c := &client{
+		currentAccountAlias: "default",
+		accounts: map[string]*accountClients{
+			"default": {
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", elbv2: m},
+				},
+			},
+		},
+	}
+
+	results, err := c.DescribeTargetHealth(context.Background(), "default", "us-east-1", "my-targets")
+	assert.NoError(t, err)
+	assert.Equal(t, testTargetGroupARN, aws.ToString(m.targetHealthInput.TargetGroupArn))
+	assert.Equal(t, []*elbv2v1.TargetHealth{
+		{
+			TargetGroupName: "my-targets",
+			TargetGroupArn:  testTargetGroupARN,
+			Target:          &elbv2v1.Target{Id: "i-123456789abcdef0", Port: 80},
+			HealthCheckPort: "80",
+			State:           elbv2v1.TargetHealth_UNHEALTHY,
+			Reason:          elbv2v1.TargetHealth_TARGET_RESPONSE_CODE_MISMATCH,
+			Description:     "Health checks failed with these codes: [503]",
+		},
+	}, results)
+
+	m.targetGroups = nil
+	_, err = c.DescribeTargetHealth(context.Background(), "default", "us-east-1", "my-targets")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestRegisterAndDeregisterTargets(t *testing.T) {
+	m := &mockELBV2{targetGroups: []elbv2types.TargetGroup{testTargetGroup}}
+	c := &client{
+		currentAccountAlias: "default",
+		accounts: map[string]*accountClients{
+			"default": {
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", elbv2: m},
+				},
+			},
+		},
+	}
+
+	targets := []*elbv2v1.Target{{Id: "i-123456789abcdef0"}, {Id: "10.0.0.1", Port: 8080, AvailabilityZone: "all"}}
+	expected := []elbv2types.TargetDescription{
+		{Id: aws.String("i-123456789abcdef0")},
+		{Id: aws.String("10.0.0.1"), Port: aws.Int32(8080), AvailabilityZone: aws.String("all")},
+	}
+
+	err := c.RegisterTargets(context.Background(), "default", "us-east-1", "my-targets", targets)
+	assert.NoError(t, err)
+	assert.Equal(t, testTargetGroupARN, aws.ToString(m.registerInput.TargetGroupArn))
+	assert.Equal(t, expected, m.registerInput.Targets)
+
+	err = c.DeregisterTargets(context.Background(), "default", "us-east-1", "my-targets", targets)
+	assert.NoError(t, err)
+	assert.Equal(t, testTargetGroupARN, aws.ToString(m.deregisterInput.TargetGroupArn))
+	assert.Equal(t, expected, m.deregisterInput.Targets)
+
+	m.err = errors.New("nope")
+	err = c.RegisterTargets(context.Background(), "default", "us-east-1", "my-targets", targets)
+	assert.EqualError(t, err, "nope")
+	err = c.DeregisterTargets(context.Background(), "default", "us-east-1", "my-targets", targets)
+	assert.EqualError(t, err, "nope")
+}
+
+func TestDescribeInstancesTargetHealth(t *testing.T) {
+	instance := testInstance
+	instance.Tags = append([]ec2types.Tag{{Key: aws.String(autoscalingGroupNameTag), Value: aws.String("my-asg")}}, testInstance.Tags...)
+
+	e := &mockEC2{instances: []ec2types.Instance{instance}}
+	m := &mockELBV2{targetHealth: testTargetHealthDescriptions}
+	a := &mockAutoscaling{
+		describeASGOutput: &autoscaling.DescribeAutoScalingGroupsOutput{
+			AutoScalingGroups: []astypes.AutoScalingGroup{
+				{AutoScalingGroupName: aws.String("my-asg"), TargetGroupARNs: []string{testTargetGroupARN}},
+			},
+		},
+	}
+	c := &client{
+		currentAccountAlias: "default",
+		log:                 zaptest.NewLogger(t),
+		accounts: map[string]*accountClients{
+			"default": {
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", ec2: e, elbv2: m, autoscaling: a},
+				},
+			},
+		},
+	}
+
+	// Target groups are only linked when requested.
+	results, err := c.DescribeInstances(context.Background(), "default", "us-east-1", []string{"i-123456789abcdef0"})
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Empty(t, results[0].TargetHealth)
+	assert.Nil(t, m.targetHealthInput)
+
+	err = c.DescribeInstancesTargetHealth(context.Background(), "default", "us-east-1", results)
+	assert.NoError(t, err)
+	assert.Len(t, results[0].TargetHealth, 1)
+	assert.Equal(t, "my-targets", results[0].TargetHealth[0].TargetGroupName)
+	assert.Equal(t, elbv2v1.TargetHealth_UNHEALTHY, results[0].TargetHealth[0].State)
+	assert.Equal(t, "i-123456789abcdef0", aws.ToString(m.targetHealthInput.Targets[0].Id))
+
+	// Failures to link target groups do not fail the describe.
+	m.err = errors.New("access denied")
+	results, err = c.DescribeInstances(context.Background(), "default", "us-east-1", []string{"i-123456789abcdef0"})
+	assert.NoError(t, err)
+	err = c.DescribeInstancesTargetHealth(context.Background(), "default", "us-east-1", results)
+	assert.NoError(t, err)
+	assert.Len(t, results, 1)
+	assert.Empty(t, results[0].TargetHealth)
+
+	err = c.DescribeInstancesTargetHealth(context.Background(), "default", "us-west-2", results)
+	assert.Error(t, err)
+}
+
+type recordingAutoscaling struct {
+	mockAutoscaling
+
+	names [][]string
+}
+
+func (r *recordingAutoscaling) DescribeAutoScalingGroups(ctx context.Context, params *autoscaling.DescribeAutoScalingGroupsInput, optFns ...func(*autoscaling.Options)) (*autoscaling.DescribeAutoScalingGroupsOutput, error) {
+	r.names = append(r.names, params.AutoScalingGroupNames)
+	return &autoscaling.DescribeAutoScalingGroupsOutput{}, nil
+}
+
+func TestLinkInstancesToTargetGroupsChunksAutoscalingGroups(t *testing.T) {
+	var instances []*ec2v1.Instance
+	for i := 0; i < 150; i++ {
+		instances = append(instances, &ec2v1.Instance{
+			InstanceId: fmt.Sprintf("i-%d", i),
+			Tags:       map[string]string{autoscalingGroupNameTag: fmt.Sprintf("asg-%d", i)},
+		})
+	}
+
+	a := &recordingAutoscaling{}
+	c := &client{log: zaptest.NewLogger(t)}
+	c.linkInstancesToTargetGroups(context.Background(), &regionalClient{autoscaling: a}, instances)
+
+	assert.Len(t, a.names, 2)
+	assert.Len(t, a.names[0], 100)
+	assert.Len(t, a.names[1], 50)
+}
+
+func TestProtoForTargetHealthState(t *testing.T) {
+	assert.Equal(t, elbv2v1.TargetHealth_UNKNOWN, protoForTargetHealthState("foo"))
+	assert.Equal(t, elbv2v1.TargetHealth_HEALTHY, protoForTargetHealthState(elbv2types.TargetHealthStateEnumHealthy))
+	assert.Equal(t, elbv2v1.TargetHealth_UNHEALTHY_DRAINING, protoForTargetHealthState(elbv2types.TargetHealthStateEnumUnhealthyDraining))
+}
+
+func TestProtoForTargetHealthReason(t *testing.T) {
+	assert.Equal(t, elbv2v1.TargetHealth_REASON_UNSPECIFIED, protoForTargetHealthReason(""))
+	assert.Equal(t, elbv2v1.TargetHealth_REASON_UNKNOWN, protoForTargetHealthReason("Elb.Foo"))
+	for _, reason := range elbv2types.TargetHealthReasonEnum("").Values() {
+		assert.NotEqual(t, elbv2v1.TargetHealth_REASON_UNKNOWN, protoForTargetHealthReason(reason), reason)
+	}
+	assert.Equal(t, elbv2v1.TargetHealth_ELB_REGISTRATION_IN_PROGRESS, protoForTargetHealthReason(elbv2types.TargetHealthReasonEnumRegistrationInProgress))
+	assert.Equal(t, elbv2v1.TargetHealth_TARGET_IP_UNUSABLE, protoForTargetHealthReason(elbv2types.TargetHealthReasonEnumIpUnusable))
+}
+
+func TestTargetGroupNameFromARN(t *testing.T) {
+	assert.Equal(t, "my-targets", targetGroupNameFromARN(testTargetGroupARN))
+	assert.Equal(t, "", targetGroupNameFromARN("foo"))
+}
+
+func TestLinkInstancesToTargetGroupsWithoutAutoscalingGroup(t *testing.T) {
+	c := &client{}
+	instances := []*ec2v1.Instance{{InstanceId: "i-123"}}
+	c.linkInstancesToTargetGroups(context.Background(), nil, instances)
+	assert.Empty(t, instances[0].TargetHealth)
+}
+
+type mockELBV2 struct {
+	err error
+
+	loadBalancers []elbv2types.LoadBalancer
+	listeners     []elbv2types.Listener
+	targetGroups  []elbv2types.TargetGroup
+	targetHealth  []elbv2types.TargetHealthDescription
+
+	listenersInput    *elbv2.DescribeListenersInput
+	targetHealthInput *elbv2.DescribeTargetHealthInput
+	registerInput     *elbv2.RegisterTargetsInput
+	deregisterInput   *elbv2.DeregisterTargetsInput
+}
+
+func (m *mockELBV2) DescribeLoadBalancers(ctx context.Context, params *elbv2.DescribeLoadBalancersInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeLoadBalancersOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &elbv2.DescribeLoadBalancersOutput{LoadBalancers: m.loadBalancers}, nil
+}
+
+func (m *mockELBV2) DescribeListeners(ctx context.Context, params *elbv2.DescribeListenersInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeListenersOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.listenersInput = params
+	return &elbv2.DescribeListenersOutput{Listeners: m.listeners}, nil
+}
+
+func (m *mockELBV2) DescribeTargetGroups(ctx context.Context, params *elbv2.DescribeTargetGroupsInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTargetGroupsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &elbv2.DescribeTargetGroupsOutput{TargetGroups: m.targetGroups}, nil
+}
+
+func (m *mockELBV2) DescribeTargetHealth(ctx context.Context, params *elbv2.DescribeTargetHealthInput, optFns ...func(*elbv2.Options)) (*elbv2.DescribeTargetHealthOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	m.targetHealthInput = params
+	return &elbv2.DescribeTargetHealthOutput{TargetHealthDescriptions: m.targetHealth}, nil
+}
+
+func (m *mockELBV2) RegisterTargets(ctx context.Context, params *elbv2.RegisterTargetsInput, optFns ...func(*elbv2.Options)) (*elbv2.RegisterTargetsOutput, error) {
+	m.registerInput = params
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &elbv2.RegisterTargetsOutput{}, nil
+}
+
+func (m *mockELBV2) DeregisterTargets(ctx context.Context, params *elbv2.DeregisterTargetsInput, optFns ...func(*elbv2.Options)) (*elbv2.DeregisterTargetsOutput, error) {
+	m.deregisterInput = params
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &elbv2.DeregisterTargetsOutput{}, nil
+}
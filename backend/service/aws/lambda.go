@@ -0,0 +1,289 @@
+package aws
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	lambdav1 "github.com/lyft/clutch/backend/api/aws/lambda/v1"
+)
+
+const (
+	// The number of most recently published versions returned when describing a function.
+	maxLambdaFunctionVersions = 25
+
+	defaultLambdaInvocationSummaryWindow = time.Hour
+	// CloudWatch periods must be a multiple of a minute, the period is grown to keep the number of datapoints bounded.
+	maxLambdaInvocationSummaryDatapoints = 60
+)
+
+func (c *client) DescribeLambdaFunction(ctx context.Context, account, region, name string) (*lambdav1.Function, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := cl.lambda.GetFunction(ctx, &lambda.GetFunctionInput{FunctionName: aws.String(name)})
+	if err != nil {
+		return nil, err
+	}
+
+	ret := newProtoForLambdaFunction(account, region, output.Configuration)
+	if output.Concurrency != nil && output.Concurrency.ReservedConcurrentExecutions != nil {
+		ret.ReservedConcurrentExecutions = wrapperspb.Int32(*output.Concurrency.ReservedConcurrentExecutions)
+	}
+
+	aliasPaginator := lambda.NewListAliasesPaginator(cl.lambda, &lambda.ListAliasesInput{FunctionName: aws.String(name)})
+	for aliasPaginator.HasMorePages() {
+		page, err := aliasPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range page.Aliases {
+			ret.Aliases = append(ret.Aliases, newProtoForLambdaAlias(a))
+		}
+	}
+
+	versionPaginator := lambda.NewListVersionsByFunctionPaginator(cl.lambda, &lambda.ListVersionsByFunctionInput{FunctionName: aws.String(name)})
+	for versionPaginator.HasMorePages() {
+		page, err := versionPaginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Versions {
+			// The unpublished version is already described by the function itself.
+			if aws.ToString(v.Version) == "$LATEST" {
+				continue
+			}
+			ret.Versions = append(ret.Versions, &lambdav1.Function_Version{
+				Version:      aws.ToString(v.Version),
+				Description:  aws.ToString(v.Description),
+				LastModified: aws.ToString(v.LastModified),
+			})
+		}
+	}
+	if len(ret.Versions) > maxLambdaFunctionVersions {
+		ret.Versions = ret.Versions[len(ret.Versions)-maxLambdaFunctionVersions:]
+	}
+
+	return ret, nil
+}
+
+func (c *client) GetLambdaInvocationSummary(ctx context.Context, account, region, name string, window time.Duration) (*lambdav1.InvocationSummary, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	if window <= 0 {
+		window = defaultLambdaInvocationSummaryWindow
+	}
+	period := lambdaInvocationSummaryPeriod(window)
+
+	// Lambda metrics are dimensioned by function name, not ARN.
+	dimensions := []cwtypes.Dimension{
+		{Name: aws.String("FunctionName"), Value: aws.String(lambdaFunctionNameFromARN(name))},
+	}
+	metricQuery := func(id, metricName string) cwtypes.MetricDataQuery {
+		return cwtypes.MetricDataQuery{
+			Id: aws.String(id),
+			MetricStat: &cwtypes.MetricStat{
+				Metric: &cwtypes.Metric{
+					Namespace:  aws.String("AWS/Lambda"),
+					MetricName: aws.String(metricName),
+					Dimensions: dimensions,
+				},
+				Period: aws.Int32(int32(period.Seconds())),
+				Stat:   aws.String("Sum"),
+			},
+		}
+	}
+
+	end := time.Now()
+	input := &cloudwatch.GetMetricDataInput{
+		StartTime: aws.Time(end.Add(-window)),
+		EndTime:   aws.Time(end),
+		MetricDataQueries: []cwtypes.MetricDataQuery{
+			metricQuery("invocations", "Invocations"),
+			metricQuery("errors", "Errors"),
+			metricQuery("throttles", "Throttles"),
+		},
+		ScanBy: cwtypes.ScanByTimestampAscending,
+	}
+
+	ret := &lambdav1.InvocationSummary{Period: durationpb.New(period)}
+	datapoints := make(map[time.Time]*lambdav1.InvocationSummary_Datapoint)
+
+	paginator := cloudwatch.NewGetMetricDataPaginator(cl.cloudwatch, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range output.MetricDataResults {
+			for idx, ts := range result.Timestamps {
+				if idx >= len(result.Values) {
+					break
+				}
+
+				dp, ok := datapoints[ts]
+				if !ok {
+					dp = &lambdav1.InvocationSummary_Datapoint{Timestamp: timestamppb.New(ts)}
+					datapoints[ts] = dp
+				}
+
+				value := result.Values[idx]
+				switch aws.ToString(result.Id) {
+				case "invocations":
+					dp.Invocations += value
+					ret.Invocations += value
+				case "errors":
+					dp.Errors += value
+					ret.Errors += value
+				case "throttles":
+					dp.Throttles += value
+					ret.Throttles += value
+				}
+			}
+		}
+	}
+
+	for _, dp := range datapoints {
+		ret.Datapoints = append(ret.Datapoints, dp)
+	}
+	sort.Slice(ret.Datapoints, func(i, j int) bool {
+		return ret.Datapoints[i].Timestamp.AsTime().Before(ret.Datapoints[j].Timestamp.AsTime())
+	})
+
+	if ret.Invocations > 0 {
+		ret.ErrorRate = ret.Errors / ret.Invocations
+	}
+
+	return ret, nil
+}
+
+func (c *client) PutLambdaFunctionConcurrency(ctx context.Context, account, region, name string, reservedConcurrentExecutions int32) error {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return err
+	}
+
+	input := &lambda.PutFunctionConcurrencyInput{
+		FunctionName:                 aws.String(name),
+		ReservedConcurrentExecutions: aws.Int32(reservedConcurrentExecutions),
+	}
+	_, err = cl.lambda.PutFunctionConcurrency(ctx, input)
+	return err
+}
+
+func (c *client) DeleteLambdaFunctionConcurrency(ctx context.Context, account, region, name string) error {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return err
+	}
+
+	_, err = cl.lambda.DeleteFunctionConcurrency(ctx, &lambda.DeleteFunctionConcurrencyInput{FunctionName: aws.String(name)})
+	return err
+}
+
+// Returns the smallest whole minute period that keeps the number of datapoints in the window bounded.
+func lambdaInvocationSummaryPeriod(window time.Duration) time.Duration {
+	period := window / maxLambdaInvocationSummaryDatapoints
+	if rem := period % time.Minute; rem != 0 {
+		period += time.Minute - rem
+	}
+	if period < time.Minute {
+		period = time.Minute
+	}
+	return period
+}
+
+// Extracts the function name from a function ARN, e.g. arn:aws:lambda:us-east-1:123456789012:function:my-function.
+// Names are returned as is.
+func lambdaFunctionNameFromARN(nameOrARN string) string {
+	if !strings.HasPrefix(nameOrARN, "arn:") {
+		return nameOrARN
+	}
+
+	parts := strings.Split(nameOrARN, ":")
+	if len(parts) < 7 {
+		return nameOrARN
+	}
+	return parts[6]
+}
+
+func newProtoForLambdaFunction(account, region string, cfg *lambdatypes.FunctionConfiguration) *lambdav1.Function {
+	if cfg == nil {
+		return &lambdav1.Function{Account: account, Region: region}
+	}
+
+	architectures := make([]string, len(cfg.Architectures))
+	for idx, a := range cfg.Architectures {
+		architectures[idx] = string(a)
+	}
+
+	var envKeys []string
+	if cfg.Environment != nil {
+		for k := range cfg.Environment.Variables {
+			envKeys = append(envKeys, k)
+		}
+		sort.Strings(envKeys)
+	}
+
+	return &lambdav1.Function{
+		Name:                    aws.ToString(cfg.FunctionName),
+		Region:                  region,
+		Account:                 account,
+		Arn:                     aws.ToString(cfg.FunctionArn),
+		Description:             aws.ToString(cfg.Description),
+		Runtime:                 string(cfg.Runtime),
+		Handler:                 aws.ToString(cfg.Handler),
+		PackageType:             string(cfg.PackageType),
+		Architectures:           architectures,
+		MemorySizeMb:            aws.ToInt32(cfg.MemorySize),
+		TimeoutSeconds:          aws.ToInt32(cfg.Timeout),
+		LastModified:            aws.ToString(cfg.LastModified),
+		State:                   protoForLambdaFunctionState(cfg.State),
+		StateReason:             aws.ToString(cfg.StateReason),
+		EnvironmentVariableKeys: envKeys,
+	}
+}
+
+func newProtoForLambdaAlias(a lambdatypes.AliasConfiguration) *lambdav1.Function_Alias {
+	ret := &lambdav1.Function_Alias{
+		Name:            aws.ToString(a.Name),
+		Arn:             aws.ToString(a.AliasArn),
+		Description:     aws.ToString(a.Description),
+		FunctionVersion: aws.ToString(a.FunctionVersion),
+	}
+	if a.RoutingConfig != nil {
+		ret.AdditionalVersionWeights = a.RoutingConfig.AdditionalVersionWeights
+	}
+	return ret
+}
+
+func protoForLambdaFunctionState(state lambdatypes.State) lambdav1.Function_State {
+	if state == "" {
+		return lambdav1.Function_UNSPECIFIED
+	}
+
+	val, ok := lambdav1.Function_State_value[strcase.ToScreamingSnake(string(state))]
+	if !ok {
+		return lambdav1.Function_UNKNOWN
+	}
+	return lambdav1.Function_State(val)
+}
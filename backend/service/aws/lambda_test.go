@@ -0,0 +1,218 @@
+package aws
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	lambdatypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/stretchr/testify/assert"
+
+	lambdav1 "github.com/lyft/clutch/backend/api/aws/lambda/v1"
+)
+
+type mockLambda struct {
+	lambdaClient
+
+	function *lambda.GetFunctionOutput
+	aliases  []lambdatypes.AliasConfiguration
+	versions []lambdatypes.FunctionConfiguration
+
+	putConcurrencyInput    *lambda.PutFunctionConcurrencyInput
+	deleteConcurrencyInput *lambda.DeleteFunctionConcurrencyInput
+}
+
+func (m *mockLambda) GetFunction(ctx context.Context, params *lambda.GetFunctionInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionOutput, error) {
+	return m.function, nil
+}
+
+func (m *mockLambda) ListAliases(ctx context.Context, params *lambda.ListAliasesInput, optFns ...func(*lambda.Options)) (*lambda.ListAliasesOutput, error) {
+	return &lambda.ListAliasesOutput{Aliases: m.aliases}, nil
+}
+
+func (m *mockLambda) ListVersionsByFunction(ctx context.Context, params *lambda.ListVersionsByFunctionInput, optFns ...func(*lambda.Options)) (*lambda.ListVersionsByFunctionOutput, error) {
+	return &lambda.ListVersionsByFunctionOutput{Versions: m.versions}, nil
+}
+
+func (m *mockLambda) PutFunctionConcurrency(ctx context.Context, params *lambda.PutFunctionConcurrencyInput, optFns ...func(*lambda.Options)) (*lambda.PutFunctionConcurrencyOutput, error) {
+	m.putConcurrencyInput = params
+	return &lambda.PutFunctionConcurrencyOutput{ReservedConcurrentExecutions: params.ReservedConcurrentExecutions}, nil
+}
+
+func (m *mockLambda) DeleteFunctionConcurrency(ctx context.Context, params *lambda.DeleteFunctionConcurrencyInput, optFns ...func(*lambda.Options)) (*lambda.DeleteFunctionConcurrencyOutput, error) {
+	m.deleteConcurrencyInput = params
+	return &lambda.DeleteFunctionConcurrencyOutput{}, nil
+}
+
+type mockCloudWatch struct {
+	cloudwatchClient
+
+	results []cwtypes.MetricDataResult
+	input   *cloudwatch.GetMetricDataInput
+}
+
+func (m *mockCloudWatch) GetMetricData(ctx context.Context, params *cloudwatch.GetMetricDataInput, optFns ...func(*cloudwatch.Options)) (*cloudwatch.GetMetricDataOutput, error) {
+	m.input = params
+	return &cloudwatch.GetMetricDataOutput{MetricDataResults: m.results}, nil
+}
+
+func newTestLambdaClient(l *mockLambda, cw *mockCloudWatch) *client {
+	return &client{
+		currentAccountAlias: "default",
+		accounts: map[string]*accountClients{
+			"default": {
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", lambda: l, cloudwatch: cw},
+				},
+			},
+		},
+	}
+}
+
+func TestDescribeLambdaFunction(t *testing.T) {
+	versions := []lambdatypes.FunctionConfiguration{{Version: aws.String("$LATEST")}}
+	for i := 1; i <= 30; i++ {
+		versions = append(versions, lambdatypes.FunctionConfiguration{Version: aws.String(strconv.Itoa(i))})
+	}
+
+	m := &mockLambda{
+		function: &lambda.GetFunctionOutput{
+			Configuration: &lambdatypes.FunctionConfiguration{
+				FunctionName:  aws.String("my-function"),
+				FunctionArn:   aws.String("arn:aws:lambda:us-east-1:123456789012:function:my-function"),
+				Runtime:       lambdatypes.RuntimePython312,
+				Handler:       aws.String("main.handler"),
+				PackageType:   lambdatypes.PackageTypeZip,
+				Architectures: []lambdatypes.Architecture{lambdatypes.ArchitectureArm64},
+				MemorySize:    aws.Int32(512),
+				Timeout:       aws.Int32(30),
+				State:         lambdatypes.StateActive,
+				Environment: &lambdatypes.EnvironmentResponse{
+					Variables: map[string]string{"LOG_LEVEL": "info", "API_TOKEN": "secret"},
+				},
+			},
+			Concurrency: &lambdatypes.Concurrency{ReservedConcurrentExecutions: aws.Int32(0)},
+		},
+		aliases: []lambdatypes.AliasConfiguration{
+			{
+				Name:            aws.String("live"),
+				FunctionVersion: aws.String("29"),
+				RoutingConfig:   &lambdatypes.AliasRoutingConfiguration{AdditionalVersionWeights: map[string]float64{"30": 0.1}},
+			},
+		},
+		versions: versions,
+	}
+	c := newTestLambdaClient(m, nil)
+
+	function, err := c.DescribeLambdaFunction(context.Background(), "default", "us-east-1", "my-function")
+	assert.NoError(t, err)
+	assert.Equal(t, "my-function", function.Name)
+	assert.Equal(t, "default", function.Account)
+	assert.Equal(t, "python3.12", function.Runtime)
+	assert.Equal(t, []string{"arm64"}, function.Architectures)
+	assert.Equal(t, lambdav1.Function_ACTIVE, function.State)
+	assert.Equal(t, []string{"API_TOKEN", "LOG_LEVEL"}, function.EnvironmentVariableKeys)
+	assert.NotContains(t, function.String(), "secret")
+	assert.NotNil(t, function.ReservedConcurrentExecutions)
+	assert.Equal(t, int32(0), function.ReservedConcurrentExecutions.Value)
+
+	assert.Len(t, function.Aliases, 1)
+	assert.Equal(t, 0.1, function.Aliases[0].AdditionalVersionWeights["30"])
+
+	assert.Len(t, function.Versions, maxLambdaFunctionVersions)
+	assert.Equal(t, "6", function.Versions[0].Version)
+	assert.Equal(t, "30", function.Versions[len(function.Versions)-1].Version)
+}
+
+func TestDescribeLambdaFunctionUnreservedConcurrency(t *testing.T) {
+	m := &mockLambda{
+		function: &lambda.GetFunctionOutput{
+			Configuration: &lambdatypes.FunctionConfiguration{FunctionName: aws.String("my-function"), State: "Unrecognized"},
+		},
+	}
+	c := newTestLambdaClient(m, nil)
+
+	function, err := c.DescribeLambdaFunction(context.Background(), "default", "us-east-1", "my-function")
+	assert.NoError(t, err)
+	assert.Nil(t, function.ReservedConcurrentExecutions)
+	assert.Equal(t, lambdav1.Function_UNKNOWN, function.State)
+	assert.Empty(t, function.EnvironmentVariableKeys)
+}
+
+func TestGetLambdaInvocationSummary(t *testing.T) {
+	t0 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Minute)
+	cw := &mockCloudWatch{
+		results: []cwtypes.MetricDataResult{
+			{Id: aws.String("invocations"), Timestamps: []time.Time{t1, t0}, Values: []float64{100, 300}},
+			{Id: aws.String("errors"), Timestamps: []time.Time{t0}, Values: []float64{20}},
+			{Id: aws.String("throttles"), Timestamps: []time.Time{t1}, Values: []float64{5}},
+		},
+	}
+	c := newTestLambdaClient(nil, cw)
+
+	summary, err := c.GetLambdaInvocationSummary(context.Background(), "default", "us-east-1", "arn:aws:lambda:us-east-1:123456789012:function:my-function", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, float64(400), summary.Invocations)
+	assert.Equal(t, float64(20), summary.Errors)
+	assert.Equal(t, float64(5), summary.Throttles)
+	assert.Equal(t, 0.05, summary.ErrorRate)
+	assert.Equal(t, time.Minute, summary.Period.AsDuration())
+
+	assert.Len(t, summary.Datapoints, 2)
+	assert.Equal(t, t0, summary.Datapoints[0].Timestamp.AsTime())
+	assert.Equal(t, float64(300), summary.Datapoints[0].Invocations)
+	assert.Equal(t, float64(20), summary.Datapoints[0].Errors)
+	assert.Equal(t, float64(5), summary.Datapoints[1].Throttles)
+
+	assert.Equal(t, time.Hour, cw.input.EndTime.Sub(*cw.input.StartTime))
+	assert.Len(t, cw.input.MetricDataQueries, 3)
+	for _, q := range cw.input.MetricDataQueries {
+		assert.Equal(t, "my-function", aws.ToString(q.MetricStat.Metric.Dimensions[0].Value))
+		assert.Equal(t, int32(60), aws.ToInt32(q.MetricStat.Period))
+	}
+}
+
+func TestLambdaInvocationSummaryPeriod(t *testing.T) {
+	tests := []struct {
+		window time.Duration
+		period time.Duration
+	}{
+		{window: time.Minute, period: time.Minute},
+		{window: time.Hour, period: time.Minute},
+		{window: 90 * time.Minute, period: 2 * time.Minute},
+		{window: 24 * time.Hour, period: 24 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.period, lambdaInvocationSummaryPeriod(tt.window), tt.window.String())
+	}
+}
+
+func TestLambdaFunctionNameFromARN(t *testing.T) {
+	assert.Equal(t, "my-function", lambdaFunctionNameFromARN("my-function"))
+	assert.Equal(t, "my-function", lambdaFunctionNameFromARN("arn:aws:lambda:us-east-1:123456789012:function:my-function"))
+	assert.Equal(t, "my-function", lambdaFunctionNameFromARN("arn:aws:lambda:us-east-1:123456789012:function:my-function:live"))
+}
+
+func TestLambdaFunctionConcurrency(t *testing.T) {
+	m := &mockLambda{}
+	c := newTestLambdaClient(m, nil)
+
+	err := c.PutLambdaFunctionConcurrency(context.Background(), "default", "us-east-1", "my-function", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-function", aws.ToString(m.putConcurrencyInput.FunctionName))
+	assert.Equal(t, int32(0), aws.ToInt32(m.putConcurrencyInput.ReservedConcurrentExecutions))
+
+	err = c.DeleteLambdaFunctionConcurrency(context.Background(), "default", "us-east-1", "my-function")
+	assert.NoError(t, err)
+	assert.Equal(t, "my-function", aws.ToString(m.deleteConcurrencyInput.FunctionName))
+
+	err = c.PutLambdaFunctionConcurrency(context.Background(), "default", "us-west-2", "my-function", 10)
+	assert.Error(t, err)
+}
@@ -0,0 +1,81 @@
+package aws
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"go.uber.org/zap"
+
+	awsv1 "github.com/lyft/clutch/backend/api/config/service/aws/v1"
+)
+
+const targetRoleAccountNumberPlaceholder = "{account_number}"
+
+// Adds clients for the accounts in the organization, which are reached through the hub role of the chain.
+func (c *client) configureOrganizationAccountClients(ctx context.Context, orgClient organizationsClient, discovery *awsv1.OrganizationsDiscovery, regions []string, chain *roleChain, ds *awsv1.ScalingLimits, awsClientOptions []func(*config.LoadOptions) error) error {
+	var aliasFilter *regexp.Regexp
+	if discovery.AliasFilter != "" {
+		var err error
+		aliasFilter, err = regexp.Compile(discovery.AliasFilter)
+		if err != nil {
+			return err
+		}
+	}
+
+	accounts, err := listOrganizationAccounts(ctx, orgClient, aliasFilter)
+	if err != nil {
+		return err
+	}
+
+	discovered := 0
+	for _, account := range accounts {
+		alias := aws.ToString(account.Name)
+		if _, ok := c.accounts[alias]; ok {
+			c.log.Info("skipping discovered account, alias is already configured", zap.String("alias", alias))
+			continue
+		}
+		// Aliases are used as part of resource identifiers which are delimited by slashes.
+		if strings.Contains(alias, "/") {
+			c.log.Warn("skipping discovered account, alias contains a slash", zap.String("alias", alias))
+			continue
+		}
+
+		roleARN := strings.ReplaceAll(chain.config.TargetRoleArnTemplate, targetRoleAccountNumberPlaceholder, aws.ToString(account.Id))
+		if err := c.configureAccountClients(alias, regions, ds, awsClientOptions, chain.providerForRole(roleARN)); err != nil {
+			return err
+		}
+		discovered++
+	}
+
+	c.log.Info("configured accounts discovered from organization", zap.Int("accounts", discovered))
+	return nil
+}
+
+// Lists the active accounts in the organization with a name matching the filter.
+func listOrganizationAccounts(ctx context.Context, cl organizationsClient, aliasFilter *regexp.Regexp) ([]orgtypes.Account, error) {
+	var ret []orgtypes.Account
+	paginator := organizations.NewListAccountsPaginator(cl, &organizations.ListAccountsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, account := range output.Accounts {
+			if account.Status != orgtypes.AccountStatusActive {
+				continue
+			}
+			if aliasFilter != nil && !aliasFilter.MatchString(aws.ToString(account.Name)) {
+				continue
+			}
+			ret = append(ret, account)
+		}
+	}
+
+	return ret, nil
+}
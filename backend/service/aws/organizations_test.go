@@ -0,0 +1,109 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	orgtypes "github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap/zaptest"
+
+	awsv1 "github.com/lyft/clutch/backend/api/config/service/aws/v1"
+)
+
+type mockOrganizations struct {
+	// Each page is returned in turn, following the next token.
+	pages [][]orgtypes.Account
+	err   error
+}
+
+func (m *mockOrganizations) ListAccounts(ctx context.Context, params *organizations.ListAccountsInput, optFns ...func(*organizations.Options)) (*organizations.ListAccountsOutput, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	page := 0
+	if params.NextToken != nil {
+		page = len(aws.ToString(params.NextToken))
+	}
+
+	output := &organizations.ListAccountsOutput{Accounts: m.pages[page]}
+	if page+1 < len(m.pages) {
+		output.NextToken = aws.String(string(make([]byte, page+1)))
+	}
+	return output, nil
+}
+
+func testOrganizationAccount(id, name string, status orgtypes.AccountStatus) orgtypes.Account {
+	return orgtypes.Account{Id: aws.String(id), Name: aws.String(name), Status: status}
+}
+
+func TestListOrganizationAccounts(t *testing.T) {
+	m := &mockOrganizations{
+		pages: [][]orgtypes.Account{
+			{
+				testOrganizationAccount("111111111111", "payments-prod", orgtypes.AccountStatusActive),
+				testOrganizationAccount("222222222222", "payments-staging", orgtypes.AccountStatusActive),
+			},
+			{
+				testOrganizationAccount("333333333333", "rides-prod", orgtypes.AccountStatusActive),
+				testOrganizationAccount("444444444444", "old-prod", orgtypes.AccountStatusSuspended),
+			},
+		},
+	}
+
+	accounts, err := listOrganizationAccounts(context.Background(), m, nil)
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 3)
+
+	accounts, err = listOrganizationAccounts(context.Background(), m, regexp.MustCompile("-prod$"))
+	assert.NoError(t, err)
+	assert.Len(t, accounts, 2)
+	assert.Equal(t, "payments-prod", aws.ToString(accounts[0].Name))
+	assert.Equal(t, "rides-prod", aws.ToString(accounts[1].Name))
+
+	m.err = errors.New("access denied")
+	_, err = listOrganizationAccounts(context.Background(), m, nil)
+	assert.Error(t, err)
+}
+
+func TestConfigureOrganizationAccountClients(t *testing.T) {
+	m := &mockOrganizations{
+		pages: [][]orgtypes.Account{
+			{
+				testOrganizationAccount("111111111111", "payments-prod", orgtypes.AccountStatusActive),
+				testOrganizationAccount("222222222222", "default", orgtypes.AccountStatusActive),
+				testOrganizationAccount("333333333333", "team/prod", orgtypes.AccountStatusActive),
+				testOrganizationAccount("444444444444", "payments-staging", orgtypes.AccountStatusActive),
+			},
+		},
+	}
+
+	c := &client{
+		currentAccountAlias: "default",
+		accounts: map[string]*accountClients{
+			"default": {alias: "default", regions: []string{"us-east-1"}},
+		},
+		log: zaptest.NewLogger(t),
+	}
+	rc := newTestRoleChain(&awsv1.AssumeRoleChain{TargetRoleArnTemplate: "arn:aws:iam::{account_number}:role/clutch"}, &mockAssumeRoleSTS{})
+
+	err := c.configureOrganizationAccountClients(context.Background(), m, &awsv1.OrganizationsDiscovery{AliasFilter: "^payments-"}, []string{"us-east-1", "us-west-2"}, rc, &awsv1.ScalingLimits{}, nil)
+	assert.NoError(t, err)
+
+	assert.ElementsMatch(t, []string{"default", "payments-prod", "payments-staging"}, c.Accounts())
+	assert.Equal(t, []string{"us-east-1", "us-west-2"}, c.AccountsAndRegions()["payments-prod"])
+
+	dc, err := c.GetDirectClient("payments-prod", "us-west-2")
+	assert.NoError(t, err)
+	p, ok := dc.Config().Credentials.(*chainedRoleProvider)
+	assert.True(t, ok)
+	assert.Equal(t, "arn:aws:iam::111111111111:role/clutch", p.roleARN)
+
+	err = c.configureOrganizationAccountClients(context.Background(), m, &awsv1.OrganizationsDiscovery{AliasFilter: "("}, nil, rc, &awsv1.ScalingLimits{}, nil)
+	assert.Error(t, err)
+}
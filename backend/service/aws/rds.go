@@ -0,0 +1,259 @@
+package aws
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/iancoleman/strcase"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rdsv1 "github.com/lyft/clutch/backend/api/aws/rds/v1"
+)
+
+// The window of events returned when no duration is specified, this matches the AWS default.
+const defaultRDSEventsDuration = time.Hour
+
+func (c *client) DescribeDBInstance(ctx context.Context, account, region, identifier string) (*rdsv1.DBInstance, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := cl.rds.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{DBInstanceIdentifier: aws.String(identifier)})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.DBInstances) != 1 {
+		return nil, status.Errorf(codes.NotFound, "db instance '%s' not found", identifier)
+	}
+
+	ret := newProtoForDBInstance(account, region, output.DBInstances[0])
+	ret.PendingMaintenanceActions, err = describePendingMaintenanceActions(ctx, cl, ret.Arn)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func (c *client) DescribeDBCluster(ctx context.Context, account, region, identifier string) (*rdsv1.DBCluster, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := cl.rds.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{DBClusterIdentifier: aws.String(identifier)})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.DBClusters) != 1 {
+		return nil, status.Errorf(codes.NotFound, "db cluster '%s' not found", identifier)
+	}
+
+	ret := newProtoForDBCluster(account, region, output.DBClusters[0])
+	ret.PendingMaintenanceActions, err = describePendingMaintenanceActions(ctx, cl, ret.Arn)
+	if err != nil {
+		return nil, err
+	}
+
+	return ret, nil
+}
+
+func describePendingMaintenanceActions(ctx context.Context, cl *regionalClient, arn string) ([]*rdsv1.PendingMaintenanceAction, error) {
+	input := &rds.DescribePendingMaintenanceActionsInput{ResourceIdentifier: aws.String(arn)}
+
+	var ret []*rdsv1.PendingMaintenanceAction
+	paginator := rds.NewDescribePendingMaintenanceActionsPaginator(cl.rds, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resource := range output.PendingMaintenanceActions {
+			for _, action := range resource.PendingMaintenanceActionDetails {
+				ret = append(ret, newProtoForPendingMaintenanceAction(action))
+			}
+		}
+	}
+
+	return ret, nil
+}
+
+func (c *client) RebootDBInstance(ctx context.Context, account, region, identifier string, forceFailover bool) error {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return err
+	}
+
+	input := &rds.RebootDBInstanceInput{
+		DBInstanceIdentifier: aws.String(identifier),
+		ForceFailover:        aws.Bool(forceFailover),
+	}
+	_, err = cl.rds.RebootDBInstance(ctx, input)
+	return err
+}
+
+func (c *client) FailoverDBCluster(ctx context.Context, account, region, identifier, targetInstanceIdentifier string) error {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return err
+	}
+
+	input := &rds.FailoverDBClusterInput{DBClusterIdentifier: aws.String(identifier)}
+	if targetInstanceIdentifier != "" {
+		input.TargetDBInstanceIdentifier = aws.String(targetInstanceIdentifier)
+	}
+	_, err = cl.rds.FailoverDBCluster(ctx, input)
+	return err
+}
+
+func (c *client) DescribeRDSEvents(ctx context.Context, account, region, sourceIdentifier string, sourceType rdsv1.Event_SourceType, duration time.Duration) ([]*rdsv1.Event, error) {
+	cl, err := c.getAccountRegionClient(account, region)
+	if err != nil {
+		return nil, err
+	}
+
+	if duration <= 0 {
+		duration = defaultRDSEventsDuration
+	}
+
+	input := &rds.DescribeEventsInput{
+		Duration: aws.Int32(int32(duration.Minutes())),
+	}
+	if sourceType != rdsv1.Event_UNSPECIFIED {
+		input.SourceType = rdsSourceTypeFromProto(sourceType)
+	}
+	if sourceIdentifier != "" {
+		if input.SourceType == "" {
+			return nil, status.Error(codes.InvalidArgument, "a source type is required when filtering events by source identifier")
+		}
+		input.SourceIdentifier = aws.String(sourceIdentifier)
+	}
+
+	var ret []*rdsv1.Event
+	paginator := rds.NewDescribeEventsPaginator(cl.rds, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range output.Events {
+			ret = append(ret, newProtoForRDSEvent(e))
+		}
+	}
+
+	return ret, nil
+}
+
+func newProtoForDBInstance(account, region string, i rdstypes.DBInstance) *rdsv1.DBInstance {
+	ret := &rdsv1.DBInstance{
+		Identifier:                  aws.ToString(i.DBInstanceIdentifier),
+		Region:                      region,
+		Account:                     account,
+		Arn:                         aws.ToString(i.DBInstanceArn),
+		Engine:                      aws.ToString(i.Engine),
+		EngineVersion:               aws.ToString(i.EngineVersion),
+		InstanceClass:               aws.ToString(i.DBInstanceClass),
+		Status:                      aws.ToString(i.DBInstanceStatus),
+		AvailabilityZone:            aws.ToString(i.AvailabilityZone),
+		SecondaryAvailabilityZone:   aws.ToString(i.SecondaryAvailabilityZone),
+		MultiAz:                     aws.ToBool(i.MultiAZ),
+		DbClusterIdentifier:         aws.ToString(i.DBClusterIdentifier),
+		ReadReplicaSourceIdentifier: aws.ToString(i.ReadReplicaSourceDBInstanceIdentifier),
+		ReadReplicaIdentifiers:      i.ReadReplicaDBInstanceIdentifiers,
+		PreferredMaintenanceWindow:  aws.ToString(i.PreferredMaintenanceWindow),
+	}
+
+	if i.Endpoint != nil {
+		ret.Endpoint = &rdsv1.Endpoint{
+			Address: aws.ToString(i.Endpoint.Address),
+			Port:    aws.ToInt32(i.Endpoint.Port),
+		}
+	}
+
+	return ret
+}
+
+func newProtoForDBCluster(account, region string, cl rdstypes.DBCluster) *rdsv1.DBCluster {
+	members := make([]*rdsv1.DBClusterMember, len(cl.DBClusterMembers))
+	for idx, m := range cl.DBClusterMembers {
+		members[idx] = &rdsv1.DBClusterMember{
+			DbInstanceIdentifier: aws.ToString(m.DBInstanceIdentifier),
+			Writer:               aws.ToBool(m.IsClusterWriter),
+			PromotionTier:        aws.ToInt32(m.PromotionTier),
+		}
+	}
+
+	return &rdsv1.DBCluster{
+		Identifier:                 aws.ToString(cl.DBClusterIdentifier),
+		Region:                     region,
+		Account:                    account,
+		Arn:                        aws.ToString(cl.DBClusterArn),
+		Engine:                     aws.ToString(cl.Engine),
+		EngineVersion:              aws.ToString(cl.EngineVersion),
+		Status:                     aws.ToString(cl.Status),
+		MultiAz:                    aws.ToBool(cl.MultiAZ),
+		Endpoint:                   aws.ToString(cl.Endpoint),
+		ReaderEndpoint:             aws.ToString(cl.ReaderEndpoint),
+		Port:                       aws.ToInt32(cl.Port),
+		Members:                    members,
+		ReadReplicaIdentifiers:     cl.ReadReplicaIdentifiers,
+		PreferredMaintenanceWindow: aws.ToString(cl.PreferredMaintenanceWindow),
+	}
+}
+
+func newProtoForPendingMaintenanceAction(a rdstypes.PendingMaintenanceAction) *rdsv1.PendingMaintenanceAction {
+	ret := &rdsv1.PendingMaintenanceAction{
+		Action:      aws.ToString(a.Action),
+		Description: aws.ToString(a.Description),
+		OptInStatus: aws.ToString(a.OptInStatus),
+	}
+
+	if a.AutoAppliedAfterDate != nil {
+		ret.AutoAppliedAfterDate = timestamppb.New(*a.AutoAppliedAfterDate)
+	}
+	if a.ForcedApplyDate != nil {
+		ret.ForcedApplyDate = timestamppb.New(*a.ForcedApplyDate)
+	}
+	if a.CurrentApplyDate != nil {
+		ret.CurrentApplyDate = timestamppb.New(*a.CurrentApplyDate)
+	}
+
+	return ret
+}
+
+func newProtoForRDSEvent(e rdstypes.Event) *rdsv1.Event {
+	ret := &rdsv1.Event{
+		SourceIdentifier: aws.ToString(e.SourceIdentifier),
+		SourceType:       protoForRDSSourceType(e.SourceType),
+		SourceArn:        aws.ToString(e.SourceArn),
+		Message:          aws.ToString(e.Message),
+		Categories:       e.EventCategories,
+	}
+
+	if e.Date != nil {
+		ret.Date = timestamppb.New(*e.Date)
+	}
+
+	return ret
+}
+
+func protoForRDSSourceType(sourceType rdstypes.SourceType) rdsv1.Event_SourceType {
+	val, ok := rdsv1.Event_SourceType_value[strcase.ToScreamingSnake(string(sourceType))]
+	if !ok {
+		return rdsv1.Event_UNKNOWN
+	}
+	return rdsv1.Event_SourceType(val)
+}
+
+func rdsSourceTypeFromProto(sourceType rdsv1.Event_SourceType) rdstypes.SourceType {
+	return rdstypes.SourceType(strings.ReplaceAll(strings.ToLower(sourceType.String()), "_", "-"))
+}
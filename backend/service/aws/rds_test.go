@@ -0,0 +1,287 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	rdsv1 "github.com/lyft/clutch/backend/api/aws/rds/v1"
+)
+
+var testDBInstance = rdstypes.DBInstance{
+	DBInstanceIdentifier:             aws.String("my-db"),
+	DBInstanceArn:                    aws.String("arn:aws:rds:us-east-1:123456789012:db:my-db"),
+	Engine:                           aws.String("postgres"),
+	EngineVersion:                    aws.String("15.4"),
+	DBInstanceClass:                  aws.String("db.r6g.large"),
+	DBInstanceStatus:                 aws.String("available"),
+	AvailabilityZone:                 aws.String("us-east-1a"),
+	SecondaryAvailabilityZone:        aws.String("us-east-1b"),
+	MultiAZ:                          aws.Bool(true),
+	Endpoint:                         &rdstypes.Endpoint{Address: aws.String("my-db.abc.us-east-1.rds.amazonaws.com"), Port: aws.Int32(5432)},
+	ReadReplicaDBInstanceIdentifiers: []string{"my-db-replica"},
+	PreferredMaintenanceWindow:       aws.String("sun:05:00-sun:06:00"),
+}
+
+var testDBCluster = rdstypes.DBCluster{
+	DBClusterIdentifier: aws.String("my-cluster"),
+	DBClusterArn:        aws.String("arn:aws:rds:us-east-1:123456789012:cluster:my-cluster"),
+	Engine:              aws.String("aurora-postgresql"),
+	EngineVersion:       aws.String("15.4"),
+	Status:              aws.String("failing-over"),
+	MultiAZ:             aws.Bool(true),
+	Endpoint:            aws.String("my-cluster.cluster-abc.us-east-1.rds.amazonaws.com"),
+	ReaderEndpoint:      aws.String("my-cluster.cluster-ro-abc.us-east-1.rds.amazonaws.com"),
+	Port:                aws.Int32(5432),
+	DBClusterMembers: []rdstypes.DBClusterMember{
+		{DBInstanceIdentifier: aws.String("my-cluster-1"), IsClusterWriter: aws.Bool(true), PromotionTier: aws.Int32(1)},
+		{DBInstanceIdentifier: aws.String("my-cluster-2"), IsClusterWriter: aws.Bool(false), PromotionTier: aws.Int32(2)},
+	},
+}
+
+func newTestRDSClient(m *mockRDS) *client {
+	return &client{
+		currentAccountAlias: "default",
+		accounts: map[string]*accountClients{
+			"default": {
+				clients: map[string]*regionalClient{
+					"us-east-1": {region: "us-east-1", rds: m},
+				},
+			},
+		},
+	}
+}
+
+func TestDescribeDBInstance(t *testing.T) {
+	applyDate := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := &mockRDS{
+		instances: []rdstypes.DBInstance{testDBInstance},
+		pendingMaintenance: []rdstypes.ResourcePendingMaintenanceActions{
+			{
+				ResourceIdentifier: testDBInstance.DBInstanceArn,
+				PendingMaintenanceActionDetails: []rdstypes.PendingMaintenanceAction{
+					{Action: aws.String("system-update"), Description: aws.String("os update"), AutoAppliedAfterDate: &applyDate},
+				},
+			},
+		},
+	}
+	c := newTestRDSClient(m)
+
+	instance, err := c.DescribeDBInstance(context.Background(), "default", "us-east-1", "my-db")
+	assert.NoError(t, err)
+	assert.Equal(t, "my-db", aws.ToString(m.instancesInput.DBInstanceIdentifier))
+	assert.Equal(t, aws.ToString(testDBInstance.DBInstanceArn), aws.ToString(m.pendingMaintenanceInput.ResourceIdentifier))
+
+	assert.Equal(t, "my-db", instance.Identifier)
+	assert.Equal(t, "default", instance.Account)
+	assert.Equal(t, "us-east-1", instance.Region)
+	assert.Equal(t, "db.r6g.large", instance.InstanceClass)
+	assert.Equal(t, "available", instance.Status)
+	assert.True(t, instance.MultiAz)
+	assert.Equal(t, "us-east-1b", instance.SecondaryAvailabilityZone)
+	assert.Equal(t, int32(5432), instance.Endpoint.Port)
+	assert.Equal(t, []string{"my-db-replica"}, instance.ReadReplicaIdentifiers)
+	assert.Len(t, instance.PendingMaintenanceActions, 1)
+	assert.Equal(t, "system-update", instance.PendingMaintenanceActions[0].Action)
+	assert.Equal(t, applyDate, instance.PendingMaintenanceActions[0].AutoAppliedAfterDate.AsTime())
+	assert.Nil(t, instance.PendingMaintenanceActions[0].ForcedApplyDate)
+
+	// Not found.
+	m.instances = nil
+	_, err = c.DescribeDBInstance(context.Background(), "default", "us-east-1", "my-db")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// Error.
+	m.err = errors.New("whoops")
+	_, err = c.DescribeDBInstance(context.Background(), "default", "us-east-1", "my-db")
+	assert.Error(t, err)
+
+	// Unknown region.
+	_, err = c.DescribeDBInstance(context.Background(), "default", "us-west-2", "my-db")
+	assert.Error(t, err)
+}
+
+func TestDescribeDBCluster(t *testing.T) {
+	m := &mockRDS{clusters: []rdstypes.DBCluster{testDBCluster}}
+	c := newTestRDSClient(m)
+
+	cluster, err := c.DescribeDBCluster(context.Background(), "default", "us-east-1", "my-cluster")
+	assert.NoError(t, err)
+	assert.Equal(t, "my-cluster", aws.ToString(m.clustersInput.DBClusterIdentifier))
+
+	assert.Equal(t, "my-cluster", cluster.Identifier)
+	assert.Equal(t, "failing-over", cluster.Status)
+	assert.Equal(t, int32(5432), cluster.Port)
+	assert.Equal(t, "my-cluster.cluster-ro-abc.us-east-1.rds.amazonaws.com", cluster.ReaderEndpoint)
+	assert.Equal(t, []*rdsv1.DBClusterMember{
+		{DbInstanceIdentifier: "my-cluster-1", Writer: true, PromotionTier: 1},
+		{DbInstanceIdentifier: "my-cluster-2", Writer: false, PromotionTier: 2},
+	}, cluster.Members)
+	assert.Empty(t, cluster.PendingMaintenanceActions)
+
+	m.clusters = nil
+	_, err = c.DescribeDBCluster(context.Background(), "default", "us-east-1", "my-cluster")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRebootDBInstance(t *testing.T) {
+	m := &mockRDS{}
+	c := newTestRDSClient(m)
+
+	err := c.RebootDBInstance(context.Background(), "default", "us-east-1", "my-db", true)
+	assert.NoError(t, err)
+	assert.Equal(t, "my-db", aws.ToString(m.rebootInput.DBInstanceIdentifier))
+	assert.True(t, aws.ToBool(m.rebootInput.ForceFailover))
+
+	err = c.RebootDBInstance(context.Background(), "default", "us-east-1", "my-db", false)
+	assert.NoError(t, err)
+	assert.False(t, aws.ToBool(m.rebootInput.ForceFailover))
+
+	m.err = errors.New("whoops")
+	err = c.RebootDBInstance(context.Background(), "default", "us-east-1", "my-db", false)
+	assert.Error(t, err)
+}
+
+func TestFailoverDBCluster(t *testing.T) {
+	m := &mockRDS{}
+	c := newTestRDSClient(m)
+
+	err := c.FailoverDBCluster(context.Background(), "default", "us-east-1", "my-cluster", "")
+	assert.NoError(t, err)
+	assert.Equal(t, "my-cluster", aws.ToString(m.failoverInput.DBClusterIdentifier))
+	assert.Nil(t, m.failoverInput.TargetDBInstanceIdentifier)
+
+	err = c.FailoverDBCluster(context.Background(), "default", "us-east-1", "my-cluster", "my-cluster-2")
+	assert.NoError(t, err)
+	assert.Equal(t, "my-cluster-2", aws.ToString(m.failoverInput.TargetDBInstanceIdentifier))
+}
+
+func TestDescribeRDSEvents(t *testing.T) {
+	date := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := &mockRDS{
+		events: []rdstypes.Event{
+			{
+				SourceIdentifier: aws.String("my-cluster"),
+				SourceType:       rdstypes.SourceTypeDbCluster,
+				Message:          aws.String("Completed failover to DB instance: my-cluster-2"),
+				EventCategories:  []string{"failover"},
+				Date:             &date,
+			},
+			{
+				SourceIdentifier: aws.String("foo"),
+				SourceType:       rdstypes.SourceType("new-source-type"),
+			},
+		},
+	}
+	c := newTestRDSClient(m)
+
+	events, err := c.DescribeRDSEvents(context.Background(), "default", "us-east-1", "my-cluster", rdsv1.Event_DB_CLUSTER, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, rdstypes.SourceTypeDbCluster, m.eventsInput.SourceType)
+	assert.Equal(t, "my-cluster", aws.ToString(m.eventsInput.SourceIdentifier))
+	assert.Equal(t, int32(60), aws.ToInt32(m.eventsInput.Duration))
+
+	assert.Len(t, events, 2)
+	assert.Equal(t, rdsv1.Event_DB_CLUSTER, events[0].SourceType)
+	assert.Equal(t, []string{"failover"}, events[0].Categories)
+	assert.Equal(t, date, events[0].Date.AsTime())
+	assert.Equal(t, rdsv1.Event_UNKNOWN, events[1].SourceType)
+
+	_, err = c.DescribeRDSEvents(context.Background(), "default", "us-east-1", "", rdsv1.Event_UNSPECIFIED, 24*time.Hour)
+	assert.NoError(t, err)
+	assert.Equal(t, rdstypes.SourceType(""), m.eventsInput.SourceType)
+	assert.Nil(t, m.eventsInput.SourceIdentifier)
+	assert.Equal(t, int32(1440), aws.ToInt32(m.eventsInput.Duration))
+
+	// Identifier without a source type.
+	_, err = c.DescribeRDSEvents(context.Background(), "default", "us-east-1", "my-cluster", rdsv1.Event_UNSPECIFIED, 0)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestRDSSourceTypeMapping(t *testing.T) {
+	for name, val := range rdsv1.Event_SourceType_value {
+		st := rdsv1.Event_SourceType(val)
+		if st == rdsv1.Event_UNSPECIFIED || st == rdsv1.Event_UNKNOWN {
+			continue
+		}
+		assert.Equal(t, st, protoForRDSSourceType(rdsSourceTypeFromProto(st)), name)
+	}
+
+	for _, st := range rdstypes.SourceTypeDbInstance.Values() {
+		assert.NotEqual(t, rdsv1.Event_UNKNOWN, protoForRDSSourceType(st), st)
+	}
+}
+
+type mockRDS struct {
+	rdsClient
+
+	err error
+
+	instances          []rdstypes.DBInstance
+	clusters           []rdstypes.DBCluster
+	pendingMaintenance []rdstypes.ResourcePendingMaintenanceActions
+	events             []rdstypes.Event
+
+	instancesInput          *rds.DescribeDBInstancesInput
+	clustersInput           *rds.DescribeDBClustersInput
+	pendingMaintenanceInput *rds.DescribePendingMaintenanceActionsInput
+	eventsInput             *rds.DescribeEventsInput
+	rebootInput             *rds.RebootDBInstanceInput
+	failoverInput           *rds.FailoverDBClusterInput
+}
+
+func (m *mockRDS) DescribeDBInstances(ctx context.Context, params *rds.DescribeDBInstancesInput, optFns ...func(*rds.Options)) (*rds.DescribeDBInstancesOutput, error) {
+	m.instancesInput = params
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &rds.DescribeDBInstancesOutput{DBInstances: m.instances}, nil
+}
+
+func (m *mockRDS) DescribeDBClusters(ctx context.Context, params *rds.DescribeDBClustersInput, optFns ...func(*rds.Options)) (*rds.DescribeDBClustersOutput, error) {
+	m.clustersInput = params
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &rds.DescribeDBClustersOutput{DBClusters: m.clusters}, nil
+}
+
+func (m *mockRDS) DescribePendingMaintenanceActions(ctx context.Context, params *rds.DescribePendingMaintenanceActionsInput, optFns ...func(*rds.Options)) (*rds.DescribePendingMaintenanceActionsOutput, error) {
+	m.pendingMaintenanceInput = params
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &rds.DescribePendingMaintenanceActionsOutput{PendingMaintenanceActions: m.pendingMaintenance}, nil
+}
+
+func (m *mockRDS) DescribeEvents(ctx context.Context, params *rds.DescribeEventsInput, optFns ...func(*rds.Options)) (*rds.DescribeEventsOutput, error) {
+	m.eventsInput = params
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &rds.DescribeEventsOutput{Events: m.events}, nil
+}
+
+func (m *mockRDS) RebootDBInstance(ctx context.Context, params *rds.RebootDBInstanceInput, optFns ...func(*rds.Options)) (*rds.RebootDBInstanceOutput, error) {
+	m.rebootInput = params
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &rds.RebootDBInstanceOutput{}, nil
+}
+
+func (m *mockRDS) FailoverDBCluster(ctx context.Context, params *rds.FailoverDBClusterInput, optFns ...func(*rds.Options)) (*rds.FailoverDBClusterOutput, error) {
+	m.failoverInput = params
+	if m.err != nil {
+		return nil, m.err
+	}
+	return &rds.FailoverDBClusterOutput{}, nil
+}
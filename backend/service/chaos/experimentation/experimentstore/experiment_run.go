@@ -123,3 +123,50 @@ func (er *ExperimentRun) CreateProperties(now time.Time) ([]*experimentationv1.P
 
 	return properties, nil
 }
+
+// Timeline returns the lifecycle events of the run that happened until the given time, in chronological order.
+func (er *ExperimentRun) Timeline(now time.Time) []*experimentationv1.TimelineEvent {
+	var events []*experimentationv1.TimelineEvent
+	if !er.CreationTime.IsZero() {
+		events = append(events, &experimentationv1.TimelineEvent{
+			Time:        timestamppb.New(er.CreationTime),
+			Type:        experimentationv1.TimelineEvent_TYPE_CREATED,
+			Description: "Run created",
+		})
+	}
+
+	canceled := er.CancellationTime != nil && !er.CancellationTime.After(now) &&
+		(er.EndTime == nil || er.CancellationTime.Before(*er.EndTime))
+	canceledBeforeStart := canceled && !er.CancellationTime.After(er.StartTime)
+	if !er.StartTime.After(now) && !canceledBeforeStart {
+		events = append(events, &experimentationv1.TimelineEvent{
+			Time:        timestamppb.New(er.StartTime),
+			Type:        experimentationv1.TimelineEvent_TYPE_STARTED,
+			Description: "Run started",
+		})
+	}
+
+	if canceled {
+		description := "Run stopped"
+		if canceledBeforeStart {
+			description = "Run canceled before it started"
+		}
+		if er.TerminationReason != "" {
+			description += ": " + er.TerminationReason
+		}
+
+		events = append(events, &experimentationv1.TimelineEvent{
+			Time:        timestamppb.New(*er.CancellationTime),
+			Type:        experimentationv1.TimelineEvent_TYPE_CANCELED,
+			Description: description,
+		})
+	} else if er.EndTime != nil && !er.EndTime.After(now) {
+		events = append(events, &experimentationv1.TimelineEvent{
+			Time:        timestamppb.New(*er.EndTime),
+			Type:        experimentationv1.TimelineEvent_TYPE_ENDED,
+			Description: "Run ended",
+		})
+	}
+
+	return events
+}
@@ -0,0 +1,53 @@
+package experimentstore
+
+import (
+	"context"
+	"time"
+
+	experimentation "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+)
+
+// RunReporter is implemented by stores that can report on individual runs.
+type RunReporter interface {
+	GetExperimentRunReport(ctx context.Context, runId string) (*RunReport, error)
+}
+
+var _ RunReporter = (*storer)(nil)
+
+// RunReport is the part of the report of a run that's known to the store.
+type RunReport struct {
+	Run    *ExperimentRun
+	Report *experimentation.ExperimentRunReport
+	// The target of the run, or nil if no transformation of its config type describes its target.
+	Target *ExperimentTarget
+}
+
+func NewRunReport(run *ExperimentRun, config *ExperimentConfig, transformer *Transformer, now time.Time) (*RunReport, error) {
+	details, err := NewRunDetails(run, config, transformer, now)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := transformer.CreateTarget(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RunReport{
+		Run: run,
+		Report: &experimentation.ExperimentRunReport{
+			Details:  details,
+			Timeline: run.Timeline(now),
+		},
+		Target: target,
+	}, nil
+}
+
+func (s *storer) GetExperimentRunReport(ctx context.Context, runId string) (*RunReport, error) {
+	e, err := s.getExperiment(ctx, runId)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRunReport(e.Run, e.Config, s.transformer, time.Now())
+}
@@ -226,3 +226,76 @@ func TestExperimentRunProperties(t *testing.T) {
 		})
 	}
 }
+
+func TestExperimentRunTimeline(t *testing.T) {
+	now := time.Now()
+	created := now.Add(-3 * time.Hour)
+	past := now.Add(-1 * time.Hour)
+	recent := now.Add(-30 * time.Minute)
+	future := now.Add(1 * time.Hour)
+
+	tests := []struct {
+		name                string
+		run                 *ExperimentRun
+		expectedTypes       []experimentationv1.TimelineEvent_Type
+		expectedDescription string
+	}{
+		{
+			name:          "scheduled",
+			run:           &ExperimentRun{CreationTime: created, StartTime: future},
+			expectedTypes: []experimentationv1.TimelineEvent_Type{experimentationv1.TimelineEvent_TYPE_CREATED},
+		},
+		{
+			name: "running",
+			run:  &ExperimentRun{CreationTime: created, StartTime: past, EndTime: &future},
+			expectedTypes: []experimentationv1.TimelineEvent_Type{
+				experimentationv1.TimelineEvent_TYPE_CREATED,
+				experimentationv1.TimelineEvent_TYPE_STARTED,
+			},
+		},
+		{
+			name: "completed",
+			run:  &ExperimentRun{CreationTime: created, StartTime: past, EndTime: &recent},
+			expectedTypes: []experimentationv1.TimelineEvent_Type{
+				experimentationv1.TimelineEvent_TYPE_CREATED,
+				experimentationv1.TimelineEvent_TYPE_STARTED,
+				experimentationv1.TimelineEvent_TYPE_ENDED,
+			},
+		},
+		{
+			name: "terminated",
+			run:  &ExperimentRun{CreationTime: created, StartTime: past, CancellationTime: &recent, TerminationReason: "success rate dropped"},
+			expectedTypes: []experimentationv1.TimelineEvent_Type{
+				experimentationv1.TimelineEvent_TYPE_CREATED,
+				experimentationv1.TimelineEvent_TYPE_STARTED,
+				experimentationv1.TimelineEvent_TYPE_CANCELED,
+			},
+			expectedDescription: "Run stopped: success rate dropped",
+		},
+		{
+			name: "canceled before start",
+			run:  &ExperimentRun{CreationTime: created, StartTime: future, CancellationTime: &past},
+			expectedTypes: []experimentationv1.TimelineEvent_Type{
+				experimentationv1.TimelineEvent_TYPE_CREATED,
+				experimentationv1.TimelineEvent_TYPE_CANCELED,
+			},
+			expectedDescription: "Run canceled before it started",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			events := tt.run.Timeline(now)
+
+			var types []experimentationv1.TimelineEvent_Type
+			for _, e := range events {
+				types = append(types, e.Type)
+			}
+			assert.Equal(t, tt.expectedTypes, types)
+			if tt.expectedDescription != "" {
+				assert.Equal(t, tt.expectedDescription, events[len(events)-1].Description)
+			}
+		})
+	}
+}
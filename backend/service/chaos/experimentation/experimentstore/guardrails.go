@@ -0,0 +1,203 @@
+package experimentstore
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	experimentstorev1 "github.com/lyft/clutch/backend/api/config/service/chaos/experimentation/experimentstore/v1"
+)
+
+// ExperimentTarget describes what an experiment injects faults into, so that guardrails can be enforced regardless of
+// the type of the experiment.
+type ExperimentTarget struct {
+	UpstreamCluster   string
+	DownstreamCluster string
+	// The percentage of requests a fault is applied to, between 0 and 100.
+	FaultPercentage float64
+}
+
+// An experiment run that may conflict with a new experiment.
+type targetedRun struct {
+	runId  string
+	target *ExperimentTarget
+}
+
+type guardrails struct {
+	maxConcurrentPerUpstream   int
+	maxConcurrentPerDownstream int
+	tiers                      []*experimentstorev1.Guardrails_Tier
+	deniedClusters             map[string]struct{}
+	allowOverlappingTargets    bool
+}
+
+func newGuardrails(config *experimentstorev1.Guardrails) *guardrails {
+	if config == nil {
+		return nil
+	}
+
+	denied := make(map[string]struct{}, len(config.DeniedClusters))
+	for _, c := range config.DeniedClusters {
+		denied[c] = struct{}{}
+	}
+
+	return &guardrails{
+		maxConcurrentPerUpstream:   int(config.MaxConcurrentPerUpstreamCluster),
+		maxConcurrentPerDownstream: int(config.MaxConcurrentPerDownstreamCluster),
+		tiers:                      config.Tiers,
+		deniedClusters:             denied,
+		allowOverlappingTargets:    config.AllowOverlappingTargets,
+	}
+}
+
+// check returns a FailedPrecondition error if the target may not be experimented on while the given runs are active.
+// Errors caused by other runs list the IDs of the conflicting runs.
+func (g *guardrails) check(target *ExperimentTarget, runs []*targetedRun) error {
+	for _, c := range []string{target.UpstreamCluster, target.DownstreamCluster} {
+		if _, ok := g.deniedClusters[c]; ok && c != "" {
+			return status.Errorf(codes.FailedPrecondition, "cluster '%s' may not be targeted by experiments", c)
+		}
+	}
+
+	for _, tier := range g.tiers {
+		if !tierContains(tier, target) {
+			continue
+		}
+		if target.FaultPercentage > tier.MaxFaultPercentage {
+			return status.Errorf(codes.FailedPrecondition, "fault percentage of %g%% exceeds the maximum of %g%% of tier '%s'",
+				target.FaultPercentage, tier.MaxFaultPercentage, tier.Name)
+		}
+	}
+
+	var overlapping, sameUpstream, sameDownstream []string
+	for _, r := range runs {
+		upstream := target.UpstreamCluster != "" && r.target.UpstreamCluster == target.UpstreamCluster
+		downstream := target.DownstreamCluster != "" && r.target.DownstreamCluster == target.DownstreamCluster
+		if upstream && downstream {
+			overlapping = append(overlapping, r.runId)
+		}
+		if upstream {
+			sameUpstream = append(sameUpstream, r.runId)
+		}
+		if downstream {
+			sameDownstream = append(sameDownstream, r.runId)
+		}
+	}
+
+	if !g.allowOverlappingTargets && len(overlapping) > 0 {
+		return conflictError(overlapping, "experiment overlaps with experiments targeting '%s' from '%s'",
+			target.UpstreamCluster, target.DownstreamCluster)
+	}
+	if g.maxConcurrentPerUpstream > 0 && len(sameUpstream) >= g.maxConcurrentPerUpstream {
+		return conflictError(sameUpstream, "upstream cluster '%s' is already targeted by the maximum of %d concurrent experiments",
+			target.UpstreamCluster, g.maxConcurrentPerUpstream)
+	}
+	if g.maxConcurrentPerDownstream > 0 && len(sameDownstream) >= g.maxConcurrentPerDownstream {
+		return conflictError(sameDownstream, "downstream cluster '%s' is already targeted by the maximum of %d concurrent experiments",
+			target.DownstreamCluster, g.maxConcurrentPerDownstream)
+	}
+
+	return nil
+}
+
+func tierContains(tier *experimentstorev1.Guardrails_Tier, target *ExperimentTarget) bool {
+	for _, c := range tier.Clusters {
+		if c == target.UpstreamCluster || c == target.DownstreamCluster {
+			return true
+		}
+	}
+	return false
+}
+
+func conflictError(runIds []string, format string, args ...interface{}) error {
+	sort.Strings(runIds)
+	return status.Errorf(codes.FailedPrecondition, "%s, conflicting runs: %s", fmt.Sprintf(format, args...), strings.Join(runIds, ", "))
+}
+
+// Guardrails are checked while holding a transaction-level advisory lock, so that concurrently created experiments
+// are checked against each other.
+const guardrailsLockId = "chaos:experimentation:guardrails"
+
+func (s *storer) checkGuardrails(ctx context.Context, tx *sql.Tx, es *ExperimentSpecification) error {
+	target, err := s.transformer.CreateTarget(&ExperimentConfig{Id: es.ConfigId, Config: es.Config})
+	if err != nil || target == nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `SELECT pg_advisory_xact_lock($1)`, ConvertLockToUint32(guardrailsLockId)); err != nil {
+		return err
+	}
+
+	runs, err := s.getTargetedRuns(ctx, tx, es)
+	if err != nil {
+		return err
+	}
+
+	if err := s.guardrails.check(target, runs); err != nil {
+		s.guardrailViolationCount.Inc(1)
+		return err
+	}
+	return nil
+}
+
+// Checks the guardrails that do not depend on other runs, e.g. denied clusters and the fault percentage of tiers.
+func (s *storer) checkStaticGuardrails(config *ExperimentConfig) error {
+	target, err := s.transformer.CreateTarget(config)
+	if err != nil || target == nil {
+		return err
+	}
+
+	if err := s.guardrails.check(target, nil); err != nil {
+		s.guardrailViolationCount.Inc(1)
+		return err
+	}
+	return nil
+}
+
+// Returns the runs whose execution time overlaps with the given specification and that have not been cancelled, along
+// with their targets. Runs of experiment types without targets are omitted.
+func (s *storer) getTargetedRuns(ctx context.Context, tx *sql.Tx, es *ExperimentSpecification) ([]*targetedRun, error) {
+	query := `
+		SELECT experiment_run.id, experiment_config.id, details
+		FROM experiment_config, experiment_run
+		WHERE
+			experiment_config.id = experiment_run.experiment_config_id
+			AND experiment_run.cancellation_time IS NULL
+			AND experiment_run.execution_time && tstzrange($1, $2, '[]')`
+
+	rows, err := tx.QueryContext(ctx, query, es.StartTime, es.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var runs []*targetedRun
+	for rows.Next() {
+		var runId, configId, details string
+		if err := rows.Scan(&runId, &configId, &details); err != nil {
+			return nil, err
+		}
+
+		config, err := NewExperimentConfig(configId, details)
+		if err != nil {
+			s.logger.Errorw("failed to deserialize experiment config", "configId", configId, "runId", runId)
+			s.configDeserializationErrorCount.Inc(1)
+			continue
+		}
+
+		target, err := s.transformer.CreateTarget(config)
+		if err != nil {
+			return nil, err
+		}
+		if target != nil {
+			runs = append(runs, &targetedRun{runId: runId, target: target})
+		}
+	}
+
+	return runs, rows.Err()
+}
@@ -0,0 +1,138 @@
+package experimentstore
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	experimentstorev1 "github.com/lyft/clutch/backend/api/config/service/chaos/experimentation/experimentstore/v1"
+)
+
+func TestGuardrailsCheck(t *testing.T) {
+	runs := []*targetedRun{
+		{runId: "1", target: &ExperimentTarget{UpstreamCluster: "users", DownstreamCluster: "api", FaultPercentage: 10}},
+		{runId: "2", target: &ExperimentTarget{UpstreamCluster: "users", DownstreamCluster: "search", FaultPercentage: 10}},
+		{runId: "3", target: &ExperimentTarget{UpstreamCluster: "payments", DownstreamCluster: "search", FaultPercentage: 10}},
+	}
+
+	tests := []struct {
+		id      string
+		config  *experimentstorev1.Guardrails
+		target  *ExperimentTarget
+		message string
+	}{
+		{
+			id:     "no limits",
+			config: &experimentstorev1.Guardrails{AllowOverlappingTargets: true},
+			target: &ExperimentTarget{UpstreamCluster: "users", DownstreamCluster: "api", FaultPercentage: 100},
+		},
+		{
+			id:      "denied cluster",
+			config:  &experimentstorev1.Guardrails{DeniedClusters: []string{"auth"}},
+			target:  &ExperimentTarget{UpstreamCluster: "users", DownstreamCluster: "auth", FaultPercentage: 1},
+			message: "cluster 'auth' may not be targeted by experiments",
+		},
+		{
+			id: "fault percentage of tier exceeded",
+			config: &experimentstorev1.Guardrails{Tiers: []*experimentstorev1.Guardrails_Tier{
+				{Name: "tier0", Clusters: []string{"payments"}, MaxFaultPercentage: 5},
+			}},
+			target:  &ExperimentTarget{UpstreamCluster: "payments", DownstreamCluster: "checkout", FaultPercentage: 5.5},
+			message: "fault percentage of 5.5% exceeds the maximum of 5% of tier 'tier0'",
+		},
+		{
+			id: "fault percentage within tier",
+			config: &experimentstorev1.Guardrails{Tiers: []*experimentstorev1.Guardrails_Tier{
+				{Name: "tier0", Clusters: []string{"payments"}, MaxFaultPercentage: 5},
+			}},
+			target: &ExperimentTarget{UpstreamCluster: "payments", DownstreamCluster: "checkout", FaultPercentage: 5},
+		},
+		{
+			id:      "overlapping targets",
+			config:  &experimentstorev1.Guardrails{},
+			target:  &ExperimentTarget{UpstreamCluster: "users", DownstreamCluster: "api", FaultPercentage: 1},
+			message: "experiment overlaps with experiments targeting 'users' from 'api', conflicting runs: 1",
+		},
+		{
+			id:      "concurrent experiments per upstream cluster",
+			config:  &experimentstorev1.Guardrails{MaxConcurrentPerUpstreamCluster: 2},
+			target:  &ExperimentTarget{UpstreamCluster: "users", DownstreamCluster: "web", FaultPercentage: 1},
+			message: "upstream cluster 'users' is already targeted by the maximum of 2 concurrent experiments, conflicting runs: 1, 2",
+		},
+		{
+			id:     "concurrent experiments per upstream cluster within the limit",
+			config: &experimentstorev1.Guardrails{MaxConcurrentPerUpstreamCluster: 2},
+			target: &ExperimentTarget{UpstreamCluster: "payments", DownstreamCluster: "web", FaultPercentage: 1},
+		},
+		{
+			id:      "concurrent experiments per downstream cluster",
+			config:  &experimentstorev1.Guardrails{MaxConcurrentPerDownstreamCluster: 1},
+			target:  &ExperimentTarget{UpstreamCluster: "catalog", DownstreamCluster: "search", FaultPercentage: 1},
+			message: "downstream cluster 'search' is already targeted by the maximum of 1 concurrent experiments, conflicting runs: 2, 3",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			err := newGuardrails(tt.config).check(tt.target, runs)
+			if tt.message == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.Equal(t, tt.message, status.Convert(err).Message())
+		})
+	}
+}
+
+func TestCreateExperimentGuardrails(t *testing.T) {
+	ctd, err := NewExperimentConfigTestData()
+	assert.NoError(t, err)
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	logger := zap.NewNop().Sugar()
+	transformer := NewTransformer(logger)
+	assert.NoError(t, transformer.Register(Transformation{
+		ConfigTypeUrl: ctd.marshaledConfig.TypeUrl,
+		TargetTransform: func(config *ExperimentConfig) (*ExperimentTarget, error) {
+			return &ExperimentTarget{UpstreamCluster: "upstreamCluster", DownstreamCluster: "downstreamCluster", FaultPercentage: 100}, nil
+		},
+	}))
+
+	es := &storer{
+		db:                              db,
+		logger:                          logger,
+		transformer:                     &transformer,
+		guardrails:                      newGuardrails(&experimentstorev1.Guardrails{}),
+		configDeserializationErrorCount: tally.NoopScope.Counter("config_deserialization_error"),
+		guardrailViolationCount:         tally.NoopScope.Counter("guardrail_violation"),
+	}
+	defer es.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_xact_lock($1)`)).
+		WithArgs(ConvertLockToUint32(guardrailsLockId)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT experiment_run.id, experiment_config.id, details FROM experiment_config, experiment_run`)).
+		WillReturnRows(sqlmock.NewRows([]string{"run_id", "config_id", "details"}).AddRow("2", "2", ctd.stringifiedConfig))
+	mock.ExpectRollback()
+
+	s := &ExperimentSpecification{RunId: "1", ConfigId: "1", StartTime: time.Now(), Config: ctd.marshaledConfig}
+	_, err = es.CreateExperiment(context.Background(), s)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	assert.Contains(t, err.Error(), "conflicting runs: 2")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,72 @@
+package experimentstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// InjectionStateStorer stores the state of faults that are injected by the gateway itself rather than by proxies.
+// Gateway instances take turns injecting faults, so the state is shared through the store rather than kept in memory.
+type InjectionStateStorer interface {
+	// GetInjectionState returns the injection state of a run, or nil if the fault of the run was never injected.
+	GetInjectionState(ctx context.Context, runId string) (*InjectionState, error)
+	SetInjectionState(ctx context.Context, runId string, state *InjectionState) error
+}
+
+var _ InjectionStateStorer = (*storer)(nil)
+
+type InjectionState struct {
+	// The last time the fault was injected, or nil if its targets were selected but the injection did not complete.
+	LastInjectionTime *time.Time
+	// The targets the fault was last injected into, e.g. pod names.
+	Targets []string
+}
+
+func (s *storer) GetInjectionState(ctx context.Context, runId string) (*InjectionState, error) {
+	selectSql := `
+		SELECT last_injection_time, targets
+		FROM experiment_run_injection_state
+		WHERE experiment_run_id = $1`
+
+	state := &InjectionState{}
+	var lastInjectionTime sql.NullTime
+	var targets []byte
+	err := s.db.QueryRowContext(ctx, selectSql, runId).Scan(&lastInjectionTime, &targets)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if lastInjectionTime.Valid {
+		state.LastInjectionTime = &lastInjectionTime.Time
+	}
+	if err := json.Unmarshal(targets, &state.Targets); err != nil {
+		return nil, err
+	}
+	return state, nil
+}
+
+func (s *storer) SetInjectionState(ctx context.Context, runId string, state *InjectionState) error {
+	targets := state.Targets
+	if targets == nil {
+		targets = []string{}
+	}
+	targetsJson, err := json.Marshal(targets)
+	if err != nil {
+		return err
+	}
+
+	upsertSql := `
+		INSERT INTO experiment_run_injection_state (experiment_run_id, last_injection_time, targets, update_time)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (experiment_run_id) DO UPDATE
+		SET last_injection_time = EXCLUDED.last_injection_time, targets = EXCLUDED.targets, update_time = NOW()`
+
+	_, err = s.db.ExecContext(ctx, upsertSql, runId, state.LastInjectionTime, string(targetsJson))
+	return err
+}
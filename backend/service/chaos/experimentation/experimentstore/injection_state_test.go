@@ -0,0 +1,50 @@
+package experimentstore
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestInjectionState(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	es := &storer{db: db, logger: zap.NewNop().Sugar()}
+
+	selectSql := `FROM experiment_run_injection_state WHERE experiment_run_id = $1`
+	columns := []string{"last_injection_time", "targets"}
+
+	// Runs whose fault was never injected have no state.
+	mock.ExpectQuery(regexp.QuoteMeta(selectSql)).WithArgs("1").WillReturnRows(sqlmock.NewRows(columns))
+	state, err := es.GetInjectionState(context.Background(), "1")
+	assert.NoError(t, err)
+	assert.Nil(t, state)
+
+	now := time.Now()
+	mock.ExpectExec(regexp.QuoteMeta(`ON CONFLICT (experiment_run_id) DO UPDATE`)).
+		WithArgs("1", &now, `["pod-1","pod-2"]`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, es.SetInjectionState(context.Background(), "1", &InjectionState{LastInjectionTime: &now, Targets: []string{"pod-1", "pod-2"}}))
+
+	mock.ExpectQuery(regexp.QuoteMeta(selectSql)).WithArgs("1").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(now, `["pod-1","pod-2"]`))
+	state, err = es.GetInjectionState(context.Background(), "1")
+	assert.NoError(t, err)
+	assert.True(t, now.Equal(*state.LastInjectionTime))
+	assert.Equal(t, []string{"pod-1", "pod-2"}, state.Targets)
+
+	// Targets are selected before the injection completes.
+	mock.ExpectQuery(regexp.QuoteMeta(selectSql)).WithArgs("2").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow(nil, `["pod-3"]`))
+	state, err = es.GetInjectionState(context.Background(), "2")
+	assert.NoError(t, err)
+	assert.Nil(t, state.LastInjectionTime)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,413 @@
+package experimentstore
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/robfig/cron"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	"github.com/lyft/clutch/backend/id"
+)
+
+// The maximum number of occurrences walked to find the latest due occurrence of a schedule, which bounds the work
+// for frequent schedules that have not been handled in a long time.
+const maxScheduleOccurrences = 100000
+
+// ScheduleStorer stores recurring experiments. Runs of the schedules are created by the scheduler service, which uses
+// the advisory lock so that only one gateway instance creates runs at a time.
+type ScheduleStorer interface {
+	CreateExperimentSchedule(ctx context.Context, schedule *ExperimentSchedule) (*ExperimentSchedule, error)
+	GetExperimentSchedules(ctx context.Context) ([]*ExperimentSchedule, error)
+	SetExperimentSchedulePaused(ctx context.Context, id string, paused bool) (*ExperimentSchedule, error)
+	DeleteExperimentSchedule(ctx context.Context, id string) error
+	// SetExperimentScheduleLastOccurrence records that an occurrence was handled, with the ID of the run created for
+	// it or an empty ID if it was skipped.
+	SetExperimentScheduleLastOccurrence(ctx context.Context, id string, occurrence time.Time, runId string) error
+
+	AttemptLock(ctx context.Context, lockID uint32) (bool, error)
+	ReleaseLock(ctx context.Context, lockID uint32) (bool, error)
+}
+
+var _ ScheduleStorer = (*storer)(nil)
+
+type BlackoutWindow struct {
+	StartTime time.Time `json:"start_time"`
+	EndTime   time.Time `json:"end_time"`
+	Reason    string    `json:"reason,omitempty"`
+}
+
+type ExperimentSchedule struct {
+	Id                 string
+	Config             *any.Any
+	Cron               string
+	Duration           time.Duration
+	BlackoutWindows    []*BlackoutWindow
+	Paused             bool
+	CreationTime       time.Time
+	LastOccurrenceTime *time.Time
+	LastRunId          string
+
+	schedule cron.Schedule
+}
+
+// NewExperimentSchedule returns a schedule from the request to create one.
+func NewExperimentSchedule(req *experimentationv1.CreateExperimentScheduleRequest) (*ExperimentSchedule, error) {
+	scheduleId := req.Id
+	if scheduleId == "" {
+		scheduleId = id.NewID().String()
+	}
+
+	var windows []*BlackoutWindow
+	for _, w := range req.BlackoutWindows {
+		window := &BlackoutWindow{StartTime: w.StartTime.AsTime(), EndTime: w.EndTime.AsTime(), Reason: w.Reason}
+		if !window.EndTime.After(window.StartTime) {
+			return nil, status.Errorf(codes.InvalidArgument, "blackout window end time (%v) must be after its start time (%v)", window.EndTime, window.StartTime)
+		}
+		windows = append(windows, window)
+	}
+
+	s := &ExperimentSchedule{
+		Id:              scheduleId,
+		Config:          req.Config,
+		Cron:            req.Cron,
+		Duration:        req.Duration.AsDuration(),
+		BlackoutWindows: windows,
+		Paused:          req.Paused,
+	}
+	if err := s.parse(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid cron expression '%s': %v", req.Cron, err)
+	}
+	return s, nil
+}
+
+func (s *ExperimentSchedule) parse() error {
+	if s.schedule != nil {
+		return nil
+	}
+
+	schedule, err := cron.ParseStandard(s.Cron)
+	if err != nil {
+		return err
+	}
+	s.schedule = schedule
+	return nil
+}
+
+// NextOccurrence returns the first occurrence after the given time.
+func (s *ExperimentSchedule) NextOccurrence(after time.Time) (time.Time, error) {
+	if err := s.parse(); err != nil {
+		return time.Time{}, err
+	}
+	return s.schedule.Next(after.UTC()), nil
+}
+
+// DueOccurrence returns the latest occurrence at or before now that has not been handled yet. Earlier occurrences that
+// were not handled, e.g. because no gateway was running, are superseded by it.
+func (s *ExperimentSchedule) DueOccurrence(now time.Time) (time.Time, bool, error) {
+	from := s.CreationTime
+	if s.LastOccurrenceTime != nil {
+		from = *s.LastOccurrenceTime
+	}
+
+	var due time.Time
+	next, err := s.NextOccurrence(from)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	for i := 0; i < maxScheduleOccurrences && !next.IsZero() && !next.After(now); i++ {
+		due = next
+		next = s.schedule.Next(next)
+	}
+	return due, !due.IsZero(), nil
+}
+
+// OverlappingBlackoutWindow returns the first blackout window that overlaps with the given period, if any.
+func (s *ExperimentSchedule) OverlappingBlackoutWindow(start, end time.Time) *BlackoutWindow {
+	for _, w := range s.BlackoutWindows {
+		if start.Before(w.EndTime) && end.After(w.StartTime) {
+			return w
+		}
+	}
+	return nil
+}
+
+// RunId returns the ID of the run of an occurrence, which is the same for every gateway instance.
+func (s *ExperimentSchedule) RunId(occurrence time.Time) string {
+	return fmt.Sprintf("%s-%d", s.Id, occurrence.Unix())
+}
+
+func (s *ExperimentSchedule) Proto(now time.Time) *experimentationv1.ExperimentSchedule {
+	ret := &experimentationv1.ExperimentSchedule{
+		Id:           s.Id,
+		Config:       s.Config,
+		Cron:         s.Cron,
+		Duration:     durationpb.New(s.Duration),
+		Paused:       s.Paused,
+		CreationTime: timestamppb.New(s.CreationTime),
+		LastRunId:    s.LastRunId,
+	}
+
+	for _, w := range s.BlackoutWindows {
+		ret.BlackoutWindows = append(ret.BlackoutWindows, &experimentationv1.BlackoutWindow{
+			StartTime: timestamppb.New(w.StartTime),
+			EndTime:   timestamppb.New(w.EndTime),
+			Reason:    w.Reason,
+		})
+	}
+
+	from := s.CreationTime
+	if s.LastOccurrenceTime != nil {
+		ret.LastOccurrenceTime = timestamppb.New(*s.LastOccurrenceTime)
+		from = *s.LastOccurrenceTime
+	}
+	if now.After(from) {
+		from = now
+	}
+	if !s.Paused {
+		if next, err := s.NextOccurrence(from); err == nil && !next.IsZero() {
+			ret.NextOccurrenceTime = timestamppb.New(next)
+		}
+	}
+
+	return ret
+}
+
+const selectExperimentScheduleSql = `
+	SELECT
+		id,
+		details,
+		cron,
+		duration_seconds,
+		blackout_windows,
+		paused,
+		creation_time,
+		last_occurrence_time,
+		last_run_id
+	FROM experiment_schedule`
+
+func (s *storer) CreateExperimentSchedule(ctx context.Context, schedule *ExperimentSchedule) (*ExperimentSchedule, error) {
+	// The runs of the schedule are created like any other experiment, reject configs that every run would be
+	// rejected for up front. Every run ends after the duration of the schedule.
+	config := &ExperimentConfig{Id: schedule.Id, Config: schedule.Config}
+	endTime := time.Now().Add(schedule.Duration)
+	if err := s.transformer.ValidateConfig(config, &endTime); err != nil {
+		return nil, err
+	}
+	if s.guardrails != nil {
+		if err := s.checkStaticGuardrails(config); err != nil {
+			return nil, err
+		}
+	}
+
+	configJson, err := marshalConfig(schedule.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := schedule.BlackoutWindows
+	if windows == nil {
+		windows = []*BlackoutWindow{}
+	}
+	windowsJson, err := json.Marshal(windows)
+	if err != nil {
+		return nil, err
+	}
+
+	insertSql := `
+		INSERT INTO experiment_schedule (id, details, cron, duration_seconds, blackout_windows, paused, creation_time)
+		VALUES ($1, $2, $3, $4, $5, $6, NOW())
+		ON CONFLICT (id) DO NOTHING`
+
+	result, err := s.db.ExecContext(ctx, insertSql, schedule.Id, configJson, schedule.Cron,
+		int64(schedule.Duration.Seconds()), string(windowsJson), schedule.Paused)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "experiment schedule '%s' already exists", schedule.Id)
+	}
+
+	return s.getExperimentSchedule(ctx, schedule.Id)
+}
+
+func (s *storer) GetExperimentSchedules(ctx context.Context) ([]*ExperimentSchedule, error) {
+	rows, err := s.db.QueryContext(ctx, selectExperimentScheduleSql+` ORDER BY creation_time`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var schedules []*ExperimentSchedule
+	for rows.Next() {
+		schedule, err := scanExperimentSchedule(rows)
+		if err != nil {
+			s.logger.Errorw("failed to deserialize experiment schedule", "err", err)
+			s.configDeserializationErrorCount.Inc(1)
+			continue
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	return schedules, rows.Err()
+}
+
+func (s *storer) SetExperimentSchedulePaused(ctx context.Context, id string, paused bool) (*ExperimentSchedule, error) {
+	// Occurrences while paused are not made up once resumed, so the schedule resumes from now.
+	updateSql := `
+		UPDATE experiment_schedule
+		SET paused = $2,
+			last_occurrence_time = CASE WHEN paused AND NOT $2 THEN GREATEST(last_occurrence_time, NOW()) ELSE last_occurrence_time END
+		WHERE id = $1`
+
+	result, err := s.db.ExecContext(ctx, updateSql, id, paused)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return nil, status.Errorf(codes.NotFound, "experiment schedule '%s' not found", id)
+	}
+
+	return s.getExperimentSchedule(ctx, id)
+}
+
+func (s *storer) DeleteExperimentSchedule(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM experiment_schedule WHERE id = $1`, id)
+	return err
+}
+
+func (s *storer) SetExperimentScheduleLastOccurrence(ctx context.Context, id string, occurrence time.Time, runId string) error {
+	updateSql := `UPDATE experiment_schedule SET last_occurrence_time = $2, last_run_id = NULLIF($3, '') WHERE id = $1`
+	_, err := s.db.ExecContext(ctx, updateSql, id, occurrence, runId)
+	return err
+}
+
+func (s *storer) getExperimentSchedule(ctx context.Context, id string) (*ExperimentSchedule, error) {
+	row := s.db.QueryRowContext(ctx, selectExperimentScheduleSql+` WHERE id = $1`, id)
+	schedule, err := scanExperimentSchedule(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "experiment schedule '%s' not found", id)
+	}
+	return schedule, err
+}
+
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanExperimentSchedule(row scanner) (*ExperimentSchedule, error) {
+	var details, windows string
+	var durationSeconds int64
+	var lastRunId sql.NullString
+	schedule := &ExperimentSchedule{}
+
+	err := row.Scan(
+		&schedule.Id,
+		&details,
+		&schedule.Cron,
+		&durationSeconds,
+		&windows,
+		&schedule.Paused,
+		&schedule.CreationTime,
+		&schedule.LastOccurrenceTime,
+		&lastRunId)
+	if err != nil {
+		return nil, err
+	}
+
+	schedule.Config = &any.Any{}
+	if err := protojson.Unmarshal([]byte(details), schedule.Config); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal([]byte(windows), &schedule.BlackoutWindows); err != nil {
+		return nil, err
+	}
+	schedule.Duration = time.Duration(durationSeconds) * time.Second
+	schedule.LastRunId = lastRunId.String
+
+	return schedule, nil
+}
+
+// ConvertLockToUint32 returns the id of the postgres advisory lock with the given name.
+func ConvertLockToUint32(lockId string) uint32 {
+	sum := sha256.Sum256([]byte(lockId))
+	return binary.BigEndian.Uint32(sum[:])
+}
+
+// We create our own connection to use for acquiring each advisory lock.
+// For advisory locks you must use the same session to issue the unlock, so the connection of a lock is kept until the
+// lock is released. Callers must hold advisoryLockMu.
+func (s *storer) getAdvisoryConn(lockID uint32) (*sql.Conn, error) {
+	if conn, ok := s.advisoryLockConns[lockID]; ok {
+		return conn, nil
+	}
+
+	advisoryLockConn, err := s.db.Conn(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	if s.advisoryLockConns == nil {
+		s.advisoryLockConns = make(map[uint32]*sql.Conn)
+	}
+	s.advisoryLockConns[lockID] = advisoryLockConn
+	return advisoryLockConn, nil
+}
+
+// Callers must hold advisoryLockMu.
+func (s *storer) closeAdvisoryConn(lockID uint32) {
+	if conn, ok := s.advisoryLockConns[lockID]; ok {
+		conn.Close()
+		delete(s.advisoryLockConns, lockID)
+	}
+}
+
+func (s *storer) AttemptLock(ctx context.Context, lockID uint32) (bool, error) {
+	s.advisoryLockMu.Lock()
+	defer s.advisoryLockMu.Unlock()
+
+	conn, err := s.getAdvisoryConn(lockID)
+	if err != nil {
+		return false, err
+	}
+
+	var lock bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1);", lockID).Scan(&lock); err != nil {
+		s.logger.Errorw("unable to query for an advisory lock", "err", err)
+		s.closeAdvisoryConn(lockID)
+		return false, err
+	}
+	return lock, nil
+}
+
+func (s *storer) ReleaseLock(ctx context.Context, lockID uint32) (bool, error) {
+	s.advisoryLockMu.Lock()
+	defer s.advisoryLockMu.Unlock()
+
+	conn, err := s.getAdvisoryConn(lockID)
+	if err != nil {
+		return false, err
+	}
+
+	var unlock bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_advisory_unlock($1)", lockID).Scan(&unlock); err != nil {
+		s.logger.Errorw("unable to perform an advisory unlock", "err", err)
+		s.closeAdvisoryConn(lockID)
+		return false, err
+	}
+
+	s.closeAdvisoryConn(lockID)
+	return unlock, nil
+}
@@ -0,0 +1,318 @@
+package experimentstore
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	experimentstorev1 "github.com/lyft/clutch/backend/api/config/service/chaos/experimentation/experimentstore/v1"
+)
+
+func TestNewExperimentSchedule(t *testing.T) {
+	ctd, err := NewExperimentConfigTestData()
+	assert.NoError(t, err)
+
+	start := time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		id   string
+		req  *experimentationv1.CreateExperimentScheduleRequest
+		code codes.Code
+	}{
+		{
+			id:   "valid",
+			req:  &experimentationv1.CreateExperimentScheduleRequest{Id: "weekly", Config: ctd.marshaledConfig, Cron: "0 10 * * MON", Duration: durationpb.New(time.Hour)},
+			code: codes.OK,
+		},
+		{
+			id:   "invalid cron expression",
+			req:  &experimentationv1.CreateExperimentScheduleRequest{Config: ctd.marshaledConfig, Cron: "every monday", Duration: durationpb.New(time.Hour)},
+			code: codes.InvalidArgument,
+		},
+		{
+			id: "blackout window ends before it starts",
+			req: &experimentationv1.CreateExperimentScheduleRequest{
+				Config:   ctd.marshaledConfig,
+				Cron:     "0 10 * * MON",
+				Duration: durationpb.New(time.Hour),
+				BlackoutWindows: []*experimentationv1.BlackoutWindow{
+					{StartTime: timestamppb.New(start), EndTime: timestamppb.New(start.Add(-time.Hour))},
+				},
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := NewExperimentSchedule(tt.req)
+			assert.Equal(t, tt.code, status.Code(err))
+			if tt.code != codes.OK {
+				return
+			}
+			assert.Equal(t, "weekly", s.Id)
+			assert.Equal(t, time.Hour, s.Duration)
+		})
+	}
+
+	// An identifier is generated if none is provided.
+	s, err := NewExperimentSchedule(&experimentationv1.CreateExperimentScheduleRequest{Config: ctd.marshaledConfig, Cron: "@daily", Duration: durationpb.New(time.Hour)})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, s.Id)
+}
+
+func TestExperimentScheduleDueOccurrence(t *testing.T) {
+	created := time.Date(2021, 1, 1, 9, 30, 0, 0, time.UTC)
+	lastOccurrence := time.Date(2021, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		id             string
+		lastOccurrence *time.Time
+		now            time.Time
+		expected       time.Time
+		due            bool
+	}{
+		{
+			id:  "no occurrence yet",
+			now: time.Date(2021, 1, 1, 9, 59, 0, 0, time.UTC),
+		},
+		{
+			id:       "first occurrence",
+			now:      time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC),
+			expected: time.Date(2021, 1, 1, 10, 0, 0, 0, time.UTC),
+			due:      true,
+		},
+		{
+			id:       "latest of several occurrences",
+			now:      time.Date(2021, 1, 1, 13, 30, 0, 0, time.UTC),
+			expected: time.Date(2021, 1, 1, 13, 0, 0, 0, time.UTC),
+			due:      true,
+		},
+		{
+			id:             "last occurrence already handled",
+			lastOccurrence: &lastOccurrence,
+			now:            time.Date(2021, 1, 1, 12, 30, 0, 0, time.UTC),
+		},
+		{
+			id:             "occurrence after the last handled one",
+			lastOccurrence: &lastOccurrence,
+			now:            time.Date(2021, 1, 1, 13, 0, 0, 0, time.UTC),
+			expected:       time.Date(2021, 1, 1, 13, 0, 0, 0, time.UTC),
+			due:            true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			s := &ExperimentSchedule{Id: "hourly", Cron: "0 * * * *", CreationTime: created, LastOccurrenceTime: tt.lastOccurrence}
+			occurrence, due, err := s.DueOccurrence(tt.now)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.due, due)
+			assert.True(t, tt.expected.Equal(occurrence), "expected %v, got %v", tt.expected, occurrence)
+		})
+	}
+}
+
+func TestExperimentScheduleOverlappingBlackoutWindow(t *testing.T) {
+	window := &BlackoutWindow{
+		StartTime: time.Date(2021, 12, 20, 0, 0, 0, 0, time.UTC),
+		EndTime:   time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC),
+		Reason:    "holidays",
+	}
+	s := &ExperimentSchedule{BlackoutWindows: []*BlackoutWindow{window}}
+
+	assert.Nil(t, s.OverlappingBlackoutWindow(time.Date(2021, 12, 19, 22, 0, 0, 0, time.UTC), time.Date(2021, 12, 20, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, window, s.OverlappingBlackoutWindow(time.Date(2021, 12, 19, 23, 0, 0, 0, time.UTC), time.Date(2021, 12, 20, 1, 0, 0, 0, time.UTC)))
+	assert.Equal(t, window, s.OverlappingBlackoutWindow(time.Date(2021, 12, 25, 10, 0, 0, 0, time.UTC), time.Date(2021, 12, 25, 11, 0, 0, 0, time.UTC)))
+	assert.Nil(t, s.OverlappingBlackoutWindow(time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 3, 1, 0, 0, 0, time.UTC)))
+}
+
+func TestExperimentScheduleProto(t *testing.T) {
+	now := time.Date(2021, 1, 1, 10, 30, 0, 0, time.UTC)
+	s := &ExperimentSchedule{
+		Id:           "hourly",
+		Cron:         "0 * * * *",
+		Duration:     10 * time.Minute,
+		CreationTime: time.Date(2021, 1, 1, 9, 30, 0, 0, time.UTC),
+	}
+
+	p := s.Proto(now)
+	assert.Equal(t, "hourly", p.Id)
+	assert.Equal(t, 10*time.Minute, p.Duration.AsDuration())
+	assert.Nil(t, p.LastOccurrenceTime)
+	assert.Equal(t, time.Date(2021, 1, 1, 11, 0, 0, 0, time.UTC), p.NextOccurrenceTime.AsTime())
+
+	s.Paused = true
+	assert.Nil(t, s.Proto(now).NextOccurrenceTime)
+}
+
+func TestCreateExperimentSchedule(t *testing.T) {
+	ctd, err := NewExperimentConfigTestData()
+	assert.NoError(t, err)
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	es := &storer{db: db, logger: zap.NewNop().Sugar(), transformer: &Transformer{}, configDeserializationErrorCount: tally.NoopScope.Counter("")}
+	defer es.Close()
+
+	schedule, err := NewExperimentSchedule(&experimentationv1.CreateExperimentScheduleRequest{
+		Id:       "weekly",
+		Config:   ctd.marshaledConfig,
+		Cron:     "0 10 * * MON",
+		Duration: durationpb.New(time.Hour),
+	})
+	assert.NoError(t, err)
+
+	insertSql := `INSERT INTO experiment_schedule (id, details, cron, duration_seconds, blackout_windows, paused, creation_time) VALUES ($1, $2, $3, $4, $5, $6, NOW()) ON CONFLICT (id) DO NOTHING`
+	columns := []string{"id", "details", "cron", "duration_seconds", "blackout_windows", "paused", "creation_time", "last_occurrence_time", "last_run_id"}
+
+	mock.ExpectExec(regexp.QuoteMeta(insertSql)).
+		WithArgs("weekly", ctd.stringifiedConfig, "0 10 * * MON", int64(3600), "[]", false).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM experiment_schedule WHERE id = $1`)).
+		WithArgs("weekly").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow("weekly", ctd.stringifiedConfig, "0 10 * * MON", 3600, "[]", false, time.Now(), nil, nil))
+
+	created, err := es.CreateExperimentSchedule(context.Background(), schedule)
+	assert.NoError(t, err)
+	assert.Equal(t, "weekly", created.Id)
+	assert.Equal(t, time.Hour, created.Duration)
+	assert.Nil(t, created.LastOccurrenceTime)
+	assert.Empty(t, created.BlackoutWindows)
+
+	// A schedule with the same identifier is not overwritten.
+	mock.ExpectExec(regexp.QuoteMeta(insertSql)).WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = es.CreateExperimentSchedule(context.Background(), schedule)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateExperimentScheduleValidatesConfig(t *testing.T) {
+	ctd, err := NewExperimentConfigTestData()
+	assert.NoError(t, err)
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+
+	logger := zap.NewNop().Sugar()
+	transformer := NewTransformer(logger)
+	assert.NoError(t, transformer.Register(Transformation{
+		ConfigTypeUrl: ctd.marshaledConfig.TypeUrl,
+		ConfigValidation: func(config *ExperimentConfig, _ *time.Time) error {
+			if config.Id == "invalid" {
+				return status.Error(codes.InvalidArgument, "invalid config")
+			}
+			return nil
+		},
+		TargetTransform: func(config *ExperimentConfig) (*ExperimentTarget, error) {
+			return &ExperimentTarget{UpstreamCluster: "upstreamCluster", DownstreamCluster: "downstreamCluster", FaultPercentage: 100}, nil
+		},
+	}))
+
+	es := &storer{
+		db:                              db,
+		logger:                          logger,
+		transformer:                     &transformer,
+		guardrails:                      newGuardrails(&experimentstorev1.Guardrails{DeniedClusters: []string{"upstreamCluster"}}),
+		configDeserializationErrorCount: tally.NoopScope.Counter("config_deserialization_error"),
+		guardrailViolationCount:         tally.NoopScope.Counter("guardrail_violation"),
+	}
+	defer es.Close()
+
+	newSchedule := func(id string) *ExperimentSchedule {
+		schedule, err := NewExperimentSchedule(&experimentationv1.CreateExperimentScheduleRequest{
+			Id:       id,
+			Config:   ctd.marshaledConfig,
+			Cron:     "0 10 * * MON",
+			Duration: durationpb.New(time.Hour),
+		})
+		assert.NoError(t, err)
+		return schedule
+	}
+
+	// Schedules are rejected without being stored.
+	_, err = es.CreateExperimentSchedule(context.Background(), newSchedule("invalid"))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = es.CreateExperimentSchedule(context.Background(), newSchedule("weekly"))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAdvisoryLocks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	mock.MatchExpectationsInOrder(false)
+
+	es := &storer{db: db, logger: zap.NewNop().Sugar()}
+	defer es.Close()
+
+	// The scheduler and the Kubernetes injector take their locks on the same store concurrently.
+	const iterations = 10
+	lockIDs := []uint32{1, 2}
+	for range lockIDs {
+		for i := 0; i < iterations; i++ {
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT pg_try_advisory_lock($1);`)).
+				WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+			mock.ExpectQuery(regexp.QuoteMeta(`SELECT pg_advisory_unlock($1)`)).
+				WillReturnRows(sqlmock.NewRows([]string{"pg_advisory_unlock"}).AddRow(true))
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, lockID := range lockIDs {
+		wg.Add(1)
+		go func(lockID uint32) {
+			defer wg.Done()
+			for i := 0; i < iterations; i++ {
+				locked, err := es.AttemptLock(context.Background(), lockID)
+				assert.NoError(t, err)
+				assert.True(t, locked)
+
+				unlocked, err := es.ReleaseLock(context.Background(), lockID)
+				assert.NoError(t, err)
+				assert.True(t, unlocked)
+			}
+		}(lockID)
+	}
+	wg.Wait()
+	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Empty(t, es.advisoryLockConns)
+
+	// Releasing a lock keeps the session holding another lock.
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT pg_try_advisory_lock($1);`)).
+		WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_try_advisory_lock"}).AddRow(true))
+	mock.ExpectQuery(regexp.QuoteMeta(`SELECT pg_advisory_unlock($1)`)).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"pg_advisory_unlock"}).AddRow(false))
+
+	_, err = es.AttemptLock(context.Background(), 1)
+	assert.NoError(t, err)
+	conn := es.advisoryLockConns[1]
+	_, err = es.ReleaseLock(context.Background(), 2)
+	assert.NoError(t, err)
+	assert.Same(t, conn, es.advisoryLockConns[1])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
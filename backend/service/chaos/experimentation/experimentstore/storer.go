@@ -8,6 +8,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
@@ -18,12 +19,16 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 
 	experimentation "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	experimentstorev1 "github.com/lyft/clutch/backend/api/config/service/chaos/experimentation/experimentstore/v1"
 	"github.com/lyft/clutch/backend/service"
 	pgservice "github.com/lyft/clutch/backend/service/db/postgres"
 )
 
 const Name = "clutch.service.chaos.experimentation.store"
 
+// Notifications are sent on this channel by a trigger on experiment_run whenever a run is created or cancelled.
+const experimentRunChangedChannel = "experiment_run_changed"
+
 // Storer stores experiment data
 type Storer interface {
 	CreateExperiment(context.Context, *ExperimentSpecification) (*Experiment, error)
@@ -36,17 +41,40 @@ type Storer interface {
 	Close()
 }
 
+// Notifier is implemented by stores that can notify subscribers of changes to experiment runs, so that consumers
+// don't have to wait for their next poll to pick up the change.
+type Notifier interface {
+	// Subscribe returns a channel that receives a value whenever experiment runs may have been created or cancelled,
+	// until the context is done. Runs starting or ending at their scheduled time are not notified.
+	Subscribe(ctx context.Context) (<-chan struct{}, error)
+}
+
 type storer struct {
 	db                              *sql.DB
+	advisoryLockMu                  sync.Mutex
+	advisoryLockConns               map[uint32]*sql.Conn
+	listener                        pgservice.Listener
 	logger                          *zap.SugaredLogger
 	transformer                     *Transformer
+	guardrails                      *guardrails
 	configDeserializationErrorCount tally.Counter
+	guardrailViolationCount         tally.Counter
 }
 
-var _ Storer = (*storer)(nil)
+var (
+	_ Storer   = (*storer)(nil)
+	_ Notifier = (*storer)(nil)
+)
 
 // New returns a new NewExperimentStore instance.
-func New(_ *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
+func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
+	config := &experimentstorev1.Config{}
+	if cfg != nil {
+		if err := cfg.UnmarshalTo(config); err != nil {
+			return nil, err
+		}
+	}
+
 	p, ok := service.Registry[pgservice.Name]
 	if !ok {
 		return nil, errors.New("could not find database service")
@@ -59,12 +87,18 @@ func New(_ *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service, er
 
 	sugaredLogger := logger.Sugar()
 	transformer := NewTransformer(sugaredLogger)
-	return &storer{
+	s := &storer{
 		db:                              client.DB(),
 		logger:                          sugaredLogger,
 		transformer:                     &transformer,
+		guardrails:                      newGuardrails(config.Guardrails),
 		configDeserializationErrorCount: scope.Counter("config_deserialization_error"),
-	}, nil
+		guardrailViolationCount:         scope.Counter("guardrail_violation"),
+	}
+	if listener, ok := p.(pgservice.Listener); ok {
+		s.listener = listener
+	}
+	return s, nil
 }
 
 func (s *storer) CreateExperiment(ctx context.Context, es *ExperimentSpecification) (*Experiment, error) {
@@ -72,11 +106,23 @@ func (s *storer) CreateExperiment(ctx context.Context, es *ExperimentSpecificati
 	// 1) creating the config
 	// 2) starting a new experiment with the config
 
+	if err := s.transformer.ValidateConfig(&ExperimentConfig{Id: es.ConfigId, Config: es.Config}, es.EndTime); err != nil {
+		return nil, err
+	}
+
 	// All experiments are created in a single transaction
 	tx, err := s.db.Begin()
 	if err != nil {
 		return nil, err
 	}
+	// Rolling back is a no-op once the transaction is committed.
+	defer tx.Rollback()
+
+	if s.guardrails != nil {
+		if err := s.checkGuardrails(ctx, tx, es); err != nil {
+			return nil, err
+		}
+	}
 
 	configJson, err := marshalConfig(es.Config)
 	if err != nil {
@@ -84,7 +130,7 @@ func (s *storer) CreateExperiment(ctx context.Context, es *ExperimentSpecificati
 	}
 
 	configSql := `INSERT INTO experiment_config (id, details) VALUES ($1, $2)`
-	_, err = s.db.ExecContext(ctx, configSql, es.ConfigId, configJson)
+	_, err = tx.ExecContext(ctx, configSql, es.ConfigId, configJson)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +144,7 @@ func (s *storer) CreateExperiment(ctx context.Context, es *ExperimentSpecificati
 				creation_time)
 			VALUES ($1, $2, tstzrange($3, $4, '[]'), NOW())`
 
-	_, err = s.db.ExecContext(ctx, runSql, es.RunId, es.ConfigId, es.StartTime, es.EndTime)
+	_, err = tx.ExecContext(ctx, runSql, es.RunId, es.ConfigId, es.StartTime, es.EndTime)
 	if err != nil {
 		return nil, err
 	}
@@ -355,6 +401,32 @@ func (s *storer) getExperiment(ctx context.Context, runId string) (*Experiment,
 	return &Experiment{Run: &run, Config: config}, nil
 }
 
+func (s *storer) Subscribe(ctx context.Context) (<-chan struct{}, error) {
+	if s.listener == nil {
+		return nil, status.Error(codes.Unimplemented, "the database client does not support notifications")
+	}
+
+	notifications, err := s.listener.Listen(ctx, experimentRunChangedChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	// The payload is the ID of the run, subscribers are only told that something changed. Notifications that arrive
+	// while the subscriber is busy are coalesced.
+	ret := make(chan struct{}, 1)
+	go func() {
+		defer close(ret)
+		for range notifications {
+			select {
+			case ret <- struct{}{}:
+			default:
+			}
+		}
+	}()
+
+	return ret, nil
+}
+
 // Close closes all resources held.
 func (s *storer) Close() {
 	s.db.Close()
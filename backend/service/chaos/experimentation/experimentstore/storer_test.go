@@ -138,7 +138,7 @@ func TestCreateExperiment(t *testing.T) {
 			db, mock, err := sqlmock.New()
 			assert.NoError(t, err)
 
-			es := &storer{db: db}
+			es := &storer{db: db, transformer: &Transformer{}}
 			defer es.Close()
 			mock.ExpectBegin()
 			for _, query := range tt.expectedExecs {
@@ -244,7 +244,7 @@ func TestCreateOrGetExperiment(t *testing.T) {
 			db, mock, err := sqlmock.New()
 			assert.NoError(t, err)
 
-			es := &storer{db: db}
+			es := &storer{db: db, transformer: &Transformer{}}
 			defer es.Close()
 			for _, query := range tt.beforeExecsQueries {
 				expected := mock.ExpectQuery(regexp.QuoteMeta(query.sql))
@@ -0,0 +1,351 @@
+package experimentstore
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	"github.com/lyft/clutch/backend/id"
+)
+
+// TemplateStorer stores experiment templates. Runs are created from templates by rendering their config with the
+// values of their parameters, see ExperimentTemplate.Render.
+type TemplateStorer interface {
+	CreateExperimentTemplate(ctx context.Context, template *ExperimentTemplate) (*ExperimentTemplate, error)
+	GetExperimentTemplates(ctx context.Context) ([]*ExperimentTemplate, error)
+	GetExperimentTemplate(ctx context.Context, id string) (*ExperimentTemplate, error)
+	DeleteExperimentTemplate(ctx context.Context, id string) error
+}
+
+var _ TemplateStorer = (*storer)(nil)
+
+var templateParameterReference = regexp.MustCompile(`\{\{\s*([A-Za-z_][A-Za-z0-9_]*)\s*\}\}`)
+
+// The proto validation generated for experiment configs.
+type validator interface {
+	Validate() error
+}
+
+type ExperimentTemplate struct {
+	Id           string
+	Description  string
+	Config       *structpb.Struct
+	Parameters   []*experimentationv1.TemplateParameter
+	CreationTime time.Time
+}
+
+// NewExperimentTemplate returns a template from the request to create one. Every parameter must be referenced by the
+// config and every reference must be to a parameter.
+func NewExperimentTemplate(req *experimentationv1.CreateExperimentTemplateRequest) (*ExperimentTemplate, error) {
+	templateId := req.Id
+	if templateId == "" {
+		templateId = id.NewID().String()
+	}
+
+	parameters := make(map[string]*experimentationv1.TemplateParameter, len(req.Parameters))
+	for _, p := range req.Parameters {
+		if _, ok := parameters[p.Name]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "template parameter '%s' is defined more than once", p.Name)
+		}
+		if p.Type != experimentationv1.TemplateParameter_NUMBER && (p.Min != nil || p.Max != nil) {
+			return nil, status.Errorf(codes.InvalidArgument, "template parameter '%s' has bounds but is not a number", p.Name)
+		}
+		if p.Min != nil && p.Max != nil && p.Min.Value > p.Max.Value {
+			return nil, status.Errorf(codes.InvalidArgument, "template parameter '%s' has a minimum (%v) above its maximum (%v)", p.Name, p.Min.Value, p.Max.Value)
+		}
+		parameters[p.Name] = p
+	}
+
+	typeUrl, ok := req.Config.GetFields()["@type"]
+	if !ok || typeUrl.GetStringValue() == "" {
+		return nil, status.Error(codes.InvalidArgument, "template config must have an '@type' field")
+	}
+	if _, err := protoregistry.GlobalTypes.FindMessageByURL(typeUrl.GetStringValue()); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown template config type '%s'", typeUrl.GetStringValue())
+	}
+
+	references := map[string]bool{}
+	collectTemplateReferences(structpb.NewStructValue(req.Config), references)
+	for name := range references {
+		if _, ok := parameters[name]; !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "template config references undefined parameter '%s'", name)
+		}
+	}
+	for name := range parameters {
+		if !references[name] {
+			return nil, status.Errorf(codes.InvalidArgument, "template parameter '%s' is not referenced by the config", name)
+		}
+	}
+
+	return &ExperimentTemplate{
+		Id:          templateId,
+		Description: req.Description,
+		Config:      req.Config,
+		Parameters:  req.Parameters,
+	}, nil
+}
+
+func collectTemplateReferences(v *structpb.Value, references map[string]bool) {
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		for _, m := range templateParameterReference.FindAllStringSubmatch(k.StringValue, -1) {
+			references[m[1]] = true
+		}
+	case *structpb.Value_StructValue:
+		for _, f := range k.StructValue.GetFields() {
+			collectTemplateReferences(f, references)
+		}
+	case *structpb.Value_ListValue:
+		for _, e := range k.ListValue.GetValues() {
+			collectTemplateReferences(e, references)
+		}
+	}
+}
+
+// Render returns the config of the template with its parameters replaced by the given values. The config is validated
+// against the proto validation of its type, so that invalid values are rejected before a run is stored.
+func (t *ExperimentTemplate) Render(values map[string]*structpb.Value) (*any.Any, error) {
+	for name := range values {
+		if t.parameter(name) == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "template '%s' has no parameter '%s'", t.Id, name)
+		}
+	}
+
+	for _, p := range t.Parameters {
+		v, ok := values[p.Name]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "missing value of template parameter '%s'", p.Name)
+		}
+
+		switch p.Type {
+		case experimentationv1.TemplateParameter_STRING:
+			if _, ok := v.GetKind().(*structpb.Value_StringValue); !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "value of template parameter '%s' must be a string", p.Name)
+			}
+		case experimentationv1.TemplateParameter_NUMBER:
+			n, ok := v.GetKind().(*structpb.Value_NumberValue)
+			if !ok {
+				return nil, status.Errorf(codes.InvalidArgument, "value of template parameter '%s' must be a number", p.Name)
+			}
+			if (p.Min != nil && n.NumberValue < p.Min.Value) || (p.Max != nil && n.NumberValue > p.Max.Value) {
+				return nil, status.Errorf(codes.InvalidArgument, "value of template parameter '%s' (%v) is out of bounds", p.Name, n.NumberValue)
+			}
+		}
+	}
+
+	rendered, err := protojson.Marshal(renderTemplateValue(structpb.NewStructValue(t.Config), values))
+	if err != nil {
+		return nil, err
+	}
+
+	config := &any.Any{}
+	if err := protojson.Unmarshal(rendered, config); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "rendered template config is invalid: %v", err)
+	}
+
+	m, err := config.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+	if v, ok := m.(validator); ok {
+		if err := v.Validate(); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "rendered template config is invalid: %v", err)
+		}
+	}
+
+	return config, nil
+}
+
+func (t *ExperimentTemplate) parameter(name string) *experimentationv1.TemplateParameter {
+	for _, p := range t.Parameters {
+		if p.Name == name {
+			return p
+		}
+	}
+	return nil
+}
+
+// Returns a copy of the value with parameter references replaced. Strings that are only a reference take the type of
+// the value, references within longer strings are formatted.
+func renderTemplateValue(v *structpb.Value, values map[string]*structpb.Value) *structpb.Value {
+	switch k := v.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		if m := templateParameterReference.FindStringSubmatch(k.StringValue); m != nil && m[0] == k.StringValue {
+			return values[m[1]]
+		}
+		return structpb.NewStringValue(templateParameterReference.ReplaceAllStringFunc(k.StringValue, func(ref string) string {
+			value := values[templateParameterReference.FindStringSubmatch(ref)[1]]
+			if n, ok := value.GetKind().(*structpb.Value_NumberValue); ok {
+				return strconv.FormatFloat(n.NumberValue, 'f', -1, 64)
+			}
+			return value.GetStringValue()
+		}))
+	case *structpb.Value_StructValue:
+		fields := make(map[string]*structpb.Value, len(k.StructValue.GetFields()))
+		for name, f := range k.StructValue.GetFields() {
+			fields[name] = renderTemplateValue(f, values)
+		}
+		return structpb.NewStructValue(&structpb.Struct{Fields: fields})
+	case *structpb.Value_ListValue:
+		elements := make([]*structpb.Value, len(k.ListValue.GetValues()))
+		for i, e := range k.ListValue.GetValues() {
+			elements[i] = renderTemplateValue(e, values)
+		}
+		return structpb.NewListValue(&structpb.ListValue{Values: elements})
+	default:
+		return v
+	}
+}
+
+func (t *ExperimentTemplate) Proto() *experimentationv1.ExperimentTemplate {
+	return &experimentationv1.ExperimentTemplate{
+		Id:           t.Id,
+		Description:  t.Description,
+		Config:       t.Config,
+		Parameters:   t.Parameters,
+		CreationTime: timestamppb.New(t.CreationTime),
+	}
+}
+
+const selectExperimentTemplateSql = `
+	SELECT
+		id,
+		description,
+		config,
+		parameters,
+		creation_time
+	FROM experiment_template`
+
+func (s *storer) CreateExperimentTemplate(ctx context.Context, template *ExperimentTemplate) (*ExperimentTemplate, error) {
+	configJson, err := protojson.Marshal(template.Config)
+	if err != nil {
+		return nil, err
+	}
+
+	parametersJson, err := marshalTemplateParameters(template.Parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	insertSql := `
+		INSERT INTO experiment_template (id, description, config, parameters, creation_time)
+		VALUES ($1, $2, $3, $4, NOW())
+		ON CONFLICT (id) DO NOTHING`
+
+	result, err := s.db.ExecContext(ctx, insertSql, template.Id, template.Description, string(configJson), parametersJson)
+	if err != nil {
+		return nil, err
+	}
+	if n, err := result.RowsAffected(); err == nil && n == 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "experiment template '%s' already exists", template.Id)
+	}
+
+	return s.GetExperimentTemplate(ctx, template.Id)
+}
+
+func (s *storer) GetExperimentTemplates(ctx context.Context) ([]*ExperimentTemplate, error) {
+	rows, err := s.db.QueryContext(ctx, selectExperimentTemplateSql+` ORDER BY creation_time`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var templates []*ExperimentTemplate
+	for rows.Next() {
+		template, err := scanExperimentTemplate(rows)
+		if err != nil {
+			s.logger.Errorw("failed to deserialize experiment template", "err", err)
+			s.configDeserializationErrorCount.Inc(1)
+			continue
+		}
+		templates = append(templates, template)
+	}
+
+	return templates, rows.Err()
+}
+
+func (s *storer) GetExperimentTemplate(ctx context.Context, id string) (*ExperimentTemplate, error) {
+	row := s.db.QueryRowContext(ctx, selectExperimentTemplateSql+` WHERE id = $1`, id)
+	template, err := scanExperimentTemplate(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "experiment template '%s' not found", id)
+	}
+	return template, err
+}
+
+func (s *storer) DeleteExperimentTemplate(ctx context.Context, id string) error {
+	_, err := s.db.ExecContext(ctx, `DELETE FROM experiment_template WHERE id = $1`, id)
+	return err
+}
+
+// Parameters are stored as a JSON array of their proto JSON representation.
+func marshalTemplateParameters(parameters []*experimentationv1.TemplateParameter) (string, error) {
+	raw := make([]json.RawMessage, len(parameters))
+	for i, p := range parameters {
+		b, err := protojson.Marshal(p)
+		if err != nil {
+			return "", err
+		}
+		raw[i] = b
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func unmarshalTemplateParameters(s string) ([]*experimentationv1.TemplateParameter, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal([]byte(s), &raw); err != nil {
+		return nil, err
+	}
+
+	parameters := make([]*experimentationv1.TemplateParameter, len(raw))
+	for i, r := range raw {
+		parameters[i] = &experimentationv1.TemplateParameter{}
+		if err := protojson.Unmarshal(r, parameters[i]); err != nil {
+			return nil, err
+		}
+	}
+	return parameters, nil
+}
+
+func scanExperimentTemplate(row scanner) (*ExperimentTemplate, error) {
+	var config, parameters string
+	template := &ExperimentTemplate{}
+
+	err := row.Scan(
+		&template.Id,
+		&template.Description,
+		&config,
+		&parameters,
+		&template.CreationTime)
+	if err != nil {
+		return nil, err
+	}
+
+	template.Config = &structpb.Struct{}
+	if err := protojson.Unmarshal([]byte(config), template.Config); err != nil {
+		return nil, err
+	}
+	if template.Parameters, err = unmarshalTemplateParameters(parameters); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
@@ -0,0 +1,258 @@
+package experimentstore
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	serverexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/serverexperimentation/v1"
+)
+
+const testTemplateConfig = `{
+	"@type": "type.googleapis.com/clutch.chaos.serverexperimentation.v1.HTTPFaultConfig",
+	"abortFault": {
+		"percentage": {"percentage": "{{percentage}}"},
+		"abortStatus": {"httpStatusCode": "{{status}}"}
+	},
+	"faultTargeting": {
+		"upstreamEnforcing": {
+			"upstreamCluster": {"name": "{{upstream}}"},
+			"downstreamCluster": {"name": "{{upstream}}-client"}
+		}
+	}
+}`
+
+func testTemplateRequest(t *testing.T, config string, parameters ...*experimentationv1.TemplateParameter) *experimentationv1.CreateExperimentTemplateRequest {
+	s := &structpb.Struct{}
+	assert.NoError(t, protojson.Unmarshal([]byte(config), s))
+	return &experimentationv1.CreateExperimentTemplateRequest{Id: "abort", Config: s, Parameters: parameters}
+}
+
+func testTemplateParameters() []*experimentationv1.TemplateParameter {
+	return []*experimentationv1.TemplateParameter{
+		{Name: "upstream", Type: experimentationv1.TemplateParameter_STRING},
+		{Name: "percentage", Type: experimentationv1.TemplateParameter_NUMBER, Min: wrapperspb.Double(1), Max: wrapperspb.Double(10)},
+		{Name: "status", Type: experimentationv1.TemplateParameter_NUMBER},
+	}
+}
+
+func TestNewExperimentTemplate(t *testing.T) {
+	parameters := testTemplateParameters()
+
+	tests := []struct {
+		id         string
+		config     string
+		parameters []*experimentationv1.TemplateParameter
+		code       codes.Code
+	}{
+		{
+			id:         "valid",
+			config:     testTemplateConfig,
+			parameters: parameters,
+			code:       codes.OK,
+		},
+		{
+			id:         "undefined parameter",
+			config:     testTemplateConfig,
+			parameters: parameters[:2],
+			code:       codes.InvalidArgument,
+		},
+		{
+			id:         "unreferenced parameter",
+			config:     testTemplateConfig,
+			parameters: append(parameters, &experimentationv1.TemplateParameter{Name: "unused", Type: experimentationv1.TemplateParameter_STRING}),
+			code:       codes.InvalidArgument,
+		},
+		{
+			id:         "duplicate parameter",
+			config:     testTemplateConfig,
+			parameters: append(parameters, parameters[0]),
+			code:       codes.InvalidArgument,
+		},
+		{
+			id:     "minimum above maximum",
+			config: testTemplateConfig,
+			parameters: []*experimentationv1.TemplateParameter{
+				parameters[0],
+				{Name: "percentage", Type: experimentationv1.TemplateParameter_NUMBER, Min: wrapperspb.Double(10), Max: wrapperspb.Double(1)},
+				parameters[2],
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			id:     "bounds of string parameter",
+			config: testTemplateConfig,
+			parameters: []*experimentationv1.TemplateParameter{
+				{Name: "upstream", Type: experimentationv1.TemplateParameter_STRING, Max: wrapperspb.Double(1)},
+				parameters[1],
+				parameters[2],
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			id:     "missing type",
+			config: `{"name": "{{upstream}}"}`,
+			parameters: []*experimentationv1.TemplateParameter{
+				parameters[0],
+			},
+			code: codes.InvalidArgument,
+		},
+		{
+			id:     "unknown type",
+			config: `{"@type": "type.googleapis.com/unknown.Config", "name": "{{upstream}}"}`,
+			parameters: []*experimentationv1.TemplateParameter{
+				parameters[0],
+			},
+			code: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			template, err := NewExperimentTemplate(testTemplateRequest(t, tt.config, tt.parameters...))
+			assert.Equal(t, tt.code, status.Code(err))
+			if tt.code == codes.OK {
+				assert.Equal(t, "abort", template.Id)
+			}
+		})
+	}
+}
+
+func TestExperimentTemplateRender(t *testing.T) {
+	template, err := NewExperimentTemplate(testTemplateRequest(t, testTemplateConfig, testTemplateParameters()...))
+	assert.NoError(t, err)
+
+	config, err := template.Render(map[string]*structpb.Value{
+		"upstream":   structpb.NewStringValue("users"),
+		"percentage": structpb.NewNumberValue(5),
+		"status":     structpb.NewNumberValue(503),
+	})
+	assert.NoError(t, err)
+
+	httpConfig := &serverexperimentationv1.HTTPFaultConfig{}
+	assert.NoError(t, config.UnmarshalTo(httpConfig))
+	assert.Equal(t, uint32(5), httpConfig.GetAbortFault().GetPercentage().GetPercentage())
+	assert.Equal(t, uint32(503), httpConfig.GetAbortFault().GetAbortStatus().GetHttpStatusCode())
+	assert.Equal(t, "users", httpConfig.GetFaultTargeting().GetUpstreamEnforcing().GetUpstreamCluster().GetName())
+	assert.Equal(t, "users-client", httpConfig.GetFaultTargeting().GetUpstreamEnforcing().GetDownstreamCluster().GetName())
+
+	tests := []struct {
+		id     string
+		values map[string]*structpb.Value
+	}{
+		{
+			id:     "missing value",
+			values: map[string]*structpb.Value{"upstream": structpb.NewStringValue("users"), "percentage": structpb.NewNumberValue(5)},
+		},
+		{
+			id: "unknown parameter",
+			values: map[string]*structpb.Value{
+				"upstream":   structpb.NewStringValue("users"),
+				"percentage": structpb.NewNumberValue(5),
+				"status":     structpb.NewNumberValue(503),
+				"downstream": structpb.NewStringValue("api"),
+			},
+		},
+		{
+			id: "wrong type",
+			values: map[string]*structpb.Value{
+				"upstream":   structpb.NewStringValue("users"),
+				"percentage": structpb.NewStringValue("5"),
+				"status":     structpb.NewNumberValue(503),
+			},
+		},
+		{
+			id: "out of bounds",
+			values: map[string]*structpb.Value{
+				"upstream":   structpb.NewStringValue("users"),
+				"percentage": structpb.NewNumberValue(50),
+				"status":     structpb.NewNumberValue(503),
+			},
+		},
+		{
+			id: "not a valid field value",
+			values: map[string]*structpb.Value{
+				"upstream":   structpb.NewStringValue("users"),
+				"percentage": structpb.NewNumberValue(5.5),
+				"status":     structpb.NewNumberValue(503),
+			},
+		},
+		{
+			id: "fails proto validation",
+			values: map[string]*structpb.Value{
+				"upstream":   structpb.NewStringValue("users"),
+				"percentage": structpb.NewNumberValue(5),
+				"status":     structpb.NewNumberValue(700),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.id, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := template.Render(tt.values)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestCreateExperimentTemplate(t *testing.T) {
+	template, err := NewExperimentTemplate(testTemplateRequest(t, testTemplateConfig, testTemplateParameters()...))
+	assert.NoError(t, err)
+
+	db, mock, err := sqlmock.New()
+	assert.NoError(t, err)
+	defer db.Close()
+	es := &storer{db: db, logger: zap.NewNop().Sugar(), configDeserializationErrorCount: tally.NoopScope.Counter("")}
+
+	config, err := protojson.Marshal(template.Config)
+	assert.NoError(t, err)
+	parameters, err := marshalTemplateParameters(template.Parameters)
+	assert.NoError(t, err)
+
+	insertSql := `INSERT INTO experiment_template (id, description, config, parameters, creation_time)`
+	columns := []string{"id", "description", "config", "parameters", "creation_time"}
+
+	mock.ExpectExec(regexp.QuoteMeta(insertSql)).
+		WithArgs("abort", "", string(config), parameters).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM experiment_template WHERE id = $1`)).
+		WithArgs("abort").
+		WillReturnRows(sqlmock.NewRows(columns).AddRow("abort", "", string(config), parameters, time.Now()))
+
+	created, err := es.CreateExperimentTemplate(context.Background(), template)
+	assert.NoError(t, err)
+	assert.Equal(t, "abort", created.Id)
+	assert.Len(t, created.Parameters, 3)
+	assert.Equal(t, 10.0, created.Parameters[1].GetMax().GetValue())
+	assert.Equal(t, "type.googleapis.com/clutch.chaos.serverexperimentation.v1.HTTPFaultConfig", created.Config.Fields["@type"].GetStringValue())
+
+	mock.ExpectExec(regexp.QuoteMeta(insertSql)).WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = es.CreateExperimentTemplate(context.Background(), template)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	mock.ExpectQuery(regexp.QuoteMeta(`FROM experiment_template WHERE id = $1`)).
+		WithArgs("missing").
+		WillReturnRows(sqlmock.NewRows(columns))
+	_, err = es.GetExperimentTemplate(context.Background(), "missing")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,6 +1,8 @@
 package experimentstore
 
 import (
+	"time"
+
 	"go.uber.org/zap"
 
 	experimentation "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
@@ -10,6 +12,12 @@ type Transformation struct {
 	ConfigTypeUrl   string
 	ConfigTransform func(config *ExperimentConfig) ([]*experimentation.Property, error)
 	RunTransform    func(run *ExperimentRun, config *ExperimentConfig) ([]*experimentation.Property, error)
+	// TargetTransform returns the target of an experiment, which is checked against the guardrails of the store.
+	TargetTransform func(config *ExperimentConfig) (*ExperimentTarget, error)
+	// ConfigValidation returns an error if the experiment can't be run as configured, e.g. because its fault can't be
+	// injected by the mechanism its type is injected with. Experiments with invalid configs are rejected on creation.
+	// The end time of the runs of the config is nil if they run until they are cancelled.
+	ConfigValidation func(config *ExperimentConfig, endTime *time.Time) error
 }
 
 type Transformer struct {
@@ -52,3 +60,36 @@ func (tr *Transformer) CreateProperties(run *ExperimentRun, config *ExperimentCo
 
 	return properties, nil
 }
+
+// CreateTarget returns the target of the experiment, or nil if no transformation of its type describes its target.
+func (tr *Transformer) CreateTarget(config *ExperimentConfig) (*ExperimentTarget, error) {
+	for _, t := range tr.nameToTransformMap[config.Config.TypeUrl] {
+		if t.TargetTransform == nil {
+			continue
+		}
+
+		target, err := t.TargetTransform(config)
+		if err != nil {
+			tr.logger.Errorw("error while creating target from config", "error", err, "config", config)
+			return nil, err
+		}
+		return target, nil
+	}
+
+	return nil, nil
+}
+
+// ValidateConfig returns the first error of the transformations of the experiment's type that validate configs.
+func (tr *Transformer) ValidateConfig(config *ExperimentConfig, endTime *time.Time) error {
+	for _, t := range tr.nameToTransformMap[config.Config.TypeUrl] {
+		if t.ConfigValidation == nil {
+			continue
+		}
+
+		if err := t.ConfigValidation(config, endTime); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,7 +1,9 @@
 package experimentstore
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/stretchr/testify/assert"
@@ -118,3 +120,22 @@ func TestMatchingMultipleRegisteredRunConfigTransforms(t *testing.T) {
 	assert.Equal(t, 2, len(properties))
 	assert.Equal(t, []*experimentation.Property{expectedProperty1, expectedProperty2}, properties)
 }
+
+func TestValidateConfig(t *testing.T) {
+	transformer := NewTransformer(zaptest.NewLogger(t).Sugar())
+	assert.NoError(t, transformer.Register(Transformation{ConfigTypeUrl: "foo"}))
+	assert.NoError(t, transformer.Register(Transformation{
+		ConfigTypeUrl: "foo",
+		ConfigValidation: func(config *ExperimentConfig, _ *time.Time) error {
+			if config.Id == "invalid" {
+				return errors.New("invalid config")
+			}
+			return nil
+		},
+	}))
+
+	assert.NoError(t, transformer.ValidateConfig(&ExperimentConfig{Id: "valid", Config: &any.Any{TypeUrl: "foo"}}, nil))
+	assert.EqualError(t, transformer.ValidateConfig(&ExperimentConfig{Id: "invalid", Config: &any.Any{TypeUrl: "foo"}}, nil), "invalid config")
+	// Configs of types without validations are valid.
+	assert.NoError(t, transformer.ValidateConfig(&ExperimentConfig{Id: "invalid", Config: &any.Any{TypeUrl: "bar"}}, nil))
+}
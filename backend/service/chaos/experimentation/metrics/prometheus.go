@@ -0,0 +1,129 @@
+package metrics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Sample is a sample of the result of an instant query, along with the labels of its series.
+type Sample struct {
+	Labels map[string]string
+	Value  float64
+}
+
+// Client queries a Prometheus-compatible API.
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+}
+
+// NewClient returns a client of the API at the given base URL, e.g. http://prometheus:9090.
+func NewClient(endpoint string) *Client {
+	return &Client{
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+		httpClient: &http.Client{},
+	}
+}
+
+// The response of the instant query API: https://prometheus.io/docs/prometheus/latest/querying/api/#instant-queries
+type queryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+// Query evaluates an instant query at the given time, or at the current time if it's zero.
+func (c *Client) Query(ctx context.Context, query string, at time.Time) ([]*Sample, error) {
+	values := url.Values{}
+	values.Set("query", query)
+	if !at.IsZero() {
+		values.Set("time", strconv.FormatFloat(float64(at.UnixMilli())/1000, 'f', 3, 64))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint+"/api/v1/query", strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	qr := &queryResponse{}
+	if err := json.Unmarshal(body, qr); err != nil {
+		return nil, fmt.Errorf("received invalid query response with status '%d %s': %w", resp.StatusCode, http.StatusText(resp.StatusCode), err)
+	}
+	if qr.Status != "success" {
+		return nil, fmt.Errorf("query failed with '%s': %s", qr.ErrorType, qr.Error)
+	}
+
+	return samplesFromQueryResult(qr.Data.ResultType, qr.Data.Result)
+}
+
+// Parses the samples of vector and scalar results, other result types have no single value per series.
+func samplesFromQueryResult(resultType string, result json.RawMessage) ([]*Sample, error) {
+	switch resultType {
+	case "vector":
+		var vector []struct {
+			Metric map[string]string `json:"metric"`
+			Value  []interface{}     `json:"value"`
+		}
+		if err := json.Unmarshal(result, &vector); err != nil {
+			return nil, err
+		}
+
+		ret := make([]*Sample, 0, len(vector))
+		for _, v := range vector {
+			value, err := sampleValue(v.Value)
+			if err != nil {
+				return nil, err
+			}
+			ret = append(ret, &Sample{Labels: v.Metric, Value: value})
+		}
+		return ret, nil
+	case "scalar":
+		var scalar []interface{}
+		if err := json.Unmarshal(result, &scalar); err != nil {
+			return nil, err
+		}
+
+		value, err := sampleValue(scalar)
+		if err != nil {
+			return nil, err
+		}
+		return []*Sample{{Value: value}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported query result type '%s', the query must return a vector or scalar", resultType)
+	}
+}
+
+// Samples are a pair of the timestamp and the value as a string.
+func sampleValue(sample []interface{}) (float64, error) {
+	if len(sample) != 2 {
+		return 0, fmt.Errorf("invalid sample '%v'", sample)
+	}
+	s, ok := sample[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid sample value '%v'", sample[1])
+	}
+	return strconv.ParseFloat(s, 64)
+}
@@ -0,0 +1,199 @@
+package scheduler
+
+// <!-- START clutchdoc -->
+// description: Chaos Experimentation Framework - Creates the runs of scheduled and recurring experiments
+// <!-- END clutchdoc -->
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	schedulerv1 "github.com/lyft/clutch/backend/api/config/service/chaos/experimentation/scheduler/v1"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+)
+
+const Name = "clutch.service.chaos.experimentation.scheduler"
+
+const (
+	schedulerLockId = "chaos:experimentation:scheduler"
+
+	defaultInterval = time.Minute
+)
+
+func New(cfg *anypb.Any, logger *zap.Logger, scope tally.Scope) (service.Service, error) {
+	s, err := NewScheduler(cfg, logger, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	go s.Run(context.Background())
+	return s, nil
+}
+
+func NewScheduler(cfg *anypb.Any, logger *zap.Logger, scope tally.Scope) (*Scheduler, error) {
+	typedConfig := &schedulerv1.Config{}
+	if cfg != nil {
+		if err := cfg.UnmarshalTo(typedConfig); err != nil {
+			return nil, err
+		}
+	}
+
+	interval := defaultInterval
+	if typedConfig.Interval != nil {
+		interval = typedConfig.Interval.AsDuration()
+	}
+
+	store, ok := service.Registry[experimentstore.Name]
+	if !ok {
+		return nil, errors.New("could not find experiment store service")
+	}
+
+	storer, ok := store.(experimentstore.Storer)
+	if !ok {
+		return nil, errors.New("service was not the correct type")
+	}
+
+	scheduleStorer, ok := store.(experimentstore.ScheduleStorer)
+	if !ok {
+		return nil, errors.New("experiment store does not support schedules")
+	}
+
+	return &Scheduler{
+		store:                 storer,
+		scheduleStore:         scheduleStorer,
+		interval:              interval,
+		log:                   logger.Sugar(),
+		runsCreated:           scope.Counter("runs_created"),
+		occurrencesMissed:     scope.Counter("occurrences_missed"),
+		occurrencesInBlackout: scope.Counter("occurrences_in_blackout"),
+		occurrencesRejected:   scope.Counter("occurrences_rejected"),
+		errors:                scope.Counter("errors"),
+	}, nil
+}
+
+type Scheduler struct {
+	store         experimentstore.Storer
+	scheduleStore experimentstore.ScheduleStorer
+
+	interval time.Duration
+
+	log *zap.SugaredLogger
+
+	runsCreated           tally.Counter
+	occurrencesMissed     tally.Counter
+	occurrencesInBlackout tally.Counter
+	occurrencesRejected   tally.Counter
+	errors                tally.Counter
+}
+
+// Run checks the schedules for due occurrences at every interval until the context is done. Gateway instances share an
+// advisory lock so that only one of them creates runs at a time.
+func (s *Scheduler) Run(ctx context.Context) {
+	lockId := experimentstore.ConvertLockToUint32(schedulerLockId)
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			tickCtx, cancel := context.WithTimeout(ctx, s.interval)
+
+			locked, err := s.scheduleStore.AttemptLock(tickCtx, lockId)
+			if err != nil {
+				s.log.Errorw("failed to acquire the scheduler lock", "err", err)
+			}
+
+			if locked {
+				s.CreateDueRuns(tickCtx, time.Now())
+
+				if _, err := s.scheduleStore.ReleaseLock(tickCtx, lockId); err != nil {
+					s.log.Errorw("failed to release the scheduler lock", "err", err)
+				}
+			}
+
+			cancel()
+		}
+	}
+}
+
+// CreateDueRuns creates the runs of the occurrences of all schedules that are due at the given time.
+func (s *Scheduler) CreateDueRuns(ctx context.Context, now time.Time) {
+	schedules, err := s.scheduleStore.GetExperimentSchedules(ctx)
+	if err != nil {
+		s.errors.Inc(1)
+		s.log.Errorw("failed to retrieve experiment schedules", "err", err)
+		return
+	}
+
+	for _, schedule := range schedules {
+		if schedule.Paused {
+			continue
+		}
+
+		if err := s.createDueRun(ctx, schedule, now); err != nil {
+			s.errors.Inc(1)
+			s.log.Errorw("failed to create the run of an experiment schedule", "scheduleId", schedule.Id, "err", err)
+		}
+	}
+}
+
+func (s *Scheduler) createDueRun(ctx context.Context, schedule *experimentstore.ExperimentSchedule, now time.Time) error {
+	occurrence, due, err := schedule.DueOccurrence(now)
+	if err != nil || !due {
+		return err
+	}
+
+	end := occurrence.Add(schedule.Duration)
+	if !end.After(now) {
+		// The whole occurrence passed before it was picked up, e.g. because no gateway was running.
+		s.occurrencesMissed.Inc(1)
+		s.log.Warnw("skipping missed occurrence of experiment schedule", "scheduleId", schedule.Id, "occurrence", occurrence)
+		return s.scheduleStore.SetExperimentScheduleLastOccurrence(ctx, schedule.Id, occurrence, "")
+	}
+
+	if window := schedule.OverlappingBlackoutWindow(occurrence, end); window != nil {
+		s.occurrencesInBlackout.Inc(1)
+		s.log.Infow("skipping occurrence of experiment schedule during blackout window", "scheduleId", schedule.Id,
+			"occurrence", occurrence, "reason", window.Reason)
+		return s.scheduleStore.SetExperimentScheduleLastOccurrence(ctx, schedule.Id, occurrence, "")
+	}
+
+	// The run ID is derived from the occurrence, so a run is created at most once even if recording the occurrence fails.
+	spec, err := experimentstore.NewExperimentSpecification(&experimentationv1.CreateExperimentData{
+		RunId:   schedule.RunId(occurrence),
+		Config:  schedule.Config,
+		EndTime: timestamppb.New(end),
+	}, now)
+	if err != nil {
+		return err
+	}
+
+	result, err := s.store.CreateOrGetExperiment(ctx, spec)
+	if status.Code(err) == codes.FailedPrecondition {
+		// The run violates the guardrails of the store, which will not change for this occurrence in most cases.
+		s.occurrencesRejected.Inc(1)
+		s.log.Warnw("skipping occurrence of experiment schedule rejected by the experiment store", "scheduleId", schedule.Id,
+			"occurrence", occurrence, "err", err)
+		return s.scheduleStore.SetExperimentScheduleLastOccurrence(ctx, schedule.Id, occurrence, "")
+	}
+	if err != nil {
+		return err
+	}
+	if result.Origin == experimentationv1.CreateOrGetExperimentResponse_ORIGIN_NEW {
+		s.runsCreated.Inc(1)
+	}
+
+	return s.scheduleStore.SetExperimentScheduleLastOccurrence(ctx, schedule.Id, occurrence, result.Experiment.Run.Id)
+}
@@ -0,0 +1,112 @@
+package scheduler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/uber-go/tally/v4"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	experimentationv1 "github.com/lyft/clutch/backend/api/chaos/experimentation/v1"
+	serverexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/serverexperimentation/v1"
+	schedulerv1 "github.com/lyft/clutch/backend/api/config/service/chaos/experimentation/scheduler/v1"
+	"github.com/lyft/clutch/backend/mock/service/chaos/experimentation/experimentstoremock"
+	"github.com/lyft/clutch/backend/service"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+)
+
+func TestConfigLoad(t *testing.T) {
+	service.Registry[experimentstore.Name] = &experimentstoremock.SimpleStorer{}
+
+	s, err := NewScheduler(nil, zap.NewNop(), tally.NoopScope)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Minute, s.interval)
+
+	cfg, err := anypb.New(&schedulerv1.Config{Interval: durationpb.New(10 * time.Second)})
+	assert.NoError(t, err)
+	s, err = NewScheduler(cfg, zap.NewNop(), tally.NoopScope)
+	assert.NoError(t, err)
+	assert.Equal(t, 10*time.Second, s.interval)
+
+	// The store must support schedules.
+	service.Registry[experimentstore.Name] = &experimentstoremock.MockStorer{}
+	_, err = NewScheduler(nil, zap.NewNop(), tally.NoopScope)
+	assert.EqualError(t, err, "experiment store does not support schedules")
+}
+
+func newTestSchedule(t *testing.T, id string, cron string, duration time.Duration, created time.Time) *experimentstore.ExperimentSchedule {
+	config, err := anypb.New(&serverexperimentationv1.HTTPFaultConfig{})
+	assert.NoError(t, err)
+
+	s, err := experimentstore.NewExperimentSchedule(&experimentationv1.CreateExperimentScheduleRequest{
+		Id:       id,
+		Config:   config,
+		Cron:     cron,
+		Duration: durationpb.New(duration),
+	})
+	assert.NoError(t, err)
+	s.CreationTime = created
+	return s
+}
+
+func TestCreateDueRuns(t *testing.T) {
+	created := time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2021, 12, 24, 10, 5, 0, 0, time.UTC)
+
+	store := &experimentstoremock.SimpleStorer{}
+	testScope := tally.NewTestScope("", nil)
+	s := &Scheduler{
+		store:                 store,
+		scheduleStore:         store,
+		log:                   zap.NewNop().Sugar(),
+		runsCreated:           testScope.Counter("runs_created"),
+		occurrencesMissed:     testScope.Counter("occurrences_missed"),
+		occurrencesInBlackout: testScope.Counter("occurrences_in_blackout"),
+		occurrencesRejected:   testScope.Counter("occurrences_rejected"),
+		errors:                testScope.Counter("errors"),
+	}
+
+	due := newTestSchedule(t, "due", "0 10 * * *", time.Hour, created)
+	missed := newTestSchedule(t, "missed", "0 8 * * *", time.Hour, created)
+	paused := newTestSchedule(t, "paused", "0 10 * * *", time.Hour, created)
+	paused.Paused = true
+	blackout := newTestSchedule(t, "blackout", "0 10 * * *", time.Hour, created)
+	blackout.BlackoutWindows = []*experimentstore.BlackoutWindow{
+		{StartTime: time.Date(2021, 12, 24, 0, 0, 0, 0, time.UTC), EndTime: time.Date(2021, 12, 27, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, schedule := range []*experimentstore.ExperimentSchedule{due, missed, paused, blackout} {
+		_, err := store.CreateExperimentSchedule(context.Background(), schedule)
+		assert.NoError(t, err)
+	}
+
+	s.CreateDueRuns(context.Background(), now)
+
+	experiments, err := store.GetExperiments(context.Background(), "", experimentationv1.GetExperimentsRequest_STATUS_UNSPECIFIED)
+	assert.NoError(t, err)
+	assert.Len(t, experiments, 1)
+	assert.Equal(t, time.Date(2021, 12, 24, 11, 0, 0, 0, time.UTC), *experiments[0].Run.EndTime)
+
+	occurrence := time.Date(2021, 12, 24, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, occurrence, *due.LastOccurrenceTime)
+	assert.Equal(t, experiments[0].Run.Id, due.LastRunId)
+	assert.Equal(t, time.Date(2021, 12, 24, 8, 0, 0, 0, time.UTC), *missed.LastOccurrenceTime)
+	assert.Empty(t, missed.LastRunId)
+	assert.Nil(t, paused.LastOccurrenceTime)
+	assert.Equal(t, occurrence, *blackout.LastOccurrenceTime)
+	assert.Empty(t, blackout.LastRunId)
+
+	snapshot := testScope.Snapshot().Counters()
+	assert.Equal(t, int64(1), snapshot["runs_created+"].Value())
+	assert.Equal(t, int64(1), snapshot["occurrences_missed+"].Value())
+	assert.Equal(t, int64(1), snapshot["occurrences_in_blackout+"].Value())
+
+	// Occurrences are handled once.
+	s.CreateDueRuns(context.Background(), now.Add(time.Minute))
+	experiments, err = store.GetExperiments(context.Background(), "", experimentationv1.GetExperimentsRequest_STATUS_UNSPECIFIED)
+	assert.NoError(t, err)
+	assert.Len(t, experiments, 1)
+}
@@ -0,0 +1,185 @@
+package terminator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	terminatorv1 "github.com/lyft/clutch/backend/api/config/service/chaos/experimentation/terminator/v1"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/metrics"
+)
+
+const defaultMetricQueryTimeout = 10 * time.Second
+
+type metricThresholdTerminationCriterion struct {
+	client      *metrics.Client
+	query       *template.Template
+	description string
+	comparison  terminatorv1.MetricThresholdTerminationCriterion_Comparison
+	threshold   float64
+	window      time.Duration
+	timeout     time.Duration
+}
+
+// The fields available to the query template.
+type metricQueryData struct {
+	RunID  string
+	Window string
+	Config map[string]interface{}
+}
+
+func (m *metricThresholdTerminationCriterion) ShouldTerminate(experiment *experimentstore.Experiment) (string, error) {
+	query, err := m.renderQuery(experiment)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.timeout)
+	defer cancel()
+
+	samples, err := m.client.Query(ctx, query, time.Time{})
+	if err != nil {
+		return "", err
+	}
+
+	// A query without results, e.g. because there was no traffic, never terminates the experiment.
+	for _, s := range samples {
+		if !m.breached(s.Value) {
+			continue
+		}
+
+		description := m.description
+		if description == "" {
+			description = query
+		}
+		return fmt.Sprintf("%s%s was %s, %s the threshold of %s over %s",
+			description, formatLabels(s.Labels), formatFloat(s.Value), m.comparisonString(), formatFloat(m.threshold), m.window), nil
+	}
+
+	return "", nil
+}
+
+func (m *metricThresholdTerminationCriterion) breached(value float64) bool {
+	switch m.comparison {
+	case terminatorv1.MetricThresholdTerminationCriterion_BELOW:
+		return value < m.threshold
+	case terminatorv1.MetricThresholdTerminationCriterion_ABOVE:
+		return value > m.threshold
+	default:
+		return false
+	}
+}
+
+func (m *metricThresholdTerminationCriterion) comparisonString() string {
+	if m.comparison == terminatorv1.MetricThresholdTerminationCriterion_ABOVE {
+		return "above"
+	}
+	return "below"
+}
+
+func (m *metricThresholdTerminationCriterion) renderQuery(experiment *experimentstore.Experiment) (string, error) {
+	config, err := experimentConfigAsMap(experiment.Config)
+	if err != nil {
+		return "", err
+	}
+
+	data := &metricQueryData{
+		RunID:  experiment.Run.Id,
+		Window: fmt.Sprintf("%ds", int64(m.window.Seconds())),
+		Config: config,
+	}
+
+	var b bytes.Buffer
+	if err := m.query.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("failed to render query: %w", err)
+	}
+	return b.String(), nil
+}
+
+func experimentConfigAsMap(config *experimentstore.ExperimentConfig) (map[string]interface{}, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	message := config.Message
+	if message == nil && config.Config != nil {
+		var err error
+		message, err = config.Config.UnmarshalNew()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if message == nil {
+		return nil, nil
+	}
+
+	b, err := protojson.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret map[string]interface{}
+	if err := json.Unmarshal(b, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	pairs := make([]string, 0, len(labels))
+	for k, v := range labels {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", k, v))
+	}
+	sort.Strings(pairs)
+	return "{" + strings.Join(pairs, ", ") + "}"
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', 4, 64)
+}
+
+type metricThresholdTerminationFactory struct{}
+
+func (metricThresholdTerminationFactory) Create(cfg *any.Any) (TerminationCriterion, error) {
+	typedConfig := &terminatorv1.MetricThresholdTerminationCriterion{}
+	if err := cfg.UnmarshalTo(typedConfig); err != nil {
+		return nil, err
+	}
+	if err := typedConfig.Validate(); err != nil {
+		return nil, err
+	}
+
+	query, err := template.New("query").Option("missingkey=error").Parse(typedConfig.Query)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query template: %w", err)
+	}
+
+	timeout := defaultMetricQueryTimeout
+	if typedConfig.Timeout != nil {
+		timeout = typedConfig.Timeout.AsDuration()
+	}
+
+	return &metricThresholdTerminationCriterion{
+		client:      metrics.NewClient(typedConfig.Endpoint),
+		query:       query,
+		description: typedConfig.Description,
+		comparison:  typedConfig.Comparison,
+		threshold:   typedConfig.Threshold,
+		window:      typedConfig.Window.AsDuration(),
+		timeout:     timeout,
+	}, nil
+}
@@ -0,0 +1,147 @@
+package terminator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	serverexperimentationv1 "github.com/lyft/clutch/backend/api/chaos/serverexperimentation/v1"
+	terminatorv1 "github.com/lyft/clutch/backend/api/config/service/chaos/experimentation/terminator/v1"
+	"github.com/lyft/clutch/backend/mock/prometheusmock"
+	"github.com/lyft/clutch/backend/service/chaos/experimentation/experimentstore"
+)
+
+const (
+	testSuccessRateQuery    = `success_rate{cluster="{{.Config.faultTargeting.upstreamEnforcing.downstreamCluster.name}}"}[{{.Window}}]`
+	testRenderedSuccessRate = `success_rate{cluster="downstream"}[300s]`
+)
+
+func newTestMetricCriterion(t *testing.T, cfg *terminatorv1.MetricThresholdTerminationCriterion) TerminationCriterion {
+	a, err := anypb.New(cfg)
+	assert.NoError(t, err)
+
+	c, err := CriterionFactories[a.TypeUrl].Create(a)
+	assert.NoError(t, err)
+	return c
+}
+
+func newTestMetricExperiment() *experimentstore.Experiment {
+	return &experimentstore.Experiment{
+		Run: &experimentstore.ExperimentRun{Id: "1", StartTime: time.Now()},
+		Config: &experimentstore.ExperimentConfig{
+			Id: "2",
+			Message: &serverexperimentationv1.HTTPFaultConfig{
+				FaultTargeting: &serverexperimentationv1.FaultTargeting{
+					Enforcer: &serverexperimentationv1.FaultTargeting_UpstreamEnforcing{
+						UpstreamEnforcing: &serverexperimentationv1.UpstreamEnforcing{
+							DownstreamType: &serverexperimentationv1.UpstreamEnforcing_DownstreamCluster{
+								DownstreamCluster: &serverexperimentationv1.SingleCluster{Name: "downstream"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestMetricThresholdCriterion(t *testing.T) {
+	server := prometheusmock.NewServer()
+	defer server.Close()
+
+	c := newTestMetricCriterion(t, &terminatorv1.MetricThresholdTerminationCriterion{
+		Endpoint:    server.URL,
+		Query:       testSuccessRateQuery,
+		Description: "downstream success rate",
+		Comparison:  terminatorv1.MetricThresholdTerminationCriterion_BELOW,
+		Threshold:   0.95,
+		Window:      durationpb.New(5 * time.Minute),
+	})
+	e := newTestMetricExperiment()
+
+	// No data.
+	reason, err := c.ShouldTerminate(e)
+	assert.NoError(t, err)
+	assert.Empty(t, reason)
+	assert.Equal(t, []string{testRenderedSuccessRate}, server.Queries())
+
+	server.SetResult(testRenderedSuccessRate, prometheusmock.Sample{Value: 0.99})
+	reason, err = c.ShouldTerminate(e)
+	assert.NoError(t, err)
+	assert.Empty(t, reason)
+
+	server.SetResult(testRenderedSuccessRate,
+		prometheusmock.Sample{Labels: map[string]string{"zone": "a"}, Value: 0.99},
+		prometheusmock.Sample{Labels: map[string]string{"zone": "b"}, Value: 0.8},
+	)
+	reason, err = c.ShouldTerminate(e)
+	assert.NoError(t, err)
+	assert.Equal(t, `downstream success rate{zone="b"} was 0.8, below the threshold of 0.95 over 5m0s`, reason)
+
+	server.SetError(testRenderedSuccessRate, "parse error")
+	reason, err = c.ShouldTerminate(e)
+	assert.EqualError(t, err, "query failed with 'bad_data': parse error")
+	assert.Empty(t, reason)
+}
+
+func TestMetricThresholdCriterionAbove(t *testing.T) {
+	server := prometheusmock.NewServer()
+	defer server.Close()
+
+	c := newTestMetricCriterion(t, &terminatorv1.MetricThresholdTerminationCriterion{
+		Endpoint:   server.URL + "/",
+		Query:      `error_rate{run="{{.RunID}}"}`,
+		Comparison: terminatorv1.MetricThresholdTerminationCriterion_ABOVE,
+		Threshold:  0.1,
+		Window:     durationpb.New(time.Minute),
+	})
+
+	server.SetResult(`error_rate{run="1"}`, prometheusmock.Sample{Value: 0.5})
+	reason, err := c.ShouldTerminate(newTestMetricExperiment())
+	assert.NoError(t, err)
+	assert.Equal(t, `error_rate{run="1"} was 0.5, above the threshold of 0.1 over 1m0s`, reason)
+}
+
+func TestMetricThresholdCriterionMissingConfigField(t *testing.T) {
+	server := prometheusmock.NewServer()
+	defer server.Close()
+
+	c := newTestMetricCriterion(t, &terminatorv1.MetricThresholdTerminationCriterion{
+		Endpoint:   server.URL,
+		Query:      `up{cluster="{{.Config.nope}}"}`,
+		Comparison: terminatorv1.MetricThresholdTerminationCriterion_BELOW,
+		Window:     durationpb.New(time.Minute),
+	})
+
+	_, err := c.ShouldTerminate(newTestMetricExperiment())
+	assert.Error(t, err)
+	assert.Empty(t, server.Queries())
+}
+
+func TestMetricThresholdFactoryInvalidConfig(t *testing.T) {
+	factory := metricThresholdTerminationFactory{}
+
+	// Missing comparison.
+	a, err := anypb.New(&terminatorv1.MetricThresholdTerminationCriterion{
+		Endpoint: "http://prometheus:9090",
+		Query:    "up",
+		Window:   durationpb.New(time.Minute),
+	})
+	assert.NoError(t, err)
+	_, err = factory.Create(a)
+	assert.Error(t, err)
+
+	// Invalid template.
+	a, err = anypb.New(&terminatorv1.MetricThresholdTerminationCriterion{
+		Endpoint:   "http://prometheus:9090",
+		Query:      "up{{",
+		Comparison: terminatorv1.MetricThresholdTerminationCriterion_ABOVE,
+		Window:     durationpb.New(time.Minute),
+	})
+	assert.NoError(t, err)
+	_, err = factory.Create(a)
+	assert.Error(t, err)
+}
@@ -29,7 +29,8 @@ func TypeUrl(message proto.Message) string {
 }
 
 var CriterionFactories = map[string]CriterionFactory{
-	TypeUrl(&terminatorv1.MaxTimeTerminationCriterion{}): &maxTimeTerminationFactory{},
+	TypeUrl(&terminatorv1.MaxTimeTerminationCriterion{}):         &maxTimeTerminationFactory{},
+	TypeUrl(&terminatorv1.MetricThresholdTerminationCriterion{}): &metricThresholdTerminationFactory{},
 }
 
 type CriterionFactory interface {
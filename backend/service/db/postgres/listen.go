@@ -0,0 +1,68 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const (
+	minListenerReconnectInterval = time.Second
+	maxListenerReconnectInterval = time.Minute
+	// Idle connections are pinged to detect a broken connection that would otherwise miss notifications silently.
+	listenerPingInterval = 90 * time.Second
+)
+
+// Listener is implemented by clients that can subscribe to notifications sent with NOTIFY.
+type Listener interface {
+	// Listen subscribes to notifications on the channel using a dedicated connection until the context is done.
+	// Payloads are sent on the returned channel. An empty payload is sent after the connection was re-established,
+	// since notifications may have been missed while it was down.
+	Listen(ctx context.Context, channel string) (<-chan string, error)
+}
+
+func (c *client) Listen(ctx context.Context, channel string) (<-chan string, error) {
+	listener := pq.NewListener(c.connection, minListenerReconnectInterval, maxListenerReconnectInterval, func(event pq.ListenerEventType, err error) {
+		if err != nil {
+			c.logger.Warn("postgres listener connection error", zap.String("channel", channel), zap.Error(err))
+		}
+	})
+	if err := listener.Listen(channel); err != nil {
+		listener.Close()
+		return nil, err
+	}
+
+	ret := make(chan string)
+	go func() {
+		defer close(ret)
+		defer listener.Close()
+
+		for {
+			var payload string
+			select {
+			case <-ctx.Done():
+				return
+			case n := <-listener.Notify:
+				// A nil notification is sent after the connection was re-established.
+				if n != nil {
+					payload = n.Extra
+				}
+			case <-time.After(listenerPingInterval):
+				go func() {
+					_ = listener.Ping()
+				}()
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case ret <- payload:
+			}
+		}
+	}()
+
+	return ret, nil
+}
@@ -25,6 +25,9 @@ type client struct {
 	sqlDB  *sql.DB
 	logger *zap.Logger
 	scope  tally.Scope
+
+	// Listeners require a dedicated connection outside of the pool.
+	connection string
 }
 
 type Client interface {
@@ -56,7 +59,7 @@ func New(cfg *any.Any, logger *zap.Logger, scope tally.Scope) (service.Service,
 		sqlDB.SetMaxIdleConns(int(pgcfg.MaxIdleConnections))
 	}
 
-	return &client{logger: logger, scope: scope, sqlDB: sqlDB}, nil
+	return &client{logger: logger, scope: scope, sqlDB: sqlDB, connection: connection}, nil
 }
 
 func connString(cfg *postgresv1.Connection) (string, error) {
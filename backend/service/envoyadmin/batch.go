@@ -0,0 +1,204 @@
+package envoyadmin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+const (
+	defaultBatchMaxConcurrency = 10
+	defaultBatchPerHostTimeout = 5 * time.Second
+
+	defaultCertExpiryWindow = 30 * 24 * time.Hour
+)
+
+// BatchOptions bound the load a batch read puts on the gateway and how long it waits for each host.
+type BatchOptions struct {
+	// Defaults to 10 if unset.
+	MaxConcurrency int
+	// Defaults to 5 seconds if unset.
+	PerHostTimeout time.Duration
+}
+
+func (c *client) BatchGet(ctx context.Context, addresses []*envoytriagev1.Address, include *envoytriagev1.ReadOperation_Include, opts BatchOptions) ([]*envoytriagev1.Result, []*envoytriagev1.BatchReadResponse_Failure) {
+	if include == nil {
+		include = &envoytriagev1.ReadOperation_Include{}
+	}
+	if opts.MaxConcurrency <= 0 {
+		opts.MaxConcurrency = defaultBatchMaxConcurrency
+	}
+	if opts.PerHostTimeout <= 0 {
+		opts.PerHostTimeout = defaultBatchPerHostTimeout
+	}
+
+	results := make([]*envoytriagev1.Result, len(addresses))
+	errs := make([]error, len(addresses))
+
+	// Failures are collected per host rather than failing the batch, so the group never returns an error.
+	var g errgroup.Group
+	g.SetLimit(opts.MaxConcurrency)
+	for idx, address := range addresses {
+		idx, address := idx, address
+		g.Go(func() error {
+			ctx, cancel := context.WithTimeout(ctx, opts.PerHostTimeout)
+			defer cancel()
+
+			results[idx], errs[idx] = c.Get(ctx, &envoytriagev1.ReadOperation{Address: address, Include: include})
+			return nil
+		})
+	}
+	_ = g.Wait()
+
+	var ret []*envoytriagev1.Result
+	var failures []*envoytriagev1.BatchReadResponse_Failure
+	for idx, err := range errs {
+		if err != nil {
+			address := c.normalizeAddress(addresses[idx])
+			failures = append(failures, &envoytriagev1.BatchReadResponse_Failure{Address: address, Error: err.Error()})
+			continue
+		}
+		ret = append(ret, results[idx])
+	}
+	return ret, failures
+}
+
+// Aggregate summarizes the outputs of many hosts: the health of upstream hosts per cluster, outlier detection stats
+// summed across hosts, the hosts whose config differs from the majority, and the certificates expiring within the
+// window, which defaults to 30 days if unset.
+func Aggregate(results []*envoytriagev1.Result, certExpiryWindow time.Duration) *envoytriagev1.Aggregate {
+	ret := &envoytriagev1.Aggregate{}
+
+	if certExpiryWindow <= 0 {
+		certExpiryWindow = defaultCertExpiryWindow
+	}
+	expiryDeadline := time.Now().Add(certExpiryWindow)
+
+	upstreams := make(map[string]*envoytriagev1.Aggregate_UpstreamHealth)
+	outlierStats := make(map[string]uint64)
+	var hashes []*envoytriagev1.Aggregate_ConfigDrift_Host
+
+	for _, result := range results {
+		output := result.GetOutput()
+
+		for _, cluster := range output.GetClusters().GetClusterStatuses() {
+			upstream, ok := upstreams[cluster.Name]
+			if !ok {
+				upstream = &envoytriagev1.Aggregate_UpstreamHealth{Name: cluster.Name}
+				upstreams[cluster.Name] = upstream
+			}
+			upstream.ReportingHosts++
+			for _, host := range cluster.HostStatuses {
+				if host.Healthy {
+					upstream.Healthy++
+				} else {
+					upstream.Unhealthy++
+				}
+			}
+		}
+
+		for _, stat := range output.GetStats().GetStats() {
+			if isOutlierDetectionStat(stat.Key) {
+				outlierStats[stat.Key] += stat.Value
+			}
+		}
+
+		if output.GetConfigDump().GetValue() != nil {
+			hashes = append(hashes, &envoytriagev1.Aggregate_ConfigDrift_Host{
+				Address: result.Address,
+				Hash:    configDumpHash(output.ConfigDump.Value),
+			})
+		}
+
+		for _, cert := range output.GetCertificates().GetCertificates() {
+			if certExpiresBefore(cert, expiryDeadline) {
+				ret.ExpiringCertificates = append(ret.ExpiringCertificates, &envoytriagev1.Aggregate_ExpiringCertificate{
+					Address:     result.Address,
+					Certificate: cert,
+				})
+			}
+		}
+	}
+
+	for _, upstream := range upstreams {
+		ret.UpstreamHealth = append(ret.UpstreamHealth, upstream)
+	}
+	sort.Slice(ret.UpstreamHealth, func(i, j int) bool {
+		return ret.UpstreamHealth[i].Name < ret.UpstreamHealth[j].Name
+	})
+
+	for key, value := range outlierStats {
+		ret.OutlierStats = append(ret.OutlierStats, &envoytriagev1.Stats_Stat{Key: key, Value: value})
+	}
+	sort.Slice(ret.OutlierStats, func(i, j int) bool {
+		return ret.OutlierStats[i].Key < ret.OutlierStats[j].Key
+	})
+
+	if len(hashes) > 0 {
+		ret.ConfigDrift = configDrift(hashes)
+	}
+
+	sort.SliceStable(ret.ExpiringCertificates, func(i, j int) bool {
+		return certExpiration(ret.ExpiringCertificates[i].Certificate).Before(certExpiration(ret.ExpiringCertificates[j].Certificate))
+	})
+
+	return ret
+}
+
+// Outlier detection stats are emitted per upstream cluster, e.g. cluster.my-upstream.outlier_detection.ejections_active.
+func isOutlierDetectionStat(key string) bool {
+	return strings.HasPrefix(key, "cluster.") && strings.Contains(key, ".outlier_detection.")
+}
+
+// The expiration time is reported by the server, the days until expiration are used if it is missing.
+func certExpiration(cert *envoytriagev1.Certificate) time.Time {
+	if cert.ExpirationTime != nil {
+		return cert.ExpirationTime.AsTime()
+	}
+	return time.Now().Add(time.Duration(cert.DaysUntilExpiration) * 24 * time.Hour)
+}
+
+func certExpiresBefore(cert *envoytriagev1.Certificate, deadline time.Time) bool {
+	return certExpiration(cert).Before(deadline)
+}
+
+func configDrift(hashes []*envoytriagev1.Aggregate_ConfigDrift_Host) *envoytriagev1.Aggregate_ConfigDrift {
+	counts := make(map[string]uint32)
+	for _, h := range hashes {
+		counts[h.Hash]++
+	}
+
+	// Ties are broken by the hash itself so the majority is stable across calls.
+	ret := &envoytriagev1.Aggregate_ConfigDrift{}
+	for hash, count := range counts {
+		if count > ret.MajorityHosts || (count == ret.MajorityHosts && hash < ret.MajorityHash) {
+			ret.MajorityHash = hash
+			ret.MajorityHosts = count
+		}
+	}
+
+	for _, h := range hashes {
+		if h.Hash != ret.MajorityHash {
+			ret.DifferingHosts = append(ret.DifferingHosts, h)
+		}
+	}
+
+	return ret
+}
+
+// Returns a hash of the config dump that ignores fields which differ between otherwise identical hosts.
+func configDumpHash(value *structpb.Value) string {
+	// JSON encoding sorts object keys, making the hash independent of the order fields were returned in.
+	b, _ := json.Marshal(normalizeConfigDump(value.AsInterface()))
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,218 @@
+package envoyadmin
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	envoyadminv1 "github.com/lyft/clutch/backend/api/config/service/envoyadmin/v1"
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func okResponse(req *http.Request, body string) *http.Response {
+	return &http.Response{
+		Status:     "OK",
+		StatusCode: http.StatusOK,
+		Request:    req,
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestBatchGet(t *testing.T) {
+	var mu sync.Mutex
+	var active, maxActive int32
+
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		n := atomic.AddInt32(&active, 1)
+		defer atomic.AddInt32(&active, -1)
+		mu.Lock()
+		if n > maxActive {
+			maxActive = n
+		}
+		mu.Unlock()
+
+		switch req.URL.Hostname() {
+		case "10.0.0.3":
+			return nil, errors.New("connection refused")
+		case "10.0.0.4":
+			// Hangs until the per-host timeout expires.
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}
+
+		time.Sleep(10 * time.Millisecond)
+		return okResponse(req, "{}"), nil
+	})
+
+	cfg, _ := anypb.New(&envoyadminv1.Config{DefaultRemotePort: 9901})
+	svc, err := NewWithHTTPClient(cfg, nil, nil, &http.Client{Transport: transport})
+	assert.NoError(t, err)
+	c := svc.(Client)
+
+	addresses := []*envoytriagev1.Address{
+		{Host: "10.0.0.1"},
+		{Host: "10.0.0.2", Port: 8001},
+		{Host: "10.0.0.3"},
+		{Host: "10.0.0.4"},
+		{Host: "10.0.0.5"},
+		{Host: "10.0.0.6"},
+	}
+	results, failures := c.BatchGet(context.Background(), addresses, nil, BatchOptions{MaxConcurrency: 2, PerHostTimeout: 50 * time.Millisecond})
+
+	assert.Len(t, results, 4)
+	assert.Equal(t, "10.0.0.1", results[0].Address.Host)
+	assert.Equal(t, uint32(9901), results[0].Address.Port)
+	assert.Equal(t, uint32(8001), results[1].Address.Port)
+
+	assert.Len(t, failures, 2)
+	assert.Equal(t, "10.0.0.3", failures[0].Address.Host)
+	assert.Equal(t, uint32(9901), failures[0].Address.Port)
+	assert.Contains(t, failures[0].Error, "connection refused")
+	assert.Equal(t, "10.0.0.4", failures[1].Address.Host)
+	assert.Contains(t, failures[1].Error, "deadline exceeded")
+
+	// Each host makes one request when nothing is included, so the number of requests in flight is bounded by the
+	// concurrency.
+	assert.LessOrEqual(t, maxActive, int32(2))
+}
+
+func mustValue(t *testing.T, json string) *structpb.Value {
+	v := &structpb.Value{}
+	assert.NoError(t, v.UnmarshalJSON([]byte(json)))
+	return v
+}
+
+func TestAggregate(t *testing.T) {
+	configA := `{"configs": [
+		{"@type": "type.googleapis.com/envoy.admin.v3.BootstrapConfigDump", "bootstrap": {"node": {"id": "%s"}, "admin": {}}},
+		{"@type": "type.googleapis.com/envoy.admin.v3.ClustersConfigDump", "version_info": "%s",
+		 "dynamic_active_clusters": [{"version_info": "%s", "cluster": {"name": "upstream"}, "last_updated": "%s"}]}
+	]}`
+	configB := `{"configs": [
+		{"@type": "type.googleapis.com/envoy.admin.v3.ClustersConfigDump",
+		 "dynamic_active_clusters": [{"cluster": {"name": "upstream", "connect_timeout": "1s"}}]}
+	]}`
+	format := func(s string, args ...string) string {
+		for _, a := range args {
+			s = strings.Replace(s, "%s", a, 1)
+		}
+		return s
+	}
+
+	results := []*envoytriagev1.Result{
+		{
+			Address: &envoytriagev1.Address{Host: "10.0.0.1", Port: 9901},
+			Output: &envoytriagev1.Result_Output{
+				Clusters: &envoytriagev1.Clusters{ClusterStatuses: []*envoytriagev1.ClusterStatus{
+					{Name: "upstream", HostStatuses: []*envoytriagev1.HostStatus{{Healthy: true}, {Healthy: false}}},
+					{Name: "other", HostStatuses: []*envoytriagev1.HostStatus{{Healthy: true}}},
+				}},
+				Stats: &envoytriagev1.Stats{Stats: []*envoytriagev1.Stats_Stat{
+					{Key: "cluster.upstream.outlier_detection.ejections_active", Value: 1},
+					{Key: "cluster.upstream.upstream_rq_total", Value: 100},
+				}},
+				ConfigDump: &envoytriagev1.ConfigDump{Value: mustValue(t, format(configA, "node-1", "1", "1", "2020-01-01T00:00:00Z"))},
+			},
+		},
+		{
+			Address: &envoytriagev1.Address{Host: "10.0.0.2", Port: 9901},
+			Output: &envoytriagev1.Result_Output{
+				Clusters: &envoytriagev1.Clusters{ClusterStatuses: []*envoytriagev1.ClusterStatus{
+					{Name: "upstream", HostStatuses: []*envoytriagev1.HostStatus{{Healthy: true}, {Healthy: true}}},
+				}},
+				Stats: &envoytriagev1.Stats{Stats: []*envoytriagev1.Stats_Stat{
+					{Key: "cluster.upstream.outlier_detection.ejections_active", Value: 2},
+					{Key: "cluster.upstream.outlier_detection.ejections_enforced_total", Value: 5},
+				}},
+				ConfigDump: &envoytriagev1.ConfigDump{Value: mustValue(t, format(configA, "node-2", "2", "2", "2021-01-01T00:00:00Z"))},
+			},
+		},
+		{
+			Address: &envoytriagev1.Address{Host: "10.0.0.3", Port: 9901},
+			Output: &envoytriagev1.Result_Output{
+				ConfigDump: &envoytriagev1.ConfigDump{Value: mustValue(t, configB)},
+			},
+		},
+	}
+
+	agg := Aggregate(results, 0)
+
+	assert.Equal(t, []*envoytriagev1.Aggregate_UpstreamHealth{
+		{Name: "other", ReportingHosts: 1, Healthy: 1},
+		{Name: "upstream", ReportingHosts: 2, Healthy: 3, Unhealthy: 1},
+	}, agg.UpstreamHealth)
+
+	assert.Equal(t, []*envoytriagev1.Stats_Stat{
+		{Key: "cluster.upstream.outlier_detection.ejections_active", Value: 3},
+		{Key: "cluster.upstream.outlier_detection.ejections_enforced_total", Value: 5},
+	}, agg.OutlierStats)
+
+	// The first two hosts only differ in node identity, versions and update times.
+	assert.Equal(t, uint32(2), agg.ConfigDrift.MajorityHosts)
+	assert.Len(t, agg.ConfigDrift.DifferingHosts, 1)
+	assert.Equal(t, "10.0.0.3", agg.ConfigDrift.DifferingHosts[0].Address.Host)
+	assert.NotEqual(t, agg.ConfigDrift.MajorityHash, agg.ConfigDrift.DifferingHosts[0].Hash)
+}
+
+func TestAggregateEmpty(t *testing.T) {
+	agg := Aggregate([]*envoytriagev1.Result{{Address: &envoytriagev1.Address{Host: "10.0.0.1"}}}, 0)
+	assert.Empty(t, agg.UpstreamHealth)
+	assert.Empty(t, agg.OutlierStats)
+	assert.Nil(t, agg.ConfigDrift)
+}
+
+func TestAggregateExpiringCertificates(t *testing.T) {
+	now := time.Now()
+	results := []*envoytriagev1.Result{
+		{
+			Address: &envoytriagev1.Address{Host: "10.0.0.1", Port: 9901},
+			Output: &envoytriagev1.Result_Output{
+				Certificates: &envoytriagev1.Certificates{Certificates: []*envoytriagev1.Certificate{
+					{SerialNumber: "a", ExpirationTime: timestamppb.New(now.Add(10 * 24 * time.Hour))},
+					{SerialNumber: "b", ExpirationTime: timestamppb.New(now.Add(90 * 24 * time.Hour))},
+				}},
+			},
+		},
+		{
+			Address: &envoytriagev1.Address{Host: "10.0.0.2", Port: 9901},
+			Output: &envoytriagev1.Result_Output{
+				Certificates: &envoytriagev1.Certificates{Certificates: []*envoytriagev1.Certificate{
+					// Already expired.
+					{SerialNumber: "c", ExpirationTime: timestamppb.New(now.Add(-time.Hour))},
+					{SerialNumber: "d", DaysUntilExpiration: 20},
+				}},
+			},
+		},
+	}
+
+	agg := Aggregate(results, 0)
+	assert.Len(t, agg.ExpiringCertificates, 3)
+	assert.Equal(t, "c", agg.ExpiringCertificates[0].Certificate.SerialNumber)
+	assert.Equal(t, "10.0.0.2", agg.ExpiringCertificates[0].Address.Host)
+	assert.Equal(t, "a", agg.ExpiringCertificates[1].Certificate.SerialNumber)
+	assert.Equal(t, "10.0.0.1", agg.ExpiringCertificates[1].Address.Host)
+	assert.Equal(t, "d", agg.ExpiringCertificates[2].Certificate.SerialNumber)
+
+	agg = Aggregate(results, 100*24*time.Hour)
+	assert.Len(t, agg.ExpiringCertificates, 4)
+
+	agg = Aggregate(results, time.Minute)
+	assert.Len(t, agg.ExpiringCertificates, 1)
+}
@@ -0,0 +1,217 @@
+package envoyadmin
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+
+	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+const inlineCertPath = "<inline>"
+
+// The certificates referenced by a TLS context, directly by file or inline, or through a secret.
+type certRefs struct {
+	paths   map[string]bool
+	serials map[string]bool
+	secrets map[string]bool
+}
+
+func newCertRefs() *certRefs {
+	return &certRefs{
+		paths:   make(map[string]bool),
+		serials: make(map[string]bool),
+		secrets: make(map[string]bool),
+	}
+}
+
+func (r *certRefs) contains(cert *envoytriagev1.Certificate) bool {
+	if cert.Path != "" && cert.Path != inlineCertPath && r.paths[cert.Path] {
+		return true
+	}
+	return r.serials[cert.SerialNumber]
+}
+
+// Returns the certificates of a /certs response along with the listeners and clusters that use them, which are found
+// in the config dump. The same certificate used by many TLS contexts is returned once.
+func certificatesFromResponse(certsResp, configDumpResp []byte) (*envoytriagev1.Certificates, error) {
+	pb := &envoy_admin_v3.Certificates{}
+	if err := unmarshal(certsResp, pb); err != nil {
+		return nil, err
+	}
+
+	var dump interface{}
+	if err := json.Unmarshal(configDumpResp, &dump); err != nil {
+		return nil, err
+	}
+	resources := resourcesFromConfigDump(dump)
+	secrets := secretsFromConfigDump(dump)
+
+	// Subjects of the certificates that are inline in the config, keyed by serial number.
+	subjects := make(map[string]string)
+
+	// Resolves the certificates a listener or cluster uses, including those of the secrets it references.
+	usage := func(resource interface{}) *certRefs {
+		refs := newCertRefs()
+		collectCertRefs(resource, refs, subjects)
+		for name := range refs.secrets {
+			collectCertRefs(secrets[name], refs, subjects)
+		}
+		return refs
+	}
+
+	listeners := make(map[string]*certRefs)
+	for name, l := range resources[envoytriagev1.ConfigDiff_LISTENER] {
+		listeners[name] = usage(l)
+	}
+	clusters := make(map[string]*certRefs)
+	for name, cl := range resources[envoytriagev1.ConfigDiff_CLUSTER] {
+		clusters[name] = usage(cl)
+	}
+	// Secrets that are not referenced by any listener or cluster can still hold the subjects of loaded certificates.
+	for _, s := range secrets {
+		collectCertRefs(s, newCertRefs(), subjects)
+	}
+
+	seen := make(map[string]bool)
+	var ret []*envoytriagev1.Certificate
+	add := func(certType envoytriagev1.Certificate_Type, details *envoy_admin_v3.CertificateDetails) {
+		cert := newProtoForCertificate(certType, details)
+		key := fmt.Sprintf("%s/%s/%s", cert.Type, cert.Path, cert.SerialNumber)
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+
+		cert.Subject = subjects[cert.SerialNumber]
+		cert.Listeners = namesUsingCert(listeners, cert)
+		cert.Clusters = namesUsingCert(clusters, cert)
+		ret = append(ret, cert)
+	}
+
+	for _, c := range pb.Certificates {
+		for _, details := range c.CaCert {
+			add(envoytriagev1.Certificate_CA, details)
+		}
+		for _, details := range c.CertChain {
+			add(envoytriagev1.Certificate_CERT_CHAIN, details)
+		}
+	}
+
+	sort.SliceStable(ret, func(i, j int) bool {
+		return ret[i].DaysUntilExpiration < ret[j].DaysUntilExpiration
+	})
+
+	return &envoytriagev1.Certificates{Certificates: ret}, nil
+}
+
+func newProtoForCertificate(certType envoytriagev1.Certificate_Type, details *envoy_admin_v3.CertificateDetails) *envoytriagev1.Certificate {
+	ret := &envoytriagev1.Certificate{
+		Type:                certType,
+		Path:                details.Path,
+		SerialNumber:        normalizeSerialNumber(details.SerialNumber),
+		DaysUntilExpiration: details.DaysUntilExpiration,
+		ValidFrom:           details.ValidFrom,
+		ExpirationTime:      details.ExpirationTime,
+	}
+
+	for _, san := range details.SubjectAltNames {
+		switch name := san.Name.(type) {
+		case *envoy_admin_v3.SubjectAlternateName_Dns:
+			ret.SubjectAltNames = append(ret.SubjectAltNames, "DNS:"+name.Dns)
+		case *envoy_admin_v3.SubjectAlternateName_Uri:
+			ret.SubjectAltNames = append(ret.SubjectAltNames, "URI:"+name.Uri)
+		case *envoy_admin_v3.SubjectAlternateName_IpAddress:
+			ret.SubjectAltNames = append(ret.SubjectAltNames, "IP:"+name.IpAddress)
+		}
+	}
+
+	return ret
+}
+
+func namesUsingCert(refsByName map[string]*certRefs, cert *envoytriagev1.Certificate) []string {
+	var ret []string
+	for name, refs := range refsByName {
+		if refs.contains(cert) {
+			ret = append(ret, name)
+		}
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// Walks a resource for the certificates of its TLS contexts. Certificate chains and trusted CAs are data sources,
+// either a file or inline PEM, and secrets are referenced by name for SDS.
+func collectCertRefs(v interface{}, refs *certRefs, subjects map[string]string) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for key, child := range t {
+			switch key {
+			case "certificate_chain", "trusted_ca":
+				collectDataSource(asMap(child), refs, subjects)
+			case "tls_certificate_sds_secret_configs":
+				for _, sds := range asSlice(child) {
+					if name, _ := asMap(sds)["name"].(string); name != "" {
+						refs.secrets[name] = true
+					}
+				}
+			case "validation_context_sds_secret_config":
+				if name, _ := asMap(child)["name"].(string); name != "" {
+					refs.secrets[name] = true
+				}
+			default:
+				collectCertRefs(child, refs, subjects)
+			}
+		}
+	case []interface{}:
+		for _, child := range t {
+			collectCertRefs(child, refs, subjects)
+		}
+	}
+}
+
+func collectDataSource(source map[string]interface{}, refs *certRefs, subjects map[string]string) {
+	if filename, _ := source["filename"].(string); filename != "" {
+		refs.paths[filename] = true
+	}
+
+	var data []byte
+	if s, ok := source["inline_string"].(string); ok {
+		data = []byte(s)
+	} else if s, ok := source["inline_bytes"].(string); ok {
+		data, _ = base64.StdEncoding.DecodeString(s)
+	}
+
+	for len(data) > 0 {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		serial := normalizeSerialNumber(cert.SerialNumber.Text(16))
+		refs.serials[serial] = true
+		subjects[serial] = cert.Subject.String()
+	}
+}
+
+func normalizeSerialNumber(serial string) string {
+	serial = strings.TrimLeft(strings.ToLower(serial), "0")
+	if serial == "" {
+		return "0"
+	}
+	return serial
+}
@@ -0,0 +1,140 @@
+package envoyadmin
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+func testCertificatePEM(t *testing.T, serial int64, commonName string) string {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: commonName, Organization: []string{"Clutch"}},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+func testCertsConfigDump(t *testing.T, serverCert string) string {
+	inlineBytes, _ := json.Marshal(base64.StdEncoding.EncodeToString([]byte(serverCert)))
+	return fmt.Sprintf(`{"configs": [
+  {"@type": "type.googleapis.com/envoy.admin.v3.ClustersConfigDump",
+   "dynamic_active_clusters": [
+     {"cluster": {"name": "upstream", "transport_socket": {"name": "envoy.transport_sockets.tls", "typed_config": {
+       "@type": "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.UpstreamTlsContext",
+       "common_tls_context": {"validation_context": {"trusted_ca": {"filename": "/etc/ssl/ca.pem"}}}}}}},
+     {"cluster": {"name": "plaintext"}}
+   ]},
+  {"@type": "type.googleapis.com/envoy.admin.v3.ListenersConfigDump",
+   "dynamic_listeners": [{"name": "ingress", "active_state": {"listener": {"name": "ingress",
+     "filter_chains": [{"transport_socket": {"name": "envoy.transport_sockets.tls", "typed_config": {
+       "@type": "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.DownstreamTlsContext",
+       "common_tls_context": {
+         "tls_certificate_sds_secret_configs": [{"name": "server_cert"}],
+         "validation_context": {"trusted_ca": {"filename": "/etc/ssl/ca.pem"}}}}}}]}}}]},
+  {"@type": "type.googleapis.com/envoy.admin.v3.SecretsConfigDump",
+   "dynamic_active_secrets": [{"name": "server_cert", "secret": {
+     "@type": "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret",
+     "tls_certificate": {"certificate_chain": {"inline_bytes": %s}, "private_key": {"inline_string": "[redacted]"}}}}]}
+]}`, inlineBytes)
+}
+
+const testCertsResponse = `{"certificates": [
+  {
+    "ca_cert": [{"path": "/etc/ssl/ca.pem", "serial_number": "00ab", "subject_alt_names": [],
+                 "days_until_expiration": "300", "valid_from": "2025-01-01T00:00:00Z", "expiration_time": "2027-08-01T00:00:00Z"}],
+    "cert_chain": [{"path": "<inline>", "serial_number": "2A",
+                    "subject_alt_names": [{"dns": "ingress.example.com"}, {"uri": "spiffe://cluster.local/ns/default/sa/ingress"}, {"ip_address": "10.0.0.1"}],
+                    "days_until_expiration": "5", "valid_from": "2026-10-01T00:00:00Z", "expiration_time": "2026-10-24T00:00:00Z"}]
+  },
+  {
+    "ca_cert": [{"path": "/etc/ssl/ca.pem", "serial_number": "00ab", "days_until_expiration": "300"}],
+    "cert_chain": []
+  }
+]}`
+
+func TestCertificatesFromResponse(t *testing.T) {
+	serverCert := testCertificatePEM(t, 42, "ingress.example.com")
+
+	certs, err := certificatesFromResponse([]byte(testCertsResponse), []byte(testCertsConfigDump(t, serverCert)))
+	assert.NoError(t, err)
+	assert.Len(t, certs.Certificates, 2)
+
+	// Ordered by days until expiration.
+	chain := certs.Certificates[0]
+	assert.Equal(t, envoytriagev1.Certificate_CERT_CHAIN, chain.Type)
+	assert.Equal(t, "<inline>", chain.Path)
+	assert.Equal(t, "2a", chain.SerialNumber)
+	assert.Equal(t, "CN=ingress.example.com,O=Clutch", chain.Subject)
+	assert.Equal(t, []string{
+		"DNS:ingress.example.com",
+		"URI:spiffe://cluster.local/ns/default/sa/ingress",
+		"IP:10.0.0.1",
+	}, chain.SubjectAltNames)
+	assert.Equal(t, uint64(5), chain.DaysUntilExpiration)
+	assert.Equal(t, time.Date(2026, 10, 24, 0, 0, 0, 0, time.UTC), chain.ExpirationTime.AsTime())
+	assert.Equal(t, []string{"ingress"}, chain.Listeners)
+	assert.Empty(t, chain.Clusters)
+
+	// The CA is used by many TLS contexts but returned once.
+	ca := certs.Certificates[1]
+	assert.Equal(t, envoytriagev1.Certificate_CA, ca.Type)
+	assert.Equal(t, "ab", ca.SerialNumber)
+	assert.Empty(t, ca.Subject)
+	assert.Equal(t, []string{"ingress"}, ca.Listeners)
+	assert.Equal(t, []string{"upstream"}, ca.Clusters)
+
+	_, err = certificatesFromResponse([]byte("not json"), []byte("{}"))
+	assert.Error(t, err)
+}
+
+func TestGetCertificates(t *testing.T) {
+	configDump := testCertsConfigDump(t, testCertificatePEM(t, 42, "ingress.example.com"))
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/certs":
+			return okResponse(req, testCertsResponse), nil
+		case "/config_dump":
+			return okResponse(req, configDump), nil
+		}
+		return okResponse(req, "{}"), nil
+	})
+	c := &client{defaultPort: 9901, httpClient: &http.Client{Transport: transport}}
+
+	result, err := c.Get(context.Background(), &envoytriagev1.ReadOperation{
+		Address: &envoytriagev1.Address{Host: "10.0.0.1"},
+		Include: &envoytriagev1.ReadOperation_Include{Certs: true},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, result.Output.Certificates.Certificates, 2)
+	// The config dump is only used to find usage and is not returned unless requested.
+	assert.Nil(t, result.Output.ConfigDump)
+}
+
+func TestNormalizeSerialNumber(t *testing.T) {
+	assert.Equal(t, "ab", normalizeSerialNumber("00AB"))
+	assert.Equal(t, "0", normalizeSerialNumber("00"))
+	assert.Equal(t, "10", normalizeSerialNumber("10"))
+}
@@ -0,0 +1,297 @@
+package envoyadmin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/structpb"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+// The maximum number of differing fields returned per resource.
+const maxConfigDiffFields = 100
+
+// Resources in a config dump by type and name.
+type configResources map[envoytriagev1.ConfigDiff_ResourceType]map[string]interface{}
+
+func (c *client) CompareConfig(ctx context.Context, base, target *envoytriagev1.ConfigSource) ([]*envoytriagev1.ConfigDiff, error) {
+	baseDump, err := c.configDumpForSource(ctx, base)
+	if err != nil {
+		return nil, err
+	}
+	targetDump, err := c.configDumpForSource(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffConfigResources(resourcesFromConfigDump(baseDump), resourcesFromConfigDump(targetDump)), nil
+}
+
+func (c *client) configDumpForSource(ctx context.Context, source *envoytriagev1.ConfigSource) (interface{}, error) {
+	var resp []byte
+	switch s := source.GetSource().(type) {
+	case *envoytriagev1.ConfigSource_Address:
+		defer c.httpClient.CloseIdleConnections()
+		_, httpClient, baseURL, err := c.resolveAddress(ctx, s.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err = makeRequest(ctx, httpClient, baseURL, "/config_dump")
+		if err != nil {
+			return nil, err
+		}
+	case *envoytriagev1.ConfigSource_SnapshotId:
+		var err error
+		resp, err = c.getConfigSnapshotDump(ctx, s.SnapshotId)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown config source type '%T'", s)
+	}
+
+	var ret interface{}
+	if err := json.Unmarshal(resp, &ret); err != nil {
+		return nil, err
+	}
+	return normalizeConfigDump(ret), nil
+}
+
+func asMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
+
+func asSlice(v interface{}) []interface{} {
+	s, _ := v.([]interface{})
+	return s
+}
+
+func resourcesFromConfigDump(dump interface{}) configResources {
+	ret := configResources{
+		envoytriagev1.ConfigDiff_LISTENER: {},
+		envoytriagev1.ConfigDiff_CLUSTER:  {},
+		envoytriagev1.ConfigDiff_ROUTE:    {},
+		envoytriagev1.ConfigDiff_SECRET:   {},
+	}
+
+	// Adds a resource by its name unless a resource of the same name was already added, e.g. active resources are
+	// added before warming ones.
+	add := func(resourceType envoytriagev1.ConfigDiff_ResourceType, name string, resource interface{}) {
+		if resource == nil {
+			return
+		}
+		if name == "" {
+			name, _ = asMap(resource)["name"].(string)
+		}
+		if _, ok := ret[resourceType][name]; !ok {
+			ret[resourceType][name] = resource
+		}
+	}
+
+	for _, config := range asSlice(asMap(dump)["configs"]) {
+		m := asMap(config)
+		typ, _ := m["@type"].(string)
+
+		switch {
+		case strings.HasSuffix(typ, ".ListenersConfigDump"):
+			for _, l := range asSlice(m["static_listeners"]) {
+				add(envoytriagev1.ConfigDiff_LISTENER, "", asMap(l)["listener"])
+			}
+			for _, l := range asSlice(m["dynamic_listeners"]) {
+				name, _ := asMap(l)["name"].(string)
+				add(envoytriagev1.ConfigDiff_LISTENER, name, asMap(asMap(l)["active_state"])["listener"])
+				add(envoytriagev1.ConfigDiff_LISTENER, name, asMap(asMap(l)["warming_state"])["listener"])
+			}
+		case strings.HasSuffix(typ, ".ClustersConfigDump"):
+			for _, key := range []string{"static_clusters", "dynamic_active_clusters", "dynamic_warming_clusters"} {
+				for _, cl := range asSlice(m[key]) {
+					add(envoytriagev1.ConfigDiff_CLUSTER, "", asMap(cl)["cluster"])
+				}
+			}
+		case strings.HasSuffix(typ, ".RoutesConfigDump"):
+			for _, key := range []string{"static_route_configs", "dynamic_route_configs"} {
+				for _, r := range asSlice(m[key]) {
+					add(envoytriagev1.ConfigDiff_ROUTE, "", asMap(r)["route_config"])
+				}
+			}
+		}
+	}
+
+	for name, secret := range secretsFromConfigDump(dump) {
+		add(envoytriagev1.ConfigDiff_SECRET, name, secretMetadata(secret))
+	}
+
+	return ret
+}
+
+// Returns the secrets in a config dump by name, active secrets take precedence over warming ones.
+func secretsFromConfigDump(dump interface{}) map[string]interface{} {
+	ret := make(map[string]interface{})
+	for _, config := range asSlice(asMap(dump)["configs"]) {
+		m := asMap(config)
+		if typ, _ := m["@type"].(string); !strings.HasSuffix(typ, ".SecretsConfigDump") {
+			continue
+		}
+
+		for _, key := range []string{"static_secrets", "dynamic_active_secrets", "dynamic_warming_secrets"} {
+			for _, s := range asSlice(m[key]) {
+				name, _ := asMap(s)["name"].(string)
+				secret := asMap(s)["secret"]
+				if _, ok := ret[name]; !ok && secret != nil {
+					ret[name] = secret
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// Secrets are compared by their type and a digest of their contents, so that key material is never returned.
+func secretMetadata(secret interface{}) interface{} {
+	if secret == nil {
+		return nil
+	}
+
+	typ, _ := asMap(secret)["@type"].(string)
+	b, _ := json.Marshal(secret)
+	sum := sha256.Sum256(b)
+	return map[string]interface{}{
+		"type":   typ,
+		"digest": hex.EncodeToString(sum[:]),
+	}
+}
+
+func diffConfigResources(base, target configResources) []*envoytriagev1.ConfigDiff {
+	var ret []*envoytriagev1.ConfigDiff
+
+	resourceTypes := []envoytriagev1.ConfigDiff_ResourceType{
+		envoytriagev1.ConfigDiff_LISTENER,
+		envoytriagev1.ConfigDiff_CLUSTER,
+		envoytriagev1.ConfigDiff_ROUTE,
+		envoytriagev1.ConfigDiff_SECRET,
+	}
+	for _, resourceType := range resourceTypes {
+		names := make(map[string]bool)
+		for name := range base[resourceType] {
+			names[name] = true
+		}
+		for name := range target[resourceType] {
+			names[name] = true
+		}
+
+		sortedNames := make([]string, 0, len(names))
+		for name := range names {
+			sortedNames = append(sortedNames, name)
+		}
+		sort.Strings(sortedNames)
+
+		for _, name := range sortedNames {
+			b, inBase := base[resourceType][name]
+			t, inTarget := target[resourceType][name]
+
+			diff := &envoytriagev1.ConfigDiff{ResourceType: resourceType, Name: name}
+			switch {
+			case !inBase:
+				diff.Change = envoytriagev1.ConfigDiff_ADDED
+			case !inTarget:
+				diff.Change = envoytriagev1.ConfigDiff_REMOVED
+			default:
+				var fields []*envoytriagev1.ConfigDiff_Field
+				diffValues("", b, t, &fields)
+				if len(fields) == 0 {
+					continue
+				}
+				diff.Change = envoytriagev1.ConfigDiff_MODIFIED
+				if len(fields) > maxConfigDiffFields {
+					fields = fields[:maxConfigDiffFields]
+					diff.FieldsTruncated = true
+				}
+				diff.Fields = fields
+			}
+			ret = append(ret, diff)
+		}
+	}
+
+	return ret
+}
+
+// Appends the paths that differ between two decoded JSON values.
+func diffValues(path string, base, target interface{}, fields *[]*envoytriagev1.ConfigDiff_Field) {
+	// Stop descending once enough differences were found to be truncated.
+	if len(*fields) > maxConfigDiffFields {
+		return
+	}
+
+	switch b := base.(type) {
+	case map[string]interface{}:
+		t, ok := target.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make(map[string]bool)
+		for k := range b {
+			keys[k] = true
+		}
+		for k := range t {
+			keys[k] = true
+		}
+		sortedKeys := make([]string, 0, len(keys))
+		for k := range keys {
+			sortedKeys = append(sortedKeys, k)
+		}
+		sort.Strings(sortedKeys)
+
+		for _, k := range sortedKeys {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			diffValues(p, b[k], t[k], fields)
+		}
+		return
+	case []interface{}:
+		t, ok := target.([]interface{})
+		if !ok {
+			break
+		}
+
+		n := len(b)
+		if len(t) > n {
+			n = len(t)
+		}
+		for i := 0; i < n; i++ {
+			var bv, tv interface{}
+			if i < len(b) {
+				bv = b[i]
+			}
+			if i < len(t) {
+				tv = t[i]
+			}
+			diffValues(fmt.Sprintf("%s[%d]", path, i), bv, tv, fields)
+		}
+		return
+	}
+
+	if reflect.DeepEqual(base, target) {
+		return
+	}
+
+	field := &envoytriagev1.ConfigDiff_Field{Path: path}
+	if base != nil {
+		field.Base, _ = structpb.NewValue(base)
+	}
+	if target != nil {
+		field.Target, _ = structpb.NewValue(target)
+	}
+	*fields = append(*fields, field)
+}
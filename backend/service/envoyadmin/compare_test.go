@@ -0,0 +1,133 @@
+package envoyadmin
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+const baseConfigDump = `{"configs": [
+  {"@type": "type.googleapis.com/envoy.admin.v3.BootstrapConfigDump", "bootstrap": {"node": {"id": "a"}}},
+  {"@type": "type.googleapis.com/envoy.admin.v3.ClustersConfigDump", "version_info": "1",
+   "static_clusters": [{"cluster": {"name": "static", "connect_timeout": "1s"}, "last_updated": "2020-01-01T00:00:00Z"}],
+   "dynamic_active_clusters": [
+     {"version_info": "1", "cluster": {"name": "upstream", "connect_timeout": "1s", "lb_policy": "ROUND_ROBIN"}},
+     {"version_info": "1", "cluster": {"name": "removed"}}
+   ]},
+  {"@type": "type.googleapis.com/envoy.admin.v3.ListenersConfigDump",
+   "dynamic_listeners": [{"name": "ingress", "active_state": {"version_info": "1", "listener": {"name": "ingress",
+     "filter_chains": [{"filters": [{"name": "envoy.filters.network.http_connection_manager"}]}]}}}]},
+  {"@type": "type.googleapis.com/envoy.admin.v3.RoutesConfigDump",
+   "dynamic_route_configs": [{"route_config": {"name": "local_route", "virtual_hosts": []}}]},
+  {"@type": "type.googleapis.com/envoy.admin.v3.SecretsConfigDump",
+   "dynamic_active_secrets": [{"name": "server_cert", "version_info": "1",
+     "secret": {"@type": "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret", "tls_certificate": {"certificate_chain": {"inline_bytes": "AAAA"}}}}]}
+]}`
+
+const targetConfigDump = `{"configs": [
+  {"@type": "type.googleapis.com/envoy.admin.v3.BootstrapConfigDump", "bootstrap": {"node": {"id": "b"}}},
+  {"@type": "type.googleapis.com/envoy.admin.v3.ClustersConfigDump", "version_info": "2",
+   "static_clusters": [{"cluster": {"name": "static", "connect_timeout": "1s"}, "last_updated": "2021-01-01T00:00:00Z"}],
+   "dynamic_active_clusters": [
+     {"version_info": "2", "cluster": {"name": "upstream", "connect_timeout": "5s"}},
+     {"version_info": "2", "cluster": {"name": "added"}}
+   ]},
+  {"@type": "type.googleapis.com/envoy.admin.v3.ListenersConfigDump",
+   "dynamic_listeners": [{"name": "ingress", "active_state": {"version_info": "2", "listener": {"name": "ingress",
+     "filter_chains": [{"filters": [{"name": "envoy.filters.network.http_connection_manager"}, {"name": "envoy.filters.network.rbac"}]}]}}}]},
+  {"@type": "type.googleapis.com/envoy.admin.v3.RoutesConfigDump",
+   "dynamic_route_configs": [{"route_config": {"name": "local_route", "virtual_hosts": []}}]},
+  {"@type": "type.googleapis.com/envoy.admin.v3.SecretsConfigDump",
+   "dynamic_active_secrets": [{"name": "server_cert", "version_info": "2",
+     "secret": {"@type": "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret", "tls_certificate": {"certificate_chain": {"inline_bytes": "BBBB"}}}}]}
+]}`
+
+func TestCompareConfig(t *testing.T) {
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Hostname() == "10.0.0.1" {
+			return okResponse(req, baseConfigDump), nil
+		}
+		return okResponse(req, targetConfigDump), nil
+	})
+	c := &client{defaultPort: 9901, httpClient: &http.Client{Transport: transport}}
+
+	diffs, err := c.CompareConfig(context.Background(),
+		&envoytriagev1.ConfigSource{Source: &envoytriagev1.ConfigSource_Address{Address: &envoytriagev1.Address{Host: "10.0.0.1"}}},
+		&envoytriagev1.ConfigSource{Source: &envoytriagev1.ConfigSource_Address{Address: &envoytriagev1.Address{Host: "10.0.0.2"}}},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, diffs, 5)
+
+	// Listener with an added filter.
+	assert.Equal(t, envoytriagev1.ConfigDiff_LISTENER, diffs[0].ResourceType)
+	assert.Equal(t, "ingress", diffs[0].Name)
+	assert.Equal(t, envoytriagev1.ConfigDiff_MODIFIED, diffs[0].Change)
+	assert.Len(t, diffs[0].Fields, 1)
+	assert.Equal(t, "filter_chains[0].filters[1]", diffs[0].Fields[0].Path)
+	assert.Nil(t, diffs[0].Fields[0].Base)
+	assert.Equal(t, "envoy.filters.network.rbac", diffs[0].Fields[0].Target.GetStructValue().Fields["name"].GetStringValue())
+
+	// Clusters ordered by name, the static cluster only differs in its update time.
+	assert.Equal(t, "added", diffs[1].Name)
+	assert.Equal(t, envoytriagev1.ConfigDiff_ADDED, diffs[1].Change)
+	assert.Equal(t, "removed", diffs[2].Name)
+	assert.Equal(t, envoytriagev1.ConfigDiff_REMOVED, diffs[2].Change)
+	assert.Equal(t, "upstream", diffs[3].Name)
+	assert.Equal(t, envoytriagev1.ConfigDiff_MODIFIED, diffs[3].Change)
+	assert.Len(t, diffs[3].Fields, 2)
+	assert.Equal(t, "connect_timeout", diffs[3].Fields[0].Path)
+	assert.Equal(t, "1s", diffs[3].Fields[0].Base.GetStringValue())
+	assert.Equal(t, "5s", diffs[3].Fields[0].Target.GetStringValue())
+	assert.Equal(t, "lb_policy", diffs[3].Fields[1].Path)
+	assert.Nil(t, diffs[3].Fields[1].Target)
+
+	// Secrets are compared by digest and never include their contents.
+	assert.Equal(t, envoytriagev1.ConfigDiff_SECRET, diffs[4].ResourceType)
+	assert.Equal(t, "server_cert", diffs[4].Name)
+	assert.Len(t, diffs[4].Fields, 1)
+	assert.Equal(t, "digest", diffs[4].Fields[0].Path)
+	assert.NotContains(t, diffs[4].String(), "AAAA")
+	assert.NotContains(t, diffs[4].String(), "BBBB")
+}
+
+func TestCompareConfigIdentical(t *testing.T) {
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return okResponse(req, baseConfigDump), nil
+	})
+	c := &client{defaultPort: 9901, httpClient: &http.Client{Transport: transport}}
+
+	source := &envoytriagev1.ConfigSource{Source: &envoytriagev1.ConfigSource_Address{Address: &envoytriagev1.Address{Host: "10.0.0.1"}}}
+	diffs, err := c.CompareConfig(context.Background(), source, source)
+	assert.NoError(t, err)
+	assert.Empty(t, diffs)
+}
+
+func TestDiffValuesTruncated(t *testing.T) {
+	base := map[string]interface{}{}
+	target := map[string]interface{}{}
+	for i := 0; i < 2*maxConfigDiffFields; i++ {
+		base[string(rune('a'+i%26))+string(rune('a'+i/26))] = float64(i)
+	}
+
+	diffs := diffConfigResources(
+		configResources{envoytriagev1.ConfigDiff_CLUSTER: {"c": base}},
+		configResources{envoytriagev1.ConfigDiff_CLUSTER: {"c": target}},
+	)
+	assert.Len(t, diffs, 1)
+	assert.Len(t, diffs[0].Fields, maxConfigDiffFields)
+	assert.True(t, diffs[0].FieldsTruncated)
+}
+
+func TestCompareConfigSnapshotsDisabled(t *testing.T) {
+	c := &client{httpClient: &http.Client{}}
+
+	source := &envoytriagev1.ConfigSource{Source: &envoytriagev1.ConfigSource_SnapshotId{SnapshotId: 1}}
+	_, err := c.CompareConfig(context.Background(), source, source)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
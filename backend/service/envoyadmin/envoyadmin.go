@@ -6,18 +6,27 @@ package envoyadmin
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	envoyadminv1 "github.com/lyft/clutch/backend/api/config/service/envoyadmin/v1"
 	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
 	"github.com/lyft/clutch/backend/service"
+	pgservice "github.com/lyft/clutch/backend/service/db/postgres"
+	k8sservice "github.com/lyft/clutch/backend/service/k8s"
 )
 
 const Name = "clutch.service.envoyadmin"
@@ -32,26 +41,102 @@ func NewWithHTTPClient(cfg *any.Any, logger *zap.Logger, scope tally.Scope, http
 		return nil, err
 	}
 
-	return &client{
+	c := &client{
 		defaultPort: config.DefaultRemotePort,
 		httpClient:  httpClient,
-	}, nil
+
+		snapshotRetention:      defaultConfigSnapshotRetention,
+		maxSnapshotsPerAddress: defaultMaxConfigSnapshotsPerAddress,
+	}
+	if snapshots := config.ConfigSnapshots; snapshots != nil {
+		if snapshots.Retention != nil {
+			c.snapshotRetention = snapshots.Retention.AsDuration()
+		}
+		if snapshots.MaxPerAddress > 0 {
+			c.maxSnapshotsPerAddress = snapshots.MaxPerAddress
+		}
+	}
+
+	// Config snapshots are only available if a datastore is configured. Services are instantiated in the order they
+	// are listed, so the datastore has to be listed before this service.
+	p, ok := service.Registry[pgservice.Name]
+	if !ok && config.ConfigSnapshots != nil {
+		return nil, errors.New("config snapshots require the datastore [clutch.service.db.postgres], please list it before [clutch.service.envoyadmin]")
+	}
+	if ok {
+		dbClient, ok := p.(pgservice.Client)
+		if !ok {
+			return nil, errors.New("unable to get the datastore client")
+		}
+		c.db = dbClient.DB()
+	}
+
+	// Pods are only reachable through the API server if the k8s service is configured.
+	if k, ok := service.Registry[k8sservice.Name]; ok {
+		k8sClient, ok := k.(k8sservice.Service)
+		if !ok {
+			return nil, errors.New("unable to get the k8s client")
+		}
+		c.k8s = k8sClient
+	}
+
+	return c, nil
 }
 
 type Client interface {
 	// Get performs read-only operations concurrently and returns the results. If any of the operations fail,
 	// an error is returned.
 	Get(ctx context.Context, operation *envoytriagev1.ReadOperation) (*envoytriagev1.Result, error)
+
+	// BatchGet performs the same read-only operations against many hosts with bounded concurrency. Unlike Get, the
+	// failure of a host does not fail the batch, failed hosts are returned alongside the results of the others.
+	BatchGet(ctx context.Context, addresses []*envoytriagev1.Address, include *envoytriagev1.ReadOperation_Include, opts BatchOptions) ([]*envoytriagev1.Result, []*envoytriagev1.BatchReadResponse_Failure)
+
+	// SampleStats takes snapshots of the stats of a host over an interval and returns counter rates, gauges and
+	// histogram quantiles.
+	SampleStats(ctx context.Context, address *envoytriagev1.Address, opts StatsSampleOptions) (*envoytriagev1.StatsSample, error)
+
+	// CompareConfig returns the resources that differ between the config dumps of two sources.
+	CompareConfig(ctx context.Context, base, target *envoytriagev1.ConfigSource) ([]*envoytriagev1.ConfigDiff, error)
+	// CreateConfigSnapshot stores the current config dump of a host so it can be compared against later. Snapshots
+	// require the postgres service.
+	CreateConfigSnapshot(ctx context.Context, address *envoytriagev1.Address) (*envoytriagev1.ConfigSnapshot, error)
+	ListConfigSnapshots(ctx context.Context, address *envoytriagev1.Address) ([]*envoytriagev1.ConfigSnapshot, error)
+
+	// ModifyRuntime applies runtime overrides in the admin layer of the server.
+	ModifyRuntime(ctx context.Context, address *envoytriagev1.Address, overrides map[string]string) error
+	// SetLogLevel changes the level of a single logger, or all loggers if the logger is empty, and returns the levels
+	// of all loggers after the change.
+	SetLogLevel(ctx context.Context, address *envoytriagev1.Address, logger string, level envoytriagev1.SetLogLevelRequest_Level) ([]*envoytriagev1.Logger, error)
+	// FailHealthcheck fails the health check of the server until PassHealthcheck is called.
+	FailHealthcheck(ctx context.Context, address *envoytriagev1.Address) error
+	PassHealthcheck(ctx context.Context, address *envoytriagev1.Address) error
+	DrainListeners(ctx context.Context, address *envoytriagev1.Address, inboundOnly, graceful bool) error
 }
 
 type client struct {
 	defaultPort uint32
 	httpClient  *http.Client
+
+	db  *sql.DB
+	k8s k8sservice.Service
+
+	snapshotRetention      time.Duration
+	maxSnapshotsPerAddress uint32
 }
 
 func makeRequest(ctx context.Context, cl *http.Client, baseURL, path string) ([]byte, error) {
+	return doRequest(ctx, cl, http.MethodGet, baseURL, path)
+}
+
+// Envoy requires mutating admin endpoints to be called with POST.
+func makePostRequest(ctx context.Context, cl *http.Client, baseURL, path string) ([]byte, error) {
+	return doRequest(ctx, cl, http.MethodPost, baseURL, path)
+}
+
+func doRequest(ctx context.Context, cl *http.Client, method, baseURL, path string) ([]byte, error) {
 	url := fmt.Sprintf("%s%s", baseURL, path)
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -68,20 +153,48 @@ func makeRequest(ctx context.Context, cl *http.Client, baseURL, path string) ([]
 	return io.ReadAll(resp.Body)
 }
 
+// Returns the address with the default port if one was not provided.
+func (c *client) normalizeAddress(address *envoytriagev1.Address) *envoytriagev1.Address {
+	port := address.Port
+	if port == 0 {
+		port = c.defaultPort
+	}
+	return &envoytriagev1.Address{Host: address.Host, Port: port, Pod: address.Pod}
+}
+
+// Returns the normalized address along with the HTTP client and base URL used to reach its admin interface. Pods are
+// reached through the API server, other addresses directly.
+func (c *client) resolveAddress(ctx context.Context, address *envoytriagev1.Address) (*envoytriagev1.Address, *http.Client, string, error) {
+	address = c.normalizeAddress(address)
+
+	if pod := address.Pod; pod != nil {
+		if c.k8s == nil {
+			return nil, nil, "", status.Error(codes.FailedPrecondition, "please configure the k8s service [clutch.service.k8s] to reach pods")
+		}
+		httpClient, baseURL, err := c.k8s.PodProxy(ctx, pod.Clientset, pod.Cluster, pod.Namespace, pod.Name, address.Port)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		return address, httpClient, baseURL, nil
+	}
+
+	if address.Host == "" {
+		return nil, nil, "", status.Error(codes.InvalidArgument, "either a host or a pod is required")
+	}
+	return address, c.httpClient, fmt.Sprintf("http://%s:%d", address.Host, address.Port), nil
+}
+
 func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation) (*envoytriagev1.Result, error) {
 	defer c.httpClient.CloseIdleConnections()
 
-	// Use default port if one was not provided and construct address.
-	port := operation.Address.Port
-	if port == 0 {
-		port = c.defaultPort
+	address, httpClient, baseURL, err := c.resolveAddress(ctx, operation.Address)
+	if err != nil {
+		return nil, err
 	}
-	addr := fmt.Sprintf("%s:%d", operation.Address.Host, port)
-	baseURL := fmt.Sprintf("http://%s", addr)
 
 	// Make an empty result.
 	result := &envoytriagev1.Result{
-		Address: &envoytriagev1.Address{Host: operation.Address.Host, Port: port},
+		Address: address,
 		Output:  &envoytriagev1.Result_Output{},
 	}
 
@@ -89,7 +202,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 	g, ctx := errgroup.WithContext(ctx)
 	if operation.Include.Clusters {
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/clusters?format=json")
+			resp, err := makeRequest(ctx, httpClient, baseURL, "/clusters?format=json")
 			if err != nil {
 				return err
 			}
@@ -102,7 +215,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 
 	if operation.Include.ConfigDump {
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/config_dump")
+			resp, err := makeRequest(ctx, httpClient, baseURL, "/config_dump")
 			if err != nil {
 				return err
 			}
@@ -115,7 +228,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 
 	if operation.Include.Listeners {
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/listeners?format=json")
+			resp, err := makeRequest(ctx, httpClient, baseURL, "/listeners?format=json")
 			if err != nil {
 				return err
 			}
@@ -128,7 +241,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 
 	if operation.Include.Runtime {
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/runtime")
+			resp, err := makeRequest(ctx, httpClient, baseURL, "/runtime")
 			if err != nil {
 				return err
 			}
@@ -141,7 +254,7 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 
 	if operation.Include.Stats {
 		g.Go(func() error {
-			resp, err := makeRequest(ctx, c.httpClient, baseURL, "/stats")
+			resp, err := makeRequest(ctx, httpClient, baseURL, "/stats")
 			if err != nil {
 				return err
 			}
@@ -152,9 +265,27 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 		})
 	}
 
+	if operation.Include.Certs {
+		g.Go(func() error {
+			certs, err := makeRequest(ctx, httpClient, baseURL, "/certs")
+			if err != nil {
+				return err
+			}
+			// The config dump is used to find the listeners and clusters using each certificate.
+			configDump, err := makeRequest(ctx, httpClient, baseURL, "/config_dump")
+			if err != nil {
+				return err
+			}
+
+			v, err := certificatesFromResponse(certs, configDump)
+			result.Output.Certificates = v
+			return err
+		})
+	}
+
 	// Always fetch server info so we can populate node metadata.
 	g.Go(func() error {
-		resp, err := makeRequest(ctx, c.httpClient, baseURL, "/server_info")
+		resp, err := makeRequest(ctx, httpClient, baseURL, "/server_info")
 		if err != nil {
 			return err
 		}
@@ -180,3 +311,93 @@ func (c *client) Get(ctx context.Context, operation *envoytriagev1.ReadOperation
 
 	return result, nil
 }
+
+func (c *client) ModifyRuntime(ctx context.Context, address *envoytriagev1.Address, overrides map[string]string) error {
+	defer c.httpClient.CloseIdleConnections()
+	_, httpClient, baseURL, err := c.resolveAddress(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	values := url.Values{}
+	for k, v := range overrides {
+		values.Set(k, v)
+	}
+
+	_, err = makePostRequest(ctx, httpClient, baseURL, "/runtime_modify?"+values.Encode())
+	return err
+}
+
+func (c *client) SetLogLevel(ctx context.Context, address *envoytriagev1.Address, logger string, level envoytriagev1.SetLogLevelRequest_Level) ([]*envoytriagev1.Logger, error) {
+	defer c.httpClient.CloseIdleConnections()
+	if level == envoytriagev1.SetLogLevelRequest_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "a log level is required")
+	}
+
+	_, httpClient, baseURL, err := c.resolveAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	// Changing the level of a single logger is done with `?<logger>=<level>`, all loggers with `?level=<level>`.
+	key := "level"
+	if logger != "" {
+		key = logger
+	}
+	values := url.Values{}
+	values.Set(key, strings.ToLower(level.String()))
+
+	resp, err := makePostRequest(ctx, httpClient, baseURL, "/logging?"+values.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	return loggersFromResponse(resp)
+}
+
+func (c *client) FailHealthcheck(ctx context.Context, address *envoytriagev1.Address) error {
+	defer c.httpClient.CloseIdleConnections()
+	_, httpClient, baseURL, err := c.resolveAddress(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	_, err = makePostRequest(ctx, httpClient, baseURL, "/healthcheck/fail")
+	return err
+}
+
+func (c *client) PassHealthcheck(ctx context.Context, address *envoytriagev1.Address) error {
+	defer c.httpClient.CloseIdleConnections()
+	_, httpClient, baseURL, err := c.resolveAddress(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	_, err = makePostRequest(ctx, httpClient, baseURL, "/healthcheck/ok")
+	return err
+}
+
+func (c *client) DrainListeners(ctx context.Context, address *envoytriagev1.Address, inboundOnly, graceful bool) error {
+	defer c.httpClient.CloseIdleConnections()
+	_, httpClient, baseURL, err := c.resolveAddress(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	// The drain options are flags without values.
+	var params []string
+	if inboundOnly {
+		params = append(params, "inboundonly")
+	}
+	if graceful {
+		params = append(params, "graceful")
+	}
+
+	path := "/drain_listeners"
+	if len(params) > 0 {
+		path += "?" + strings.Join(params, "&")
+	}
+
+	_, err = makePostRequest(ctx, httpClient, baseURL, path)
+	return err
+}
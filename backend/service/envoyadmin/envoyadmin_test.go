@@ -0,0 +1,183 @@
+package envoyadmin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	envoyadminv1 "github.com/lyft/clutch/backend/api/config/service/envoyadmin/v1"
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+	k8sservice "github.com/lyft/clutch/backend/service/k8s"
+)
+
+type recordingTransport struct {
+	requests []*http.Request
+
+	statusCode int
+	body       string
+}
+
+func (r *recordingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.requests = append(r.requests, req)
+
+	statusCode := r.statusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	return &http.Response{
+		Status:     http.StatusText(statusCode),
+		StatusCode: statusCode,
+		Request:    req,
+		Body:       io.NopCloser(strings.NewReader(r.body)),
+	}, nil
+}
+
+func newTestClient(t *testing.T, transport *recordingTransport) Client {
+	cfg, err := anypb.New(&envoyadminv1.Config{DefaultRemotePort: 9901})
+	assert.NoError(t, err)
+
+	svc, err := NewWithHTTPClient(cfg, nil, nil, &http.Client{Transport: transport})
+	assert.NoError(t, err)
+	return svc.(Client)
+}
+
+func TestModifyRuntime(t *testing.T) {
+	transport := &recordingTransport{}
+	c := newTestClient(t, transport)
+
+	err := c.ModifyRuntime(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}, map[string]string{
+		"upstream.use_http2":        "false",
+		"health_check.min_interval": "",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, transport.requests, 1)
+
+	req := transport.requests[0]
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "10.0.0.1:9901", req.URL.Host)
+	assert.Equal(t, "/runtime_modify", req.URL.Path)
+	assert.Equal(t, "false", req.URL.Query().Get("upstream.use_http2"))
+	assert.True(t, req.URL.Query().Has("health_check.min_interval"))
+}
+
+func TestSetLogLevel(t *testing.T) {
+	transport := &recordingTransport{body: "active loggers:\n  upstream: info\n  http: debug\n"}
+	c := newTestClient(t, transport)
+
+	loggers, err := c.SetLogLevel(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1", Port: 8001}, "http", envoytriagev1.SetLogLevelRequest_DEBUG)
+	assert.NoError(t, err)
+	assert.Equal(t, []*envoytriagev1.Logger{{Name: "http", Level: "debug"}, {Name: "upstream", Level: "info"}}, loggers)
+
+	req := transport.requests[0]
+	assert.Equal(t, http.MethodPost, req.Method)
+	assert.Equal(t, "10.0.0.1:8001", req.URL.Host)
+	assert.Equal(t, "/logging", req.URL.Path)
+	assert.Equal(t, "http=debug", req.URL.RawQuery)
+
+	_, err = c.SetLogLevel(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}, "", envoytriagev1.SetLogLevelRequest_WARNING)
+	assert.NoError(t, err)
+	assert.Equal(t, "level=warning", transport.requests[1].URL.RawQuery)
+
+	_, err = c.SetLogLevel(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}, "", envoytriagev1.SetLogLevelRequest_UNSPECIFIED)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Len(t, transport.requests, 2)
+}
+
+func TestHealthcheck(t *testing.T) {
+	transport := &recordingTransport{}
+	c := newTestClient(t, transport)
+
+	assert.NoError(t, c.FailHealthcheck(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}))
+	assert.NoError(t, c.PassHealthcheck(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}))
+
+	assert.Len(t, transport.requests, 2)
+	assert.Equal(t, http.MethodPost, transport.requests[0].Method)
+	assert.Equal(t, "/healthcheck/fail", transport.requests[0].URL.Path)
+	assert.Equal(t, http.MethodPost, transport.requests[1].Method)
+	assert.Equal(t, "/healthcheck/ok", transport.requests[1].URL.Path)
+}
+
+func TestDrainListeners(t *testing.T) {
+	testCases := []struct {
+		inboundOnly bool
+		graceful    bool
+		query       string
+	}{
+		{query: ""},
+		{inboundOnly: true, query: "inboundonly"},
+		{graceful: true, query: "graceful"},
+		{inboundOnly: true, graceful: true, query: "inboundonly&graceful"},
+	}
+
+	for _, tt := range testCases {
+		transport := &recordingTransport{}
+		c := newTestClient(t, transport)
+
+		err := c.DrainListeners(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}, tt.inboundOnly, tt.graceful)
+		assert.NoError(t, err)
+		assert.Equal(t, http.MethodPost, transport.requests[0].Method)
+		assert.Equal(t, "/drain_listeners", transport.requests[0].URL.Path)
+		assert.Equal(t, tt.query, transport.requests[0].URL.RawQuery)
+	}
+}
+
+func TestWriteOperationError(t *testing.T) {
+	transport := &recordingTransport{statusCode: http.StatusNotFound, body: "error: unknown logger"}
+	c := newTestClient(t, transport)
+
+	_, err := c.SetLogLevel(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}, "nope", envoytriagev1.SetLogLevelRequest_INFO)
+	assert.Error(t, err)
+	assert.Error(t, c.FailHealthcheck(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}))
+}
+
+type podProxyK8s struct {
+	k8sservice.Service
+
+	transport *recordingTransport
+}
+
+func (p *podProxyK8s) PodProxy(ctx context.Context, clientset, cluster, namespace, name string, port uint32) (*http.Client, string, error) {
+	baseURL := fmt.Sprintf("https://apiserver/api/v1/namespaces/%s/pods/%s:%d/proxy", namespace, name, port)
+	return &http.Client{Transport: p.transport}, baseURL, nil
+}
+
+func TestPodAddress(t *testing.T) {
+	transport := &recordingTransport{body: `{"state": "LIVE"}`}
+	c := newTestClient(t, &recordingTransport{})
+
+	pod := &envoytriagev1.Address_Pod{Cluster: "production", Namespace: "envoy", Name: "envoy-abc"}
+	_, err := c.Get(context.Background(), &envoytriagev1.ReadOperation{Address: &envoytriagev1.Address{Pod: pod}})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	c.(*client).k8s = &podProxyK8s{transport: transport}
+	result, err := c.Get(context.Background(), &envoytriagev1.ReadOperation{
+		Address: &envoytriagev1.Address{Pod: pod},
+		Include: &envoytriagev1.ReadOperation_Include{},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(9901), result.Address.Port)
+	assert.Equal(t, "envoy-abc", result.Address.Pod.Name)
+
+	assert.Len(t, transport.requests, 1)
+	req := transport.requests[0]
+	assert.Equal(t, "apiserver", req.URL.Host)
+	assert.Equal(t, "/api/v1/namespaces/envoy/pods/envoy-abc:9901/proxy/server_info", req.URL.Path)
+
+	assert.NoError(t, c.FailHealthcheck(context.Background(), &envoytriagev1.Address{Pod: pod, Port: 9000}))
+	assert.Equal(t, "/api/v1/namespaces/envoy/pods/envoy-abc:9000/proxy/healthcheck/fail", transport.requests[1].URL.Path)
+}
+
+func TestAddressRequired(t *testing.T) {
+	c := newTestClient(t, &recordingTransport{})
+
+	err := c.PassHealthcheck(context.Background(), &envoytriagev1.Address{Port: 9901})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
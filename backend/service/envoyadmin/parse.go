@@ -12,9 +12,11 @@ import (
 
 	envoy_admin_v3 "github.com/envoyproxy/go-control-plane/envoy/admin/v3"
 	envoy_config_core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	envoy_type_v3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
 )
@@ -66,6 +68,9 @@ type Runtime struct {
 
 var scalarStatPattern = regexp.MustCompile(`^([\w.]+): (\d+)$`)
 
+// Loggers are listed one per line after an "active loggers:" header, e.g. "  http: debug".
+var loggerPattern = regexp.MustCompile(`^\s+([\w.:/-]+): (\w+)$`)
+
 func statsFromResponse(resp []byte) (*envoytriagev1.Stats, error) {
 	scanner := bufio.NewScanner(bytes.NewReader(resp))
 
@@ -87,6 +92,27 @@ func statsFromResponse(resp []byte) (*envoytriagev1.Stats, error) {
 	}, nil
 }
 
+func loggersFromResponse(resp []byte) ([]*envoytriagev1.Logger, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(resp))
+
+	var loggers []*envoytriagev1.Logger
+	for scanner.Scan() {
+		matches := loggerPattern.FindStringSubmatch(scanner.Text())
+		if len(matches) == 3 {
+			loggers = append(loggers, &envoytriagev1.Logger{Name: matches[1], Level: matches[2]})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(loggers, func(i, j int) bool {
+		return loggers[i].Name < loggers[j].Name
+	})
+
+	return loggers, nil
+}
+
 func runtimeFromResponse(resp []byte) (*envoytriagev1.Runtime, error) {
 	r := &Runtime{}
 	if err := json.Unmarshal(resp, r); err != nil {
@@ -148,13 +174,49 @@ func configDumpFromResponse(resp []byte) (*envoytriagev1.ConfigDump, error) {
 	return ret, nil
 }
 
+// Fields that change with every config update even if the config itself did not change.
+var configDumpNoiseFields = map[string]bool{
+	"version_info": true,
+	"last_updated": true,
+}
+
+// Returns a copy of a decoded config dump without fields that differ between hosts with the same config.
+func normalizeConfigDump(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		ret := make(map[string]interface{}, len(t))
+		for key, value := range t {
+			if configDumpNoiseFields[key] {
+				continue
+			}
+			ret[key] = normalizeConfigDump(value)
+		}
+
+		// The node in the bootstrap config identifies the host itself.
+		if typ, _ := t["@type"].(string); strings.HasSuffix(typ, ".BootstrapConfigDump") {
+			if bootstrap, ok := ret["bootstrap"].(map[string]interface{}); ok {
+				delete(bootstrap, "node")
+			}
+		}
+		return ret
+	case []interface{}:
+		ret := make([]interface{}, len(t))
+		for idx, value := range t {
+			ret[idx] = normalizeConfigDump(value)
+		}
+		return ret
+	default:
+		return v
+	}
+}
+
 func healthy(status *envoy_admin_v3.HostHealthStatus) bool {
-	unhealthy := status.FailedActiveDegradedCheck ||
-		status.FailedActiveHealthCheck ||
-		status.FailedOutlierCheck ||
-		status.PendingActiveHc ||
-		status.PendingDynamicRemoval ||
-		(status.EdsHealthStatus != envoy_config_core_v3.HealthStatus_HEALTHY)
+	unhealthy := status.GetFailedActiveDegradedCheck() ||
+		status.GetFailedActiveHealthCheck() ||
+		status.GetFailedOutlierCheck() ||
+		status.GetPendingActiveHc() ||
+		status.GetPendingDynamicRemoval() ||
+		(status.GetEdsHealthStatus() != envoy_config_core_v3.HealthStatus_HEALTHY)
 	return !unhealthy
 }
 
@@ -169,19 +231,119 @@ func clustersFromResponse(resp []byte) (*envoytriagev1.Clusters, error) {
 	}
 
 	for i, cluster := range pb.ClusterStatuses {
-		hostStatuses := make([]*envoytriagev1.HostStatus, len(cluster.HostStatuses))
+		cs := &envoytriagev1.ClusterStatus{
+			Name:                                    cluster.Name,
+			HostStatuses:                            make([]*envoytriagev1.HostStatus, len(cluster.HostStatuses)),
+			SuccessRateEjectionThreshold:            percentValue(cluster.SuccessRateEjectionThreshold),
+			LocalOriginSuccessRateEjectionThreshold: percentValue(cluster.LocalOriginSuccessRateEjectionThreshold),
+		}
 		for j, hs := range cluster.HostStatuses {
-			hostStatuses[j] = &envoytriagev1.HostStatus{
-				Address: addrAsString(hs.Address),
-				Healthy: healthy(hs.HealthStatus),
-			}
+			cs.HostStatuses[j] = newProtoForHostStatus(cs, hs)
 		}
+		cs.ZoneSummaries = zoneSummaries(cs.HostStatuses)
 
-		ret.ClusterStatuses[i] = &envoytriagev1.ClusterStatus{
-			Name:         cluster.Name,
-			HostStatuses: hostStatuses,
-		}
+		ret.ClusterStatuses[i] = cs
 	}
 
 	return ret, nil
 }
+
+func newProtoForHostStatus(cluster *envoytriagev1.ClusterStatus, hs *envoy_admin_v3.HostStatus) *envoytriagev1.HostStatus {
+	health := hs.GetHealthStatus()
+	ret := &envoytriagev1.HostStatus{
+		Address:                addrAsString(hs.Address),
+		Healthy:                healthy(health),
+		Hostname:               hs.Hostname,
+		Priority:               hs.Priority,
+		Weight:                 hs.Weight,
+		SuccessRate:            percentValue(hs.SuccessRate),
+		LocalOriginSuccessRate: percentValue(hs.LocalOriginSuccessRate),
+		HealthFlags: &envoytriagev1.HostStatus_HealthFlags{
+			FailedActiveHealthCheck:             health.GetFailedActiveHealthCheck(),
+			FailedOutlierCheck:                  health.GetFailedOutlierCheck(),
+			FailedActiveDegradedCheck:           health.GetFailedActiveDegradedCheck(),
+			PendingDynamicRemoval:               health.GetPendingDynamicRemoval(),
+			PendingActiveHealthCheck:            health.GetPendingActiveHc(),
+			ExcludedViaImmediateHealthCheckFail: health.GetExcludedViaImmediateHcFail(),
+			ActiveHealthCheckTimeout:            health.GetActiveHcTimeout(),
+			EdsHealthStatus:                     health.GetEdsHealthStatus().String(),
+		},
+		OutlierEjection: &envoytriagev1.HostStatus_OutlierEjection{},
+	}
+
+	if l := hs.Locality; l != nil {
+		ret.Locality = &envoytriagev1.HostStatus_Locality{Region: l.Region, Zone: l.Zone, SubZone: l.SubZone}
+	}
+
+	if len(hs.Stats) > 0 {
+		ret.Stats = make(map[string]uint64, len(hs.Stats))
+		for _, stat := range hs.Stats {
+			ret.Stats[stat.Name] = stat.Value
+		}
+	}
+
+	if health.GetFailedOutlierCheck() {
+		ret.OutlierEjection.Ejected = true
+		ret.OutlierEjection.Reason = ejectionReason(cluster, ret)
+	}
+
+	return ret
+}
+
+// Envoy does not report why a host was ejected. A success rate below the threshold of the cluster is the likely
+// reason, otherwise the host was ejected by another detector, e.g. consecutive 5xx.
+func ejectionReason(cluster *envoytriagev1.ClusterStatus, host *envoytriagev1.HostStatus) envoytriagev1.HostStatus_OutlierEjection_Reason {
+	below := func(rate, threshold *wrapperspb.DoubleValue) bool {
+		return rate != nil && threshold != nil && rate.Value < threshold.Value
+	}
+
+	switch {
+	case below(host.SuccessRate, cluster.SuccessRateEjectionThreshold):
+		return envoytriagev1.HostStatus_OutlierEjection_SUCCESS_RATE
+	case below(host.LocalOriginSuccessRate, cluster.LocalOriginSuccessRateEjectionThreshold):
+		return envoytriagev1.HostStatus_OutlierEjection_LOCAL_ORIGIN_SUCCESS_RATE
+	default:
+		return envoytriagev1.HostStatus_OutlierEjection_OTHER
+	}
+}
+
+// Counts the hosts of a cluster by zone, ordered by region and zone. Hosts without a locality are counted under an
+// empty zone.
+func zoneSummaries(hosts []*envoytriagev1.HostStatus) []*envoytriagev1.ClusterStatus_ZoneSummary {
+	zones := make(map[string]*envoytriagev1.ClusterStatus_ZoneSummary)
+	var ret []*envoytriagev1.ClusterStatus_ZoneSummary
+	for _, host := range hosts {
+		region, zone := host.Locality.GetRegion(), host.Locality.GetZone()
+		key := region + "/" + zone
+
+		summary, ok := zones[key]
+		if !ok {
+			summary = &envoytriagev1.ClusterStatus_ZoneSummary{Region: region, Zone: zone}
+			zones[key] = summary
+			ret = append(ret, summary)
+		}
+
+		summary.Hosts++
+		if host.Healthy {
+			summary.Healthy++
+		}
+		if host.OutlierEjection.GetEjected() {
+			summary.Ejected++
+		}
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Region != ret[j].Region {
+			return ret[i].Region < ret[j].Region
+		}
+		return ret[i].Zone < ret[j].Zone
+	})
+	return ret
+}
+
+func percentValue(p *envoy_type_v3.Percent) *wrapperspb.DoubleValue {
+	if p == nil {
+		return nil
+	}
+	return wrapperspb.Double(p.Value)
+}
@@ -34,3 +34,90 @@ func TestNodeMetadataFromResponse(t *testing.T) {
 		}
 	}
 }
+
+func TestLoggersFromResponse(t *testing.T) {
+	resp := []byte("active loggers:\n  upstream: info\n  http: debug\n  envoy.filters.http.router: warning\n")
+
+	loggers, err := loggersFromResponse(resp)
+	assert.NoError(t, err)
+	assert.Equal(t, []*envoytriagev1.Logger{
+		{Name: "envoy.filters.http.router", Level: "warning"},
+		{Name: "http", Level: "debug"},
+		{Name: "upstream", Level: "info"},
+	}, loggers)
+
+	loggers, err = loggersFromResponse([]byte("OK\n"))
+	assert.NoError(t, err)
+	assert.Empty(t, loggers)
+}
+
+func TestClustersFromResponse(t *testing.T) {
+	resp := []byte(`{
+  "cluster_statuses": [
+    {
+      "name": "upstream",
+      "success_rate_ejection_threshold": {"value": 80},
+      "host_statuses": [
+        {
+          "address": {"socket_address": {"address": "10.0.0.1", "port_value": 80}},
+          "stats": [{"name": "rq_success", "value": "10", "type": "COUNTER"}, {"name": "rq_error", "value": "90", "type": "COUNTER"}],
+          "health_status": {"failed_outlier_check": true, "eds_health_status": "HEALTHY"},
+          "success_rate": {"value": 10},
+          "weight": 1,
+          "locality": {"region": "us-east-1", "zone": "us-east-1a"}
+        },
+        {
+          "address": {"socket_address": {"address": "10.0.0.2", "port_value": 80}},
+          "health_status": {"failed_outlier_check": true, "eds_health_status": "HEALTHY"},
+          "success_rate": {"value": 99},
+          "weight": 1,
+          "locality": {"region": "us-east-1", "zone": "us-east-1a"}
+        },
+        {
+          "address": {"socket_address": {"address": "10.0.0.3", "port_value": 80}},
+          "health_status": {"eds_health_status": "HEALTHY", "active_hc_timeout": true},
+          "weight": 2,
+          "priority": 1,
+          "locality": {"region": "us-east-1", "zone": "us-east-1b"}
+        }
+      ]
+    }
+  ]
+}`)
+
+	clusters, err := clustersFromResponse(resp)
+	assert.NoError(t, err)
+	assert.Len(t, clusters.ClusterStatuses, 1)
+
+	cluster := clusters.ClusterStatuses[0]
+	assert.Equal(t, 80.0, cluster.SuccessRateEjectionThreshold.GetValue())
+	assert.Nil(t, cluster.LocalOriginSuccessRateEjectionThreshold)
+	assert.Len(t, cluster.HostStatuses, 3)
+
+	host := cluster.HostStatuses[0]
+	assert.Equal(t, "tcp://10.0.0.1:80", host.Address)
+	assert.False(t, host.Healthy)
+	assert.Equal(t, 10.0, host.SuccessRate.GetValue())
+	assert.Equal(t, map[string]uint64{"rq_success": 10, "rq_error": 90}, host.Stats)
+	assert.True(t, host.HealthFlags.FailedOutlierCheck)
+	assert.Equal(t, "HEALTHY", host.HealthFlags.EdsHealthStatus)
+	assert.True(t, host.OutlierEjection.Ejected)
+	assert.Equal(t, envoytriagev1.HostStatus_OutlierEjection_SUCCESS_RATE, host.OutlierEjection.Reason)
+
+	// Ejected with a success rate above the threshold.
+	assert.Equal(t, envoytriagev1.HostStatus_OutlierEjection_OTHER, cluster.HostStatuses[1].OutlierEjection.Reason)
+
+	host = cluster.HostStatuses[2]
+	assert.True(t, host.Healthy)
+	assert.False(t, host.OutlierEjection.Ejected)
+	assert.Equal(t, envoytriagev1.HostStatus_OutlierEjection_UNSPECIFIED, host.OutlierEjection.Reason)
+	assert.True(t, host.HealthFlags.ActiveHealthCheckTimeout)
+	assert.Equal(t, uint32(1), host.Priority)
+	assert.Equal(t, uint32(2), host.Weight)
+	assert.Equal(t, "us-east-1b", host.Locality.Zone)
+
+	assert.Equal(t, []*envoytriagev1.ClusterStatus_ZoneSummary{
+		{Region: "us-east-1", Zone: "us-east-1a", Hosts: 2, Healthy: 0, Ejected: 2},
+		{Region: "us-east-1", Zone: "us-east-1b", Hosts: 1, Healthy: 1, Ejected: 0},
+	}, cluster.ZoneSummaries)
+}
@@ -0,0 +1,161 @@
+package envoyadmin
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+const (
+	// The maximum number of snapshots returned when listing the snapshots of an address.
+	maxListedConfigSnapshots = 100
+
+	defaultConfigSnapshotRetention      = 30 * 24 * time.Hour
+	defaultMaxConfigSnapshotsPerAddress = 100
+)
+
+var errSnapshotsDisabled = status.Error(codes.FailedPrecondition, "please configure the datastore [clutch.service.db.postgres] to use config snapshots")
+
+func (c *client) CreateConfigSnapshot(ctx context.Context, address *envoytriagev1.Address) (*envoytriagev1.ConfigSnapshot, error) {
+	if c.db == nil {
+		return nil, errSnapshotsDisabled
+	}
+
+	defer c.httpClient.CloseIdleConnections()
+	address, httpClient, baseURL, err := c.resolveAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := makeRequest(ctx, httpClient, baseURL, "/config_dump")
+	if err != nil {
+		return nil, err
+	}
+	if !json.Valid(resp) {
+		return nil, fmt.Errorf("config dump of '%s:%d' is not valid JSON", snapshotHost(address), address.Port)
+	}
+
+	tx, err := c.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	// Rolling back is a no-op once the transaction is committed.
+	defer tx.Rollback()
+
+	host := snapshotHost(address)
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Insert("envoy_config_snapshot").
+		Columns("host", "port", "config_dump").
+		Values(host, address.Port, resp).
+		Suffix("RETURNING id, created_at")
+
+	var id int64
+	var createdAt time.Time
+	if err := query.RunWith(tx).QueryRowContext(ctx).Scan(&id, &createdAt); err != nil {
+		return nil, err
+	}
+
+	if err := c.pruneConfigSnapshots(ctx, tx, host, address.Port); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return &envoytriagev1.ConfigSnapshot{
+		Id:        id,
+		Address:   address,
+		CreatedAt: timestamppb.New(createdAt),
+	}, nil
+}
+
+// Deletes the snapshots past the retention of all addresses and the oldest snapshots of the address beyond the maximum
+// number kept per address.
+func (c *client) pruneConfigSnapshots(ctx context.Context, tx *sql.Tx, host string, port uint32) error {
+	expired := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("envoy_config_snapshot").
+		Where(sq.Lt{"created_at": time.Now().Add(-c.snapshotRetention)})
+	if _, err := expired.RunWith(tx).ExecContext(ctx); err != nil {
+		return err
+	}
+
+	excess := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Delete("envoy_config_snapshot").
+		Where(sq.Eq{"host": host, "port": port}).
+		Where("id NOT IN (SELECT id FROM envoy_config_snapshot WHERE host = ? AND port = ? ORDER BY created_at DESC LIMIT ?)", host, port, c.maxSnapshotsPerAddress)
+	_, err := excess.RunWith(tx).ExecContext(ctx)
+	return err
+}
+
+func (c *client) ListConfigSnapshots(ctx context.Context, address *envoytriagev1.Address) ([]*envoytriagev1.ConfigSnapshot, error) {
+	if c.db == nil {
+		return nil, errSnapshotsDisabled
+	}
+
+	address = c.normalizeAddress(address)
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("id", "created_at").
+		From("envoy_config_snapshot").
+		Where(sq.Eq{"host": snapshotHost(address), "port": address.Port}).
+		OrderBy("created_at DESC").
+		Limit(maxListedConfigSnapshots)
+
+	rows, err := query.RunWith(c.db).QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ret []*envoytriagev1.ConfigSnapshot
+	for rows.Next() {
+		var id int64
+		var createdAt time.Time
+		if err := rows.Scan(&id, &createdAt); err != nil {
+			return nil, err
+		}
+		ret = append(ret, &envoytriagev1.ConfigSnapshot{
+			Id:        id,
+			Address:   address,
+			CreatedAt: timestamppb.New(createdAt),
+		})
+	}
+
+	return ret, rows.Err()
+}
+
+// Pods reached through the API server have no host, their snapshots are stored under the pod instead.
+func snapshotHost(address *envoytriagev1.Address) string {
+	if pod := address.Pod; pod != nil {
+		return fmt.Sprintf("pod:%s/%s/%s/%s", pod.Clientset, pod.Cluster, pod.Namespace, pod.Name)
+	}
+	return address.Host
+}
+
+func (c *client) getConfigSnapshotDump(ctx context.Context, id int64) ([]byte, error) {
+	if c.db == nil {
+		return nil, errSnapshotsDisabled
+	}
+
+	query := sq.StatementBuilder.PlaceholderFormat(sq.Dollar).
+		Select("config_dump").
+		From("envoy_config_snapshot").
+		Where(sq.Eq{"id": id})
+
+	var dump []byte
+	err := query.RunWith(c.db).QueryRowContext(ctx).Scan(&dump)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "config snapshot '%d' not found", id)
+	}
+	return dump, err
+}
@@ -0,0 +1,150 @@
+package envoyadmin
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	envoyadminv1 "github.com/lyft/clutch/backend/api/config/service/envoyadmin/v1"
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+func TestCreateConfigSnapshot(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return okResponse(req, baseConfigDump), nil
+	})
+	c := &client{
+		defaultPort:            9901,
+		httpClient:             &http.Client{Transport: transport},
+		db:                     db,
+		snapshotRetention:      defaultConfigSnapshotRetention,
+		maxSnapshotsPerAddress: 5,
+	}
+
+	createdAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO envoy_config_snapshot (host,port,config_dump) VALUES ($1,$2,$3) RETURNING id, created_at").
+		WithArgs("10.0.0.1", uint32(9901), []byte(baseConfigDump)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(42, createdAt))
+	mock.ExpectExec("DELETE FROM envoy_config_snapshot WHERE created_at < $1").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("DELETE FROM envoy_config_snapshot WHERE host = $1 AND port = $2 AND id NOT IN (SELECT id FROM envoy_config_snapshot WHERE host = $3 AND port = $4 ORDER BY created_at DESC LIMIT $5)").
+		WithArgs("10.0.0.1", uint32(9901), "10.0.0.1", uint32(9901), uint32(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	snapshot, err := c.CreateConfigSnapshot(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), snapshot.Id)
+	assert.Equal(t, uint32(9901), snapshot.Address.Port)
+	assert.Equal(t, createdAt, snapshot.CreatedAt.AsTime())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateConfigSnapshotRollback(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return okResponse(req, baseConfigDump), nil
+	})
+	c := &client{defaultPort: 9901, httpClient: &http.Client{Transport: transport}, db: db, maxSnapshotsPerAddress: 5}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("INSERT INTO envoy_config_snapshot (host,port,config_dump) VALUES ($1,$2,$3) RETURNING id, created_at").
+		WithArgs("10.0.0.1", uint32(9901), []byte(baseConfigDump)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(42, time.Now()))
+	mock.ExpectExec("DELETE FROM envoy_config_snapshot WHERE created_at < $1").
+		WithArgs(sqlmock.AnyArg()).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	_, err = c.CreateConfigSnapshot(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"})
+	assert.ErrorIs(t, err, sql.ErrConnDone)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestNewConfigSnapshotsRequireDatastore(t *testing.T) {
+	cfg, err := anypb.New(&envoyadminv1.Config{
+		ConfigSnapshots: &envoyadminv1.ConfigSnapshots{Retention: durationpb.New(time.Hour)},
+	})
+	assert.NoError(t, err)
+
+	_, err = NewWithHTTPClient(cfg, nil, nil, &http.Client{})
+	assert.Error(t, err)
+
+	// Without snapshots configured, the service starts with snapshots disabled.
+	cfg, err = anypb.New(&envoyadminv1.Config{})
+	assert.NoError(t, err)
+	svc, err := NewWithHTTPClient(cfg, nil, nil, &http.Client{})
+	assert.NoError(t, err)
+	_, err = svc.(Client).ListConfigSnapshots(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
+
+func TestListConfigSnapshots(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	c := &client{defaultPort: 9901, httpClient: &http.Client{}, db: db}
+
+	now := time.Now()
+	mock.ExpectQuery("SELECT id, created_at FROM envoy_config_snapshot WHERE host = $1 AND port = $2 ORDER BY created_at DESC LIMIT 100").
+		WithArgs("10.0.0.1", uint32(8001)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(2, now).AddRow(1, now.Add(-time.Hour)))
+
+	snapshots, err := c.ListConfigSnapshots(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1", Port: 8001})
+	assert.NoError(t, err)
+	assert.Len(t, snapshots, 2)
+	assert.Equal(t, int64(2), snapshots[0].Id)
+	assert.Equal(t, "10.0.0.1", snapshots[1].Address.Host)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompareConfigWithSnapshot(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.QueryMatcherOption(sqlmock.QueryMatcherEqual))
+	assert.NoError(t, err)
+	defer db.Close()
+
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return okResponse(req, targetConfigDump), nil
+	})
+	c := &client{defaultPort: 9901, httpClient: &http.Client{Transport: transport}, db: db}
+
+	mock.ExpectQuery("SELECT config_dump FROM envoy_config_snapshot WHERE id = $1").
+		WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"config_dump"}).AddRow([]byte(baseConfigDump)))
+	mock.ExpectQuery("SELECT config_dump FROM envoy_config_snapshot WHERE id = $1").
+		WithArgs(int64(2)).
+		WillReturnError(sql.ErrNoRows)
+
+	diffs, err := c.CompareConfig(context.Background(),
+		&envoytriagev1.ConfigSource{Source: &envoytriagev1.ConfigSource_SnapshotId{SnapshotId: 1}},
+		&envoytriagev1.ConfigSource{Source: &envoytriagev1.ConfigSource_Address{Address: &envoytriagev1.Address{Host: "10.0.0.1"}}},
+	)
+	assert.NoError(t, err)
+	assert.Len(t, diffs, 5)
+
+	_, err = c.CompareConfig(context.Background(),
+		&envoytriagev1.ConfigSource{Source: &envoytriagev1.ConfigSource_SnapshotId{SnapshotId: 2}},
+		&envoytriagev1.ConfigSource{Source: &envoytriagev1.ConfigSource_Address{Address: &envoytriagev1.Address{Host: "10.0.0.1"}}},
+	)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
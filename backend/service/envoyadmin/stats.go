@@ -0,0 +1,287 @@
+package envoyadmin
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+const (
+	defaultStatsSamples = 2
+
+	// The longest time between the first and the last snapshot of a sample.
+	maxStatsSampleDuration = time.Minute
+	// The time reserved for the requests of a sample, the sample must end this long before the deadline of the
+	// request so that the gauges and histograms can still be read.
+	statsSampleRequestMargin = 2 * time.Second
+)
+
+type StatsSampleOptions struct {
+	// The time between consecutive snapshots.
+	Interval time.Duration
+	// The number of snapshots, at least two are taken.
+	Samples int
+	// A regular expression that stat names must match, all stats are returned if empty.
+	Filter string
+	// Limits the counters and gauges returned to the N highest, all are returned if zero.
+	TopN int
+}
+
+// The JSON format of /stats?type=Histograms. Histograms are returned as a single entry of the stats.
+type jsonStats struct {
+	Stats []struct {
+		Histograms *jsonHistograms `json:"histograms"`
+	} `json:"stats"`
+}
+
+type jsonHistograms struct {
+	SupportedQuantiles []float64 `json:"supported_quantiles"`
+	ComputedQuantiles  []struct {
+		Name   string `json:"name"`
+		Values []struct {
+			Interval   *float64 `json:"interval"`
+			Cumulative *float64 `json:"cumulative"`
+		} `json:"values"`
+	} `json:"computed_quantiles"`
+}
+
+// The scalar stats of a host at a point in time.
+type statsSnapshot struct {
+	time   time.Time
+	values map[string]uint64
+}
+
+func (c *client) SampleStats(ctx context.Context, address *envoytriagev1.Address, opts StatsSampleOptions) (*envoytriagev1.StatsSample, error) {
+	var filter *regexp.Regexp
+	if opts.Filter != "" {
+		var err error
+		filter, err = regexp.Compile(opts.Filter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %s", err)
+		}
+	}
+
+	samples := opts.Samples
+	if samples < defaultStatsSamples {
+		samples = defaultStatsSamples
+	}
+
+	duration := time.Duration(samples-1) * opts.Interval
+	if duration > maxStatsSampleDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "sampling takes %s, which is longer than the maximum of %s", duration, maxStatsSampleDuration)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if remaining := time.Until(deadline) - statsSampleRequestMargin; duration > remaining {
+			return nil, status.Errorf(codes.InvalidArgument, "sampling takes %s, which is longer than the %s left before the request times out", duration, remaining.Truncate(time.Millisecond))
+		}
+	}
+
+	defer c.httpClient.CloseIdleConnections()
+	_, httpClient, baseURL, err := c.resolveAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*statsSnapshot, 0, samples)
+	for i := 0; i < samples; i++ {
+		if i > 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(opts.Interval):
+			}
+		}
+
+		start := time.Now()
+		values, err := scalarStats(ctx, httpClient, baseURL, "Counters", filter)
+		if err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, &statsSnapshot{time: start, values: values})
+	}
+
+	// Gauges and histograms are only reported at the end of the sample.
+	gauges, err := scalarStats(ctx, httpClient, baseURL, "Gauges", filter)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := makeRequest(ctx, httpClient, baseURL, statsPath("Histograms", opts.Filter, true))
+	if err != nil {
+		return nil, err
+	}
+	histograms, err := histogramsFromJSONResponse(resp, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &envoytriagev1.StatsSample{
+		Start:      timestamppb.New(snapshots[0].time),
+		End:        timestamppb.New(snapshots[len(snapshots)-1].time),
+		Counters:   counterRates(snapshots),
+		Gauges:     gaugesByValue(gauges),
+		Histograms: histograms,
+	}
+	if opts.TopN > 0 {
+		if len(ret.Counters) > opts.TopN {
+			ret.Counters = ret.Counters[:opts.TopN]
+		}
+		if len(ret.Gauges) > opts.TopN {
+			ret.Gauges = ret.Gauges[:opts.TopN]
+		}
+	}
+
+	return ret, nil
+}
+
+func statsPath(statType string, filter string, jsonFormat bool) string {
+	values := url.Values{}
+	if jsonFormat {
+		values.Set("format", "json")
+	}
+	values.Set("type", statType)
+	if filter != "" {
+		values.Set("filter", filter)
+	}
+	return "/stats?" + values.Encode()
+}
+
+// Returns the scalar stats of the given type that match the filter. The filter is also applied by the server, it is
+// applied again in case the server does not support filtering.
+func scalarStats(ctx context.Context, httpClient *http.Client, baseURL string, statType string, filter *regexp.Regexp) (map[string]uint64, error) {
+	filterString := ""
+	if filter != nil {
+		filterString = filter.String()
+	}
+	resp, err := makeRequest(ctx, httpClient, baseURL, statsPath(statType, filterString, false))
+	if err != nil {
+		return nil, err
+	}
+	stats, err := statsFromResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]uint64, len(stats.Stats))
+	for _, stat := range stats.Stats {
+		if filter != nil && !filter.MatchString(stat.Key) {
+			continue
+		}
+		values[stat.Key] = stat.Value
+	}
+	return values, nil
+}
+
+// Returns the histograms of a /stats?format=json&type=Histograms response that match the filter.
+func histogramsFromJSONResponse(resp []byte, filter *regexp.Regexp) ([]*envoytriagev1.StatsSample_Histogram, error) {
+	s := &jsonStats{}
+	if err := json.Unmarshal(resp, s); err != nil {
+		return nil, err
+	}
+
+	var histograms []*envoytriagev1.StatsSample_Histogram
+	for _, stat := range s.Stats {
+		if stat.Histograms != nil {
+			histograms = append(histograms, histogramsFromJSON(stat.Histograms, filter)...)
+		}
+	}
+
+	sort.Slice(histograms, func(i, j int) bool {
+		return histograms[i].Name < histograms[j].Name
+	})
+
+	return histograms, nil
+}
+
+func histogramsFromJSON(h *jsonHistograms, filter *regexp.Regexp) []*envoytriagev1.StatsSample_Histogram {
+	var ret []*envoytriagev1.StatsSample_Histogram
+	for _, cq := range h.ComputedQuantiles {
+		if filter != nil && !filter.MatchString(cq.Name) {
+			continue
+		}
+
+		histogram := &envoytriagev1.StatsSample_Histogram{Name: cq.Name}
+		// Values are ordered by the supported quantiles.
+		for idx, v := range cq.Values {
+			if idx >= len(h.SupportedQuantiles) {
+				break
+			}
+
+			q := &envoytriagev1.StatsSample_Histogram_Quantile{Quantile: h.SupportedQuantiles[idx]}
+			if v.Interval != nil {
+				q.Interval = wrapperspb.Double(*v.Interval)
+			}
+			if v.Cumulative != nil {
+				q.Cumulative = wrapperspb.Double(*v.Cumulative)
+			}
+			histogram.Quantiles = append(histogram.Quantiles, q)
+		}
+		ret = append(ret, histogram)
+	}
+	return ret
+}
+
+// Computes the rate of each counter from the first to the last snapshot, ordered by rate with the highest first.
+// Counters missing from a snapshot, e.g. because the stat was created during the sample, start from zero.
+func counterRates(snapshots []*statsSnapshot) []*envoytriagev1.StatsSample_Counter {
+	if len(snapshots) == 0 {
+		return nil
+	}
+
+	last := snapshots[len(snapshots)-1]
+	elapsed := last.time.Sub(snapshots[0].time).Seconds()
+
+	ret := make([]*envoytriagev1.StatsSample_Counter, 0, len(last.values))
+	for key, value := range last.values {
+		var delta uint64
+		for i := 1; i < len(snapshots); i++ {
+			prev, cur := snapshots[i-1].values[key], snapshots[i].values[key]
+			if cur < prev {
+				// The counter was reset, e.g. by a hot restart.
+				delta += cur
+			} else {
+				delta += cur - prev
+			}
+		}
+
+		counter := &envoytriagev1.StatsSample_Counter{Key: key, Value: value, Delta: delta}
+		if elapsed > 0 {
+			counter.RatePerSecond = float64(delta) / elapsed
+		}
+		ret = append(ret, counter)
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].RatePerSecond != ret[j].RatePerSecond {
+			return ret[i].RatePerSecond > ret[j].RatePerSecond
+		}
+		return ret[i].Key < ret[j].Key
+	})
+	return ret
+}
+
+func gaugesByValue(values map[string]uint64) []*envoytriagev1.StatsSample_Gauge {
+	ret := make([]*envoytriagev1.StatsSample_Gauge, 0, len(values))
+	for key, value := range values {
+		ret = append(ret, &envoytriagev1.StatsSample_Gauge{Key: key, Value: value})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Value != ret[j].Value {
+			return ret[i].Value > ret[j].Value
+		}
+		return ret[i].Key < ret[j].Key
+	})
+	return ret
+}
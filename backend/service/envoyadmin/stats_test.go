@@ -0,0 +1,142 @@
+package envoyadmin
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	envoytriagev1 "github.com/lyft/clutch/backend/api/envoytriage/v1"
+)
+
+const histogramsResponse = `{"stats": [{"histograms": {
+  "supported_quantiles": [50, 99],
+  "computed_quantiles": [
+    {"name": "cluster.upstream.upstream_rq_time", "values": [{"interval": 1.5, "cumulative": 2.5}, {"interval": null, "cumulative": 10}]},
+    {"name": "cluster.other.upstream_rq_time", "values": [{"interval": null, "cumulative": null}, {"interval": null, "cumulative": null}]},
+    {"name": "http.ingress.downstream_rq_time", "values": [{"interval": 1, "cumulative": 1}, {"interval": 1, "cumulative": 1}]}
+  ]
+}}]}`
+
+func TestHistogramsFromJSONResponse(t *testing.T) {
+	histograms, err := histogramsFromJSONResponse([]byte(histogramsResponse), regexp.MustCompile(`^cluster\.`))
+	assert.NoError(t, err)
+	assert.Len(t, histograms, 2)
+	assert.Equal(t, "cluster.other.upstream_rq_time", histograms[0].Name)
+	assert.Nil(t, histograms[0].Quantiles[0].Interval)
+	assert.Nil(t, histograms[0].Quantiles[0].Cumulative)
+	assert.Equal(t, "cluster.upstream.upstream_rq_time", histograms[1].Name)
+	assert.Equal(t, 50.0, histograms[1].Quantiles[0].Quantile)
+	assert.Equal(t, 1.5, histograms[1].Quantiles[0].Interval.GetValue())
+	assert.Equal(t, 2.5, histograms[1].Quantiles[0].Cumulative.GetValue())
+	assert.Equal(t, 99.0, histograms[1].Quantiles[1].Quantile)
+	assert.Nil(t, histograms[1].Quantiles[1].Interval)
+	assert.Equal(t, 10.0, histograms[1].Quantiles[1].Cumulative.GetValue())
+
+	_, err = histogramsFromJSONResponse([]byte("not json"), nil)
+	assert.Error(t, err)
+}
+
+func TestCounterRates(t *testing.T) {
+	start := time.Now()
+	snapshots := []*statsSnapshot{
+		{time: start, values: map[string]uint64{"rq_total": 100, "rq_5xx": 10, "idle": 5}},
+		{time: start.Add(5 * time.Second), values: map[string]uint64{"rq_total": 150, "rq_5xx": 20, "idle": 5, "new": 4}},
+		// The server was hot restarted.
+		{time: start.Add(10 * time.Second), values: map[string]uint64{"rq_total": 30, "rq_5xx": 25, "idle": 5, "new": 6}},
+	}
+
+	counters := counterRates(snapshots)
+	assert.Equal(t, []*envoytriagev1.StatsSample_Counter{
+		{Key: "rq_total", Value: 30, Delta: 80, RatePerSecond: 8},
+		{Key: "rq_5xx", Value: 25, Delta: 15, RatePerSecond: 1.5},
+		{Key: "new", Value: 6, Delta: 6, RatePerSecond: 0.6},
+		{Key: "idle", Value: 5, Delta: 0, RatePerSecond: 0},
+	}, counters)
+
+	assert.Nil(t, counterRates(nil))
+}
+
+func TestSampleStats(t *testing.T) {
+	var counterRequests int
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/stats", req.URL.Path)
+		assert.Equal(t, "upstream", req.URL.Query().Get("filter"))
+
+		switch req.URL.Query().Get("type") {
+		case "Counters":
+			assert.Empty(t, req.URL.Query().Get("format"))
+			counterRequests++
+			if counterRequests == 1 {
+				return okResponse(req, "cluster.upstream.upstream_rq_5xx: 1\ncluster.upstream.upstream_rq_2xx: 10\nhttp.ingress.downstream_rq_total: 7\n"), nil
+			}
+			return okResponse(req, "cluster.upstream.upstream_rq_5xx: 5\ncluster.upstream.upstream_rq_2xx: 12\nhttp.ingress.downstream_rq_total: 9\n"), nil
+		case "Gauges":
+			assert.Empty(t, req.URL.Query().Get("format"))
+			return okResponse(req, "cluster.upstream.membership_total: 3\ncluster.upstream.membership_healthy: 2\n"), nil
+		case "Histograms":
+			assert.Equal(t, "json", req.URL.Query().Get("format"))
+			return okResponse(req, histogramsResponse), nil
+		}
+		return nil, assert.AnError
+	})
+	c := &client{defaultPort: 9901, httpClient: &http.Client{Transport: transport}}
+
+	sample, err := c.SampleStats(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}, StatsSampleOptions{
+		Interval: 10 * time.Millisecond,
+		Filter:   "upstream",
+		TopN:     1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, counterRequests)
+	assert.True(t, sample.End.AsTime().After(sample.Start.AsTime()))
+
+	assert.Len(t, sample.Counters, 1)
+	assert.Equal(t, "cluster.upstream.upstream_rq_5xx", sample.Counters[0].Key)
+	assert.Equal(t, uint64(4), sample.Counters[0].Delta)
+	assert.Greater(t, sample.Counters[0].RatePerSecond, 0.0)
+
+	assert.Equal(t, []*envoytriagev1.StatsSample_Gauge{{Key: "cluster.upstream.membership_total", Value: 3}}, sample.Gauges)
+
+	// Histograms are only filtered, not limited.
+	assert.Len(t, sample.Histograms, 2)
+	assert.Equal(t, "cluster.other.upstream_rq_time", sample.Histograms[0].Name)
+}
+
+func TestSampleStatsInvalidFilter(t *testing.T) {
+	c := &client{defaultPort: 9901, httpClient: &http.Client{}}
+
+	_, err := c.SampleStats(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}, StatsSampleOptions{Filter: "("})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSampleStatsDuration(t *testing.T) {
+	c := &client{defaultPort: 9901, httpClient: &http.Client{}}
+
+	// The sample can't take longer than the maximum.
+	_, err := c.SampleStats(context.Background(), &envoytriagev1.Address{Host: "10.0.0.1"}, StatsSampleOptions{Interval: time.Minute, Samples: 3})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// The sample must end before the request times out.
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	_, err = c.SampleStats(ctx, &envoytriagev1.Address{Host: "10.0.0.1"}, StatsSampleOptions{Interval: 5 * time.Second, Samples: 4})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestSampleStatsCancelled(t *testing.T) {
+	transport := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		return okResponse(req, ""), nil
+	})
+	c := &client{defaultPort: 9901, httpClient: &http.Client{Transport: transport}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+	_, err := c.SampleStats(ctx, &envoytriagev1.Address{Host: "10.0.0.1"}, StatsSampleOptions{Interval: 30 * time.Second, Samples: 3})
+	assert.ErrorIs(t, err, context.Canceled)
+}
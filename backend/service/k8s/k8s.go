@@ -6,12 +6,14 @@ package k8s
 
 import (
 	"context"
+	"net/http"
 
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/uber-go/tally/v4"
 	"go.uber.org/zap"
 	"golang.org/x/sync/semaphore"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/oidc"
 	"k8s.io/client-go/tools/clientcmd"
 
@@ -60,6 +62,11 @@ type Service interface {
 	ListPods(ctx context.Context, clientset, cluster, namespace string, listOptions *k8sapiv1.ListOptions) ([]*k8sapiv1.Pod, error)
 	UpdatePod(ctx context.Context, clientset, cluster, namespace, name string, expected_object_meta_fields *k8sapiv1.ExpectedObjectMetaFields, object_meta_fields *k8sapiv1.ObjectMetaFields, remove_object_meta_fields *k8sapiv1.RemoveObjectMetaFields) error
 	GetPodLogs(ctx context.Context, clientset, cluster, namespace, name string, opts *k8sapiv1.PodLogsOptions) (*k8sapiv1.GetPodLogsResponse, error)
+	// PodProxy returns an HTTP client and the base URL of a pod port through the proxy subresource of the API server,
+	// which reaches pods that are not routable from Clutch.
+	PodProxy(ctx context.Context, clientset, cluster, namespace, name string, port uint32) (*http.Client, string, error)
+	// AddEphemeralContainer adds an ephemeral container to a running pod. Ephemeral containers can't be removed again.
+	AddEphemeralContainer(ctx context.Context, clientset, cluster, namespace, name string, container *corev1.EphemeralContainer) error
 
 	// HPA management functions.
 	DescribeHPA(ctx context.Context, clientset, cluster, namespace, name string) (*k8sapiv1.HPA, error)
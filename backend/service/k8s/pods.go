@@ -43,6 +43,28 @@ func (s *svc) DeletePod(ctx context.Context, clientset, cluster, namespace, name
 	return cs.CoreV1().Pods(cs.Namespace()).Delete(ctx, name, metav1.DeleteOptions{})
 }
 
+func (s *svc) AddEphemeralContainer(ctx context.Context, clientset, cluster, namespace, name string, container *corev1.EphemeralContainer) error {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return err
+	}
+
+	pod, err := cs.CoreV1().Pods(cs.Namespace()).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range pod.Spec.EphemeralContainers {
+		if c.Name == container.Name {
+			return status.Errorf(codes.AlreadyExists, "pod already has an ephemeral container named '%s'", container.Name)
+		}
+	}
+
+	pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, *container)
+	_, err = cs.CoreV1().Pods(cs.Namespace()).UpdateEphemeralContainers(ctx, name, pod, metav1.UpdateOptions{})
+	return err
+}
+
 func (s *svc) ListPods(ctx context.Context, clientset, cluster, namespace string, listOpts *k8sapiv1.ListOptions) ([]*k8sapiv1.Pod, error) {
 	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
 	if err != nil {
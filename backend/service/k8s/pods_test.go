@@ -6,6 +6,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -221,6 +223,40 @@ func TestListPods(t *testing.T) {
 	assert.Len(t, result, 2)
 }
 
+func TestAddEphemeralContainer(t *testing.T) {
+	t.Parallel()
+
+	cs := testPodClientset()
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{"testing-clientset": {
+				Interface: cs,
+				namespace: "testing-namespace",
+				cluster:   "testing-cluster",
+			}},
+		},
+	}
+
+	container := &corev1.EphemeralContainer{
+		EphemeralContainerCommon: corev1.EphemeralContainerCommon{Name: "stress", Image: "stress-ng"},
+	}
+	err := s.AddEphemeralContainer(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-pod-name", container)
+	assert.NoError(t, err)
+
+	pod, err := cs.CoreV1().Pods("testing-namespace").Get(context.Background(), "testing-pod-name", metav1.GetOptions{})
+	assert.NoError(t, err)
+	assert.Len(t, pod.Spec.EphemeralContainers, 1)
+	assert.Equal(t, "stress", pod.Spec.EphemeralContainers[0].Name)
+
+	// Container names are unique within a pod.
+	err = s.AddEphemeralContainer(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "testing-pod-name", container)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	// Not found.
+	err = s.AddEphemeralContainer(context.Background(), "testing-clientset", "testing-cluster", "testing-namespace", "unknown-pod", container)
+	assert.Error(t, err)
+}
+
 func TestPodDescription(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,33 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/rest"
+)
+
+func (s *svc) PodProxy(ctx context.Context, clientset, cluster, namespace, name string, port uint32) (*http.Client, string, error) {
+	cs, err := s.manager.GetK8sClientset(ctx, clientset, cluster, namespace)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// The HTTP client of the REST client carries the credentials of the clientset.
+	rc, ok := cs.CoreV1().RESTClient().(*rest.RESTClient)
+	if !ok || rc == nil || rc.Client == nil {
+		return nil, "", status.Errorf(codes.Unimplemented, "clientset '%s' does not support proxying to pods", clientset)
+	}
+
+	u := rc.Get().
+		Namespace(cs.Namespace()).
+		Resource("pods").
+		Name(fmt.Sprintf("%s:%d", name, port)).
+		SubResource("proxy").
+		URL()
+	return rc.Client, strings.TrimSuffix(u.String(), "/"), nil
+}
@@ -0,0 +1,46 @@
+package k8s
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+func TestPodProxy(t *testing.T) {
+	cs, err := kubernetes.NewForConfig(&rest.Config{Host: "https://apiserver.example.com"})
+	assert.NoError(t, err)
+
+	s := &svc{
+		manager: &managerImpl{
+			clientsets: map[string]*ctxClientsetImpl{
+				"foo": {
+					Interface: cs,
+					namespace: "default",
+					cluster:   "core-testing",
+				},
+				"fake": {
+					Interface: testPodClientset(),
+					namespace: "default",
+					cluster:   "fake-testing",
+				},
+			},
+		},
+	}
+
+	httpClient, baseURL, err := s.PodProxy(context.Background(), "foo", "core-testing", "testing-namespace", "envoy-pod", 9901)
+	assert.NoError(t, err)
+	assert.NotNil(t, httpClient)
+	assert.Equal(t, "https://apiserver.example.com/api/v1/namespaces/testing-namespace/pods/envoy-pod:9901/proxy", baseURL)
+
+	_, _, err = s.PodProxy(context.Background(), "foo", "other-cluster", "testing-namespace", "envoy-pod", 9901)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// Fake clientsets have no REST client.
+	_, _, err = s.PodProxy(context.Background(), "fake", "fake-testing", "testing-namespace", "envoy-pod", 9901)
+	assert.Equal(t, codes.Unimplemented, status.Code(err))
+}